@@ -0,0 +1,15 @@
+package auth
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/auth/discord/dashboard"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/auth/discord/invite"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+)
+
+// Commands returns the application commands contributed by the auth module.
+func Commands() []interactions.CommandSpec {
+	return []interactions.CommandSpec{
+		dashboard.CommandSpec(),
+		invite.CommandSpec(),
+	}
+}
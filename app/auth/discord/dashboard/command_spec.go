@@ -0,0 +1,18 @@
+package dashboard
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /dashboard command.
+func CommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "dashboard",
+			Description: "Get a link to access the Frolf PWA dashboard",
+		},
+		RequiredPermission: interactions.PlayerRequired,
+		RequiresSetup:      true,
+	}
+}
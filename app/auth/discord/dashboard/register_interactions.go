@@ -12,7 +12,7 @@ import (
 // RegisterHandlers registers dashboard interaction handlers
 func RegisterHandlers(registry *interactions.Registry, manager DashboardManager) {
 	// /dashboard requires player role and guild setup
-	registry.RegisterHandlerWithPermissions("dashboard", func(ctx context.Context, i *discordgo.InteractionCreate) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling /dashboard command",
 			attr.String("user_id", i.Member.User.ID),
 			attr.String("guild_id", i.GuildID),
@@ -20,5 +20,5 @@ func RegisterHandlers(registry *interactions.Registry, manager DashboardManager)
 		if err := manager.HandleDashboardCommand(ctx, i); err != nil {
 			slog.Error("Failed to handle dashboard command", attr.Error(err))
 		}
-	}, interactions.PlayerRequired, true)
+	})
 }
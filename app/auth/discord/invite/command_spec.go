@@ -0,0 +1,18 @@
+package invite
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /invite command (Editor role or higher).
+func CommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "invite",
+			Description: "Get a link to manage club invites (Editor/Admin only)",
+		},
+		RequiredPermission: interactions.EditorRequired,
+		RequiresSetup:      true,
+	}
+}
@@ -11,11 +11,11 @@ import (
 
 // RegisterHandlers registers the /invite slash command handler.
 func RegisterHandlers(registry *interactions.Registry, manager InviteManager) {
-	registry.RegisterHandlerWithPermissions("invite", func(ctx context.Context, i *discordgo.InteractionCreate) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling /invite command",
 			attr.String("user_id", i.Member.User.ID),
 			attr.String("guild_id", i.GuildID),
 		)
 		manager.HandleInviteCommand(ctx, i)
-	}, interactions.EditorRequired, true)
+	})
 }
@@ -0,0 +1,13 @@
+package betting
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/betting/discord/bet"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+)
+
+// Commands returns the application commands contributed by the betting module.
+func Commands() []interactions.CommandSpec {
+	return []interactions.CommandSpec{
+		bet.CommandSpec(),
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"log/slog"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
 	"github.com/bwmarrin/discordgo"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -49,3 +51,14 @@ func BetCommand() *discordgo.ApplicationCommand {
 		Description: "Access the seasonal betting module for this club",
 	}
 }
+
+// CommandSpec returns the manifest entry for /bet, gated on the betting feature.
+func CommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command:            BetCommand(),
+		RequiredPermission: interactions.PlayerRequired, // Any player can use /bet
+		RequiresSetup:      true,
+		RequiredFeature:    guildtypes.ClubFeatureBetting,
+		IsMutating:         false, // read-only; allows viewing link even if frozen
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/club"
 	clubrouter "github.com/Black-And-White-Club/discord-frolf-bot/app/club/router"
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/gatewaybridge"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild"
 	guildrouter "github.com/Black-And-White-Club/discord-frolf-bot/app/guild/router"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
@@ -41,6 +42,7 @@ import (
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/bwmarrin/discordgo"
+	"github.com/nats-io/nats.go"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
@@ -56,6 +58,9 @@ type DiscordBot struct {
 	Helper   utils.Helpers
 	Tracer   trace.Tracer
 
+	// Mode selects which parts of the bot this process runs.
+	Mode RuntimeMode
+
 	// Multi-tenant config resolver for per-guild config
 	GuildConfigResolver guildconfig.GuildConfigResolver
 
@@ -86,6 +91,7 @@ type DiscordBot struct {
 	handlerWg sync.WaitGroup
 
 	// Command reconciliation (startup)
+	commandManifest        *interactions.CommandManifest
 	commandRegistrar       func(discord.Session, *slog.Logger, string) error
 	commandSyncDelay       time.Duration
 	commandSyncWorkers     int
@@ -94,10 +100,20 @@ type DiscordBot struct {
 	commandSyncState       sync.Map // guildID -> manifest version
 	commandSyncRetries     sync.Map // guildID -> struct{}
 
-	gatewayStateMu       sync.RWMutex
-	gatewaySessionID     string
-	gatewayGuildCount    int
-	gatewayEverConnected bool
+	// Gateway sharding. shardStates is keyed by shard ID: standalone and
+	// gateway processes track their own shard, workers track every shard that
+	// has forwarded a Ready.
+	shardID        int
+	shardCount     int
+	gatewayStateMu sync.RWMutex
+	shardStates    map[int]*ShardState
+
+	// Worker mode: forwarded gateway events are dispatched here
+	dispatcher       *gatewaybridge.Dispatcher
+	workerQueueGroup string
+	workerSubsMu     sync.Mutex
+	workerSubs       []*nats.Subscription
+	workerLease      *workerLease
 }
 
 const (
@@ -185,6 +201,20 @@ func NewDiscordBot(
 	eventBusMetrics eventbusmetrics.EventBusMetrics,
 	tracer trace.Tracer,
 	helper utils.Helpers,
+) (*DiscordBot, error) {
+	return newDiscordBot(RuntimeModeStandalone, session, cfg, logger, appStores, discordMetrics, eventBusMetrics, tracer, helper)
+}
+
+func newDiscordBot(
+	mode RuntimeMode,
+	session discord.Session,
+	cfg *config.Config,
+	logger *slog.Logger,
+	appStores *storage.Stores,
+	discordMetrics discordmetrics.DiscordMetrics,
+	eventBusMetrics eventbusmetrics.EventBusMetrics,
+	tracer trace.Tracer,
+	helper utils.Helpers,
 ) (*DiscordBot, error) {
 	if session == nil {
 		return nil, fmt.Errorf("discord session is required")
@@ -205,7 +235,12 @@ func NewDiscordBot(
 		return nil, fmt.Errorf("interaction store is required")
 	}
 
-	logger.Info("Creating DiscordBot instance")
+	logger.Info("Creating DiscordBot instance", attr.String("mode", string(mode)))
+
+	commandManifest, err := newCommandManifest(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build command manifest: %w", err)
+	}
 
 	ctx := context.Background()
 
@@ -213,7 +248,7 @@ func NewDiscordBot(
 		ctx,
 		cfg.NATS.URL,
 		logger,
-		"discord",
+		mode.eventBusServiceName(),
 		eventBusMetrics,
 		tracer,
 	)
@@ -269,7 +304,7 @@ func NewDiscordBot(
 		return nil, fmt.Errorf("failed to create auth router: %w", err)
 	}
 
-	return &DiscordBot{
+	bot := &DiscordBot{
 		Session:                    session,
 		Logger:                     logger,
 		Config:                     cfg,
@@ -278,6 +313,9 @@ func NewDiscordBot(
 		Metrics:                    discordMetrics,
 		Helper:                     helper,
 		Tracer:                     tracer,
+		Mode:                       mode,
+		shardID:                    cfg.Discord.ShardID,
+		shardCount:                 max(cfg.Discord.ShardCount, 1),
 		GuildConfigResolver:        guildConfigResolver,
 		UserWatermillRouter:        userRouter,
 		RoundWatermillRouter:       roundRouter,
@@ -286,33 +324,57 @@ func NewDiscordBot(
 		LeaderboardWatermillRouter: leaderboardRouter,
 		GuildWatermillRouter:       guildRouter,
 		AuthWatermillRouter:        authRouter,
-		commandRegistrar:           discord.RegisterCommands,
+		commandManifest:            commandManifest,
 		commandSyncDelay:           commandSyncDelayFromEnv(logger),
 		commandSyncWorkers:         commandSyncWorkersFromEnv(logger),
 		commandSyncRetryDelay:      commandSyncRetryDelayFromEnv(logger),
-		commandManifestVersion:     discord.GuildCommandManifestVersion(),
-	}, nil
-}
+		commandManifestVersion:     commandManifest.Version(),
+	}
+	bot.commandRegistrar = bot.registerManifestCommands
 
-func (bot *DiscordBot) setGatewayContext(sessionID string, guildCount int) {
-	bot.gatewayStateMu.Lock()
-	bot.gatewaySessionID = sessionID
-	bot.gatewayGuildCount = guildCount
-	bot.gatewayStateMu.Unlock()
+	return bot, nil
 }
 
-func (bot *DiscordBot) gatewayContext() (string, int) {
-	bot.gatewayStateMu.RLock()
-	defer bot.gatewayStateMu.RUnlock()
-	return bot.gatewaySessionID, bot.gatewayGuildCount
+// newCommandManifest collects the application commands every module
+// contributes, registering those of unreleased backend features only once cfg
+// releases them.
+func newCommandManifest(cfg *config.Config) (*interactions.CommandManifest, error) {
+	manifest, err := interactions.NewCommandManifest(
+		guild.Commands(),
+		user.Commands(),
+		round.Commands(),
+		club.Commands(),
+		leaderboard.Commands(),
+		auth.Commands(),
+		betting.Commands(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return manifest.WithBackendFeatures(cfg.BackendFeatures)
 }
 
-func (bot *DiscordBot) markGatewayConnected() bool {
-	bot.gatewayStateMu.Lock()
-	defer bot.gatewayStateMu.Unlock()
-	reconnect := bot.gatewayEverConnected
-	bot.gatewayEverConnected = true
-	return reconnect
+// registerManifestCommands reconciles guildID (global when empty) against the
+// manifest commands for that scope. Guild commands are filtered by the club's
+// entitlements so feature-gated commands only appear where they can be used.
+func (bot *DiscordBot) registerManifestCommands(s discord.Session, logger *slog.Logger, guildID string) error {
+	if bot.commandManifest == nil {
+		return fmt.Errorf("command manifest not configured")
+	}
+	if guildID == "" {
+		return discord.RegisterCommands(s, logger, "", bot.commandManifest.Commands(""))
+	}
+
+	var guildConfig *storage.GuildConfig
+	if bot.GuildConfigResolver != nil {
+		cfg, err := bot.GuildConfigResolver.GetGuildConfigWithContext(context.Background(), guildID)
+		if err != nil {
+			return fmt.Errorf("failed to resolve guild config for command sync: %w", err)
+		}
+		guildConfig = cfg
+	}
+
+	return discord.RegisterCommands(s, logger, guildID, bot.commandManifest.GuildCommands(guildConfig))
 }
 
 func (bot *DiscordBot) registerGatewayLifecycleHandlers() {
@@ -329,6 +391,7 @@ func (bot *DiscordBot) registerGatewayLifecycleHandlers() {
 		}
 
 		bot.Logger.InfoContext(ctx, "Discord gateway connected",
+			attr.Int("shard_id", bot.shardID),
 			attr.Bool("reconnect", reconnect),
 			attr.String("session_id", sessionID),
 			attr.Int("guild_count", guildCount))
@@ -336,6 +399,7 @@ func (bot *DiscordBot) registerGatewayLifecycleHandlers() {
 
 	bot.Session.AddHandler(func(s *discordgo.Session, event *discordgo.Disconnect) {
 		ctx := context.Background()
+		bot.markGatewayDisconnected()
 		sessionID, guildCount := bot.gatewayContext()
 
 		if bot.Metrics != nil {
@@ -343,6 +407,7 @@ func (bot *DiscordBot) registerGatewayLifecycleHandlers() {
 		}
 
 		bot.Logger.WarnContext(ctx, "Discord gateway disconnected",
+			attr.Int("shard_id", bot.shardID),
 			attr.String("reason", "gateway_disconnect"),
 			attr.String("session_id", sessionID),
 			attr.Int("guild_count", guildCount))
@@ -358,6 +423,7 @@ func (bot *DiscordBot) registerGatewayLifecycleHandlers() {
 		}
 
 		bot.Logger.InfoContext(ctx, "Discord gateway resumed session",
+			attr.Int("shard_id", bot.shardID),
 			attr.String("session_id", sessionID),
 			attr.Int("guild_count", guildCount))
 	})
@@ -366,28 +432,15 @@ func (bot *DiscordBot) registerGatewayLifecycleHandlers() {
 func (bot *DiscordBot) Run(ctx context.Context) error {
 	bot.Logger.Info("Starting Discord bot initialization")
 
-	var commandSyncOnce sync.Once
-	routerErrCh := make(chan error, 7)
-	reportFatalRouterError := func(name string, err error) {
-		if err == nil || errors.Is(err, context.Canceled) {
-			return
-		}
-		wrapped := fmt.Errorf("%s Watermill router failed: %w", name, err)
-		bot.Logger.Error(wrapped.Error(), attr.Error(err))
-		select {
-		case routerErrCh <- wrapped:
-		default:
-		}
+	switch bot.Mode {
+	case RuntimeModeGateway:
+		return bot.runGateway(ctx)
+	case RuntimeModeWorker:
+		return bot.runWorker(ctx)
 	}
 
-	startRouter := func(name string, router *message.Router) {
-		go func() {
-			bot.Logger.Info(fmt.Sprintf("Starting %s Watermill router", name))
-			if err := router.Run(ctx); err != nil {
-				reportFatalRouterError(name, err)
-			}
-		}()
-	}
+	var commandSyncOnce sync.Once
+	startRouter, routerErrCh := bot.routerStarter(ctx)
 
 	// Setup interaction registries
 	registry := interactions.NewRegistry()
@@ -402,7 +455,97 @@ func (bot *DiscordBot) Run(ctx context.Context) error {
 	messageRegistry := interactions.NewMessageRegistry(bot.Logger)
 	messageRegistry.RegisterWithSession(bot.Session, bot.Session)
 
-	// Initialize modules
+	if err := bot.initializeModules(ctx, registry, reactionRegistry, messageRegistry); err != nil {
+		return err
+	}
+
+	// Start guild router - MUST be running before Discord session opens
+	// to ensure we can receive guild config retrieval responses
+	startRouter("Guild", bot.GuildWatermillRouter)
+
+	// Wait for guild router to be fully running before proceeding
+	// This ensures the subscription for guild.config.retrieved.v1 is established
+	// before we open Discord session and trigger config retrieval requests
+	bot.Logger.Info("Waiting for Guild Watermill router to be running...")
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-routerErrCh:
+		return err
+	case <-bot.GuildWatermillRouter.Running():
+	}
+	bot.Logger.Info("Guild Watermill router is now running")
+
+	// Multi-tenant deployment: register all commands globally with proper gating
+	// Setup command is admin-gated, other commands are setup-completion-gated
+	bot.Logger.Info("Registering all commands globally for multi-tenant deployment")
+	if err := bot.syncGlobalCommands(); err != nil {
+		return fmt.Errorf("failed to register global commands with Discord: %w", err)
+	}
+
+	// Register Discord handlers
+	bot.Session.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+		bot.Logger.Info("Handling interaction", attr.String("type", i.Type.String()))
+		registry.HandleInteraction(s, i)
+	})
+
+	bot.Session.AddHandler(func(s *discordgo.Session, r *discordgo.Ready) {
+		bot.setGatewayContext(r.SessionID, len(r.Guilds))
+		if bot.Metrics != nil {
+			bot.Metrics.RecordWebsocketEvent(ctx, "ready")
+		}
+
+		bot.Logger.Info("Bot is ready", attr.Int("guilds", len(r.Guilds)))
+		bot.warmGuildConfigurations(ctx, r.Guilds)
+
+		// Reconcile NativeEventMap from active Guild Scheduled Events (post-restart).
+		// This ensures RSVP gateway listeners can resolve events immediately.
+		// Note: Orphaned native event cleanup (CleanupOrphanedNativeEvents) is available
+		// but not run on startup — the NativeEventMap is freshly populated and cannot
+		// distinguish orphans without a backend round-existence check. Orphaned events
+		// expire naturally via their ScheduledEndTime for V1.
+		go gateway.ReconcileNativeEventMap(bot.Session, bot.NativeEventMap, r.Guilds, bot.Logger)
+
+		commandSyncOnce.Do(func() {
+			go bot.syncGuildCommands(ctx, r.Guilds)
+		})
+	})
+
+	bot.registerGatewayLifecycleHandlers()
+
+	// Handle guild lifecycle events for multi-tenant support
+	bot.registerGuildLifecycleHandlers(ctx)
+
+	// Start the Watermill routers
+	startRouter("User", bot.UserWatermillRouter)
+	startRouter("Round", bot.RoundWatermillRouter)
+	startRouter("Score", bot.ScoreWatermillRouter)
+	startRouter("Club", bot.ClubWatermillRouter)
+	startRouter("Leaderboard", bot.LeaderboardWatermillRouter)
+	startRouter("Auth", bot.AuthWatermillRouter)
+
+	// Open Discord connection
+	if err := bot.Session.Open(); err != nil {
+		return fmt.Errorf("failed to open Discord session: %w", err)
+	}
+
+	bot.Logger.Info("Discord bot is now running")
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-routerErrCh:
+		return err
+	}
+}
+
+// initializeModules wires every domain module into the shared registries.
+// Standalone and worker modes both run the full module set.
+func (bot *DiscordBot) initializeModules(
+	ctx context.Context,
+	registry *interactions.Registry,
+	reactionRegistry *interactions.ReactionRegistry,
+	messageRegistry *interactions.MessageRegistry,
+) error {
 	var err error
 	bot.UserRouter, err = user.InitializeUserModule(
 		ctx,
@@ -508,6 +651,7 @@ func (bot *DiscordBot) Run(ctx context.Context) error {
 		bot.Metrics,
 		bot.GuildConfigResolver,
 		bot.UserRouter.GetSignupManager(),
+		bot.commandManifest,
 	)
 	if err != nil {
 		return fmt.Errorf("guild module initialization failed: %w", err)
@@ -545,70 +689,62 @@ func (bot *DiscordBot) Run(ctx context.Context) error {
 		return fmt.Errorf("betting module initialization failed: %w", err)
 	}
 
-	// Start guild router - MUST be running before Discord session opens
-	// to ensure we can receive guild config retrieval responses
-	startRouter("Guild", bot.GuildWatermillRouter)
-
-	// Wait for guild router to be fully running before proceeding
-	// This ensures the subscription for guild.config.retrieved.v1 is established
-	// before we open Discord session and trigger config retrieval requests
-	bot.Logger.Info("Waiting for Guild Watermill router to be running...")
-	select {
-	case <-ctx.Done():
-		return nil
-	case err := <-routerErrCh:
-		return err
-	case <-bot.GuildWatermillRouter.Running():
+	if bot.commandManifest == nil {
+		return fmt.Errorf("command manifest not configured")
 	}
-	bot.Logger.Info("Guild Watermill router is now running")
-
-	// Multi-tenant deployment: register all commands globally with proper gating
-	// Setup command is admin-gated, other commands are setup-completion-gated
-	bot.Logger.Info("Registering all commands globally for multi-tenant deployment")
-	if err := discord.RegisterCommands(bot.Session, bot.Logger, ""); err != nil {
-		return fmt.Errorf("failed to register global commands with Discord: %w", err)
+	if err := bot.commandManifest.ValidateHandlers(registry); err != nil {
+		return fmt.Errorf("command manifest does not match registered handlers: %w", err)
 	}
 
-	// Register Discord handlers
-	bot.Session.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
-		bot.Logger.Info("Handling interaction", attr.String("type", i.Type.String()))
-		registry.HandleInteraction(s, i)
-	})
+	return nil
+}
 
-	bot.Session.AddHandler(func(s *discordgo.Session, r *discordgo.Ready) {
-		bot.setGatewayContext(r.SessionID, len(r.Guilds))
-		if bot.Metrics != nil {
-			bot.Metrics.RecordWebsocketEvent(ctx, "ready")
+// routerStarter returns a function that runs a Watermill router in the
+// background and a channel that receives the first fatal router error.
+func (bot *DiscordBot) routerStarter(ctx context.Context) (func(name string, router *message.Router), <-chan error) {
+	routerErrCh := make(chan error, 7)
+	reportFatalRouterError := func(name string, err error) {
+		if err == nil || errors.Is(err, context.Canceled) {
+			return
 		}
-
-		bot.Logger.Info("Bot is ready", attr.Int("guilds", len(r.Guilds)))
-		for _, g := range r.Guilds {
-			if g == nil || g.ID == "" {
-				continue
-			}
-			if err := bot.requestGuildConfiguration(ctx, g.ID, g.Name); err != nil {
-				bot.Logger.WarnContext(ctx, "Failed to warm guild configuration",
-					attr.String("guild_id", g.ID),
-					attr.Error(err))
-			}
+		wrapped := fmt.Errorf("%s Watermill router failed: %w", name, err)
+		bot.Logger.Error(wrapped.Error(), attr.Error(err))
+		select {
+		case routerErrCh <- wrapped:
+		default:
 		}
+	}
 
-		// Reconcile NativeEventMap from active Guild Scheduled Events (post-restart).
-		// This ensures RSVP gateway listeners can resolve events immediately.
-		// Note: Orphaned native event cleanup (CleanupOrphanedNativeEvents) is available
-		// but not run on startup — the NativeEventMap is freshly populated and cannot
-		// distinguish orphans without a backend round-existence check. Orphaned events
-		// expire naturally via their ScheduledEndTime for V1.
-		go gateway.ReconcileNativeEventMap(bot.Session, bot.NativeEventMap, r.Guilds, bot.Logger)
+	startRouter := func(name string, router *message.Router) {
+		go func() {
+			bot.Logger.Info(fmt.Sprintf("Starting %s Watermill router", name))
+			if err := router.Run(ctx); err != nil {
+				reportFatalRouterError(name, err)
+			}
+		}()
+	}
 
-		commandSyncOnce.Do(func() {
-			go bot.syncGuildCommands(ctx, r.Guilds)
-		})
-	})
+	return startRouter, routerErrCh
+}
 
-	bot.registerGatewayLifecycleHandlers()
+// warmGuildConfigurations requests config for every guild in a Ready payload.
+func (bot *DiscordBot) warmGuildConfigurations(ctx context.Context, guilds []*discordgo.Guild) {
+	for _, g := range guilds {
+		if g == nil || g.ID == "" {
+			continue
+		}
+		if err := bot.requestGuildConfiguration(ctx, g.ID, g.Name); err != nil {
+			bot.Logger.WarnContext(ctx, "Failed to warm guild configuration",
+				attr.String("guild_id", g.ID),
+				attr.Error(err))
+		}
+	}
+}
 
-	// Handle guild lifecycle events for multi-tenant support
+// registerGuildLifecycleHandlers wires GuildCreate/GuildDelete to config
+// retrieval and removal events. Only the process that owns the gateway
+// connection should call this.
+func (bot *DiscordBot) registerGuildLifecycleHandlers(ctx context.Context) {
 	bot.Session.AddHandler(func(s *discordgo.Session, event *discordgo.GuildCreate) {
 		bot.handlerWg.Add(1)
 		defer bot.handlerWg.Done()
@@ -657,27 +793,6 @@ func (bot *DiscordBot) Run(ctx context.Context) error {
 				attr.Error(err))
 		}
 	})
-
-	// Start the Watermill routers
-	startRouter("User", bot.UserWatermillRouter)
-	startRouter("Round", bot.RoundWatermillRouter)
-	startRouter("Score", bot.ScoreWatermillRouter)
-	startRouter("Club", bot.ClubWatermillRouter)
-	startRouter("Leaderboard", bot.LeaderboardWatermillRouter)
-	startRouter("Auth", bot.AuthWatermillRouter)
-
-	// Open Discord connection
-	if err := bot.Session.Open(); err != nil {
-		return fmt.Errorf("failed to open Discord session: %w", err)
-	}
-
-	bot.Logger.Info("Discord bot is now running")
-	select {
-	case <-ctx.Done():
-		return nil
-	case err := <-routerErrCh:
-		return err
-	}
 }
 
 func (bot *DiscordBot) syncGuildCommands(ctx context.Context, guilds []*discordgo.Guild) {
@@ -691,11 +806,13 @@ func (bot *DiscordBot) syncGuildCommands(ctx context.Context, guilds []*discordg
 	}
 
 	bot.Logger.InfoContext(ctx, "Syncing guild commands for existing guilds",
-		attr.Int("guilds", len(guilds)))
+		attr.Int("guilds", len(guilds)),
+		attr.Int("shard_id", bot.shardID),
+		attr.Int("shard_count", bot.shardCount))
 
 	registrar := bot.commandRegistrar
 	if registrar == nil {
-		registrar = discord.RegisterCommands
+		registrar = bot.registerManifestCommands
 	}
 
 	workers := bot.commandSyncWorkers
@@ -745,6 +862,16 @@ func (bot *DiscordBot) syncGuildCommands(ctx context.Context, guilds []*discordg
 					continue
 				}
 
+				// Each shard reconciles only the guilds Discord routes to it, so
+				// N gateway shards never race on the same guild's commands.
+				if !bot.ownsGuild(g.ID) {
+					bot.Logger.DebugContext(ctx, "Skipping command sync: guild owned by another shard",
+						attr.String("guild_id", g.ID),
+						attr.Int("shard_id", bot.shardID),
+						attr.Int("shard_count", bot.shardCount))
+					continue
+				}
+
 				// Preserve the intended UX: only register guild commands after setup is complete.
 				// This also avoids spamming unconfigured guilds with commands that will just be blocked.
 				if bot.GuildConfigResolver != nil {
@@ -794,7 +921,7 @@ func (bot *DiscordBot) syncGuildCommands(ctx context.Context, guilds []*discordg
 }
 
 func (bot *DiscordBot) scheduleGuildCommandRetry(ctx context.Context, guildID string) {
-	if guildID == "" {
+	if guildID == "" || !bot.ownsGuild(guildID) {
 		return
 	}
 	if _, loaded := bot.commandSyncRetries.LoadOrStore(guildID, struct{}{}); loaded {
@@ -814,7 +941,7 @@ func (bot *DiscordBot) scheduleGuildCommandRetry(ctx context.Context, guildID st
 
 		registrar := bot.commandRegistrar
 		if registrar == nil {
-			registrar = discord.RegisterCommands
+			registrar = bot.registerManifestCommands
 		}
 
 		for {
@@ -882,6 +1009,9 @@ func (bot *DiscordBot) Shutdown(ctx context.Context) error {
 	bot.shutdownOnce.Do(func() {
 		bot.Logger.Info("Shutting down Discord bot...")
 
+		// Worker mode: stop taking forwarded gateway events before closing the session
+		bot.unsubscribeGatewayEvents()
+
 		// Close Discord session first to stop receiving events
 		if bot.Session != nil {
 			bot.Logger.Info("Closing Discord session...")
@@ -1031,6 +1161,13 @@ func (bot *DiscordBot) Shutdown(ctx context.Context) error {
 			bot.AuthWatermillRouter = nil
 		}
 
+		// Worker mode: hand the lease to a standby worker once nothing here
+		// consumes events anymore
+		if bot.workerLease != nil {
+			bot.workerLease.release(ctx)
+			bot.workerLease = nil
+		}
+
 		// Close EventBus last (after all routers are closed)
 		if bot.EventBus != nil {
 			bot.Logger.Info("Closing event bus...")
@@ -11,11 +11,13 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
 	eventbusmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/eventbus"
+	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
 	"github.com/bwmarrin/discordgo"
 	"go.opentelemetry.io/otel/trace"
@@ -283,3 +285,52 @@ func TestRegisterGatewayLifecycleHandlers_EmitsMetrics(t *testing.T) {
 		t.Fatalf("unexpected disconnect reasons: %#v", disconnectReasons)
 	}
 }
+
+func TestRegisterManifestCommands_FiltersGuildCommandsByEntitlements(t *testing.T) {
+	manifest, err := interactions.NewCommandManifest([]interactions.CommandSpec{
+		{Command: &discordgo.ApplicationCommand{Name: "frolf-setup", Description: "setup"}, Scope: interactions.CommandScopeGlobal},
+		{Command: &discordgo.ApplicationCommand{Name: "createround", Description: "create"}},
+		{Command: &discordgo.ApplicationCommand{Name: "bet", Description: "bet"}, RequiredFeature: guildtypes.ClubFeatureBetting},
+	})
+	if err != nil {
+		t.Fatalf("NewCommandManifest error: %v", err)
+	}
+
+	resolver := &testutils.FakeGuildConfigResolver{}
+	resolver.GetGuildConfigFunc = func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+		return &storage.GuildConfig{GuildID: guildID}, nil
+	}
+
+	fakeSession := discord.NewFakeSession()
+	fakeSession.GetBotUserFunc = func() (*discordgo.User, error) {
+		return &discordgo.User{ID: "bot"}, nil
+	}
+	fakeSession.ApplicationCommandsFunc = func(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+		return []*discordgo.ApplicationCommand{{ID: "old-bet", Name: "bet", Description: "bet"}}, nil
+	}
+	var created, deleted []string
+	fakeSession.ApplicationCommandCreateFunc = func(appID, guildID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error) {
+		created = append(created, cmd.Name)
+		return &discordgo.ApplicationCommand{ID: cmd.Name}, nil
+	}
+	fakeSession.ApplicationCommandDeleteFunc = func(appID, guildID, cmdID string, options ...discordgo.RequestOption) error {
+		deleted = append(deleted, cmdID)
+		return nil
+	}
+
+	bot := &DiscordBot{
+		Logger:              testLogger(),
+		GuildConfigResolver: resolver,
+		commandManifest:     manifest,
+	}
+
+	if err := bot.registerManifestCommands(fakeSession, testLogger(), "g1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(created) != 1 || created[0] != "createround" {
+		t.Fatalf("expected only ungated guild commands to be created, got %v", created)
+	}
+	if len(deleted) != 1 || deleted[0] != "old-bet" {
+		t.Fatalf("expected gated command to be pruned when feature is disabled, got %v", deleted)
+	}
+}
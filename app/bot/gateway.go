@@ -0,0 +1,116 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/gatewaybridge"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	eventbusmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewGatewayBot creates a bot that owns the Discord websocket, reconciles
+// slash commands, and forwards raw events to workers over NATS.
+func NewGatewayBot(
+	session discord.Session,
+	cfg *config.Config,
+	logger *slog.Logger,
+	appStores *storage.Stores,
+	discordMetrics discordmetrics.DiscordMetrics,
+	eventBusMetrics eventbusmetrics.EventBusMetrics,
+	tracer trace.Tracer,
+	helper utils.Helpers,
+) (*DiscordBot, error) {
+	return newDiscordBot(RuntimeModeGateway, session, cfg, logger, appStores, discordMetrics, eventBusMetrics, tracer, helper)
+}
+
+// runGateway is Run for RuntimeModeGateway. Only the guild config cache is
+// initialized: it keeps the config resolver warm so command sync can be
+// gated on setup completion. Every other guild event, including the
+// re-registration of commands when a guild finishes setup, is handled by
+// the workers; the gateway's event bus consumer sees the same events, so
+// running those handlers here too would apply each one twice.
+// Interactions, messages, reactions and scheduled events are forwarded
+// untouched; workers own every handler.
+func (bot *DiscordBot) runGateway(ctx context.Context) error {
+	bot.Logger.Info("Starting Discord gateway initialization")
+
+	var commandSyncOnce sync.Once
+	startRouter, routerErrCh := bot.routerStarter(ctx)
+
+	nc := bot.EventBus.GetNATSConnection()
+	if nc == nil {
+		return fmt.Errorf("gateway mode requires a NATS connection")
+	}
+	forwarder := gatewaybridge.NewForwarder(nc, bot.shardID, bot.shardCount, bot.Logger)
+
+	var err error
+	bot.GuildRouter, err = guild.InitializeGuildConfigCache(
+		ctx,
+		bot.GuildWatermillRouter,
+		bot.EventBus,
+		bot.Logger,
+		bot.Config,
+		bot.Helper,
+		bot.GuildConfigResolver,
+	)
+	if err != nil {
+		return fmt.Errorf("guild config cache initialization failed: %w", err)
+	}
+
+	startRouter("Guild", bot.GuildWatermillRouter)
+
+	bot.Logger.Info("Waiting for Guild Watermill router to be running...")
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-routerErrCh:
+		return err
+	case <-bot.GuildWatermillRouter.Running():
+	}
+	bot.Logger.Info("Guild Watermill router is now running")
+
+	if err := bot.syncGlobalCommands(); err != nil {
+		return fmt.Errorf("failed to register global commands with Discord: %w", err)
+	}
+
+	bot.Session.AddHandler(func(s *discordgo.Session, r *discordgo.Ready) {
+		bot.setGatewayContext(r.SessionID, len(r.Guilds))
+		if bot.Metrics != nil {
+			bot.Metrics.RecordWebsocketEvent(ctx, "ready")
+		}
+
+		bot.Logger.Info("Gateway is ready", attr.Int("guilds", len(r.Guilds)))
+		bot.warmGuildConfigurations(ctx, r.Guilds)
+
+		commandSyncOnce.Do(func() {
+			go bot.syncGuildCommands(ctx, r.Guilds)
+		})
+	})
+
+	bot.registerGatewayLifecycleHandlers()
+	bot.registerGuildLifecycleHandlers(ctx)
+	forwarder.RegisterWithSession(bot.Session)
+
+	if err := bot.Session.Open(); err != nil {
+		return fmt.Errorf("failed to open Discord session: %w", err)
+	}
+
+	bot.Logger.Info("Discord gateway is now running")
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-routerErrCh:
+		return err
+	}
+}
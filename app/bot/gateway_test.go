@@ -0,0 +1,88 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	eventbusmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNewGatewayBot_UsesDedicatedEventBusService(t *testing.T) {
+	t.Cleanup(func() {
+		newEventBusFactory = eventbus.NewEventBus
+		newGuildConfigResolverFactory = guildconfig.NewResolver
+	})
+
+	var gotService string
+	newEventBusFactory = func(
+		ctx context.Context,
+		natsURL string,
+		logger *slog.Logger,
+		serviceName string,
+		metrics eventbusmetrics.EventBusMetrics,
+		tracer trace.Tracer,
+	) (eventbus.EventBus, error) {
+		gotService = serviceName
+		return &testutils.FakeEventBus{}, nil
+	}
+
+	wantErr := errors.New("stop after event bus")
+	newGuildConfigResolverFactory = func(
+		ctx context.Context,
+		eventBus eventbus.EventBus,
+		cache storage.ISInterface[storage.GuildConfig],
+		cfg *guildconfig.ResolverConfig,
+	) (*guildconfig.Resolver, error) {
+		return nil, wantErr
+	}
+
+	_, err := NewGatewayBot(
+		discord.NewFakeSession(),
+		&config.Config{},
+		testLogger(),
+		storage.NewStores(context.Background()),
+		&testutils.FakeDiscordMetrics{},
+		eventbusmetrics.NewNoop(),
+		trace.NewNoopTracerProvider().Tracer("test"),
+		utils.NewHelper(testLogger()),
+	)
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected resolver error, got %v", err)
+	}
+	if gotService != "discord-gateway" {
+		t.Fatalf("expected gateway event bus service, got %q", gotService)
+	}
+}
+
+func TestRunGateway_RequiresNATSConnection(t *testing.T) {
+	opened := false
+	session := discord.NewFakeSession()
+	session.OpenFunc = func() error {
+		opened = true
+		return nil
+	}
+
+	bot := &DiscordBot{
+		Session:  session,
+		Logger:   testLogger(),
+		EventBus: &testutils.FakeEventBus{},
+		Mode:     RuntimeModeGateway,
+	}
+
+	if err := bot.Run(context.Background()); err == nil {
+		t.Fatalf("expected error when NATS connection is unavailable")
+	}
+	if opened {
+		t.Fatalf("gateway must not open the Discord session without a NATS connection")
+	}
+}
@@ -0,0 +1,24 @@
+package bot
+
+// RuntimeMode selects which responsibilities a bot process takes on.
+type RuntimeMode string
+
+const (
+	// RuntimeModeStandalone owns the gateway connection and runs all handlers in-process.
+	RuntimeModeStandalone RuntimeMode = "standalone"
+	// RuntimeModeGateway owns the gateway connection and forwards events to NATS.
+	RuntimeModeGateway RuntimeMode = "gateway"
+	// RuntimeModeWorker consumes forwarded events and runs handlers over REST only.
+	RuntimeModeWorker RuntimeMode = "worker"
+)
+
+// eventBusServiceName keeps each mode on its own JetStream consumer group so
+// the gateway and workers never compete for the same backend events.
+func (m RuntimeMode) eventBusServiceName() string {
+	switch m {
+	case RuntimeModeGateway:
+		return "discord-gateway"
+	default:
+		return "discord"
+	}
+}
@@ -0,0 +1,118 @@
+package bot
+
+import (
+	"sort"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+)
+
+// ShardState is the gateway state of one shard as reported by health checks.
+type ShardState struct {
+	ShardID     int       `json:"shard_id"`
+	ShardCount  int       `json:"shard_count"`
+	SessionID   string    `json:"session_id,omitempty"`
+	GuildCount  int       `json:"guild_count"`
+	Connected   bool      `json:"connected"`
+	Reconnects  int       `json:"reconnects"`
+	LastReadyAt time.Time `json:"last_ready_at,omitzero"`
+
+	everConnected bool
+}
+
+// localShardLocked returns the state for this process's shard, creating it on
+// first use. Callers must hold gatewayStateMu for writing.
+func (bot *DiscordBot) localShardLocked() *ShardState {
+	return bot.shardStateLocked(bot.shardID, bot.shardCount)
+}
+
+func (bot *DiscordBot) shardStateLocked(shardID, shardCount int) *ShardState {
+	if bot.shardStates == nil {
+		bot.shardStates = make(map[int]*ShardState)
+	}
+	state, ok := bot.shardStates[shardID]
+	if !ok {
+		state = &ShardState{ShardID: shardID}
+		bot.shardStates[shardID] = state
+	}
+	state.ShardCount = max(shardCount, 1)
+	return state
+}
+
+// setGatewayContext records a Ready received on this process's own shard.
+func (bot *DiscordBot) setGatewayContext(sessionID string, guildCount int) {
+	bot.recordShardReady(bot.shardID, bot.shardCount, sessionID, guildCount)
+}
+
+// recordShardReady records a Ready for any shard. Workers call this with the
+// shard reported by each gateway's forwarded Ready.
+func (bot *DiscordBot) recordShardReady(shardID, shardCount int, sessionID string, guildCount int) {
+	bot.gatewayStateMu.Lock()
+	defer bot.gatewayStateMu.Unlock()
+	state := bot.shardStateLocked(shardID, shardCount)
+	state.SessionID = sessionID
+	state.GuildCount = guildCount
+	state.LastReadyAt = time.Now().UTC()
+}
+
+func (bot *DiscordBot) gatewayContext() (string, int) {
+	bot.gatewayStateMu.RLock()
+	defer bot.gatewayStateMu.RUnlock()
+	state, ok := bot.shardStates[bot.shardID]
+	if !ok {
+		return "", 0
+	}
+	return state.SessionID, state.GuildCount
+}
+
+func (bot *DiscordBot) markGatewayConnected() bool {
+	bot.gatewayStateMu.Lock()
+	defer bot.gatewayStateMu.Unlock()
+	state := bot.localShardLocked()
+	reconnect := state.everConnected
+	state.everConnected = true
+	state.Connected = true
+	if reconnect {
+		state.Reconnects++
+	}
+	return reconnect
+}
+
+func (bot *DiscordBot) markGatewayDisconnected() {
+	bot.gatewayStateMu.Lock()
+	defer bot.gatewayStateMu.Unlock()
+	bot.localShardLocked().Connected = false
+}
+
+// ShardStates returns a snapshot of every known shard, ordered by shard ID.
+func (bot *DiscordBot) ShardStates() []ShardState {
+	bot.gatewayStateMu.RLock()
+	defer bot.gatewayStateMu.RUnlock()
+	states := make([]ShardState, 0, len(bot.shardStates))
+	for _, state := range bot.shardStates {
+		states = append(states, *state)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].ShardID < states[j].ShardID })
+	return states
+}
+
+// ownsGuild reports whether guildID is routed to this process's shard.
+func (bot *DiscordBot) ownsGuild(guildID string) bool {
+	if bot.shardCount <= 1 {
+		return true
+	}
+	return discord.GuildShardID(guildID, bot.shardCount) == bot.shardID
+}
+
+// syncGlobalCommands reconciles the global command set. Global commands are
+// shared by every shard, so only shard 0 writes them; the others would race
+// it and spend the same rate limit N times over.
+func (bot *DiscordBot) syncGlobalCommands() error {
+	if bot.shardID != 0 {
+		bot.Logger.Info("Skipping global command sync: owned by shard 0",
+			attr.Int("shard_id", bot.shardID))
+		return nil
+	}
+	return bot.registerManifestCommands(bot.Session, bot.Logger, "")
+}
@@ -0,0 +1,120 @@
+package bot
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	guildOnShard0 = "197038439483310086"
+	guildOnShard1 = "197038439487504390"
+)
+
+func TestSyncGuildCommands_OnlyReconcilesLocalShardGuilds(t *testing.T) {
+	var got []string
+	bot := &DiscordBot{
+		Logger:     testLogger(),
+		shardID:    1,
+		shardCount: 2,
+		commandRegistrar: func(_ discord.Session, _ *slog.Logger, guildID string) error {
+			got = append(got, guildID)
+			return nil
+		},
+	}
+
+	bot.syncGuildCommands(context.Background(), []*discordgo.Guild{{ID: guildOnShard0}, {ID: guildOnShard1}})
+
+	if len(got) != 1 || got[0] != guildOnShard1 {
+		t.Fatalf("expected only shard-1 guild to sync, got %v", got)
+	}
+	if _, ok := bot.commandSyncState.Load(guildOnShard0); ok {
+		t.Fatalf("foreign-shard guild must not be marked synced")
+	}
+}
+
+func TestScheduleGuildCommandRetry_IgnoresForeignShardGuild(t *testing.T) {
+	bot := &DiscordBot{
+		Logger:     testLogger(),
+		shardID:    0,
+		shardCount: 2,
+	}
+
+	bot.scheduleGuildCommandRetry(context.Background(), guildOnShard1)
+
+	if _, ok := bot.commandSyncRetries.Load(guildOnShard1); ok {
+		t.Fatalf("expected no retry for guild owned by another shard")
+	}
+}
+
+func TestShardStates_TracksEachShard(t *testing.T) {
+	bot := &DiscordBot{shardID: 0, shardCount: 2}
+
+	bot.setGatewayContext("local-session", 3)
+	bot.markGatewayConnected()
+	bot.markGatewayDisconnected()
+	bot.markGatewayConnected()
+	bot.recordShardReady(1, 2, "remote-session", 5)
+
+	states := bot.ShardStates()
+	if len(states) != 2 {
+		t.Fatalf("expected 2 shard states, got %d", len(states))
+	}
+
+	local := states[0]
+	if local.ShardID != 0 || local.ShardCount != 2 || local.SessionID != "local-session" || local.GuildCount != 3 {
+		t.Fatalf("unexpected local shard state: %+v", local)
+	}
+	if !local.Connected || local.Reconnects != 1 || local.LastReadyAt.IsZero() {
+		t.Fatalf("unexpected local connection state: %+v", local)
+	}
+
+	remote := states[1]
+	if remote.ShardID != 1 || remote.SessionID != "remote-session" || remote.GuildCount != 5 {
+		t.Fatalf("unexpected remote shard state: %+v", remote)
+	}
+
+	sessionID, guildCount := bot.gatewayContext()
+	if sessionID != "local-session" || guildCount != 3 {
+		t.Fatalf("gatewayContext should report the local shard, got %q/%d", sessionID, guildCount)
+	}
+}
+
+func TestSyncGlobalCommands_OnlyShardZeroWrites(t *testing.T) {
+	manifest, err := interactions.NewCommandManifest([]interactions.CommandSpec{
+		{Command: &discordgo.ApplicationCommand{Name: "frolf-setup", Description: "setup"}, Scope: interactions.CommandScopeGlobal},
+	})
+	if err != nil {
+		t.Fatalf("NewCommandManifest error: %v", err)
+	}
+
+	for shardID, wantCalls := range map[int]int{0: 1, 1: 0} {
+		calls := 0
+		fakeSession := discord.NewFakeSession()
+		fakeSession.GetBotUserFunc = func() (*discordgo.User, error) {
+			return &discordgo.User{ID: "bot"}, nil
+		}
+		fakeSession.ApplicationCommandsFunc = func(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+			calls++
+			return nil, nil
+		}
+
+		bot := &DiscordBot{
+			Session:         fakeSession,
+			Logger:          testLogger(),
+			shardID:         shardID,
+			shardCount:      2,
+			commandManifest: manifest,
+		}
+		if err := bot.syncGlobalCommands(); err != nil {
+			t.Fatalf("shard %d: unexpected error: %v", shardID, err)
+		}
+		if calls != wantCalls {
+			t.Fatalf("shard %d: expected %d global command reads, got %d", shardID, wantCalls, calls)
+		}
+	}
+}
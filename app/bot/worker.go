@@ -0,0 +1,206 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/gatewaybridge"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/round/gateway"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	eventbusmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	defaultWorkerQueueGroup = "discord-workers"
+	workerQueueGroupEnvVar  = "DISCORD_WORKER_QUEUE_GROUP"
+)
+
+var errWorkerSessionOpen = errors.New("worker sessions are REST-only and never open the gateway")
+
+func workerQueueGroupFromEnv() string {
+	if val := strings.TrimSpace(os.Getenv(workerQueueGroupEnvVar)); val != "" {
+		return val
+	}
+	return defaultWorkerQueueGroup
+}
+
+// workerSession is a REST-only discord.Session whose AddHandler feeds the
+// gateway dispatcher instead of discordgo's websocket handler table, so
+// modules register handlers exactly as they do in standalone mode.
+type workerSession struct {
+	discord.Session
+	dispatcher *gatewaybridge.Dispatcher
+}
+
+func (w *workerSession) AddHandler(handler interface{}) func() {
+	return w.dispatcher.AddHandler(handler)
+}
+
+func (w *workerSession) Open() error {
+	return errWorkerSessionOpen
+}
+
+// NewWorkerBot creates a bot that consumes events forwarded by a gateway
+// process and runs every module against a REST-only session. The session's
+// State.User must be populated by the caller; the message and reaction
+// registries use it to ignore the bot's own events.
+func NewWorkerBot(
+	session discord.Session,
+	cfg *config.Config,
+	logger *slog.Logger,
+	appStores *storage.Stores,
+	discordMetrics discordmetrics.DiscordMetrics,
+	eventBusMetrics eventbusmetrics.EventBusMetrics,
+	tracer trace.Tracer,
+	helper utils.Helpers,
+) (*DiscordBot, error) {
+	underlying, ok := session.(interface{ GetUnderlyingSession() *discordgo.Session })
+	if !ok {
+		return nil, fmt.Errorf("worker mode requires a discordgo-backed session")
+	}
+	dispatcher := gatewaybridge.NewDispatcher(underlying.GetUnderlyingSession(), logger)
+
+	bot, err := newDiscordBot(RuntimeModeWorker, &workerSession{Session: session, dispatcher: dispatcher}, cfg, logger, appStores, discordMetrics, eventBusMetrics, tracer, helper)
+	if err != nil {
+		return nil, err
+	}
+	bot.dispatcher = dispatcher
+	bot.workerQueueGroup = workerQueueGroupFromEnv()
+	return bot, nil
+}
+
+// runWorker is Run for RuntimeModeWorker. It initializes the same modules as
+// standalone mode but never opens the websocket or reconciles commands; the
+// gateway owns both.
+func (bot *DiscordBot) runWorker(ctx context.Context) error {
+	bot.Logger.Info("Starting Discord worker initialization",
+		attr.String("queue_group", bot.workerQueueGroup))
+
+	startRouter, routerErrCh := bot.routerStarter(ctx)
+
+	nc := bot.EventBus.GetNATSConnection()
+	if nc == nil {
+		return fmt.Errorf("worker mode requires a NATS connection")
+	}
+	if bot.dispatcher == nil {
+		return fmt.Errorf("worker mode requires a gateway dispatcher")
+	}
+
+	// Only one worker runs at a time; a standby waits here without starting
+	// any module or consumer until the active worker goes away.
+	lease, err := newWorkerLease(ctx, bot.EventBus.GetJetStream(), bot.Logger)
+	if err != nil {
+		return err
+	}
+	if err := lease.acquire(ctx, workerLeaseRetry); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+	bot.workerLease = lease
+	leaseErrCh := make(chan error, 1)
+	go func() {
+		if err := lease.keep(ctx, workerLeaseRenew); err != nil {
+			leaseErrCh <- err
+		}
+	}()
+
+	registry := interactions.NewRegistry()
+	registry.SetGuildConfigResolver(bot.GuildConfigResolver)
+	registry.SetLogger(bot.Logger)
+
+	reactionRegistry := interactions.NewReactionRegistry(bot.Logger)
+	reactionRegistry.RegisterWithSession(bot.Session, bot.Session)
+
+	messageRegistry := interactions.NewMessageRegistry(bot.Logger)
+	messageRegistry.RegisterWithSession(bot.Session, bot.Session)
+
+	if err := bot.initializeModules(ctx, registry, reactionRegistry, messageRegistry); err != nil {
+		return err
+	}
+
+	startRouter("Guild", bot.GuildWatermillRouter)
+	bot.Logger.Info("Waiting for Guild Watermill router to be running...")
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-routerErrCh:
+		return err
+	case <-bot.GuildWatermillRouter.Running():
+	}
+	bot.Logger.Info("Guild Watermill router is now running")
+
+	bot.Session.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+		bot.Logger.Info("Handling interaction", attr.String("type", i.Type.String()))
+		registry.HandleInteraction(s, i)
+	})
+
+	// The gateway forwards a trimmed Ready (session ID + guild IDs) to every
+	// worker so per-process caches can be rebuilt after a gateway restart.
+	bot.Session.AddHandler(func(s *discordgo.Session, r *discordgo.Ready) {
+		shardID, shardCount := 0, 1
+		if r.Shard != nil {
+			shardID, shardCount = r.Shard[0], r.Shard[1]
+		}
+		bot.recordShardReady(shardID, shardCount, r.SessionID, len(r.Guilds))
+		bot.Logger.Info("Gateway ready received",
+			attr.Int("shard_id", shardID),
+			attr.Int("guilds", len(r.Guilds)))
+		bot.warmGuildConfigurations(ctx, r.Guilds)
+		go gateway.ReconcileNativeEventMap(bot.Session, bot.NativeEventMap, r.Guilds, bot.Logger)
+	})
+
+	startRouter("User", bot.UserWatermillRouter)
+	startRouter("Round", bot.RoundWatermillRouter)
+	startRouter("Score", bot.ScoreWatermillRouter)
+	startRouter("Club", bot.ClubWatermillRouter)
+	startRouter("Leaderboard", bot.LeaderboardWatermillRouter)
+	startRouter("Auth", bot.AuthWatermillRouter)
+
+	subs, err := bot.dispatcher.Subscribe(nc, bot.workerQueueGroup)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to gateway events: %w", err)
+	}
+	bot.workerSubsMu.Lock()
+	bot.workerSubs = subs
+	bot.workerSubsMu.Unlock()
+
+	bot.Logger.Info("Discord worker is now running")
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-routerErrCh:
+		return err
+	case err := <-leaseErrCh:
+		return err
+	}
+}
+
+// unsubscribeGatewayEvents stops a worker from taking new forwarded events.
+func (bot *DiscordBot) unsubscribeGatewayEvents() {
+	bot.workerSubsMu.Lock()
+	subs := bot.workerSubs
+	bot.workerSubs = nil
+	bot.workerSubsMu.Unlock()
+
+	for _, sub := range subs {
+		if err := sub.Unsubscribe(); err != nil {
+			bot.Logger.Warn("Error unsubscribing from gateway events",
+				attr.String("subject", sub.Subject),
+				attr.Error(err))
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	workerLeaseBucket = "discord-worker-lease"
+	workerLeaseKey    = "active"
+	// workerLeaseTTL is how long a lease outlives a worker that died without
+	// releasing it.
+	workerLeaseTTL   = 30 * time.Second
+	workerLeaseRenew = 10 * time.Second
+	workerLeaseRetry = 5 * time.Second
+)
+
+// leaseStore is the part of a JetStream key-value bucket the worker lease uses.
+type leaseStore interface {
+	Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error)
+	Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error)
+	Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error
+}
+
+// workerLease keeps a single worker active. Multi-step interactions stage
+// their state in per-process stores and timers, so a flow started on one
+// worker must finish on it; a second worker waits as a standby until the
+// active one releases the lease or its lease expires.
+type workerLease struct {
+	kv       leaseStore
+	holder   string
+	logger   *slog.Logger
+	revision uint64
+}
+
+func newWorkerLease(ctx context.Context, js jetstream.JetStream, logger *slog.Logger) (*workerLease, error) {
+	if js == nil {
+		return nil, fmt.Errorf("worker mode requires JetStream for the worker lease")
+	}
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:      workerLeaseBucket,
+		Description: "Holder of the single active Discord worker",
+		TTL:         workerLeaseTTL,
+		History:     1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open worker lease bucket: %w", err)
+	}
+
+	holder, err := os.Hostname()
+	if err != nil || holder == "" {
+		holder = "discord-worker"
+	}
+	return &workerLease{kv: kv, holder: holder, logger: logger}, nil
+}
+
+// acquire blocks until this worker holds the lease or ctx is done.
+func (l *workerLease) acquire(ctx context.Context, retry time.Duration) error {
+	waiting := false
+	for {
+		revision, err := l.kv.Create(ctx, workerLeaseKey, []byte(l.holder))
+		if err == nil {
+			l.revision = revision
+			l.logger.Info("Acquired worker lease", attr.String("holder", l.holder))
+			return nil
+		}
+		if !errors.Is(err, jetstream.ErrKeyExists) {
+			return fmt.Errorf("failed to acquire worker lease: %w", err)
+		}
+		if !waiting {
+			waiting = true
+			l.logger.Info("Another worker is active; waiting for the worker lease",
+				attr.String("holder", l.holder))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retry):
+		}
+	}
+}
+
+// keep renews the lease until ctx is done. It returns an error once the lease
+// is lost, since another worker may already be handling events.
+func (l *workerLease) keep(ctx context.Context, every time.Duration) error {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			revision, err := l.kv.Update(ctx, workerLeaseKey, []byte(l.holder), l.revision)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("lost worker lease: %w", err)
+			}
+			l.revision = revision
+		}
+	}
+}
+
+// release hands the lease to a waiting worker. It only deletes the lease while
+// this worker still holds it.
+func (l *workerLease) release(ctx context.Context) {
+	if l.revision == 0 {
+		return
+	}
+	if err := l.kv.Delete(ctx, workerLeaseKey, jetstream.LastRevision(l.revision)); err != nil {
+		l.logger.Warn("Failed to release worker lease", attr.Error(err))
+		return
+	}
+	l.revision = 0
+	l.logger.Info("Released worker lease", attr.String("holder", l.holder))
+}
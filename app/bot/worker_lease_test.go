@@ -0,0 +1,130 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// fakeLeaseStore is a single-key bucket with revision checks.
+type fakeLeaseStore struct {
+	mu       sync.Mutex
+	value    string
+	revision uint64
+	held     bool
+}
+
+func (f *fakeLeaseStore) Create(ctx context.Context, key string, value []byte, opts ...jetstream.KVCreateOpt) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.held {
+		return 0, jetstream.ErrKeyExists
+	}
+	f.held = true
+	f.value = string(value)
+	f.revision++
+	return f.revision, nil
+}
+
+func (f *fakeLeaseStore) Update(ctx context.Context, key string, value []byte, revision uint64) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.held || revision != f.revision {
+		return 0, errors.New("wrong last sequence")
+	}
+	f.revision++
+	return f.revision, nil
+}
+
+func (f *fakeLeaseStore) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.held = false
+	f.value = ""
+	f.revision++
+	return nil
+}
+
+func (f *fakeLeaseStore) isHeld() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.held
+}
+
+func TestWorkerLease_StandbyWaitsForRelease(t *testing.T) {
+	kv := &fakeLeaseStore{}
+	active := &workerLease{kv: kv, holder: "worker-a", logger: testLogger()}
+	standby := &workerLease{kv: kv, holder: "worker-b", logger: testLogger()}
+
+	if err := active.acquire(context.Background(), time.Millisecond); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	acquired := make(chan error, 1)
+	go func() { acquired <- standby.acquire(context.Background(), time.Millisecond) }()
+
+	select {
+	case err := <-acquired:
+		t.Fatalf("standby acquired the lease while it was held (err = %v)", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	active.release(context.Background())
+
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("standby acquire: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("standby never acquired the released lease")
+	}
+	if !kv.isHeld() {
+		t.Fatalf("expected the standby to hold the lease")
+	}
+}
+
+func TestWorkerLease_AcquireStopsWithContext(t *testing.T) {
+	kv := &fakeLeaseStore{held: true, revision: 1}
+	lease := &workerLease{kv: kv, holder: "worker-b", logger: testLogger()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := lease.acquire(ctx, time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the wait to end with the context, got %v", err)
+	}
+}
+
+func TestWorkerLease_KeepFailsOnceLeaseIsLost(t *testing.T) {
+	kv := &fakeLeaseStore{}
+	lease := &workerLease{kv: kv, holder: "worker-a", logger: testLogger()}
+	if err := lease.acquire(context.Background(), time.Millisecond); err != nil {
+		t.Fatalf("acquire: %v", err)
+	}
+
+	// The lease expired and another worker took it.
+	_ = kv.Delete(context.Background(), workerLeaseKey)
+	if _, err := kv.Create(context.Background(), workerLeaseKey, []byte("worker-b")); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := lease.keep(ctx, time.Millisecond); err == nil {
+		t.Fatalf("expected keep to report the lost lease")
+	}
+}
+
+func TestWorkerLease_ReleaseWithoutLeaseIsNoop(t *testing.T) {
+	kv := &fakeLeaseStore{held: true, revision: 3}
+	lease := &workerLease{kv: kv, holder: "worker-b", logger: testLogger()}
+
+	lease.release(context.Background())
+	if !kv.isHeld() {
+		t.Fatalf("a worker that never held the lease must not delete it")
+	}
+}
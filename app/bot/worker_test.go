@@ -0,0 +1,94 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/gatewaybridge"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	eventbusmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	"github.com/bwmarrin/discordgo"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWorkerSession_RoutesHandlersToDispatcher(t *testing.T) {
+	rest := &discordgo.Session{}
+	dispatcher := gatewaybridge.NewDispatcher(rest, testLogger())
+
+	underlyingCalled := false
+	fake := discord.NewFakeSession()
+	fake.AddHandlerFunc = func(handler interface{}) func() {
+		underlyingCalled = true
+		return func() {}
+	}
+	ws := &workerSession{Session: fake, dispatcher: dispatcher}
+
+	var gotSession *discordgo.Session
+	ws.AddHandler(func(s *discordgo.Session, m *discordgo.MessageCreate) { gotSession = s })
+
+	env, err := gatewaybridge.NewEnvelope(gatewaybridge.MessageCreateV1, 0, "g1", time.Now(),
+		&discordgo.MessageCreate{Message: &discordgo.Message{ID: "m1"}})
+	if err != nil {
+		t.Fatalf("new envelope: %v", err)
+	}
+	if err := dispatcher.Dispatch(env); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+
+	if underlyingCalled {
+		t.Fatalf("worker session must not register handlers on the underlying session")
+	}
+	if gotSession != rest {
+		t.Fatalf("expected REST session to be passed to handler")
+	}
+	if err := ws.Open(); !errors.Is(err, errWorkerSessionOpen) {
+		t.Fatalf("expected Open to be refused, got %v", err)
+	}
+}
+
+func TestNewWorkerBot_RequiresDiscordgoBackedSession(t *testing.T) {
+	_, err := NewWorkerBot(
+		discord.NewFakeSession(),
+		&config.Config{},
+		testLogger(),
+		storage.NewStores(context.Background()),
+		&testutils.FakeDiscordMetrics{},
+		eventbusmetrics.NewNoop(),
+		trace.NewNoopTracerProvider().Tracer("test"),
+		utils.NewHelper(testLogger()),
+	)
+	if err == nil {
+		t.Fatalf("expected error for session without an underlying discordgo session")
+	}
+}
+
+func TestRunWorker_RequiresNATSConnection(t *testing.T) {
+	bot := &DiscordBot{
+		Session:    discord.NewFakeSession(),
+		Logger:     testLogger(),
+		EventBus:   &testutils.FakeEventBus{},
+		Mode:       RuntimeModeWorker,
+		dispatcher: gatewaybridge.NewDispatcher(&discordgo.Session{}, testLogger()),
+	}
+
+	if err := bot.Run(context.Background()); err == nil {
+		t.Fatalf("expected error when NATS connection is unavailable")
+	}
+}
+
+func TestWorkerQueueGroupFromEnv(t *testing.T) {
+	t.Setenv(workerQueueGroupEnvVar, "")
+	if got := workerQueueGroupFromEnv(); got != defaultWorkerQueueGroup {
+		t.Fatalf("expected default queue group, got %q", got)
+	}
+	t.Setenv(workerQueueGroupEnvVar, "rounds")
+	if got := workerQueueGroupFromEnv(); got != "rounds" {
+		t.Fatalf("expected override queue group, got %q", got)
+	}
+}
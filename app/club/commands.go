@@ -0,0 +1,14 @@
+package club
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/club/discord/challenge"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+)
+
+// Commands returns the application commands contributed by the club module.
+func Commands() []interactions.CommandSpec {
+	return []interactions.CommandSpec{
+		challenge.CommandSpec(),
+		challenge.UserCommandSpec(),
+	}
+}
@@ -0,0 +1,134 @@
+package challenge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	clubtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/club"
+	"github.com/bwmarrin/discordgo"
+)
+
+// AutocompleteChallengeID offers the guild's active challenges for a
+// challenge_id option, narrowed to the ones the subcommand can act on.
+func (m *manager) AutocompleteChallengeID(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	subcommand := ""
+	if options := i.ApplicationCommandData().Options; len(options) > 0 {
+		subcommand = options[0].Name
+	}
+
+	response, err := m.listChallenges(ctx, i.GuildID, []clubtypes.ChallengeStatus{
+		clubtypes.ChallengeStatusOpen,
+		clubtypes.ChallengeStatusAccepted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, nil
+	}
+
+	challenges := make([]clubtypes.ChallengeSummary, 0, len(response.Challenges))
+	for _, challenge := range response.Challenges {
+		if challengeMatchesSubcommand(challenge, subcommand) {
+			challenges = append(challenges, challenge)
+		}
+	}
+	sort.SliceStable(challenges, func(a, b int) bool {
+		return challenges[a].OpenedAt.After(challenges[b].OpenedAt)
+	})
+
+	query := strings.ToLower(strings.TrimSpace(focused.StringValue()))
+	names := make(map[string]string)
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(challenges))
+	for _, challenge := range challenges {
+		label := fmt.Sprintf("%s vs %s · %s",
+			m.participantName(i.GuildID, challenge.ChallengerExternalID, challenge.ChallengerUserUUID, names),
+			m.participantName(i.GuildID, challenge.DefenderExternalID, challenge.DefenderUserUUID, names),
+			challengeStatusLabel(challenge),
+		)
+		if query != "" && !strings.Contains(strings.ToLower(label), query) && !strings.HasPrefix(strings.ToLower(challenge.ID), query) {
+			continue
+		}
+
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  interactions.TruncateChoiceName(fmt.Sprintf("%s · %s", label, shortChallengeID(challenge.ID))),
+			Value: challenge.ID,
+		})
+		if len(choices) == interactions.MaxAutocompleteChoices {
+			break
+		}
+	}
+	return choices, nil
+}
+
+// AutocompleteRoundID offers the guild's upcoming and in-progress rounds for
+// /challenge link, or nothing while round lists are held back.
+func (m *manager) AutocompleteRoundID(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	if m.listRounds == nil {
+		return nil, nil
+	}
+	response, err := m.listRounds(ctx, i.GuildID, []string{
+		roundautocomplete.RoundStateUpcoming,
+		roundautocomplete.RoundStateInProgress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, nil
+	}
+	loc := guildconfig.GuildLocation(ctx, m.logger, m.guildConfigResolver, i.GuildID)
+	return roundautocomplete.RoundIDChoices(response.Rounds, focused.StringValue(), loc), nil
+}
+
+func challengeMatchesSubcommand(challenge clubtypes.ChallengeSummary, subcommand string) bool {
+	linked := challenge.LinkedRound != nil && challenge.LinkedRound.IsActive
+	switch subcommand {
+	case "schedule", "link":
+		return challenge.Status == clubtypes.ChallengeStatusAccepted && !linked
+	case "unlink":
+		return challenge.Status == clubtypes.ChallengeStatusAccepted && linked
+	default:
+		return true
+	}
+}
+
+func challengeStatusLabel(challenge clubtypes.ChallengeSummary) string {
+	if challenge.Status != clubtypes.ChallengeStatusAccepted {
+		return string(challenge.Status)
+	}
+	if challenge.LinkedRound != nil && challenge.LinkedRound.IsActive {
+		return "accepted, round linked"
+	}
+	return "accepted, awaiting round"
+}
+
+// participantName resolves a challenge participant to their server display
+// name, caching lookups for the duration of one autocomplete request.
+func (m *manager) participantName(guildID string, externalID *string, userUUID string, cache map[string]string) string {
+	if externalID == nil || *externalID == "" {
+		return shortChallengeID(userUUID)
+	}
+	if name, ok := cache[*externalID]; ok {
+		return name
+	}
+
+	name := *externalID
+	if member, err := m.session.GuildMember(guildID, *externalID); err == nil && member != nil {
+		switch {
+		case member.Nick != "":
+			name = member.Nick
+		case member.User != nil && member.User.GlobalName != "":
+			name = member.User.GlobalName
+		case member.User != nil && member.User.Username != "":
+			name = member.User.Username
+		}
+	}
+	cache[*externalID] = name
+	return name
+}
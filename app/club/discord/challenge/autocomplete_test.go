@@ -0,0 +1,146 @@
+package challenge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	discordpkg "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	clubevents "github.com/Black-And-White-Club/frolf-bot-shared/events/club"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	clubtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/club"
+	"github.com/bwmarrin/discordgo"
+)
+
+func newAutocompleteTestManager(session *discordpkg.FakeSession) *manager {
+	return NewManager(
+		session,
+		&testutils.FakeEventBus{},
+		testutils.NoOpLogger(),
+		&testutils.FakeHelpers{},
+		&config.Config{},
+		&testutils.FakeGuildConfigResolver{},
+		discordmetrics.NewNoop(),
+		nil,
+	).(*manager)
+}
+
+func autocompleteInteraction(subcommand, option, value string) (*discordgo.InteractionCreate, *discordgo.ApplicationCommandInteractionDataOption) {
+	focused := &discordgo.ApplicationCommandInteractionDataOption{
+		Name:    option,
+		Type:    discordgo.ApplicationCommandOptionString,
+		Value:   value,
+		Focused: true,
+	}
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			GuildID: "guild-1",
+			Type:    discordgo.InteractionApplicationCommandAutocomplete,
+			Data: discordgo.ApplicationCommandInteractionData{
+				Name: "challenge",
+				Options: []*discordgo.ApplicationCommandInteractionDataOption{
+					{
+						Name:    subcommand,
+						Type:    discordgo.ApplicationCommandOptionSubCommand,
+						Options: []*discordgo.ApplicationCommandInteractionDataOption{focused},
+					},
+				},
+			},
+		},
+	}, focused
+}
+
+func TestManagerAutocompleteChallengeIDLabelsWithMemberNames(t *testing.T) {
+	fakeSession := discordpkg.NewFakeSession()
+	fakeSession.GuildMemberFunc = func(guildID, userID string, options ...discordgo.RequestOption) (*discordgo.Member, error) {
+		names := map[string]string{"challenger-1": "Alice", "defender-1": "Bob", "defender-2": "Cara"}
+		return &discordgo.Member{User: &discordgo.User{ID: userID, Username: names[userID]}}, nil
+	}
+
+	manager := newAutocompleteTestManager(fakeSession)
+
+	challenger := "challenger-1"
+	firstDefender := "defender-1"
+	secondDefender := "defender-2"
+	now := time.Now().UTC()
+	manager.listChallenges = func(ctx context.Context, guildID string, statuses []clubtypes.ChallengeStatus) (*clubevents.ChallengeListResponsePayloadV1, error) {
+		return &clubevents.ChallengeListResponsePayloadV1{
+			Challenges: []clubtypes.ChallengeSummary{
+				{
+					ID:                   "11111111-aaaa",
+					Status:               clubtypes.ChallengeStatusOpen,
+					ChallengerExternalID: &challenger,
+					DefenderExternalID:   &firstDefender,
+					OpenedAt:             now.Add(-time.Hour),
+				},
+				{
+					ID:                   "22222222-bbbb",
+					Status:               clubtypes.ChallengeStatusAccepted,
+					ChallengerExternalID: &challenger,
+					DefenderExternalID:   &secondDefender,
+					OpenedAt:             now,
+				},
+			},
+		}, nil
+	}
+
+	i, focused := autocompleteInteraction("withdraw", "challenge_id", "")
+	choices, err := manager.AutocompleteChallengeID(context.Background(), i, focused)
+	if err != nil {
+		t.Fatalf("AutocompleteChallengeID returned error: %v", err)
+	}
+	if len(choices) != 2 {
+		t.Fatalf("expected 2 choices, got %d", len(choices))
+	}
+	if want := "Alice vs Cara · accepted, awaiting round · 22222222"; choices[0].Name != want {
+		t.Fatalf("expected newest challenge first with names, got %q", choices[0].Name)
+	}
+	if choices[0].Value != "22222222-bbbb" {
+		t.Fatalf("expected challenge ID as value, got %v", choices[0].Value)
+	}
+
+	i, focused = autocompleteInteraction("schedule", "challenge_id", "")
+	choices, err = manager.AutocompleteChallengeID(context.Background(), i, focused)
+	if err != nil {
+		t.Fatalf("AutocompleteChallengeID returned error: %v", err)
+	}
+	if len(choices) != 1 || choices[0].Value != "22222222-bbbb" {
+		t.Fatalf("expected only accepted challenges for schedule, got %+v", choices)
+	}
+
+	i, focused = autocompleteInteraction("hide", "challenge_id", "bob")
+	choices, err = manager.AutocompleteChallengeID(context.Background(), i, focused)
+	if err != nil {
+		t.Fatalf("AutocompleteChallengeID returned error: %v", err)
+	}
+	if len(choices) != 1 || choices[0].Value != "11111111-aaaa" {
+		t.Fatalf("expected query to match participant name, got %+v", choices)
+	}
+}
+
+func TestManagerAutocompleteRoundIDOffersActiveRounds(t *testing.T) {
+	manager := newAutocompleteTestManager(discordpkg.NewFakeSession())
+
+	var gotStates []string
+	manager.listRounds = func(ctx context.Context, guildID string, states []string) (*roundautocomplete.RoundListResponsePayloadV1, error) {
+		gotStates = states
+		return &roundautocomplete.RoundListResponsePayloadV1{
+			Rounds: []roundautocomplete.RoundSummaryV1{{ID: "round-1", Title: "Doubles", State: roundautocomplete.RoundStateUpcoming}},
+		}, nil
+	}
+
+	i, focused := autocompleteInteraction("link", "round_id", "")
+	choices, err := manager.AutocompleteRoundID(context.Background(), i, focused)
+	if err != nil {
+		t.Fatalf("AutocompleteRoundID returned error: %v", err)
+	}
+	if len(gotStates) != 2 || gotStates[0] != roundautocomplete.RoundStateUpcoming || gotStates[1] != roundautocomplete.RoundStateInProgress {
+		t.Fatalf("expected upcoming and in-progress rounds, got %v", gotStates)
+	}
+	if len(choices) != 1 || choices[0].Name != "Doubles" || choices[0].Value != "round-1" {
+		t.Fatalf("unexpected round choices %+v", choices)
+	}
+}
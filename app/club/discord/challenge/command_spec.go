@@ -0,0 +1,101 @@
+package challenge
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /challenge command and its subcommands.
+func CommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "challenge",
+			Description: "Open, manage, and link club challenges",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "open",
+					Description: "Challenge another player",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "user",
+							Description: "The player to challenge",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "schedule",
+					Description: "Schedule a new round for an accepted challenge",
+					Options:     []*discordgo.ApplicationCommandOption{challengeIDOption()},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "withdraw",
+					Description: "Withdraw one of your active challenges",
+					Options:     []*discordgo.ApplicationCommandOption{challengeIDOption()},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "link",
+					Description: "Link an existing round to an accepted challenge",
+					Options: []*discordgo.ApplicationCommandOption{
+						challengeIDOption(),
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "round_id",
+							Description:  "The round to link",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "unlink",
+					Description: "Remove the round currently linked to a challenge",
+					Options:     []*discordgo.ApplicationCommandOption{challengeIDOption()},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "hide",
+					Description: "Hide a challenge from the board",
+					Options:     []*discordgo.ApplicationCommandOption{challengeIDOption()},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "See where active challenges are surfaced",
+				},
+			},
+		},
+		RequiredPermission: interactions.PlayerRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+	}
+}
+
+// UserCommandSpec returns the "Challenge this player" user context-menu command.
+func UserCommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name: "Challenge this player",
+			Type: discordgo.UserApplicationCommand,
+		},
+		RequiredPermission: interactions.PlayerRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+	}
+}
+
+func challengeIDOption() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:         discordgo.ApplicationCommandOptionString,
+		Name:         "challenge_id",
+		Description:  "The challenge",
+		Required:     true,
+		Autocomplete: true,
+	}
+}
@@ -0,0 +1,34 @@
+package challenge
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestCommandSpec_IncludesScheduleSubcommand(t *testing.T) {
+	challengeCommand := CommandSpec().Command
+	if challengeCommand == nil || challengeCommand.Name != "challenge" {
+		t.Fatalf("expected challenge command, got %+v", challengeCommand)
+	}
+
+	var scheduleOption *discordgo.ApplicationCommandOption
+	for _, option := range challengeCommand.Options {
+		if option.Name == "schedule" {
+			scheduleOption = option
+			break
+		}
+	}
+	if scheduleOption == nil {
+		t.Fatal("expected schedule subcommand in challenge command")
+	}
+	if scheduleOption.Type != discordgo.ApplicationCommandOptionSubCommand {
+		t.Fatalf("expected schedule to be a subcommand, got %v", scheduleOption.Type)
+	}
+	if len(scheduleOption.Options) != 1 {
+		t.Fatalf("expected schedule to accept exactly one option, got %d", len(scheduleOption.Options))
+	}
+	if scheduleOption.Options[0].Name != "challenge_id" || !scheduleOption.Options[0].Required {
+		t.Fatalf("unexpected schedule option definition: %+v", scheduleOption.Options[0])
+	}
+}
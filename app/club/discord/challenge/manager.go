@@ -2,7 +2,6 @@ package challenge
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"sort"
@@ -12,7 +11,10 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
 	createround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/create_round"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
 	clubevents "github.com/Black-And-White-Club/frolf-bot-shared/events/club"
@@ -23,7 +25,6 @@ import (
 	wmmessage "github.com/ThreeDotsLabs/watermill/message"
 	"github.com/bwmarrin/discordgo"
 	"github.com/google/uuid"
-	nc "github.com/nats-io/nats.go"
 )
 
 const (
@@ -33,14 +34,20 @@ const (
 	challengeListLimit      = 10
 )
 
-const challengeRoundAnnouncementTTL = 15 * time.Minute
+const (
+	challengeRoundAnnouncementTTL = 15 * time.Minute
+	challengeRequestTimeout       = 5 * time.Second
+)
 
 type Manager interface {
 	HandleChallengeCommand(ctx context.Context, i *discordgo.InteractionCreate) error
+	HandleChallengeUserCommand(ctx context.Context, i *discordgo.InteractionCreate) error
 	HandleAcceptButton(ctx context.Context, i *discordgo.InteractionCreate) error
 	HandleDeclineButton(ctx context.Context, i *discordgo.InteractionCreate) error
 	HandleScheduleButton(ctx context.Context, i *discordgo.InteractionCreate) error
 	HandleChallengeFact(ctx context.Context, topic string, payload *clubevents.ChallengeFactPayloadV1) error
+	AutocompleteChallengeID(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error)
+	AutocompleteRoundID(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error)
 }
 
 type manager struct {
@@ -54,7 +61,9 @@ type manager struct {
 	createRoundManager  createround.CreateRoundManager
 	listChallenges      func(ctx context.Context, guildID string, statuses []clubtypes.ChallengeStatus) (*clubevents.ChallengeListResponsePayloadV1, error)
 	getChallengeDetail  func(ctx context.Context, guildID, challengeID string) (*clubevents.ChallengeDetailResponsePayloadV1, error)
+	listRounds          roundautocomplete.RoundLister
 	roundAnnouncements  sync.Map
+	notifier            notify.Notifier
 }
 
 type challengeScheduleValidatorConfigurer interface {
@@ -80,9 +89,11 @@ func NewManager(
 		guildConfigResolver: guildConfigResolver,
 		metrics:             metrics,
 		createRoundManager:  createRoundManager,
+		notifier:            notify.NewNotifier(session, publisher, logger, cfg),
 	}
 	mgr.listChallenges = mgr.requestChallengeList
 	mgr.getChallengeDetail = mgr.requestChallengeDetail
+	mgr.listRounds = roundautocomplete.NewRoundLister(cfg, publisher)
 	if validatorConfigurer, ok := createRoundManager.(challengeScheduleValidatorConfigurer); ok {
 		validatorConfigurer.SetChallengeScheduleValidator(mgr.validateScheduleRequest)
 	}
@@ -180,6 +191,7 @@ func (m *manager) HandleChallengeFact(ctx context.Context, topic string, payload
 			Components: &components,
 		})
 		if err == nil {
+			m.notifyChallenge(ctx, topic, guildID, channelID, messageID, challenge)
 			if isRoundLinkedTopic(topic) {
 				return m.sendChallengeRoundAnnouncement(ctx, channelID, challenge)
 			}
@@ -209,6 +221,7 @@ func (m *manager) HandleChallengeFact(ctx context.Context, topic string, payload
 			attr.String("message_id", msg.ID),
 		)
 	}
+	m.notifyChallenge(ctx, topic, guildID, channelID, msg.ID, challenge)
 
 	if isRoundLinkedTopic(topic) {
 		return m.sendChallengeRoundAnnouncement(ctx, channelID, challenge)
@@ -222,9 +235,46 @@ func (m *manager) handleOpen(ctx context.Context, i *discordgo.InteractionCreate
 	if targetUser == nil {
 		return m.respondEphemeral(i, "Choose a player to challenge.")
 	}
+
+	return m.openChallenge(ctx, i, actorID, targetUser)
+}
+
+// HandleChallengeUserCommand handles the "Challenge this player" user
+// context-menu command by opening a challenge against the right-clicked member.
+func (m *manager) HandleChallengeUserCommand(ctx context.Context, i *discordgo.InteractionCreate) error {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "challenge")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "user_command")
+
+	actorID, err := interactionUserID(i)
+	if err != nil {
+		return err
+	}
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, actorID)
+
+	data := i.ApplicationCommandData()
+	var targetUser *discordgo.User
+	if data.Resolved != nil {
+		targetUser = data.Resolved.Users[data.TargetID]
+	}
+	if targetUser == nil && data.TargetID != "" {
+		targetUser = &discordgo.User{ID: data.TargetID}
+	}
+	if targetUser == nil {
+		return m.respondEphemeral(i, "Choose a player to challenge.")
+	}
+
+	return m.openChallenge(ctx, i, actorID, targetUser)
+}
+
+// openChallenge validates the target and requests a new challenge; shared by
+// /challenge open and the user context menu.
+func (m *manager) openChallenge(ctx context.Context, i *discordgo.InteractionCreate, actorID string, targetUser *discordgo.User) error {
 	if targetUser.ID == actorID {
 		return m.respondEphemeral(i, "You cannot challenge yourself.")
 	}
+	if targetUser.Bot {
+		return m.respondEphemeral(i, "You cannot challenge a bot.")
+	}
 
 	if err := m.deferEphemeral(i); err != nil {
 		return err
@@ -625,19 +675,16 @@ func (m *manager) requestChallengeList(ctx context.Context, guildID string, stat
 		return nil, fmt.Errorf("guild id is required")
 	}
 
-	response := &clubevents.ChallengeListResponsePayloadV1{}
-	if err := m.requestReplyJSON(
+	return messagecreator.NATSRequest[*clubevents.ChallengeListRequestPayloadV1, clubevents.ChallengeListResponsePayloadV1](
 		ctx,
+		m.publisher,
 		challengeRequestSubject(clubevents.ChallengeListRequestV1, guildID),
 		&clubevents.ChallengeListRequestPayloadV1{
 			GuildID:  guildID,
 			Statuses: statuses,
 		},
-		response,
-	); err != nil {
-		return nil, err
-	}
-	return response, nil
+		challengeRequestTimeout,
+	)
 }
 
 func (m *manager) requestChallengeDetail(ctx context.Context, guildID, challengeID string) (*clubevents.ChallengeDetailResponsePayloadV1, error) {
@@ -648,70 +695,16 @@ func (m *manager) requestChallengeDetail(ctx context.Context, guildID, challenge
 		return nil, fmt.Errorf("challenge id is required")
 	}
 
-	response := &clubevents.ChallengeDetailResponsePayloadV1{}
-	if err := m.requestReplyJSON(
+	return messagecreator.NATSRequest[*clubevents.ChallengeDetailRequestPayloadV1, clubevents.ChallengeDetailResponsePayloadV1](
 		ctx,
+		m.publisher,
 		challengeRequestSubject(clubevents.ChallengeDetailRequestV1, guildID),
 		&clubevents.ChallengeDetailRequestPayloadV1{
 			GuildID:     guildID,
 			ChallengeID: challengeID,
 		},
-		response,
-	); err != nil {
-		return nil, err
-	}
-	return response, nil
-}
-
-func (m *manager) requestReplyJSON(ctx context.Context, subject string, requestPayload any, responsePayload any) error {
-	if m.publisher == nil {
-		return fmt.Errorf("event bus unavailable")
-	}
-
-	natsConn := m.publisher.GetNATSConnection()
-	if natsConn == nil {
-		return fmt.Errorf("nats connection unavailable")
-	}
-
-	payloadBytes, err := json.Marshal(requestPayload)
-	if err != nil {
-		return fmt.Errorf("marshal request: %w", err)
-	}
-
-	inbox := nc.NewInbox()
-	subscription, err := natsConn.SubscribeSync(inbox)
-	if err != nil {
-		return fmt.Errorf("subscribe reply inbox: %w", err)
-	}
-	defer func() {
-		_ = subscription.Unsubscribe()
-	}()
-
-	requestMsg := nc.NewMsg(subject)
-	requestMsg.Data = payloadBytes
-	requestMsg.Header = nc.Header{}
-	requestMsg.Header.Set("reply_to", inbox)
-
-	if err := natsConn.PublishMsg(requestMsg); err != nil {
-		return fmt.Errorf("publish request: %w", err)
-	}
-
-	timeout := 5 * time.Second
-	if deadline, ok := ctx.Deadline(); ok {
-		if remaining := time.Until(deadline); remaining > 0 && remaining < timeout {
-			timeout = remaining
-		}
-	}
-
-	replyMsg, err := subscription.NextMsg(timeout)
-	if err != nil {
-		return fmt.Errorf("await reply: %w", err)
-	}
-	if err := json.Unmarshal(replyMsg.Data, responsePayload); err != nil {
-		return fmt.Errorf("decode reply: %w", err)
-	}
-
-	return nil
+		challengeRequestTimeout,
+	)
 }
 
 func challengeRequestSubject(baseSubject, guildID string) string {
@@ -822,6 +815,26 @@ func challengeBoardURL(cfg *config.Config) string {
 	return baseURL + "/challenges"
 }
 
+// notifyChallenge DMs the player a challenge fact is news to, if they opted in
+// to challenge alerts.
+func (m *manager) notifyChallenge(ctx context.Context, topic, guildID, channelID, messageID string, challenge clubtypes.ChallengeDetail) {
+	if m.notifier == nil {
+		return
+	}
+	link := ""
+	if channelID != "" && messageID != "" {
+		link = fmt.Sprintf("https://discord.com/channels/%s/%s/%s", guildID, channelID, messageID)
+	}
+	if recipient, content, ok := challengeAlert(topic, challenge, link); ok {
+		m.notifier.Notify(ctx, notify.Alert{
+			GuildID:  guildID,
+			Category: notify.Challenges,
+			UserIDs:  []string{recipient},
+			Content:  content,
+		})
+	}
+}
+
 func isRoundLinkedTopic(topic string) bool {
 	return strings.HasPrefix(topic, clubevents.ChallengeRoundLinkedV1)
 }
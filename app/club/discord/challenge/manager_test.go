@@ -111,6 +111,72 @@ func TestManagerHandleChallengeCommandOpenPublishesRequest(t *testing.T) {
 	}
 }
 
+func TestManagerHandleChallengeUserCommandOpensChallengeForTarget(t *testing.T) {
+	fakeSession := discordpkg.NewFakeSession()
+	fakeBus := &testutils.FakeEventBus{}
+	fakeHelper := &testutils.FakeHelpers{}
+
+	var publishedPayload *clubevents.ChallengeOpenRequestedPayloadV1
+	fakeHelper.CreateNewMessageFunc = func(payload any, topic string) (*message.Message, error) {
+		publishedPayload, _ = payload.(*clubevents.ChallengeOpenRequestedPayloadV1)
+		return message.NewMessage("msg-1", []byte("{}")), nil
+	}
+
+	manager := NewManager(
+		fakeSession,
+		fakeBus,
+		testutils.NoOpLogger(),
+		fakeHelper,
+		&config.Config{},
+		&testutils.FakeGuildConfigResolver{},
+		discordmetrics.NewNoop(),
+		nil,
+	)
+
+	userCommand := func(target *discordgo.User) *discordgo.InteractionCreate {
+		return &discordgo.InteractionCreate{
+			Interaction: &discordgo.Interaction{
+				GuildID: "guild-1",
+				Member:  &discordgo.Member{User: &discordgo.User{ID: "actor-1"}},
+				Type:    discordgo.InteractionApplicationCommand,
+				Data: discordgo.ApplicationCommandInteractionData{
+					Name:        "Challenge this player",
+					CommandType: discordgo.UserApplicationCommand,
+					TargetID:    target.ID,
+					Resolved: &discordgo.ApplicationCommandInteractionDataResolved{
+						Users: map[string]*discordgo.User{target.ID: target},
+					},
+				},
+			},
+		}
+	}
+
+	if err := manager.HandleChallengeUserCommand(context.Background(), userCommand(&discordgo.User{ID: "target-1"})); err != nil {
+		t.Fatalf("HandleChallengeUserCommand returned error: %v", err)
+	}
+	if publishedPayload == nil || publishedPayload.ActorExternalID != "actor-1" || publishedPayload.TargetExternalID != "target-1" {
+		t.Fatalf("unexpected payload: %+v", publishedPayload)
+	}
+
+	for _, target := range []*discordgo.User{{ID: "actor-1"}, {ID: "bot-1", Bot: true}} {
+		publishedPayload = nil
+		var replied string
+		fakeSession.InteractionRespondFunc = func(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+			replied = resp.Data.Content
+			return nil
+		}
+		if err := manager.HandleChallengeUserCommand(context.Background(), userCommand(target)); err != nil {
+			t.Fatalf("HandleChallengeUserCommand returned error: %v", err)
+		}
+		if publishedPayload != nil {
+			t.Fatalf("expected no challenge request for target %+v", target)
+		}
+		if !strings.HasPrefix(replied, "You cannot challenge") {
+			t.Fatalf("expected rejection for target %+v, got %q", target, replied)
+		}
+	}
+}
+
 func TestManagerHandleChallengeFactPostsCardAndBindsMessage(t *testing.T) {
 	fakeSession := discordpkg.NewFakeSession()
 	fakeBus := &testutils.FakeEventBus{}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	clubevents "github.com/Black-And-White-Club/frolf-bot-shared/events/club"
 	clubtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/club"
 	"github.com/bwmarrin/discordgo"
 )
@@ -143,11 +144,11 @@ func challengeDeadline(challenge clubtypes.ChallengeDetail) string {
 	switch challenge.Status {
 	case clubtypes.ChallengeStatusOpen:
 		if challenge.OpenExpiresAt != nil {
-			return challenge.OpenExpiresAt.Format(time.RFC822)
+			return fmt.Sprintf("<t:%d:f>", challenge.OpenExpiresAt.Unix())
 		}
 	case clubtypes.ChallengeStatusAccepted:
 		if challenge.AcceptedExpiresAt != nil {
-			return challenge.AcceptedExpiresAt.Format(time.RFC822)
+			return fmt.Sprintf("<t:%d:f>", challenge.AcceptedExpiresAt.Unix())
 		}
 	}
 	return ""
@@ -188,3 +189,31 @@ func challengeAppURL(cfg *config.Config, challengeID string) string {
 	}
 	return fmt.Sprintf("%s/challenges/%s", baseURL, url.PathEscape(challengeID))
 }
+
+// challengeAlert returns the DM sent to a player about a challenge fact, and
+// who gets it: the defender when they are challenged and the challenger when
+// their challenge is accepted. ok is false for other facts.
+func challengeAlert(topic string, challenge clubtypes.ChallengeDetail, link string) (recipient, content string, ok bool) {
+	challenger := participantMention(challenge.ChallengerExternalID, challenge.ChallengerUserUUID)
+	defender := participantMention(challenge.DefenderExternalID, challenge.DefenderUserUUID)
+
+	switch {
+	case strings.HasPrefix(topic, clubevents.ChallengeOpenedV1) && challenge.DefenderExternalID != nil:
+		recipient = *challenge.DefenderExternalID
+		content = fmt.Sprintf("⚔️ %s challenged you for your tag (%s vs your %s). Accept or decline on the challenge card.",
+			challenger, formatTag(challenge.CurrentTags.Challenger), formatTag(challenge.CurrentTags.Defender))
+	case strings.HasPrefix(topic, clubevents.ChallengeAcceptedV1) && challenge.ChallengerExternalID != nil:
+		recipient = *challenge.ChallengerExternalID
+		content = fmt.Sprintf("✅ %s accepted your challenge. Schedule the round from the challenge card.", defender)
+	default:
+		return "", "", false
+	}
+
+	if recipient == "" {
+		return "", "", false
+	}
+	if link != "" {
+		content += "\n" + link
+	}
+	return recipient, content, true
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	clubevents "github.com/Black-And-White-Club/frolf-bot-shared/events/club"
 	clubtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/club"
 	"github.com/bwmarrin/discordgo"
 )
@@ -101,3 +102,34 @@ func assertLinkButton(t *testing.T, component discordgo.MessageComponent, wantLa
 		t.Fatalf("expected link URL %q, got %q", wantURL, button.URL)
 	}
 }
+
+func TestChallengeAlert(t *testing.T) {
+	challenger, defender := "111", "222"
+	challenge := clubtypes.ChallengeDetail{
+		ChallengeSummary: clubtypes.ChallengeSummary{
+			ID:                   "challenge-1",
+			ChallengerExternalID: &challenger,
+			DefenderExternalID:   &defender,
+			Status:               clubtypes.ChallengeStatusOpen,
+		},
+	}
+
+	recipient, content, ok := challengeAlert(clubevents.ChallengeOpenedV1, challenge, "https://discord.com/channels/g/c/m")
+	want := "⚔️ <@111> challenged you for your tag (unranked vs your unranked). Accept or decline on the challenge card.\nhttps://discord.com/channels/g/c/m"
+	if !ok || recipient != defender || content != want {
+		t.Fatalf("challengeAlert(opened) = %q, %q, %v", recipient, content, ok)
+	}
+
+	recipient, content, ok = challengeAlert(clubevents.ChallengeAcceptedV1, challenge, "")
+	if !ok || recipient != challenger || content != "✅ <@222> accepted your challenge. Schedule the round from the challenge card." {
+		t.Fatalf("challengeAlert(accepted) = %q, %q, %v", recipient, content, ok)
+	}
+
+	if _, _, ok := challengeAlert(clubevents.ChallengeDeclinedV1, challenge, ""); ok {
+		t.Fatal("expected no alert for a declined challenge")
+	}
+	challenge.DefenderExternalID = nil
+	if _, _, ok := challengeAlert(clubevents.ChallengeOpenedV1, challenge, ""); ok {
+		t.Fatal("expected no alert for a defender without a Discord account")
+	}
+}
@@ -9,9 +9,9 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
-// RegisterHandlers registers challenge slash commands and button interactions.
+// RegisterHandlers registers challenge slash and user commands, ID autocomplete and button interactions.
 func RegisterHandlers(registry *interactions.Registry, manager Manager) {
-	registry.RegisterMutatingHandler("challenge", func(ctx context.Context, i *discordgo.InteractionCreate) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling /challenge command",
 			attr.String("guild_id", i.GuildID),
 			attr.String("user_id", interactionUserIDForLog(i)),
@@ -19,7 +19,20 @@ func RegisterHandlers(registry *interactions.Registry, manager Manager) {
 		if err := manager.HandleChallengeCommand(ctx, i); err != nil {
 			slog.Error("Challenge command failed", attr.Error(err))
 		}
-	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+	})
+
+	registry.RegisterCommandHandler(UserCommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling Challenge this player user command",
+			attr.String("guild_id", i.GuildID),
+			attr.String("user_id", interactionUserIDForLog(i)),
+		)
+		if err := manager.HandleChallengeUserCommand(ctx, i); err != nil {
+			slog.Error("Challenge user command failed", attr.Error(err))
+		}
+	})
+
+	registry.RegisterAutocompleteHandler(CommandSpec().Name(), "challenge_id", manager.AutocompleteChallengeID)
+	registry.RegisterAutocompleteHandler(CommandSpec().Name(), "round_id", manager.AutocompleteRoundID)
 
 	registry.RegisterMutatingHandler(challengeAcceptPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling challenge accept button",
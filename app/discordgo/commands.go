@@ -9,15 +9,9 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
-const guildCommandManifestVersion = "2026-03-11.2"
-
-// GuildCommandManifestVersion returns the current guild command manifest version.
-func GuildCommandManifestVersion() string {
-	return guildCommandManifestVersion
-}
-
-// RegisterCommands reconciles slash commands with Discord state using upsert + prune semantics.
-func RegisterCommands(s Session, logger *slog.Logger, guildID string) error {
+// RegisterCommands reconciles the commands registered for guildID (global when
+// empty) with desired using upsert + prune semantics.
+func RegisterCommands(s Session, logger *slog.Logger, guildID string, desired []*discordgo.ApplicationCommand) error {
 	appID, err := s.GetBotUser()
 	if err != nil {
 		return fmt.Errorf("failed to retrieve bot user: %w", err)
@@ -30,13 +24,12 @@ func RegisterCommands(s Session, logger *slog.Logger, guildID string) error {
 		logger.Info("Reconciling commands for specific guild", attr.String("guild_id", targetGuildID))
 	}
 
-	desired := desiredCommands(targetGuildID)
 	desiredByName := make(map[string]*discordgo.ApplicationCommand, len(desired))
 	for _, cmd := range desired {
 		if cmd == nil || cmd.Name == "" {
 			return fmt.Errorf("desired command has empty name")
 		}
-		desiredByName[cmd.Name] = cmd
+		desiredByName[commandKey(cmd)] = cmd
 	}
 
 	var existing []*discordgo.ApplicationCommand
@@ -53,7 +46,7 @@ func RegisterCommands(s Session, logger *slog.Logger, guildID string) error {
 		if cmd == nil || cmd.Name == "" {
 			continue
 		}
-		existingByName[cmd.Name] = cmd
+		existingByName[commandKey(cmd)] = cmd
 	}
 
 	for name, desiredCmd := range desiredByName {
@@ -117,244 +110,43 @@ func RegisterCommands(s Session, logger *slog.Logger, guildID string) error {
 	return nil
 }
 
-func desiredCommands(targetGuildID string) []*discordgo.ApplicationCommand {
-	// For multi-tenant mode (empty guildID), only setup/reset are global.
-	if targetGuildID == "" {
-		return []*discordgo.ApplicationCommand{
-			{
-				Name:                     "frolf-setup",
-				Description:              "Set up Frolf Bot for this server (Admin only)",
-				DefaultMemberPermissions: int64Ptr(discordgo.PermissionAdministrator),
-			},
-			{
-				Name:                     "frolf-reset",
-				Description:              "Reset Frolf Bot configuration for this server (Admin only)",
-				DefaultMemberPermissions: int64Ptr(discordgo.PermissionAdministrator),
-			},
-		}
-	}
-
-	return []*discordgo.ApplicationCommand{
-		{
-			Name:        "updaterole",
-			Description: "Request a role for a user (Requires Editor role or higher)",
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Type:        discordgo.ApplicationCommandOptionUser,
-					Name:        "user",
-					Description: "The user to request a role for",
-					Required:    true,
-				},
-			},
-		},
-		{
-			Name:        "createround",
-			Description: "Create a new frolf round (Available to all players)",
-		},
-		{
-			Name:        "claimtag",
-			Description: "Claim a specific tag number on the leaderboard (Available to all players)",
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Type:        discordgo.ApplicationCommandOptionInteger,
-					Name:        "tag",
-					Description: "Tag number to claim (1-100)",
-					Required:    true,
-					MinValue:    float64Ptr(1),
-					MaxValue:    100,
-				},
-			},
-		},
-		{
-			Name:        "set-udisc-name",
-			Description: "Set your UDisc username and name for scorecard matching (Available to all players)",
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "username",
-					Description: "Your UDisc username (e.g., @johndoe)",
-					Required:    false,
-					MaxLength:   100,
-				},
-				{
-					Type:        discordgo.ApplicationCommandOptionString,
-					Name:        "name",
-					Description: "Your name as shown on UDisc rounds",
-					Required:    false,
-					MaxLength:   100,
-				},
-			},
-		},
-		{
-			Name:        "dashboard",
-			Description: "Get a link to access the Frolf PWA dashboard",
-		},
-		{
-			Name:        "challenge",
-			Description: "Open, manage, and link club challenges",
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "open",
-					Description: "Challenge another player",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionUser,
-							Name:        "user",
-							Description: "The player to challenge",
-							Required:    true,
-						},
-					},
-				},
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "schedule",
-					Description: "Schedule a new round for an accepted challenge",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "challenge_id",
-							Description: "The challenge ID",
-							Required:    true,
-						},
-					},
-				},
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "withdraw",
-					Description: "Withdraw one of your active challenges",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "challenge_id",
-							Description: "The challenge ID",
-							Required:    true,
-						},
-					},
-				},
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "link",
-					Description: "Link an existing round to an accepted challenge",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "challenge_id",
-							Description: "The challenge ID",
-							Required:    true,
-						},
-						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "round_id",
-							Description: "The round ID",
-							Required:    true,
-						},
-					},
-				},
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "unlink",
-					Description: "Remove the round currently linked to a challenge",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "challenge_id",
-							Description: "The challenge ID",
-							Required:    true,
-						},
-					},
-				},
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "hide",
-					Description: "Hide a challenge from the board",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "challenge_id",
-							Description: "The challenge ID",
-							Required:    true,
-						},
-					},
-				},
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "list",
-					Description: "See where active challenges are surfaced",
-				},
-			},
-		},
-		{
-			Name:        "invite",
-			Description: "Get a link to manage club invites (Editor/Admin only)",
-		},
-		{
-			Name:        "season",
-			Description: "Manage and view seasons (Admin only)",
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "start",
-					Description: "Start a new season",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "name",
-							Description: "Name of the new season",
-							Required:    true,
-						},
-					},
-				},
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "standings",
-					Description: "View season standings",
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Type:        discordgo.ApplicationCommandOptionString,
-							Name:        "season_id",
-							Description: "ID of the season (optional, defaults to current)",
-							Required:    false,
-						},
-					},
-				},
-				{
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Name:        "end",
-					Description: "End the current season",
-				},
-			},
-			DefaultMemberPermissions: int64Ptr(discordgo.PermissionAdministrator),
-		},
-		{
-			Name:        "bet",
-			Description: "Access the seasonal betting module for this club",
-		},
-	}
-}
-
 func commandShapeEqual(a, b *discordgo.ApplicationCommand) bool {
 	if a == nil || b == nil {
 		return a == b
 	}
 
 	return a.Name == b.Name &&
+		commandType(a) == commandType(b) &&
 		a.Description == b.Description &&
 		int64PtrEqual(a.DefaultMemberPermissions, b.DefaultMemberPermissions) &&
 		reflect.DeepEqual(a.Options, b.Options)
 }
 
-func int64PtrEqual(a, b *int64) bool {
-	if a == nil || b == nil {
-		return a == b
+// commandKey identifies a command within a scope. Discord allows a slash
+// command and a context-menu command to share a name.
+func commandKey(cmd *discordgo.ApplicationCommand) string {
+	switch commandType(cmd) {
+	case discordgo.UserApplicationCommand:
+		return "user:" + cmd.Name
+	case discordgo.MessageApplicationCommand:
+		return "message:" + cmd.Name
+	default:
+		return cmd.Name
 	}
-	return *a == *b
 }
 
-func int64Ptr(v int64) *int64 {
-	return &v
+// commandType treats an unset type as a slash command, which is how Discord
+// reports commands created without one.
+func commandType(cmd *discordgo.ApplicationCommand) discordgo.ApplicationCommandType {
+	if cmd.Type == 0 {
+		return discordgo.ChatApplicationCommand
+	}
+	return cmd.Type
 }
 
-func float64Ptr(v float64) *float64 {
-	return &v
+func int64PtrEqual(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
 }
@@ -14,6 +14,51 @@ func testLogger() *slog.Logger {
 	return slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelInfo}))
 }
 
+// testDesiredCommands is a stand-in for a module-built command manifest.
+func testDesiredCommands() []*discordgo.ApplicationCommand {
+	minTag := 1.0
+	return []*discordgo.ApplicationCommand{
+		{
+			Name:        "updaterole",
+			Description: "Request a role for a user (Requires Editor role or higher)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The user to request a role for",
+					Required:    true,
+				},
+			},
+		},
+		{
+			Name:        "createround",
+			Description: "Create a new frolf round (Available to all players)",
+		},
+		{
+			Name:        "claimtag",
+			Description: "Claim a specific tag number on the leaderboard (Available to all players)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "tag",
+					Description: "Tag number to claim (1-100)",
+					Required:    true,
+					MinValue:    &minTag,
+					MaxValue:    100,
+				},
+			},
+		},
+		{
+			Name:        "dashboard",
+			Description: "Get a link to access the Frolf PWA dashboard",
+		},
+		{
+			Name:        "challenge",
+			Description: "Open, manage, and link club challenges",
+		},
+	}
+}
+
 func TestRegisterCommands_ReconcileGuildCommands_CreatesUpdatesDeletes(t *testing.T) {
 	fs := NewFakeSession()
 	logger := testLogger()
@@ -67,11 +112,11 @@ func TestRegisterCommands_ReconcileGuildCommands_CreatesUpdatesDeletes(t *testin
 		return nil
 	}
 
-	if err := RegisterCommands(fs, logger, "g1"); err != nil {
+	if err := RegisterCommands(fs, logger, "g1", testDesiredCommands()); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
-	for _, name := range []string{"createround", "claimtag", "set-udisc-name", "challenge", "season"} {
+	for _, name := range []string{"createround", "claimtag", "challenge"} {
 		if !created[name] {
 			t.Fatalf("expected command %q to be created; created=%v", name, created)
 		}
@@ -95,7 +140,7 @@ func TestRegisterCommands_AllCommandsCurrent_NoChanges(t *testing.T) {
 	}
 
 	fs.ApplicationCommandsFunc = func(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
-		desired := desiredCommands("g1")
+		desired := testDesiredCommands()
 		current := make([]*discordgo.ApplicationCommand, 0, len(desired))
 		for i, cmd := range desired {
 			copyCmd := *cmd
@@ -119,7 +164,7 @@ func TestRegisterCommands_AllCommandsCurrent_NoChanges(t *testing.T) {
 		return nil
 	}
 
-	if err := RegisterCommands(fs, logger, "g1"); err != nil {
+	if err := RegisterCommands(fs, logger, "g1", testDesiredCommands()); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 
@@ -139,7 +184,7 @@ func TestRegisterCommands_ListError_ReturnsError(t *testing.T) {
 		return nil, errors.New("list failed")
 	}
 
-	if err := RegisterCommands(fs, logger, "g1"); err == nil {
+	if err := RegisterCommands(fs, logger, "g1", testDesiredCommands()); err == nil {
 		t.Fatal("expected list failure to be returned")
 	}
 }
@@ -153,61 +198,16 @@ func TestRegisterCommands_RetriesTransientCreateFailure(t *testing.T) {
 	}
 
 	fs.ApplicationCommandsFunc = func(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
-		desiredByName := map[string]*discordgo.ApplicationCommand{}
-		for _, cmd := range desiredCommands("g1") {
-			desiredByName[cmd.Name] = cmd
+		current := make([]*discordgo.ApplicationCommand, 0)
+		for _, cmd := range testDesiredCommands() {
+			if cmd.Name == "challenge" {
+				continue
+			}
+			copyCmd := *cmd
+			copyCmd.ID = "cmd-" + cmd.Name
+			current = append(current, &copyCmd)
 		}
-		return []*discordgo.ApplicationCommand{
-			{
-				ID:          "cmd-updaterole",
-				Name:        desiredByName["updaterole"].Name,
-				Description: desiredByName["updaterole"].Description,
-				Options:     desiredByName["updaterole"].Options,
-			},
-			{
-				ID:          "cmd-createround",
-				Name:        desiredByName["createround"].Name,
-				Description: desiredByName["createround"].Description,
-				Options:     desiredByName["createround"].Options,
-			},
-			{
-				ID:          "cmd-claimtag",
-				Name:        desiredByName["claimtag"].Name,
-				Description: desiredByName["claimtag"].Description,
-				Options:     desiredByName["claimtag"].Options,
-			},
-			{
-				ID:          "cmd-set-udisc-name",
-				Name:        desiredByName["set-udisc-name"].Name,
-				Description: desiredByName["set-udisc-name"].Description,
-				Options:     desiredByName["set-udisc-name"].Options,
-			},
-			{
-				ID:          "cmd-dashboard",
-				Name:        desiredByName["dashboard"].Name,
-				Description: desiredByName["dashboard"].Description,
-				Options:     desiredByName["dashboard"].Options,
-			},
-			{
-				ID:          "cmd-invite",
-				Name:        desiredByName["invite"].Name,
-				Description: desiredByName["invite"].Description,
-				Options:     desiredByName["invite"].Options,
-			},
-			{
-				ID:                       "cmd-season",
-				Name:                     desiredByName["season"].Name,
-				Description:              desiredByName["season"].Description,
-				Options:                  desiredByName["season"].Options,
-				DefaultMemberPermissions: desiredByName["season"].DefaultMemberPermissions,
-			},
-			{
-				ID:          "cmd-bet",
-				Name:        desiredByName["bet"].Name,
-				Description: desiredByName["bet"].Description,
-				Options:     desiredByName["bet"].Options,
-			},
-		}, nil
+		return current, nil
 	}
 
 	createCalls := 0
@@ -222,7 +222,7 @@ func TestRegisterCommands_RetriesTransientCreateFailure(t *testing.T) {
 		return &discordgo.ApplicationCommand{ID: cmd.Name + "-id"}, nil
 	}
 
-	if err := RegisterCommands(fs, logger, "g1"); err != nil {
+	if err := RegisterCommands(fs, logger, "g1", testDesiredCommands()); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if createCalls != 3 {
@@ -230,35 +230,47 @@ func TestRegisterCommands_RetriesTransientCreateFailure(t *testing.T) {
 	}
 }
 
-func TestDesiredCommands_ChallengeIncludesScheduleSubcommand(t *testing.T) {
-	var challengeCommand *discordgo.ApplicationCommand
-	for _, cmd := range desiredCommands("g1") {
-		if cmd.Name == "challenge" {
-			challengeCommand = cmd
-			break
-		}
+func TestRegisterCommands_ContextMenuCommandsReconcileByType(t *testing.T) {
+	fs := NewFakeSession()
+	logger := testLogger()
+
+	fs.GetBotUserFunc = func() (*discordgo.User, error) {
+		return &discordgo.User{ID: "bot"}, nil
 	}
-	if challengeCommand == nil {
-		t.Fatal("expected challenge command in manifest")
+	fs.ApplicationCommandsFunc = func(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+		return []*discordgo.ApplicationCommand{
+			{ID: "cmd-history", Name: "history", Type: discordgo.ChatApplicationCommand, Description: "View tag history"},
+			{ID: "cmd-history-user", Name: "history", Type: discordgo.UserApplicationCommand},
+		}, nil
 	}
 
-	var scheduleOption *discordgo.ApplicationCommandOption
-	for _, option := range challengeCommand.Options {
-		if option.Name == "schedule" {
-			scheduleOption = option
-			break
-		}
+	var created []*discordgo.ApplicationCommand
+	fs.ApplicationCommandCreateFunc = func(appID, guildID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error) {
+		created = append(created, cmd)
+		return cmd, nil
+	}
+	fs.ApplicationCommandEditFunc = func(appID, guildID, cmdID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error) {
+		t.Fatalf("unexpected edit of %s", cmdID)
+		return nil, nil
 	}
-	if scheduleOption == nil {
-		t.Fatal("expected schedule subcommand in challenge manifest")
+	var deleted []string
+	fs.ApplicationCommandDeleteFunc = func(appID, guildID, cmdID string, options ...discordgo.RequestOption) error {
+		deleted = append(deleted, cmdID)
+		return nil
+	}
+
+	desired := []*discordgo.ApplicationCommand{
+		{Name: "history", Description: "View tag history"},
+		{Name: "history", Type: discordgo.MessageApplicationCommand},
 	}
-	if scheduleOption.Type != discordgo.ApplicationCommandOptionSubCommand {
-		t.Fatalf("expected schedule to be a subcommand, got %v", scheduleOption.Type)
+	if err := RegisterCommands(fs, logger, "g1", desired); err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	if len(scheduleOption.Options) != 1 {
-		t.Fatalf("expected schedule to accept exactly one option, got %d", len(scheduleOption.Options))
+
+	if len(created) != 1 || created[0].Type != discordgo.MessageApplicationCommand {
+		t.Fatalf("expected only the message command to be created, got %+v", created)
 	}
-	if scheduleOption.Options[0].Name != "challenge_id" || !scheduleOption.Options[0].Required {
-		t.Fatalf("unexpected schedule option definition: %+v", scheduleOption.Options[0])
+	if len(deleted) != 1 || deleted[0] != "cmd-history-user" {
+		t.Fatalf("expected the user command to be pruned, got %v", deleted)
 	}
 }
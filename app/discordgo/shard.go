@@ -0,0 +1,17 @@
+package discord
+
+import "strconv"
+
+// GuildShardID returns the gateway shard that receives events for guildID,
+// using Discord's formula (guild_id >> 22) % shard_count. Unparseable IDs
+// and non-positive shard counts map to shard 0.
+func GuildShardID(guildID string, shardCount int) int {
+	if shardCount <= 1 {
+		return 0
+	}
+	id, err := strconv.ParseUint(guildID, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return int((id >> 22) % uint64(shardCount))
+}
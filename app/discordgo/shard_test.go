@@ -0,0 +1,28 @@
+package discord
+
+import "testing"
+
+func TestGuildShardID(t *testing.T) {
+	tests := []struct {
+		name       string
+		guildID    string
+		shardCount int
+		want       int
+	}{
+		{name: "unsharded", guildID: "197038439483310086", shardCount: 1, want: 0},
+		{name: "zero count", guildID: "197038439483310086", shardCount: 0, want: 0},
+		{name: "invalid id", guildID: "not-a-snowflake", shardCount: 4, want: 0},
+		// 197038439483310086 >> 22 = 46977624770
+		{name: "two shards", guildID: "197038439483310086", shardCount: 2, want: 0},
+		{name: "three shards", guildID: "197038439483310086", shardCount: 3, want: 2},
+		{name: "seven shards", guildID: "197038439483310086", shardCount: 7, want: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GuildShardID(tt.guildID, tt.shardCount); got != tt.want {
+				t.Fatalf("GuildShardID(%q, %d) = %d, want %d", tt.guildID, tt.shardCount, got, tt.want)
+			}
+		})
+	}
+}
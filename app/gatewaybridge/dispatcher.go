@@ -0,0 +1,181 @@
+package gatewaybridge
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/nats-io/nats.go"
+)
+
+var sessionType = reflect.TypeOf((*discordgo.Session)(nil))
+
+// eventFactories maps each subject to a constructor for the discordgo value
+// its payload decodes into. Ready is handled separately because the gateway
+// forwards a trimmed ReadyPayload.
+var eventFactories = map[string]func() interface{}{
+	InteractionCreateV1:             func() interface{} { return &discordgo.InteractionCreate{} },
+	MessageCreateV1:                 func() interface{} { return &discordgo.MessageCreate{} },
+	MessageReactionAddV1:            func() interface{} { return &discordgo.MessageReactionAdd{} },
+	MessageReactionRemoveV1:         func() interface{} { return &discordgo.MessageReactionRemove{} },
+	GuildScheduledEventCreateV1:     func() interface{} { return &discordgo.GuildScheduledEventCreate{} },
+	GuildScheduledEventUpdateV1:     func() interface{} { return &discordgo.GuildScheduledEventUpdate{} },
+	GuildScheduledEventDeleteV1:     func() interface{} { return &discordgo.GuildScheduledEventDelete{} },
+	GuildScheduledEventUserAddV1:    func() interface{} { return &discordgo.GuildScheduledEventUserAdd{} },
+	GuildScheduledEventUserRemoveV1: func() interface{} { return &discordgo.GuildScheduledEventUserRemove{} },
+}
+
+type dispatchHandler struct {
+	fn reflect.Value
+}
+
+// Dispatcher stands in for discordgo's handler table on workers. It accepts
+// the same func(*discordgo.Session, *Event) handlers and invokes them with a
+// REST-only session when forwarded events arrive from NATS.
+type Dispatcher struct {
+	session  *discordgo.Session
+	logger   *slog.Logger
+	mu       sync.RWMutex
+	handlers map[reflect.Type][]*dispatchHandler
+}
+
+// NewDispatcher creates a Dispatcher that passes session to every handler.
+func NewDispatcher(session *discordgo.Session, logger *slog.Logger) *Dispatcher {
+	return &Dispatcher{
+		session:  session,
+		logger:   logger,
+		handlers: make(map[reflect.Type][]*dispatchHandler),
+	}
+}
+
+// AddHandler registers a discordgo-style event handler and returns a func that
+// removes it. Handlers for events that are never forwarded (Connect,
+// GuildCreate, ...) are accepted and simply never called.
+func (d *Dispatcher) AddHandler(handler interface{}) func() {
+	fn := reflect.ValueOf(handler)
+	t := fn.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 2 || t.In(0) != sessionType {
+		if d.logger != nil {
+			d.logger.Error("Ignoring invalid gateway handler", slog.String("type", t.String()))
+		}
+		return func() {}
+	}
+
+	h := &dispatchHandler{fn: fn}
+	eventType := t.In(1)
+
+	d.mu.Lock()
+	d.handlers[eventType] = append(d.handlers[eventType], h)
+	d.mu.Unlock()
+
+	return func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		list := d.handlers[eventType]
+		for i := range list {
+			if list[i] == h {
+				d.handlers[eventType] = append(list[:i], list[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Dispatch decodes env and synchronously invokes every matching handler.
+func (d *Dispatcher) Dispatch(env *Envelope) error {
+	event, err := decodeEvent(env)
+	if err != nil {
+		return err
+	}
+
+	d.mu.RLock()
+	handlers := append([]*dispatchHandler(nil), d.handlers[reflect.TypeOf(event)]...)
+	d.mu.RUnlock()
+
+	args := []reflect.Value{reflect.ValueOf(d.session), reflect.ValueOf(event)}
+	for _, h := range handlers {
+		h.fn.Call(args)
+	}
+	return nil
+}
+
+// HandleMsg is a nats.MsgHandler. Each message is dispatched on its own
+// goroutine, matching discordgo's default (non-SyncEvents) behavior so a slow
+// handler cannot stall the subscription.
+func (d *Dispatcher) HandleMsg(msg *nats.Msg) {
+	env, err := DecodeEnvelope(msg.Data)
+	if err != nil {
+		d.logError("Failed to decode gateway envelope", msg.Subject, err)
+		return
+	}
+	go func() {
+		if err := d.Dispatch(env); err != nil {
+			d.logError("Failed to dispatch gateway event", env.Subject, err)
+		}
+	}()
+}
+
+// Subscribe attaches the dispatcher to every forwarded subject. Ready is
+// fanned out to all workers because each one keeps in-memory state that is
+// rebuilt from it; everything else is load-balanced across queue.
+func (d *Dispatcher) Subscribe(nc *nats.Conn, queue string) ([]*nats.Subscription, error) {
+	subs := make([]*nats.Subscription, 0, len(Subjects()))
+	for _, subject := range Subjects() {
+		var (
+			sub *nats.Subscription
+			err error
+		)
+		if subject == ReadyV1 {
+			sub, err = nc.Subscribe(subject, d.HandleMsg)
+		} else {
+			sub, err = nc.QueueSubscribe(subject, queue, d.HandleMsg)
+		}
+		if err != nil {
+			for _, s := range subs {
+				_ = s.Unsubscribe()
+			}
+			return nil, fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+func decodeEvent(env *Envelope) (interface{}, error) {
+	if env.Subject == ReadyV1 {
+		var payload ReadyPayload
+		if err := env.Decode(&payload); err != nil {
+			return nil, err
+		}
+		ready := &discordgo.Ready{
+			SessionID: payload.SessionID,
+			Shard:     &[2]int{payload.ShardID, max(payload.ShardCount, 1)},
+			Guilds:    make([]*discordgo.Guild, 0, len(payload.GuildIDs)),
+		}
+		for _, id := range payload.GuildIDs {
+			ready.Guilds = append(ready.Guilds, &discordgo.Guild{ID: id})
+		}
+		return ready, nil
+	}
+
+	factory, ok := eventFactories[env.Subject]
+	if !ok {
+		return nil, fmt.Errorf("unknown gateway subject %q", env.Subject)
+	}
+	event := factory()
+	if err := env.Decode(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (d *Dispatcher) logError(msg, subject string, err error) {
+	if d.logger == nil {
+		return
+	}
+	d.logger.Error(msg,
+		slog.String("subject", subject),
+		slog.String("error", err.Error()))
+}
@@ -0,0 +1,101 @@
+package gatewaybridge
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestDispatcher_DispatchesForwardedInteraction(t *testing.T) {
+	pub := &fakePublisher{}
+	f := newTestForwarder(pub)
+	f.Forward(InteractionCreateV1, "g1", &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "int-1",
+		Type:    discordgo.InteractionMessageComponent,
+		GuildID: "g1",
+		Data:    discordgo.MessageComponentInteractionData{CustomID: "round_accept|r1"},
+	}})
+
+	session := &discordgo.Session{}
+	d := NewDispatcher(session, slog.Default())
+
+	var got *discordgo.InteractionCreate
+	var gotSession *discordgo.Session
+	d.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+		gotSession = s
+		got = i
+	})
+	d.AddHandler(func(s *discordgo.Session, m *discordgo.MessageCreate) {
+		t.Fatalf("message handler should not receive interactions")
+	})
+
+	env, err := DecodeEnvelope(pub.messages[0].data)
+	if err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if err := d.Dispatch(env); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+
+	if gotSession != session {
+		t.Fatalf("expected dispatcher session to be passed to handler")
+	}
+	if got == nil || got.ID != "int-1" {
+		t.Fatalf("unexpected interaction: %+v", got)
+	}
+	if custom := got.MessageComponentData().CustomID; custom != "round_accept|r1" {
+		t.Fatalf("expected component data to survive, got %q", custom)
+	}
+}
+
+func TestDispatcher_ReadyRebuildsGuildList(t *testing.T) {
+	env, err := NewEnvelope(ReadyV1, 1, "", time.Now(), ReadyPayload{SessionID: "s1", ShardID: 1, ShardCount: 2, GuildIDs: []string{"g1", "g2"}})
+	if err != nil {
+		t.Fatalf("new envelope: %v", err)
+	}
+
+	d := NewDispatcher(&discordgo.Session{}, slog.Default())
+	var ready *discordgo.Ready
+	d.AddHandler(func(s *discordgo.Session, r *discordgo.Ready) { ready = r })
+
+	if err := d.Dispatch(env); err != nil {
+		t.Fatalf("dispatch: %v", err)
+	}
+	if ready == nil || ready.SessionID != "s1" || len(ready.Guilds) != 2 || ready.Guilds[1].ID != "g2" {
+		t.Fatalf("unexpected ready: %+v", ready)
+	}
+	if ready.Shard == nil || ready.Shard[0] != 1 || ready.Shard[1] != 2 {
+		t.Fatalf("expected shard [1 2], got %v", ready.Shard)
+	}
+}
+
+func TestDispatcher_RemoveHandler(t *testing.T) {
+	env, _ := NewEnvelope(MessageCreateV1, 0, "g1", time.Now(), &discordgo.MessageCreate{Message: &discordgo.Message{ID: "m1"}})
+
+	d := NewDispatcher(&discordgo.Session{}, slog.Default())
+	calls := 0
+	remove := d.AddHandler(func(s *discordgo.Session, m *discordgo.MessageCreate) { calls++ })
+
+	_ = d.Dispatch(env)
+	remove()
+	_ = d.Dispatch(env)
+
+	if calls != 1 {
+		t.Fatalf("expected handler to run once before removal, got %d", calls)
+	}
+}
+
+func TestDispatcher_RejectsUnknownSubjectAndInvalidHandlers(t *testing.T) {
+	d := NewDispatcher(&discordgo.Session{}, slog.Default())
+
+	// Invalid handler shapes are ignored rather than panicking at dispatch time.
+	d.AddHandler(func(m *discordgo.MessageCreate) {})
+	d.AddHandler("not a func")
+
+	env := &Envelope{Subject: "discord.gateway.unknown.v1", Payload: []byte(`{}`)}
+	if err := d.Dispatch(env); err == nil {
+		t.Fatalf("expected error for unknown subject")
+	}
+}
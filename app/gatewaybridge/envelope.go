@@ -0,0 +1,51 @@
+package gatewaybridge
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Envelope wraps a raw discordgo event payload with routing metadata.
+type Envelope struct {
+	Subject    string          `json:"subject"`
+	ShardID    int             `json:"shard_id"`
+	GuildID    string          `json:"guild_id,omitempty"`
+	ReceivedAt time.Time       `json:"received_at"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// NewEnvelope marshals payload into a new Envelope for subject.
+func NewEnvelope(subject string, shardID int, guildID string, receivedAt time.Time, payload any) (*Envelope, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s payload: %w", subject, err)
+	}
+	return &Envelope{
+		Subject:    subject,
+		ShardID:    shardID,
+		GuildID:    guildID,
+		ReceivedAt: receivedAt.UTC(),
+		Payload:    raw,
+	}, nil
+}
+
+// DecodeEnvelope parses an Envelope from a NATS message body.
+func DecodeEnvelope(data []byte) (*Envelope, error) {
+	var env Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal gateway envelope: %w", err)
+	}
+	return &env, nil
+}
+
+// Decode unmarshals the wrapped payload into target.
+func (e *Envelope) Decode(target any) error {
+	if len(e.Payload) == 0 {
+		return fmt.Errorf("gateway envelope %s has empty payload", e.Subject)
+	}
+	if err := json.Unmarshal(e.Payload, target); err != nil {
+		return fmt.Errorf("failed to unmarshal %s payload: %w", e.Subject, err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package gatewaybridge
+
+import (
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Publisher is the subset of *nats.Conn used to forward events.
+type Publisher interface {
+	Publish(subject string, data []byte) error
+}
+
+type discordgoAdder interface {
+	AddHandler(handler interface{}) func()
+}
+
+// ReadyPayload is the trimmed Ready event forwarded to workers. The full Ready
+// carries private channels and complete guild state which workers never need.
+type ReadyPayload struct {
+	SessionID  string   `json:"session_id"`
+	ShardID    int      `json:"shard_id"`
+	ShardCount int      `json:"shard_count"`
+	GuildIDs   []string `json:"guild_ids"`
+}
+
+// Forwarder publishes gateway events onto NATS without interpreting them.
+type Forwarder struct {
+	publisher  Publisher
+	shardID    int
+	shardCount int
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewForwarder creates a Forwarder that publishes through publisher and stamps
+// every envelope with the gateway's shard.
+func NewForwarder(publisher Publisher, shardID, shardCount int, logger *slog.Logger) *Forwarder {
+	return &Forwarder{
+		publisher:  publisher,
+		shardID:    shardID,
+		shardCount: max(shardCount, 1),
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// RegisterWithSession attaches a discordgo handler per forwarded event type.
+func (f *Forwarder) RegisterWithSession(session discordgoAdder) {
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.Ready) {
+		if e == nil {
+			return
+		}
+		payload := ReadyPayload{
+			SessionID:  e.SessionID,
+			ShardID:    f.shardID,
+			ShardCount: f.shardCount,
+			GuildIDs:   make([]string, 0, len(e.Guilds)),
+		}
+		for _, g := range e.Guilds {
+			if g != nil {
+				payload.GuildIDs = append(payload.GuildIDs, g.ID)
+			}
+		}
+		f.Forward(ReadyV1, "", payload)
+	})
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.InteractionCreate) {
+		if e == nil || e.Interaction == nil {
+			return
+		}
+		f.Forward(InteractionCreateV1, e.GuildID, e)
+	})
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.MessageCreate) {
+		if e == nil || e.Message == nil {
+			return
+		}
+		f.Forward(MessageCreateV1, e.GuildID, e)
+	})
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.MessageReactionAdd) {
+		if e == nil || e.MessageReaction == nil {
+			return
+		}
+		f.Forward(MessageReactionAddV1, e.GuildID, e)
+	})
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.MessageReactionRemove) {
+		if e == nil || e.MessageReaction == nil {
+			return
+		}
+		f.Forward(MessageReactionRemoveV1, e.GuildID, e)
+	})
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.GuildScheduledEventCreate) {
+		if e == nil || e.GuildScheduledEvent == nil {
+			return
+		}
+		f.Forward(GuildScheduledEventCreateV1, e.GuildID, e)
+	})
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.GuildScheduledEventUpdate) {
+		if e == nil || e.GuildScheduledEvent == nil {
+			return
+		}
+		f.Forward(GuildScheduledEventUpdateV1, e.GuildID, e)
+	})
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.GuildScheduledEventDelete) {
+		if e == nil || e.GuildScheduledEvent == nil {
+			return
+		}
+		f.Forward(GuildScheduledEventDeleteV1, e.GuildID, e)
+	})
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.GuildScheduledEventUserAdd) {
+		if e == nil {
+			return
+		}
+		f.Forward(GuildScheduledEventUserAddV1, e.GuildID, e)
+	})
+	session.AddHandler(func(_ *discordgo.Session, e *discordgo.GuildScheduledEventUserRemove) {
+		if e == nil {
+			return
+		}
+		f.Forward(GuildScheduledEventUserRemoveV1, e.GuildID, e)
+	})
+}
+
+// Forward wraps payload in an Envelope and publishes it. Failures are logged
+// rather than returned because discordgo handlers have no error channel.
+func (f *Forwarder) Forward(subject, guildID string, payload any) {
+	env, err := NewEnvelope(subject, f.shardID, guildID, f.now(), payload)
+	if err != nil {
+		f.logError("Failed to build gateway envelope", subject, guildID, err)
+		return
+	}
+	data, err := json.Marshal(env)
+	if err != nil {
+		f.logError("Failed to marshal gateway envelope", subject, guildID, err)
+		return
+	}
+	if err := f.publisher.Publish(subject, data); err != nil {
+		f.logError("Failed to publish gateway event", subject, guildID, err)
+	}
+}
+
+func (f *Forwarder) logError(msg, subject, guildID string, err error) {
+	if f.logger == nil {
+		return
+	}
+	f.logger.Error(msg,
+		slog.String("subject", subject),
+		slog.Int("shard_id", f.shardID),
+		slog.String("guild_id", guildID),
+		slog.String("error", err.Error()))
+}
@@ -0,0 +1,172 @@
+package gatewaybridge
+
+import (
+	"errors"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+type publishedMessage struct {
+	subject string
+	data    []byte
+}
+
+type fakePublisher struct {
+	messages []publishedMessage
+	err      error
+}
+
+func (p *fakePublisher) Publish(subject string, data []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, publishedMessage{subject: subject, data: data})
+	return nil
+}
+
+type capturingAdder struct {
+	handlers []interface{}
+}
+
+func (a *capturingAdder) AddHandler(handler interface{}) func() {
+	a.handlers = append(a.handlers, handler)
+	return func() {}
+}
+
+// fire invokes every captured handler whose event argument matches event's type.
+func (a *capturingAdder) fire(event interface{}) int {
+	calls := 0
+	for _, h := range a.handlers {
+		fn := reflect.ValueOf(h)
+		if fn.Type().NumIn() != 2 || fn.Type().In(1) != reflect.TypeOf(event) {
+			continue
+		}
+		fn.Call([]reflect.Value{reflect.ValueOf(&discordgo.Session{}), reflect.ValueOf(event)})
+		calls++
+	}
+	return calls
+}
+
+func newTestForwarder(pub Publisher) *Forwarder {
+	f := NewForwarder(pub, 1, 2, slog.Default())
+	f.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	return f
+}
+
+func TestForwarder_InteractionCreateRoundTrips(t *testing.T) {
+	pub := &fakePublisher{}
+	adder := &capturingAdder{}
+	newTestForwarder(pub).RegisterWithSession(adder)
+
+	event := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "int-1",
+		Type:    discordgo.InteractionApplicationCommand,
+		GuildID: "g1",
+		Token:   "tok",
+		Data: discordgo.ApplicationCommandInteractionData{
+			ID:   "cmd-1",
+			Name: "createround",
+		},
+	}}
+	if calls := adder.fire(event); calls != 1 {
+		t.Fatalf("expected one InteractionCreate handler, got %d", calls)
+	}
+	if len(pub.messages) != 1 {
+		t.Fatalf("expected one published message, got %d", len(pub.messages))
+	}
+	if pub.messages[0].subject != InteractionCreateV1 {
+		t.Fatalf("unexpected subject %q", pub.messages[0].subject)
+	}
+
+	env, err := DecodeEnvelope(pub.messages[0].data)
+	if err != nil {
+		t.Fatalf("decode envelope: %v", err)
+	}
+	if env.GuildID != "g1" || env.Subject != InteractionCreateV1 || env.ShardID != 1 {
+		t.Fatalf("unexpected envelope metadata: %+v", env)
+	}
+
+	var decoded discordgo.InteractionCreate
+	if err := env.Decode(&decoded); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if decoded.Interaction == nil || decoded.ID != "int-1" || decoded.Token != "tok" {
+		t.Fatalf("unexpected interaction: %+v", decoded.Interaction)
+	}
+	if got := decoded.ApplicationCommandData().Name; got != "createround" {
+		t.Fatalf("expected command name createround, got %q", got)
+	}
+}
+
+func TestForwarder_ForwardsEachEventType(t *testing.T) {
+	pub := &fakePublisher{}
+	adder := &capturingAdder{}
+	newTestForwarder(pub).RegisterWithSession(adder)
+
+	events := []struct {
+		event   interface{}
+		subject string
+	}{
+		{&discordgo.Ready{SessionID: "s1", Guilds: []*discordgo.Guild{{ID: "g1"}, {ID: "g2"}}}, ReadyV1},
+		{&discordgo.MessageCreate{Message: &discordgo.Message{ID: "m1", GuildID: "g1"}}, MessageCreateV1},
+		{&discordgo.MessageReactionAdd{MessageReaction: &discordgo.MessageReaction{MessageID: "m1", GuildID: "g1"}}, MessageReactionAddV1},
+		{&discordgo.MessageReactionRemove{MessageReaction: &discordgo.MessageReaction{MessageID: "m1", GuildID: "g1"}}, MessageReactionRemoveV1},
+		{&discordgo.GuildScheduledEventCreate{GuildScheduledEvent: &discordgo.GuildScheduledEvent{ID: "e1", GuildID: "g1"}}, GuildScheduledEventCreateV1},
+		{&discordgo.GuildScheduledEventUpdate{GuildScheduledEvent: &discordgo.GuildScheduledEvent{ID: "e1", GuildID: "g1"}}, GuildScheduledEventUpdateV1},
+		{&discordgo.GuildScheduledEventDelete{GuildScheduledEvent: &discordgo.GuildScheduledEvent{ID: "e1", GuildID: "g1"}}, GuildScheduledEventDeleteV1},
+		{&discordgo.GuildScheduledEventUserAdd{GuildScheduledEventID: "e1", UserID: "u1", GuildID: "g1"}, GuildScheduledEventUserAddV1},
+		{&discordgo.GuildScheduledEventUserRemove{GuildScheduledEventID: "e1", UserID: "u1", GuildID: "g1"}, GuildScheduledEventUserRemoveV1},
+	}
+
+	for _, tc := range events {
+		if calls := adder.fire(tc.event); calls != 1 {
+			t.Fatalf("expected one handler for %T, got %d", tc.event, calls)
+		}
+	}
+	if len(pub.messages) != len(events) {
+		t.Fatalf("expected %d published messages, got %d", len(events), len(pub.messages))
+	}
+	for i, tc := range events {
+		if pub.messages[i].subject != tc.subject {
+			t.Fatalf("event %T: expected subject %q, got %q", tc.event, tc.subject, pub.messages[i].subject)
+		}
+	}
+
+	env, err := DecodeEnvelope(pub.messages[0].data)
+	if err != nil {
+		t.Fatalf("decode ready envelope: %v", err)
+	}
+	var ready ReadyPayload
+	if err := env.Decode(&ready); err != nil {
+		t.Fatalf("decode ready payload: %v", err)
+	}
+	if ready.SessionID != "s1" || ready.ShardID != 1 || ready.ShardCount != 2 || !reflect.DeepEqual(ready.GuildIDs, []string{"g1", "g2"}) {
+		t.Fatalf("unexpected ready payload: %+v", ready)
+	}
+}
+
+func TestForwarder_IgnoresNilPayloads(t *testing.T) {
+	pub := &fakePublisher{}
+	adder := &capturingAdder{}
+	newTestForwarder(pub).RegisterWithSession(adder)
+
+	adder.fire(&discordgo.InteractionCreate{})
+	adder.fire(&discordgo.MessageCreate{})
+	adder.fire(&discordgo.MessageReactionAdd{})
+
+	if len(pub.messages) != 0 {
+		t.Fatalf("expected nil payloads to be dropped, got %d messages", len(pub.messages))
+	}
+}
+
+func TestForwarder_PublishErrorIsSwallowed(t *testing.T) {
+	pub := &fakePublisher{err: errors.New("nats down")}
+	f := newTestForwarder(pub)
+
+	// Must not panic; the gateway keeps running when NATS is briefly unavailable.
+	f.Forward(MessageCreateV1, "g1", &discordgo.MessageCreate{Message: &discordgo.Message{ID: "m1"}})
+}
@@ -0,0 +1,38 @@
+// Package gatewaybridge carries raw Discord gateway events between the gateway
+// process, which owns the websocket, and worker processes, which run the
+// interaction, message and reaction handlers.
+package gatewaybridge
+
+// Versioned NATS subjects for forwarded gateway events. The version suffix is
+// bumped whenever the Envelope shape changes incompatibly so that gateway and
+// worker deployments can be rolled independently.
+const (
+	SubjectPrefix = "discord.gateway"
+
+	ReadyV1                         = "discord.gateway.ready.v1"
+	InteractionCreateV1             = "discord.gateway.interaction.create.v1"
+	MessageCreateV1                 = "discord.gateway.message.create.v1"
+	MessageReactionAddV1            = "discord.gateway.message.reaction.add.v1"
+	MessageReactionRemoveV1         = "discord.gateway.message.reaction.remove.v1"
+	GuildScheduledEventCreateV1     = "discord.gateway.scheduled_event.create.v1"
+	GuildScheduledEventUpdateV1     = "discord.gateway.scheduled_event.update.v1"
+	GuildScheduledEventDeleteV1     = "discord.gateway.scheduled_event.delete.v1"
+	GuildScheduledEventUserAddV1    = "discord.gateway.scheduled_event.user_add.v1"
+	GuildScheduledEventUserRemoveV1 = "discord.gateway.scheduled_event.user_remove.v1"
+)
+
+// Subjects returns every forwarded subject in a stable order.
+func Subjects() []string {
+	return []string{
+		ReadyV1,
+		InteractionCreateV1,
+		MessageCreateV1,
+		MessageReactionAddV1,
+		MessageReactionRemoveV1,
+		GuildScheduledEventCreateV1,
+		GuildScheduledEventUpdateV1,
+		GuildScheduledEventDeleteV1,
+		GuildScheduledEventUserAddV1,
+		GuildScheduledEventUserRemoveV1,
+	}
+}
@@ -0,0 +1,21 @@
+package guild
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/attestation"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/reminders"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/reset"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/setup"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/timezone"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+)
+
+// Commands returns the application commands contributed by the guild module.
+func Commands() []interactions.CommandSpec {
+	return []interactions.CommandSpec{
+		setup.CommandSpec(),
+		reset.CommandSpec(),
+		timezone.CommandSpec(),
+		reminders.CommandSpec(),
+		attestation.CommandSpec(),
+	}
+}
@@ -0,0 +1,114 @@
+// Package attestation implements /frolf-attestation, which shows or changes
+// whether a guild's submitted scores wait for another player on the card to
+// confirm them before they count.
+package attestation
+
+import (
+	"context"
+	"log/slog"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	"github.com/bwmarrin/discordgo"
+)
+
+// AttestationManager handles /frolf-attestation.
+type AttestationManager interface {
+	HandleAttestationCommand(ctx context.Context, i *discordgo.InteractionCreate)
+}
+
+type attestationManager struct {
+	session         discord.Session
+	logger          *slog.Logger
+	currentMode     func(ctx context.Context, guildID string) bool
+	saveAttestation func(ctx context.Context, request guildconfig.AttestationSetRequestPayloadV1) (*guildconfig.AttestationResponsePayloadV1, error)
+}
+
+// NewAttestationManager creates an AttestationManager backed by the backend's
+// guild attestation requests.
+func NewAttestationManager(session discord.Session, eventBus eventbus.EventBus, logger *slog.Logger, guildConfigResolver guildconfig.GuildConfigResolver) AttestationManager {
+	return &attestationManager{
+		session: session,
+		logger:  logger,
+		currentMode: func(ctx context.Context, guildID string) bool {
+			return guildconfig.AttestedScoring(ctx, guildConfigResolver, eventBus, guildID)
+		},
+		saveAttestation: func(ctx context.Context, request guildconfig.AttestationSetRequestPayloadV1) (*guildconfig.AttestationResponsePayloadV1, error) {
+			return guildconfig.SaveAttestation(ctx, guildConfigResolver, eventBus, request)
+		},
+	}
+}
+
+// HandleAttestationCommand shows the guild's scoring mode, or changes it when
+// enabled is given.
+func (m *attestationManager) HandleAttestationCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "frolf-attestation")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "application_command")
+
+	// Requests go to the backend, so defer before making them.
+	if err := m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to defer frolf-attestation interaction", attr.Error(err))
+		return
+	}
+
+	var enabled *bool
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "enabled" {
+			value := opt.BoolValue()
+			enabled = &value
+		}
+	}
+
+	var content string
+	if enabled == nil {
+		content = describe(m.currentMode(ctx, i.GuildID)) +
+			"\nChange it with `/frolf-attestation enabled:True` or `enabled:False`."
+	} else {
+		content = m.update(ctx, i, *enabled)
+	}
+
+	if _, err := m.session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: &content}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to edit frolf-attestation response", attr.Error(err))
+	}
+}
+
+func (m *attestationManager) update(ctx context.Context, i *discordgo.InteractionCreate, enabled bool) string {
+	response, err := m.saveAttestation(ctx, guildconfig.AttestationSetRequestPayloadV1{
+		GuildID:   i.GuildID,
+		Enabled:   enabled,
+		UpdatedBy: userID(i),
+	})
+	if err != nil {
+		m.logger.ErrorContext(ctx, "Failed to save guild scoring mode", attr.Error(err), attr.String("guild_id", i.GuildID))
+		return "❌ Couldn't save the scoring mode right now. Please try again."
+	}
+	if response.Error != "" {
+		return "❌ " + response.Error
+	}
+	return "✅ " + describe(response.Enabled)
+}
+
+// describe explains what happens to a submitted score in the mode.
+func describe(enabled bool) string {
+	if enabled {
+		return "Attested scoring is **on**: each submitted score is pending until another player on the card confirms it. " +
+			"Disputed scores go to the score editors, and a round isn't finalized while scores are pending unless an admin accepts them."
+	}
+	return "Attested scoring is **off**: submitted scores count right away."
+}
+
+func userID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
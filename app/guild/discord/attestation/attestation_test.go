@@ -0,0 +1,104 @@
+package attestation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/bwmarrin/discordgo"
+)
+
+func newAttestationCommand(options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "interaction-1",
+		GuildID: "guild-1",
+		Type:    discordgo.InteractionApplicationCommand,
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "admin-1"}},
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name:    "frolf-attestation",
+			Options: options,
+		},
+	}}
+}
+
+func enabledOption(value bool) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{Name: "enabled", Type: discordgo.ApplicationCommandOptionBoolean, Value: value}
+}
+
+// newTestManager returns a manager for a guild without attested scoring,
+// whose saves fail unless a test overrides them, and a pointer to the content
+// of its last response edit.
+func newTestManager(t *testing.T) (*attestationManager, *string) {
+	t.Helper()
+
+	fakeSession := discord.NewFakeSession()
+	var content string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		if r.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource || r.Data.Flags != discordgo.MessageFlagsEphemeral {
+			t.Errorf("expected an ephemeral deferred response, got %+v", r)
+		}
+		return nil
+	}
+	fakeSession.InteractionResponseEditFunc = func(i *discordgo.Interaction, edit *discordgo.WebhookEdit, opts ...discordgo.RequestOption) (*discordgo.Message, error) {
+		content = *edit.Content
+		return &discordgo.Message{}, nil
+	}
+
+	return &attestationManager{
+		session:     fakeSession,
+		logger:      testutils.NoOpLogger(),
+		currentMode: func(ctx context.Context, guildID string) bool { return false },
+		saveAttestation: func(ctx context.Context, request guildconfig.AttestationSetRequestPayloadV1) (*guildconfig.AttestationResponsePayloadV1, error) {
+			return nil, errors.New("backend unavailable")
+		},
+	}, &content
+}
+
+func TestAttestationManager_ShowsCurrentMode(t *testing.T) {
+	m, content := newTestManager(t)
+	m.HandleAttestationCommand(context.Background(), newAttestationCommand())
+
+	want := "Attested scoring is **off**: submitted scores count right away." +
+		"\nChange it with `/frolf-attestation enabled:True` or `enabled:False`."
+	if *content != want {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
+
+func TestAttestationManager_Update(t *testing.T) {
+	m, content := newTestManager(t)
+	var saved *guildconfig.AttestationSetRequestPayloadV1
+	m.saveAttestation = func(ctx context.Context, request guildconfig.AttestationSetRequestPayloadV1) (*guildconfig.AttestationResponsePayloadV1, error) {
+		saved = &request
+		return &guildconfig.AttestationResponsePayloadV1{Enabled: request.Enabled}, nil
+	}
+
+	m.HandleAttestationCommand(context.Background(), newAttestationCommand(enabledOption(true)))
+	if saved == nil || saved.GuildID != "guild-1" || !saved.Enabled || saved.UpdatedBy != "admin-1" {
+		t.Fatalf("unexpected save request %+v", saved)
+	}
+	want := "✅ Attested scoring is **on**: each submitted score is pending until another player on the card confirms it. " +
+		"Disputed scores go to the score editors, and a round isn't finalized while scores are pending unless an admin accepts them."
+	if *content != want {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
+
+func TestAttestationManager_SaveFails(t *testing.T) {
+	m, content := newTestManager(t)
+	m.HandleAttestationCommand(context.Background(), newAttestationCommand(enabledOption(true)))
+	if *content != "❌ Couldn't save the scoring mode right now. Please try again." {
+		t.Fatalf("unexpected response %q", *content)
+	}
+
+	m.saveAttestation = func(ctx context.Context, request guildconfig.AttestationSetRequestPayloadV1) (*guildconfig.AttestationResponsePayloadV1, error) {
+		return &guildconfig.AttestationResponsePayloadV1{Error: "Club not found."}, nil
+	}
+	m.HandleAttestationCommand(context.Background(), newAttestationCommand(enabledOption(false)))
+	if *content != "❌ Club not found." {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
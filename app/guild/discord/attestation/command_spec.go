@@ -0,0 +1,31 @@
+package attestation
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /frolf-attestation command (Admin only).
+func CommandSpec() interactions.CommandSpec {
+	adminPermission := int64(discordgo.PermissionAdministrator)
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "frolf-attestation",
+			Description: "Show or change whether scores need confirming by another player on the card (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionBoolean,
+					Name:        "enabled",
+					Description: "Hold each submitted score until another player on the card confirms it",
+					Required:    false,
+				},
+			},
+			DefaultMemberPermissions: &adminPermission,
+		},
+		RequiredPermission: interactions.AdminRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+		BackendFeature:     config.BackendFeatureAttestedScoring,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/reset"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/setup"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
@@ -23,7 +24,7 @@ import (
 type GuildDiscordInterface interface {
 	GetSetupManager() setup.SetupManager
 	GetResetManager() reset.ResetManager
-	RegisterAllCommands(guildID string) error
+	RegisterAllCommands(guildID string, guildConfig *storage.GuildConfig) error
 	UnregisterAllCommands(guildID string) error
 }
 
@@ -31,6 +32,7 @@ type GuildDiscordInterface interface {
 type GuildDiscord struct {
 	session      discordgocommands.Session
 	logger       *slog.Logger
+	commands     *interactions.CommandManifest
 	SetupManager setup.SetupManager
 	ResetManager reset.ResetManager
 }
@@ -47,6 +49,7 @@ func NewGuildDiscord(
 	tracer trace.Tracer,
 	metrics discordmetrics.DiscordMetrics,
 	guildConfigResolver guildconfig.GuildConfigResolver,
+	commands *interactions.CommandManifest,
 ) (GuildDiscordInterface, error) {
 
 	// SetupManager and ResetManager constructors will also need
@@ -64,6 +67,7 @@ func NewGuildDiscord(
 	return &GuildDiscord{
 		session:      session,
 		logger:       logger,
+		commands:     commands,
 		SetupManager: setupManager,
 		ResetManager: resetManager,
 	}, nil
@@ -81,12 +85,22 @@ func (gd *GuildDiscord) GetResetManager() reset.ResetManager {
 
 // RegisterAllCommands registers all guild-specific commands for the given guild.
 // This enables per-guild customization and ensures commands only appear after setup.
-func (gd *GuildDiscord) RegisterAllCommands(guildID string) error {
+// Feature-gated commands are filtered by guildConfig's entitlements; an empty
+// guildID registers the global commands instead.
+func (gd *GuildDiscord) RegisterAllCommands(guildID string, guildConfig *storage.GuildConfig) error {
 	gd.logger.Info("Registering guild-specific commands after successful setup",
 		attr.String("guild_id", guildID))
 
-	// Use the discord commands package to register guild-specific commands
-	return discordgocommands.RegisterCommands(gd.session, gd.logger, guildID)
+	if gd.commands == nil {
+		return fmt.Errorf("command manifest not configured")
+	}
+
+	desired := gd.commands.Commands("")
+	if guildID != "" {
+		desired = gd.commands.GuildCommands(guildConfig)
+	}
+
+	return discordgocommands.RegisterCommands(gd.session, gd.logger, guildID, desired)
 }
 
 // UnregisterAllCommands removes all guild-specific commands for the given guild.
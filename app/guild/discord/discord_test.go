@@ -8,7 +8,10 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
 	"github.com/bwmarrin/discordgo"
 )
@@ -17,6 +20,19 @@ func testLogger() *slog.Logger {
 	return slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelInfo}))
 }
 
+func testCommandManifest(t *testing.T) *interactions.CommandManifest {
+	t.Helper()
+	manifest, err := interactions.NewCommandManifest([]interactions.CommandSpec{
+		{Command: &discordgo.ApplicationCommand{Name: "frolf-setup", Description: "setup"}, Scope: interactions.CommandScopeGlobal},
+		{Command: &discordgo.ApplicationCommand{Name: "createround", Description: "create"}},
+		{Command: &discordgo.ApplicationCommand{Name: "bet", Description: "bet"}, RequiredFeature: guildtypes.ClubFeatureBetting},
+	})
+	if err != nil {
+		t.Fatalf("NewCommandManifest error: %v", err)
+	}
+	return manifest
+}
+
 func TestRegisterAllCommands_Global(t *testing.T) {
 	fakeSession := discord.NewFakeSession()
 	logger := testLogger()
@@ -27,14 +43,19 @@ func TestRegisterAllCommands_Global(t *testing.T) {
 	fakeSession.ApplicationCommandsFunc = func(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
 		return []*discordgo.ApplicationCommand{}, nil
 	}
+	var created []string
 	fakeSession.ApplicationCommandCreateFunc = func(appID, guildID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error) {
+		created = append(created, cmd.Name)
 		return &discordgo.ApplicationCommand{ID: "cmd1"}, nil
 	}
 
-	gd := &GuildDiscord{session: fakeSession, logger: logger}
-	if err := gd.RegisterAllCommands(""); err != nil {
+	gd := &GuildDiscord{session: fakeSession, logger: logger, commands: testCommandManifest(t)}
+	if err := gd.RegisterAllCommands("", nil); err != nil {
 		t.Fatalf("RegisterAllCommands(global) unexpected error: %v", err)
 	}
+	if len(created) != 1 || created[0] != "frolf-setup" {
+		t.Fatalf("expected only global commands to be created, got %v", created)
+	}
 }
 
 func TestRegisterAllCommands_Guild(t *testing.T) {
@@ -47,14 +68,59 @@ func TestRegisterAllCommands_Guild(t *testing.T) {
 	fakeSession.ApplicationCommandsFunc = func(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
 		return []*discordgo.ApplicationCommand{}, nil
 	}
+	var created []string
 	fakeSession.ApplicationCommandCreateFunc = func(appID, guildID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error) {
+		created = append(created, cmd.Name)
 		return &discordgo.ApplicationCommand{ID: "c"}, nil
 	}
 
-	gd := &GuildDiscord{session: fakeSession, logger: logger}
-	if err := gd.RegisterAllCommands("g1"); err != nil {
+	gd := &GuildDiscord{session: fakeSession, logger: logger, commands: testCommandManifest(t)}
+	if err := gd.RegisterAllCommands("g1", nil); err != nil {
+		t.Fatalf("RegisterAllCommands(guild) unexpected error: %v", err)
+	}
+	if len(created) != 1 || created[0] != "createround" {
+		t.Fatalf("expected only guild commands to be created, got %v", created)
+	}
+}
+
+func TestRegisterAllCommands_GuildWithFeatureEnabled(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+
+	fakeSession.GetBotUserFunc = func() (*discordgo.User, error) {
+		return &discordgo.User{ID: "bot"}, nil
+	}
+	fakeSession.ApplicationCommandsFunc = func(appID, guildID string, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+		return []*discordgo.ApplicationCommand{}, nil
+	}
+	created := map[string]bool{}
+	fakeSession.ApplicationCommandCreateFunc = func(appID, guildID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error) {
+		created[cmd.Name] = true
+		return &discordgo.ApplicationCommand{ID: "c"}, nil
+	}
+
+	guildConfig := &storage.GuildConfig{
+		GuildID: "g1",
+		Entitlements: guildtypes.ResolvedClubEntitlements{
+			Features: map[guildtypes.ClubFeatureKey]guildtypes.ClubFeatureAccess{
+				guildtypes.ClubFeatureBetting: {Key: guildtypes.ClubFeatureBetting, State: guildtypes.FeatureAccessStateEnabled},
+			},
+		},
+	}
+
+	gd := &GuildDiscord{session: fakeSession, logger: testLogger(), commands: testCommandManifest(t)}
+	if err := gd.RegisterAllCommands("g1", guildConfig); err != nil {
 		t.Fatalf("RegisterAllCommands(guild) unexpected error: %v", err)
 	}
+	if len(created) != 2 || !created["createround"] || !created["bet"] {
+		t.Fatalf("expected createround and bet to be created, got %v", created)
+	}
+}
+
+func TestRegisterAllCommands_NoManifest(t *testing.T) {
+	gd := &GuildDiscord{session: discord.NewFakeSession(), logger: testLogger()}
+	if err := gd.RegisterAllCommands("g1", nil); err == nil {
+		t.Fatal("expected error when no command manifest is configured")
+	}
 }
 
 func TestUnregisterAllCommands(t *testing.T) {
@@ -100,6 +166,7 @@ func TestNewGuildDiscord_And_GetSetupManager(t *testing.T) {
 		nil, // tracer
 		nil, // metrics
 		resolver,
+		nil, // command manifest
 	)
 	if err != nil {
 		t.Fatalf("NewGuildDiscord error: %v", err)
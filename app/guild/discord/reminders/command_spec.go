@@ -0,0 +1,47 @@
+package reminders
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /frolf-reminders command (Admin only).
+func CommandSpec() interactions.CommandSpec {
+	adminPermission := int64(discordgo.PermissionAdministrator)
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "frolf-reminders",
+			Description: "Show or change when round reminders are sent and what they say (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "offsets",
+					Description: "When to remind players before each round, e.g. 24h, 2h, 15m",
+					Required:    false,
+					MaxLength:   60,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "offset",
+					Description: "The reminder whose message to change, e.g. 2h",
+					Required:    false,
+					MaxLength:   10,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "template",
+					Description: "Its message, using {title} {start} {relative} {countdown} {location}; \"default\" resets it",
+					Required:    false,
+					MaxLength:   guildconfig.MaxReminderTemplateLength,
+				},
+			},
+			DefaultMemberPermissions: &adminPermission,
+		},
+		RequiredPermission: interactions.AdminRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+		BackendFeature:     config.BackendFeatureGuildReminders,
+	}
+}
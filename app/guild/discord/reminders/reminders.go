@@ -0,0 +1,172 @@
+// Package reminders implements /frolf-reminders, which shows or changes how
+// long before its rounds a guild's players are reminded, and the message each
+// reminder sends.
+package reminders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RemindersManager handles /frolf-reminders.
+type RemindersManager interface {
+	HandleRemindersCommand(ctx context.Context, i *discordgo.InteractionCreate)
+}
+
+type remindersManager struct {
+	session          discord.Session
+	logger           *slog.Logger
+	currentReminders func(ctx context.Context, guildID string) []storage.ReminderSetting
+	saveReminders    func(ctx context.Context, request guildconfig.RemindersSetRequestPayloadV1) (*guildconfig.RemindersResponsePayloadV1, error)
+}
+
+// NewRemindersManager creates a RemindersManager backed by the backend's guild
+// reminder requests.
+func NewRemindersManager(session discord.Session, eventBus eventbus.EventBus, logger *slog.Logger, guildConfigResolver guildconfig.GuildConfigResolver) RemindersManager {
+	return &remindersManager{
+		session: session,
+		logger:  logger,
+		currentReminders: func(ctx context.Context, guildID string) []storage.ReminderSetting {
+			return guildconfig.GuildReminders(ctx, guildConfigResolver, eventBus, guildID)
+		},
+		saveReminders: func(ctx context.Context, request guildconfig.RemindersSetRequestPayloadV1) (*guildconfig.RemindersResponsePayloadV1, error) {
+			return guildconfig.SaveReminders(ctx, guildConfigResolver, eventBus, request)
+		},
+	}
+}
+
+// HandleRemindersCommand shows the guild's reminders, or changes them when
+// offsets, or an offset and its template, are given.
+func (m *remindersManager) HandleRemindersCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "frolf-reminders")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "application_command")
+
+	// Requests go to the backend, so defer before making them.
+	if err := m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to defer frolf-reminders interaction", attr.Error(err))
+		return
+	}
+
+	var offsets, offset, template string
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "offsets":
+			offsets = strings.TrimSpace(opt.StringValue())
+		case "offset":
+			offset = strings.TrimSpace(opt.StringValue())
+		case "template":
+			template = strings.TrimSpace(opt.StringValue())
+		}
+	}
+
+	current := m.currentReminders(ctx, i.GuildID)
+	var content string
+	if offsets == "" && offset == "" && template == "" {
+		content = "Round reminders are sent " + describe(current) +
+			"\nChange when with `/frolf-reminders offsets:24h, 2h, 15m`, or a reminder's message with `offset:` and `template:`."
+	} else {
+		content = m.update(ctx, i, current, offsets, offset, template)
+	}
+
+	if _, err := m.session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: &content}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to edit frolf-reminders response", attr.Error(err))
+	}
+}
+
+func (m *remindersManager) update(ctx context.Context, i *discordgo.InteractionCreate, current []storage.ReminderSetting, offsets, offset, template string) string {
+	reminders, err := changeReminders(current, offsets, offset, template)
+	if err != nil {
+		return "❌ " + err.Error()
+	}
+
+	response, err := m.saveReminders(ctx, guildconfig.RemindersSetRequestPayloadV1{
+		GuildID:   i.GuildID,
+		Reminders: reminders,
+		UpdatedBy: userID(i),
+	})
+	if err != nil {
+		m.logger.ErrorContext(ctx, "Failed to save guild reminders", attr.Error(err), attr.String("guild_id", i.GuildID))
+		return "❌ Couldn't save the reminders right now. Please try again."
+	}
+	if response.Error != "" {
+		return "❌ " + response.Error
+	}
+	return "Round reminders will now be sent " + describe(response.Reminders)
+}
+
+// changeReminders applies the command's options to the current reminders.
+func changeReminders(current []storage.ReminderSetting, offsets, offset, template string) ([]storage.ReminderSetting, error) {
+	reminders := append([]storage.ReminderSetting(nil), current...)
+	if offsets != "" {
+		parsed, err := guildconfig.ParseReminderOffsets(offsets, current)
+		if err != nil {
+			return nil, err
+		}
+		reminders = parsed
+	}
+	if offset == "" && template == "" {
+		return reminders, nil
+	}
+	if offset == "" || template == "" {
+		return nil, errors.New("Give both `offset` and `template` to change a reminder's message.")
+	}
+
+	parsed, err := guildconfig.ParseReminderOffset(offset)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(template, "default") {
+		template = ""
+	}
+	for idx, reminder := range reminders {
+		if existing, err := guildconfig.ParseReminderOffset(reminder.Offset); err == nil && existing == parsed {
+			reminders[idx].Template = template
+			return reminders, nil
+		}
+	}
+	return nil, fmt.Errorf("There's no %s reminder. Add it to `offsets` first.", guildconfig.FormatReminderOffset(parsed))
+}
+
+// describe lists reminders as a sentence followed by their messages.
+func describe(reminders []storage.ReminderSetting) string {
+	offsets := make([]string, len(reminders))
+	var sb strings.Builder
+	for idx, reminder := range reminders {
+		offsets[idx] = "**" + reminder.Offset + "**"
+		message := "default message"
+		if reminder.Template != "" {
+			message = fmt.Sprintf("“%s”", reminder.Template)
+		}
+		sb.WriteString(fmt.Sprintf("\n• %s: %s", reminder.Offset, message))
+	}
+
+	list := strings.Join(offsets, ", ")
+	if len(offsets) > 1 {
+		list = strings.Join(offsets[:len(offsets)-1], ", ") + " and " + offsets[len(offsets)-1]
+	}
+	return list + " before each round." + sb.String()
+}
+
+func userID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
@@ -0,0 +1,170 @@
+package reminders
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/bwmarrin/discordgo"
+)
+
+func newRemindersCommand(options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "interaction-1",
+		GuildID: "guild-1",
+		Type:    discordgo.InteractionApplicationCommand,
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "admin-1"}},
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name:    "frolf-reminders",
+			Options: options,
+		},
+	}}
+}
+
+func stringOption(name, value string) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{Name: name, Type: discordgo.ApplicationCommandOptionString, Value: value}
+}
+
+// newTestManager returns a manager for a guild reminded 24h and 15m before
+// its rounds, whose saves fail unless a test overrides them, and a pointer to
+// the content of its last response edit.
+func newTestManager(t *testing.T) (*remindersManager, *string) {
+	t.Helper()
+
+	fakeSession := discord.NewFakeSession()
+	var content string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		if r.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource || r.Data.Flags != discordgo.MessageFlagsEphemeral {
+			t.Errorf("expected an ephemeral deferred response, got %+v", r)
+		}
+		return nil
+	}
+	fakeSession.InteractionResponseEditFunc = func(i *discordgo.Interaction, edit *discordgo.WebhookEdit, opts ...discordgo.RequestOption) (*discordgo.Message, error) {
+		content = *edit.Content
+		return &discordgo.Message{}, nil
+	}
+
+	return &remindersManager{
+		session: fakeSession,
+		logger:  testutils.NoOpLogger(),
+		currentReminders: func(ctx context.Context, guildID string) []storage.ReminderSetting {
+			return []storage.ReminderSetting{{Offset: "24h", Template: "See you tomorrow!"}, {Offset: "15m"}}
+		},
+		saveReminders: func(ctx context.Context, request guildconfig.RemindersSetRequestPayloadV1) (*guildconfig.RemindersResponsePayloadV1, error) {
+			return nil, errors.New("backend unavailable")
+		},
+	}, &content
+}
+
+func TestRemindersManager_ShowsCurrentReminders(t *testing.T) {
+	m, content := newTestManager(t)
+	m.HandleRemindersCommand(context.Background(), newRemindersCommand())
+
+	want := "Round reminders are sent **24h** and **15m** before each round.\n• 24h: “See you tomorrow!”\n• 15m: default message" +
+		"\nChange when with `/frolf-reminders offsets:24h, 2h, 15m`, or a reminder's message with `offset:` and `template:`."
+	if *content != want {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
+
+func TestRemindersManager_Update(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []*discordgo.ApplicationCommandInteractionDataOption
+		want    []storage.ReminderSetting
+	}{
+		{
+			name:    "offsets",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{stringOption("offsets", "15m, 2h, 24h")},
+			want:    []storage.ReminderSetting{{Offset: "24h", Template: "See you tomorrow!"}, {Offset: "2h"}, {Offset: "15m"}},
+		},
+		{
+			name:    "template",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{stringOption("offset", "15-minutes"), stringOption("template", "{title} tees off {relative}!")},
+			want:    []storage.ReminderSetting{{Offset: "24h", Template: "See you tomorrow!"}, {Offset: "15m", Template: "{title} tees off {relative}!"}},
+		},
+		{
+			name:    "default template",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{stringOption("offset", "24h"), stringOption("template", "Default")},
+			want:    []storage.ReminderSetting{{Offset: "24h"}, {Offset: "15m"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, content := newTestManager(t)
+			var saved guildconfig.RemindersSetRequestPayloadV1
+			m.saveReminders = func(ctx context.Context, request guildconfig.RemindersSetRequestPayloadV1) (*guildconfig.RemindersResponsePayloadV1, error) {
+				saved = request
+				return &guildconfig.RemindersResponsePayloadV1{Reminders: request.Reminders}, nil
+			}
+
+			m.HandleRemindersCommand(context.Background(), newRemindersCommand(tt.options...))
+
+			want := guildconfig.RemindersSetRequestPayloadV1{GuildID: "guild-1", Reminders: tt.want, UpdatedBy: "admin-1"}
+			if !reflect.DeepEqual(saved, want) {
+				t.Fatalf("save request = %+v, want %+v", saved, want)
+			}
+			if *content != "Round reminders will now be sent "+describe(tt.want) {
+				t.Fatalf("unexpected response %q", *content)
+			}
+		})
+	}
+}
+
+func TestRemindersManager_UpdateFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []*discordgo.ApplicationCommandInteractionDataOption
+		response *guildconfig.RemindersResponsePayloadV1
+		want     string
+	}{
+		{
+			name:    "bad offset",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{stringOption("offsets", "24h, 2m")},
+			want:    `❌ "2m" is not a reminder time. Reminders can be sent from 5m to 7d before a round, in whole minutes.`,
+		},
+		{
+			name:    "template without offset",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{stringOption("template", "Tee time!")},
+			want:    "❌ Give both `offset` and `template` to change a reminder's message.",
+		},
+		{
+			name:    "unknown offset",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{stringOption("offset", "2h"), stringOption("template", "Tee time!")},
+			want:    "❌ There's no 2h reminder. Add it to `offsets` first.",
+		},
+		{
+			name:    "backend unavailable",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{stringOption("offsets", "1h")},
+			want:    "❌ Couldn't save the reminders right now. Please try again.",
+		},
+		{
+			name:     "backend rejects",
+			options:  []*discordgo.ApplicationCommandInteractionDataOption{stringOption("offsets", "1h")},
+			response: &guildconfig.RemindersResponsePayloadV1{Error: "Guild is not set up."},
+			want:     "❌ Guild is not set up.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, content := newTestManager(t)
+			if tt.response != nil {
+				m.saveReminders = func(ctx context.Context, request guildconfig.RemindersSetRequestPayloadV1) (*guildconfig.RemindersResponsePayloadV1, error) {
+					return tt.response, nil
+				}
+			}
+
+			m.HandleRemindersCommand(context.Background(), newRemindersCommand(tt.options...))
+			if *content != tt.want {
+				t.Fatalf("response = %q, want %q", *content, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package reset
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the global /frolf-reset command. Discord enforces the
+// Administrator permission, so the registry applies no role check.
+func CommandSpec() interactions.CommandSpec {
+	adminPermission := int64(discordgo.PermissionAdministrator)
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:                     "frolf-reset",
+			Description:              "Reset Frolf Bot configuration for this server (Admin only)",
+			DefaultMemberPermissions: &adminPermission,
+		},
+		Scope:              interactions.CommandScopeGlobal,
+		RequiredPermission: interactions.NoPermissionRequired,
+		IsMutating:         true,
+	}
+}
@@ -0,0 +1,22 @@
+package setup
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the global /frolf-setup command. Discord enforces the
+// Administrator permission, so the registry applies no role check.
+func CommandSpec() interactions.CommandSpec {
+	adminPermission := int64(discordgo.PermissionAdministrator)
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:                     "frolf-setup",
+			Description:              "Set up Frolf Bot for this server (Admin only)",
+			DefaultMemberPermissions: &adminPermission,
+		},
+		Scope:              interactions.CommandScopeGlobal,
+		RequiredPermission: interactions.NoPermissionRequired,
+		IsMutating:         true,
+	}
+}
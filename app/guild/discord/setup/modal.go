@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/bwmarrin/discordgo"
 )
@@ -22,6 +23,7 @@ type SetupResult struct {
 	AdminRoleID            string
 	SignupMessageID        string
 	SignupEmoji            string
+	Timezone               string
 	RoleMappings           map[string]string
 }
 
@@ -109,6 +111,19 @@ func (s *setupManager) sendSetupModalWithCorrelation(ctx context.Context, i *dis
 							},
 						},
 					},
+					discordgo.ActionsRow{
+						Components: []discordgo.MessageComponent{
+							discordgo.TextInput{
+								CustomID:    "timezone",
+								Label:       "Timezone",
+								Style:       discordgo.TextInputShort,
+								Placeholder: "America/Chicago, Europe/London, ...",
+								Required:    false,
+								MaxLength:   50,
+								Value:       guildconfig.DefaultTimezone,
+							},
+						},
+					},
 				},
 			},
 		})
@@ -206,6 +221,24 @@ func (s *setupManager) HandleSetupModalSubmit(ctx context.Context, i *discordgo.
 				signupEmoji = strings.TrimSpace(v)
 			}
 		}
+		// The timezone input was added later, so find it by ID rather than position
+		var timezone string
+		for _, row := range rows {
+			r, ok := getRow(row)
+			if !ok || len(r.Components) == 0 {
+				continue
+			}
+			switch v := r.Components[0].(type) {
+			case discordgo.TextInput:
+				if v.CustomID == "timezone" {
+					timezone = strings.TrimSpace(v.Value)
+				}
+			case *discordgo.TextInput:
+				if v != nil && v.CustomID == "timezone" {
+					timezone = strings.TrimSpace(v.Value)
+				}
+			}
+		}
 
 		// Parse role names from comma-separated string
 		var userRoleName, editorRoleName, adminRoleName string
@@ -242,6 +275,13 @@ func (s *setupManager) HandleSetupModalSubmit(ctx context.Context, i *discordgo.
 		if signupEmoji == "" {
 			signupEmoji = "🥏"
 		}
+		if timezone == "" {
+			timezone = guildconfig.DefaultTimezone
+		}
+		timezone, err := guildconfig.ValidateTimezone(timezone)
+		if err != nil {
+			return s.respondError(i, err.Error())
+		}
 
 		// Get guild name automatically
 		guild, err := s.session.Guild(i.GuildID)
@@ -259,7 +299,8 @@ func (s *setupManager) HandleSetupModalSubmit(ctx context.Context, i *discordgo.
 			"editor_role", editorRoleName,
 			"admin_role", adminRoleName,
 			"signup_message", signupMessage,
-			"signup_emoji", signupEmoji)
+			"signup_emoji", signupEmoji,
+			"timezone", timezone)
 
 		// Acknowledge the submission immediately
 		err = s.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -322,6 +363,7 @@ func (s *setupManager) HandleSetupModalSubmit(ctx context.Context, i *discordgo.
 			s.logger.ErrorContext(ctx, "Failed to publish setup event", "guild_id", i.GuildID, "error", err)
 			return s.sendFollowupError(i, "Setup completed but failed to save configuration")
 		}
+		result.Timezone = s.saveTimezone(ctx, i, timezone)
 
 		// Send success followup
 		return s.sendFollowupSuccess(i, result)
@@ -392,6 +434,13 @@ func (s *setupManager) sendFollowupSuccess(i *discordgo.InteractionCreate, resul
 			Inline: true,
 		})
 	}
+	if result.Timezone != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "🕒 Timezone",
+			Value:  result.Timezone,
+			Inline: true,
+		})
+	}
 
 	_, err := s.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
 		Embeds: []*discordgo.MessageEmbed{embed},
@@ -454,6 +503,13 @@ func (s *setupManager) sendFollowupAlreadyConfigured(i *discordgo.InteractionCre
 			Inline: true,
 		})
 	}
+	if cfg.Timezone != "" {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   "🕒 Timezone",
+			Value:  cfg.Timezone,
+			Inline: true,
+		})
+	}
 	if cfg.SignupMessageID != "" {
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
 			Name:  "📝 Signup Message ID",
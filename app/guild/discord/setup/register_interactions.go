@@ -11,12 +11,12 @@ import (
 
 func RegisterHandlers(registry *interactions.Registry, manager SetupManager) {
 	// frolf-setup command requires Discord Admin permissions (checked by Discord, no custom permission needed)
-	registry.RegisterMutatingHandler("frolf-setup", func(ctx context.Context, i *discordgo.InteractionCreate) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling /frolf-setup command", attr.String("command_name", i.ApplicationCommandData().Name))
 		if err := manager.HandleSetupCommand(ctx, i); err != nil {
 			slog.Error("Failed to handle frolf-setup command", attr.Error(err))
 		}
-	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.NoPermissionRequired, RequiresSetup: false}) // Discord admin perms handled by Discord itself
+	})
 
 	// Setup modal submission (only accessible to those who can run frolf-setup)
 	registry.RegisterMutatingHandler("guild_setup_modal", func(ctx context.Context, i *discordgo.InteractionCreate) {
@@ -2,10 +2,13 @@ package setup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	discordpkg "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	guildevents "github.com/Black-And-White-Club/frolf-bot-shared/events/guild"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/bwmarrin/discordgo"
@@ -146,6 +149,41 @@ func (s *setupManager) publishSetupEvent(i *discordgo.InteractionCreate, result
 	return s.publisher.Publish(guildevents.GuildSetupRequestedV1, msg)
 }
 
+// saveTimezone stores the timezone chosen during setup. It returns the saved
+// timezone, or "" if the backend didn't accept it or doesn't serve timezones
+// yet; setup still succeeds and the guild keeps the default until an admin
+// runs /frolf-timezone.
+func (s *setupManager) saveTimezone(ctx context.Context, i *discordgo.InteractionCreate, timezone string) string {
+	if !s.config.BackendFeatureEnabled(config.BackendFeatureGuildTimezone) {
+		return ""
+	}
+	updatedBy := ""
+	if i.Member != nil && i.Member.User != nil {
+		updatedBy = i.Member.User.ID
+	} else if i.User != nil {
+		updatedBy = i.User.ID
+	}
+
+	response, err := guildconfig.SaveTimezone(ctx, s.guildConfigResolver, s.publisher, guildconfig.TimezoneSetRequestPayloadV1{
+		GuildID:   i.GuildID,
+		Timezone:  timezone,
+		UpdatedBy: updatedBy,
+	})
+	if err == nil && response.Error != "" {
+		err = errors.New(response.Error)
+	}
+	if err != nil {
+		if s.logger != nil {
+			s.logger.WarnContext(ctx, "Failed to save guild timezone during setup",
+				"guild_id", i.GuildID,
+				"timezone", timezone,
+				"error", err)
+		}
+		return ""
+	}
+	return response.Timezone
+}
+
 // createOrFindChannel creates a new channel or finds an existing one
 func (s *setupManager) createOrFindChannel(guildID, channelName, topic string) (string, error) {
 	// Try to find existing channel first
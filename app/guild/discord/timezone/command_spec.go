@@ -0,0 +1,33 @@
+package timezone
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /frolf-timezone command (Admin only).
+func CommandSpec() interactions.CommandSpec {
+	adminPermission := int64(discordgo.PermissionAdministrator)
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "frolf-timezone",
+			Description: "Show or change the server's default timezone for rounds (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "timezone",
+					Description:  "New timezone, e.g. America/Chicago. Leave out to see the current one",
+					Required:     false,
+					Autocomplete: true,
+					MaxLength:    50,
+				},
+			},
+			DefaultMemberPermissions: &adminPermission,
+		},
+		RequiredPermission: interactions.AdminRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+		BackendFeature:     config.BackendFeatureGuildTimezone,
+	}
+}
@@ -0,0 +1,19 @@
+package timezone
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RegisterHandlers registers the frolf-timezone command and its timezone autocomplete.
+func RegisterHandlers(registry *interactions.Registry, manager TimezoneManager) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling frolf-timezone command", attr.String("interaction_id", i.ID))
+		manager.HandleTimezoneCommand(ctx, i)
+	})
+	registry.RegisterAutocompleteHandler(CommandSpec().Name(), "timezone", AutocompleteTimezone)
+}
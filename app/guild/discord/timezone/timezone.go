@@ -0,0 +1,147 @@
+// Package timezone implements /frolf-timezone, which shows or changes the
+// timezone a guild's round start times are read in by default.
+package timezone
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	"github.com/bwmarrin/discordgo"
+)
+
+// TimezoneManager handles /frolf-timezone.
+type TimezoneManager interface {
+	HandleTimezoneCommand(ctx context.Context, i *discordgo.InteractionCreate)
+}
+
+type timezoneManager struct {
+	session         discord.Session
+	logger          *slog.Logger
+	currentTimezone func(ctx context.Context, guildID string) string
+	saveTimezone    func(ctx context.Context, request guildconfig.TimezoneSetRequestPayloadV1) (*guildconfig.TimezoneResponsePayloadV1, error)
+}
+
+// NewTimezoneManager creates a TimezoneManager that reads the timezone from
+// the guild config and saves changes with the backend.
+func NewTimezoneManager(session discord.Session, eventBus eventbus.EventBus, logger *slog.Logger, guildConfigResolver guildconfig.GuildConfigResolver) TimezoneManager {
+	return &timezoneManager{
+		session: session,
+		logger:  logger,
+		currentTimezone: func(ctx context.Context, guildID string) string {
+			return guildconfig.GuildTimezone(ctx, logger, guildConfigResolver, guildID)
+		},
+		saveTimezone: func(ctx context.Context, request guildconfig.TimezoneSetRequestPayloadV1) (*guildconfig.TimezoneResponsePayloadV1, error) {
+			return guildconfig.SaveTimezone(ctx, guildConfigResolver, eventBus, request)
+		},
+	}
+}
+
+// HandleTimezoneCommand shows the guild's timezone, or changes it when the
+// timezone option is given.
+func (m *timezoneManager) HandleTimezoneCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "frolf-timezone")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "application_command")
+
+	// Requests go to the backend, so defer before making them.
+	if err := m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to defer frolf-timezone interaction", attr.Error(err))
+		return
+	}
+
+	var requested string
+	for _, opt := range i.ApplicationCommandData().Options {
+		if opt.Name == "timezone" {
+			requested = strings.TrimSpace(opt.StringValue())
+		}
+	}
+
+	var content string
+	if requested == "" {
+		content = fmt.Sprintf("Round start times are read in **%s** unless a round names its own timezone. Change it with `/frolf-timezone timezone:<name>`.",
+			m.currentTimezone(ctx, i.GuildID))
+	} else {
+		content = m.update(ctx, i, requested)
+	}
+
+	if _, err := m.session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: &content}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to edit frolf-timezone response", attr.Error(err))
+	}
+}
+
+func (m *timezoneManager) update(ctx context.Context, i *discordgo.InteractionCreate, requested string) string {
+	timezone, err := guildconfig.ValidateTimezone(requested)
+	if err != nil {
+		return "❌ " + err.Error()
+	}
+
+	response, err := m.saveTimezone(ctx, guildconfig.TimezoneSetRequestPayloadV1{
+		GuildID:   i.GuildID,
+		Timezone:  timezone,
+		UpdatedBy: userID(i),
+	})
+	if err != nil {
+		m.logger.ErrorContext(ctx, "Failed to save guild timezone", attr.Error(err), attr.String("guild_id", i.GuildID))
+		return "❌ Couldn't save the timezone right now. Please try again."
+	}
+	if response.Error != "" {
+		return "❌ " + response.Error
+	}
+	return fmt.Sprintf("Round start times will now be read in **%s**. Existing rounds keep their start times.", response.Timezone)
+}
+
+// AutocompleteTimezone offers the common timezones matching what has been
+// typed, plus the typed name itself when it is a valid timezone.
+func AutocompleteTimezone(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	return TimezoneChoices(focused.StringValue()), nil
+}
+
+// TimezoneChoices builds autocomplete choices for the common timezones whose
+// names contain query. Spaces match underscores, so "new york" finds
+// America/New_York.
+func TimezoneChoices(query string) []*discordgo.ApplicationCommandOptionChoice {
+	query = strings.TrimSpace(query)
+	needle := strings.ToLower(strings.ReplaceAll(query, " ", "_"))
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, interactions.MaxAutocompleteChoices)
+	seen := map[string]bool{}
+	add := func(name string) {
+		if seen[name] || len(choices) == interactions.MaxAutocompleteChoices {
+			return
+		}
+		seen[name] = true
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: name})
+	}
+
+	if query != "" {
+		if timezone, err := guildconfig.ValidateTimezone(query); err == nil {
+			add(timezone)
+		}
+	}
+	for _, name := range guildconfig.CommonTimezones {
+		if needle == "" || strings.Contains(strings.ToLower(name), needle) {
+			add(name)
+		}
+	}
+	return choices
+}
+
+func userID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package timezone
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/bwmarrin/discordgo"
+)
+
+func newTimezoneCommand(options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "interaction-1",
+		GuildID: "guild-1",
+		Type:    discordgo.InteractionApplicationCommand,
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "admin-1"}},
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name:    "frolf-timezone",
+			Options: options,
+		},
+	}}
+}
+
+func timezoneOption(value string) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{Name: "timezone", Type: discordgo.ApplicationCommandOptionString, Value: value}
+}
+
+// newTestManager returns a manager whose saves fail unless a test overrides
+// them, and a pointer to the content of its last response edit.
+func newTestManager(t *testing.T) (*timezoneManager, *string) {
+	t.Helper()
+	if _, err := time.LoadLocation("Europe/London"); err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	fakeSession := discord.NewFakeSession()
+	var content string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		if r.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource || r.Data.Flags != discordgo.MessageFlagsEphemeral {
+			t.Errorf("expected an ephemeral deferred response, got %+v", r)
+		}
+		return nil
+	}
+	fakeSession.InteractionResponseEditFunc = func(i *discordgo.Interaction, edit *discordgo.WebhookEdit, opts ...discordgo.RequestOption) (*discordgo.Message, error) {
+		content = *edit.Content
+		return &discordgo.Message{}, nil
+	}
+
+	return &timezoneManager{
+		session: fakeSession,
+		logger:  testutils.NoOpLogger(),
+		currentTimezone: func(ctx context.Context, guildID string) string {
+			return "America/Denver"
+		},
+		saveTimezone: func(ctx context.Context, request guildconfig.TimezoneSetRequestPayloadV1) (*guildconfig.TimezoneResponsePayloadV1, error) {
+			return nil, errors.New("backend unavailable")
+		},
+	}, &content
+}
+
+func TestTimezoneManager_ShowsCurrentTimezone(t *testing.T) {
+	m, content := newTestManager(t)
+	m.HandleTimezoneCommand(context.Background(), newTimezoneCommand())
+
+	want := "Round start times are read in **America/Denver** unless a round names its own timezone. Change it with `/frolf-timezone timezone:<name>`."
+	if *content != want {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
+
+func TestTimezoneManager_Update(t *testing.T) {
+	m, content := newTestManager(t)
+	var saved guildconfig.TimezoneSetRequestPayloadV1
+	m.saveTimezone = func(ctx context.Context, request guildconfig.TimezoneSetRequestPayloadV1) (*guildconfig.TimezoneResponsePayloadV1, error) {
+		saved = request
+		return &guildconfig.TimezoneResponsePayloadV1{Timezone: request.Timezone}, nil
+	}
+
+	m.HandleTimezoneCommand(context.Background(), newTimezoneCommand(timezoneOption(" europe/london ")))
+
+	want := guildconfig.TimezoneSetRequestPayloadV1{GuildID: "guild-1", Timezone: "Europe/London", UpdatedBy: "admin-1"}
+	if saved != want {
+		t.Fatalf("unexpected save request %+v", saved)
+	}
+	if *content != "Round start times will now be read in **Europe/London**. Existing rounds keep their start times." {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
+
+func TestTimezoneManager_UpdateFailures(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		response *guildconfig.TimezoneResponsePayloadV1
+		want     string
+	}{
+		{
+			name:     "unknown timezone",
+			timezone: "Mars/Olympus_Mons",
+			want:     `❌ Timezone "Mars/Olympus_Mons" is not recognised. Use a name like America/Chicago or Europe/London.`,
+		},
+		{
+			name:     "backend unavailable",
+			timezone: "Europe/London",
+			want:     "❌ Couldn't save the timezone right now. Please try again.",
+		},
+		{
+			name:     "backend rejects",
+			timezone: "Europe/London",
+			response: &guildconfig.TimezoneResponsePayloadV1{Error: "Guild is not set up."},
+			want:     "❌ Guild is not set up.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, content := newTestManager(t)
+			if tt.response != nil {
+				m.saveTimezone = func(ctx context.Context, request guildconfig.TimezoneSetRequestPayloadV1) (*guildconfig.TimezoneResponsePayloadV1, error) {
+					return tt.response, nil
+				}
+			}
+
+			m.HandleTimezoneCommand(context.Background(), newTimezoneCommand(timezoneOption(tt.timezone)))
+			if *content != tt.want {
+				t.Fatalf("response = %q, want %q", *content, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimezoneChoices(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/London"); err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	if got := len(TimezoneChoices("")); got != len(guildconfig.CommonTimezones) {
+		t.Fatalf("expected every common timezone, got %d", got)
+	}
+
+	choices := TimezoneChoices("new york")
+	if len(choices) != 1 || choices[0].Value != "America/New_York" {
+		t.Fatalf("unexpected choices %+v", choices)
+	}
+
+	// A valid zone that isn't in the common list is offered first.
+	choices = TimezoneChoices("Europe/Lisbon")
+	if len(choices) != 1 || choices[0].Value != "Europe/Lisbon" {
+		t.Fatalf("unexpected choices %+v", choices)
+	}
+
+	choices = TimezoneChoices("america/chicago")
+	if len(choices) != 1 || choices[0].Value != "America/Chicago" {
+		t.Fatalf("expected the canonical name once, got %+v", choices)
+	}
+}
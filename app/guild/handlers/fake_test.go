@@ -6,6 +6,7 @@ import (
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/reset"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/setup"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
 	"github.com/bwmarrin/discordgo"
 )
@@ -14,7 +15,7 @@ import (
 type FakeGuildDiscord struct {
 	GetSetupManagerFunc       func() setup.SetupManager
 	GetResetManagerFunc       func() reset.ResetManager
-	RegisterAllCommandsFunc   func(guildID string) error
+	RegisterAllCommandsFunc   func(guildID string, guildConfig *storage.GuildConfig) error
 	UnregisterAllCommandsFunc func(guildID string) error
 
 	// Holds the sub-fakes
@@ -36,9 +37,9 @@ func (f *FakeGuildDiscord) GetResetManager() reset.ResetManager {
 	return &f.ResetManager
 }
 
-func (f *FakeGuildDiscord) RegisterAllCommands(guildID string) error {
+func (f *FakeGuildDiscord) RegisterAllCommands(guildID string, guildConfig *storage.GuildConfig) error {
 	if f.RegisterAllCommandsFunc != nil {
-		return f.RegisterAllCommandsFunc(guildID)
+		return f.RegisterAllCommandsFunc(guildID, guildConfig)
 	}
 	return nil
 }
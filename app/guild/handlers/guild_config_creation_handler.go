@@ -40,8 +40,10 @@ func (h *GuildHandlers) HandleGuildConfigCreated(ctx context.Context, payload *g
 		}
 	}
 
+	convertedConfig := convertGuildConfigFromShared(&payload.Config)
+
 	// 2. Register all bot commands for the successfully configured guild
-	if err := h.service.RegisterAllCommands(guildID); err != nil {
+	if err := h.service.RegisterAllCommands(guildID, convertedConfig); err != nil {
 		h.logger.ErrorContext(ctx, "Failed to register all commands for guild after config creation",
 			attr.String("guild_id", guildID),
 			attr.Error(err))
@@ -49,7 +51,6 @@ func (h *GuildHandlers) HandleGuildConfigCreated(ctx context.Context, payload *g
 	}
 
 	// 3. Make guild config available to runtime immediately
-	convertedConfig := convertGuildConfigFromShared(&payload.Config)
 	if convertedConfig != nil {
 
 		// Notify resolver to unblock any pending config lookups
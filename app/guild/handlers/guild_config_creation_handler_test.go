@@ -36,7 +36,7 @@ func TestGuildHandlers_HandleGuildConfigCreated(t *testing.T) {
 			wantErr: false,
 			wantLen: 0,
 			setup: func(fakeGuildDiscord *FakeGuildDiscord, fakeGuildConfigResolver *guildconfig.FakeGuildConfigResolver) {
-				fakeGuildDiscord.RegisterAllCommandsFunc = func(guildID string) error {
+				fakeGuildDiscord.RegisterAllCommandsFunc = func(guildID string, guildConfig *storage.GuildConfig) error {
 					return nil
 				}
 				fakeGuildConfigResolver.HandleGuildConfigReceivedFunc = func(ctx context.Context, guildID string, config *storage.GuildConfig) {
@@ -58,7 +58,7 @@ func TestGuildHandlers_HandleGuildConfigCreated(t *testing.T) {
 			wantErr: true,
 			wantLen: 0,
 			setup: func(fakeGuildDiscord *FakeGuildDiscord, fakeGuildConfigResolver *guildconfig.FakeGuildConfigResolver) {
-				fakeGuildDiscord.RegisterAllCommandsFunc = func(guildID string) error {
+				fakeGuildDiscord.RegisterAllCommandsFunc = func(guildID string, guildConfig *storage.GuildConfig) error {
 					return fmt.Errorf("failed to register")
 				}
 			},
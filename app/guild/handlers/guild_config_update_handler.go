@@ -221,6 +221,17 @@ func (h *GuildHandlers) HandleGuildFeatureAccessUpdated(ctx context.Context, pay
 		// type) and would alias the cached value, causing a data race.
 		updated.Entitlements = payload.Entitlements
 		h.guildConfigResolver.HandleGuildConfigReceived(ctx, string(payload.GuildID), &updated)
+
+		// Re-sync the guild's command set so gated commands appear or disappear
+		// with the club's entitlements instead of failing at click time.
+		if h.service != nil && updated.IsConfigured() {
+			if err := h.service.RegisterAllCommands(string(payload.GuildID), &updated); err != nil {
+				h.logger.ErrorContext(ctx, "Failed to re-sync commands after feature access update",
+					attr.String("guild_id", string(payload.GuildID)),
+					attr.Error(err))
+				return nil, fmt.Errorf("failed to re-sync commands for guild %s: %w", payload.GuildID, err)
+			}
+		}
 	} else {
 		// Config not cached yet. Invalidate so the next Get() fetches fresh data from
 		// the backend, which will include the updated entitlements.
@@ -392,3 +392,78 @@ func TestGuildHandlers_HandleGuildFeatureAccessUpdated_CacheMissInvalidates(t *t
 		t.Errorf("ClearInflightRequest was not called on cache miss — stale inflight state may persist")
 	}
 }
+
+func TestGuildHandlers_HandleGuildFeatureAccessUpdated_ResyncsCommands(t *testing.T) {
+	logger := loggerfrolfbot.NoOpLogger
+
+	fakeResolver := &guildconfig.FakeGuildConfigResolver{
+		GetGuildConfigWithContextFunc: func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+			return &storage.GuildConfig{
+				GuildID:              "123456789",
+				SignupChannelID:      "signup",
+				EventChannelID:       "events",
+				LeaderboardChannelID: "leaderboard",
+				RegisteredRoleID:     "role",
+			}, nil
+		},
+	}
+
+	var syncedGuild string
+	var syncedConfig *storage.GuildConfig
+	fakeGuildDiscord := &FakeGuildDiscord{
+		RegisterAllCommandsFunc: func(guildID string, guildConfig *storage.GuildConfig) error {
+			syncedGuild = guildID
+			syncedConfig = guildConfig
+			return nil
+		},
+	}
+
+	h := NewGuildHandlers(logger, nil, fakeGuildDiscord, fakeResolver, nil, nil, nil)
+
+	payload := &guildevents.GuildFeatureAccessUpdatedPayloadV1{
+		GuildID: "123456789",
+		Entitlements: guildtypes.ResolvedClubEntitlements{
+			Features: map[guildtypes.ClubFeatureKey]guildtypes.ClubFeatureAccess{
+				guildtypes.ClubFeatureBetting: {
+					Key:   guildtypes.ClubFeatureBetting,
+					State: guildtypes.FeatureAccessStateEnabled,
+				},
+			},
+		},
+	}
+
+	if _, err := h.HandleGuildFeatureAccessUpdated(context.Background(), payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if syncedGuild != "123456789" {
+		t.Fatalf("expected commands to be re-synced for guild, got %q", syncedGuild)
+	}
+	if syncedConfig == nil || !syncedConfig.IsFeatureEnabled(guildtypes.ClubFeatureBetting) {
+		t.Fatalf("expected re-sync to use the updated entitlements, got %+v", syncedConfig)
+	}
+}
+
+func TestGuildHandlers_HandleGuildFeatureAccessUpdated_SkipsResyncForUnconfiguredGuild(t *testing.T) {
+	logger := loggerfrolfbot.NoOpLogger
+
+	fakeResolver := &guildconfig.FakeGuildConfigResolver{
+		GetGuildConfigWithContextFunc: func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+			return &storage.GuildConfig{GuildID: "123456789", IsPlaceholder: true}, nil
+		},
+	}
+
+	fakeGuildDiscord := &FakeGuildDiscord{
+		RegisterAllCommandsFunc: func(guildID string, guildConfig *storage.GuildConfig) error {
+			t.Fatalf("commands should not be registered before setup completes")
+			return nil
+		},
+	}
+
+	h := NewGuildHandlers(logger, nil, fakeGuildDiscord, fakeResolver, nil, nil, nil)
+
+	payload := &guildevents.GuildFeatureAccessUpdatedPayloadV1{GuildID: "123456789"}
+	if _, err := h.HandleGuildFeatureAccessUpdated(context.Background(), payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
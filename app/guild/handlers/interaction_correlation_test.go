@@ -7,6 +7,7 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	guildevents "github.com/Black-And-White-Club/frolf-bot-shared/events/guild"
@@ -39,7 +40,7 @@ func TestGuildHandlers_CorrelationIDIsolationForConcurrentSetupResponses(t *test
 	}
 
 	fakeGuildDiscord := &FakeGuildDiscord{
-		RegisterAllCommandsFunc: func(guildID string) error { return nil },
+		RegisterAllCommandsFunc: func(guildID string, guildConfig *storage.GuildConfig) error { return nil },
 	}
 
 	handler := NewGuildHandlers(
@@ -7,8 +7,11 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	guilddiscord "github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/attestation"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/reminders"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/reset"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/setup"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guild/discord/timezone"
 	guildhandlers "github.com/Black-And-White-Club/discord-frolf-bot/app/guild/handlers"
 	guildrouter "github.com/Black-And-White-Club/discord-frolf-bot/app/guild/router"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
@@ -41,6 +44,7 @@ func InitializeGuildModule(
 	discordMetrics discordmetrics.DiscordMetrics,
 	guildConfigResolver guildconfig.GuildConfigResolver,
 	signupManager signup.SignupManager,
+	commandManifest *interactions.CommandManifest,
 ) (*guildrouter.GuildRouter, error) {
 	tracer := otel.Tracer("guild-module")
 
@@ -56,6 +60,7 @@ func InitializeGuildModule(
 		tracer,
 		discordMetrics,
 		guildConfigResolver,
+		commandManifest,
 	)
 	if err != nil {
 		logger.ErrorContext(ctx, "Failed to initialize guild Discord services", attr.Error(err))
@@ -65,6 +70,9 @@ func InitializeGuildModule(
 	// Register Discord interactions
 	setup.RegisterHandlers(interactionRegistry, guildDiscord.GetSetupManager())
 	reset.RegisterHandlers(interactionRegistry, guildDiscord.GetResetManager())
+	timezone.RegisterHandlers(interactionRegistry, timezone.NewTimezoneManager(session, eventBus, logger, guildConfigResolver))
+	reminders.RegisterHandlers(interactionRegistry, reminders.NewRemindersManager(session, eventBus, logger, guildConfigResolver))
+	attestation.RegisterHandlers(interactionRegistry, attestation.NewAttestationManager(session, eventBus, logger, guildConfigResolver))
 
 	// Build Watermill Handlers
 	guildHandlers := guildhandlers.NewGuildHandlers(
@@ -95,3 +103,37 @@ func InitializeGuildModule(
 
 	return guildRouter, nil
 }
+
+// InitializeGuildConfigCache wires only the guild config retrieval events to
+// guildConfigResolver. It is for processes that need the config cache but
+// leave every other guild event, and all interactions, to another process.
+func InitializeGuildConfigCache(
+	ctx context.Context,
+	router *message.Router,
+	eventBus eventbus.EventBus,
+	logger *slog.Logger,
+	cfg *config.Config,
+	helper utils.Helpers,
+	guildConfigResolver guildconfig.GuildConfigResolver,
+) (*guildrouter.GuildRouter, error) {
+	tracer := otel.Tracer("guild-module")
+
+	guildHandlers := guildhandlers.NewGuildHandlers(logger, cfg, nil, guildConfigResolver, nil, nil, nil)
+
+	guildRouter := guildrouter.NewGuildRouter(
+		logger,
+		router,
+		eventBus,
+		eventBus,
+		cfg,
+		helper,
+		tracer,
+	)
+
+	if err := guildRouter.ConfigureConfigCache(ctx, guildHandlers); err != nil {
+		logger.ErrorContext(ctx, "Failed to configure guild config cache router", attr.Error(err))
+		return nil, fmt.Errorf("failed to configure guild config cache router: %w", err)
+	}
+
+	return guildRouter, nil
+}
@@ -69,6 +69,42 @@ func (r *GuildRouter) Configure(ctx context.Context, handlers guildhandlers.Hand
 	return nil
 }
 
+// ConfigureConfigCache sets up the router with only the config retrieval
+// handlers, which fill this process's resolver cache and have no Discord
+// side effects. The gateway uses it: its consumer sees every guild event
+// the workers see, so it must not run the handlers that register commands
+// or answer interactions.
+func (r *GuildRouter) ConfigureConfigCache(ctx context.Context, handlers guildhandlers.Handlers) error {
+	middlewareHelper := utils.NewMiddlewareHelper()
+	r.Router.AddMiddleware(
+		middleware.CorrelationID,
+		middlewareHelper.CommonMetadataMiddleware("discord-guild"),
+		middlewareHelper.DiscordMetadataMiddleware(),
+		middlewareHelper.RoutingMetadataMiddleware(),
+		middleware.Recoverer,
+		tracingfrolfbot.TraceHandler(r.tracer),
+	)
+
+	var metrics handlerwrapper.ReturningMetrics // reserved for future metrics integration
+
+	deps := handlerDeps{
+		router:     r.Router,
+		subscriber: r.subscriber,
+		publisher:  r.publisher,
+		logger:     r.logger,
+		tracer:     r.tracer,
+		helper:     r.helper,
+		metrics:    metrics,
+	}
+
+	registerHandler(deps, guildevents.GuildConfigRetrievedV1, handlers.HandleGuildConfigRetrieved)
+	registerHandler(deps, guildevents.GuildConfigRetrievalFailedV1, handlers.HandleGuildConfigRetrievalFailed)
+
+	r.logger.InfoContext(ctx, "Guild config cache router configured successfully")
+
+	return nil
+}
+
 // RegisterHandlers registers event handlers using pure transformation pattern.
 func (r *GuildRouter) RegisterHandlers(ctx context.Context, handlers guildhandlers.Handlers) error {
 	r.logger.InfoContext(ctx, "Registering Guild Handlers")
@@ -0,0 +1,130 @@
+package guildconfig
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+)
+
+// Request-reply subjects of config.BackendFeatureAttestedScoring, which
+// stores whether a guild's scores need confirming.
+const (
+	AttestationGetRequestV1 = "guild.attestation.get.request.v1"
+	AttestationSetRequestV1 = "guild.attestation.set.request.v1"
+)
+
+const attestationRequestTimeout = time.Second
+
+// AttestationGetRequestPayloadV1 asks whether a guild uses attested scoring.
+type AttestationGetRequestPayloadV1 struct {
+	GuildID string `json:"guild_id"`
+}
+
+// AttestationSetRequestPayloadV1 turns a guild's attested scoring on or off.
+type AttestationSetRequestPayloadV1 struct {
+	GuildID   string `json:"guild_id"`
+	Enabled   bool   `json:"enabled"`
+	UpdatedBy string `json:"updated_by"`
+}
+
+// AttestationResponsePayloadV1 is the reply to both attestation requests.
+type AttestationResponsePayloadV1 struct {
+	Enabled bool   `json:"enabled"`
+	Error   string `json:"error,omitempty"`
+}
+
+// AttestedScoring reports whether a guild's submitted scores wait for another
+// player on the card to confirm them. The cached guild config is used when it
+// knows; otherwise the backend is asked and the answer cached. Guilds whose
+// mode can't be loaded score as usual.
+func AttestedScoring(ctx context.Context, resolver GuildConfigResolver, eventBus eventbus.EventBus, guildID string) bool {
+	if resolver == nil || guildID == "" {
+		return false
+	}
+	cfg, err := resolver.GetGuildConfigWithContext(ctx, guildID)
+	if err != nil || cfg == nil {
+		return false
+	}
+	if cfg.AttestedScoring != nil {
+		return *cfg.AttestedScoring
+	}
+
+	response, err := RequestAttestation(ctx, eventBus, guildID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load guild scoring mode, scores won't need attesting",
+			attr.String("guild_id", guildID),
+			attr.Error(err))
+		return false
+	}
+
+	enabled := response.Enabled
+	if !cfg.IsPlaceholder {
+		updated := *cfg
+		updated.AttestedScoring = &enabled
+		resolver.HandleGuildConfigReceived(ctx, guildID, &updated)
+	}
+	return enabled
+}
+
+// NewAttestedScoring returns AttestedScoring backed by eventBus, or nil while
+// cfg holds attested scoring back and every guild scores as usual.
+func NewAttestedScoring(cfg *config.Config, resolver GuildConfigResolver, eventBus eventbus.EventBus) func(ctx context.Context, guildID string) bool {
+	if !cfg.BackendFeatureEnabled(config.BackendFeatureAttestedScoring) {
+		return nil
+	}
+	return func(ctx context.Context, guildID string) bool {
+		return AttestedScoring(ctx, resolver, eventBus, guildID)
+	}
+}
+
+// RequestAttestation asks the backend whether a guild uses attested scoring.
+func RequestAttestation(ctx context.Context, eventBus eventbus.EventBus, guildID string) (*AttestationResponsePayloadV1, error) {
+	if guildID == "" {
+		return nil, errors.New("guild id is required")
+	}
+
+	return messagecreator.NATSRequest[AttestationGetRequestPayloadV1, AttestationResponsePayloadV1](
+		ctx,
+		eventBus,
+		AttestationGetRequestV1+"."+guildID,
+		AttestationGetRequestPayloadV1{GuildID: guildID},
+		attestationRequestTimeout,
+	)
+}
+
+// SaveAttestation stores a guild's scoring mode with the backend and, once it
+// is accepted, in the cached guild config.
+func SaveAttestation(ctx context.Context, resolver GuildConfigResolver, eventBus eventbus.EventBus, request AttestationSetRequestPayloadV1) (*AttestationResponsePayloadV1, error) {
+	if request.GuildID == "" {
+		return nil, errors.New("guild id is required")
+	}
+
+	response, err := messagecreator.NATSRequest[AttestationSetRequestPayloadV1, AttestationResponsePayloadV1](
+		ctx,
+		eventBus,
+		AttestationSetRequestV1+"."+request.GuildID,
+		request,
+		attestationRequestTimeout,
+	)
+	if err != nil || response.Error != "" {
+		return response, err
+	}
+
+	if resolver != nil {
+		lookupCtx, cancel := context.WithTimeout(ctx, attestationRequestTimeout)
+		defer cancel()
+		if cfg, err := resolver.GetGuildConfigWithContext(lookupCtx, request.GuildID); err == nil && cfg != nil && !cfg.IsPlaceholder {
+			updated := *cfg
+			enabled := response.Enabled
+			updated.AttestedScoring = &enabled
+			resolver.HandleGuildConfigReceived(ctx, request.GuildID, &updated)
+		}
+	}
+	return response, nil
+}
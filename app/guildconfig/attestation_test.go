@@ -0,0 +1,65 @@
+package guildconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+)
+
+func TestAttestedScoring(t *testing.T) {
+	ctx := context.Background()
+	enabled := true
+
+	resolver := &FakeGuildConfigResolver{
+		GetGuildConfigWithContextFunc: func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+			return &storage.GuildConfig{GuildID: guildID, AttestedScoring: &enabled}, nil
+		},
+	}
+	if !AttestedScoring(ctx, resolver, &fakeEventBus{}, "g") {
+		t.Fatal("AttestedScoring() = false, want the cached mode")
+	}
+
+	resolver.GetGuildConfigWithContextFunc = func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+		return &storage.GuildConfig{GuildID: guildID}, nil
+	}
+	// The fake event bus has no NATS connection, so the backend can't answer.
+	if AttestedScoring(ctx, resolver, &fakeEventBus{}, "g") {
+		t.Fatal("AttestedScoring() = true, want scores not to need attesting")
+	}
+	if AttestedScoring(ctx, nil, nil, "g") {
+		t.Fatal("AttestedScoring() without a resolver = true, want false")
+	}
+}
+
+func TestResolver_HandleGuildConfigReceived_KeepsAttestedScoring(t *testing.T) {
+	cfg := &ResolverConfig{RequestTimeout: 5 * time.Millisecond, ResponseTimeout: 10 * time.Millisecond}
+	r, err := NewResolver(context.Background(), &fakeEventBus{}, storage.NewInteractionStore[storage.GuildConfig](context.Background(), 1*time.Hour), cfg)
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	enabled := true
+	r.HandleGuildConfigReceived(context.Background(), "g", &storage.GuildConfig{GuildID: "g", EventChannelID: "e", AttestedScoring: &enabled})
+	r.HandleGuildConfigReceived(context.Background(), "g", &storage.GuildConfig{GuildID: "g", EventChannelID: "e2"})
+
+	got, err := r.GetGuildConfigWithContext(context.Background(), "g")
+	if err != nil {
+		t.Fatalf("GetGuildConfigWithContext() error = %v", err)
+	}
+	if got.EventChannelID != "e2" || got.AttestedScoring == nil || !*got.AttestedScoring {
+		t.Fatalf("expected the refreshed config to keep its scoring mode, got %+v", got)
+	}
+}
+
+func TestNewAttestedScoring_HeldUntilReleased(t *testing.T) {
+	if NewAttestedScoring(&config.Config{}, nil, nil) != nil {
+		t.Fatal("expected attested scoring to be held back by default")
+	}
+	released := &config.Config{BackendFeatures: []config.BackendFeature{config.BackendFeatureAttestedScoring}}
+	if NewAttestedScoring(released, nil, nil) == nil {
+		t.Fatal("expected attested scoring once released")
+	}
+}
@@ -0,0 +1,252 @@
+package guildconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+)
+
+// Request-reply subjects of config.BackendFeatureGuildReminders. The backend
+// schedules a round's RoundReminderSentV1 events from the same settings.
+const (
+	RemindersGetRequestV1 = "guild.reminders.get.request.v1"
+	RemindersSetRequestV1 = "guild.reminders.set.request.v1"
+)
+
+const (
+	// MaxReminders is how many reminders a guild can have per round.
+	MaxReminders = 5
+	// MaxReminderTemplateLength leaves room in a reminder message for the
+	// heading and mentions.
+	MaxReminderTemplateLength = 1000
+
+	remindersRequestTimeout = time.Second
+	minReminderOffset       = 5 * time.Minute
+	maxReminderOffset       = 7 * 24 * time.Hour
+)
+
+// DefaultReminderOffset is the reminder guilds get when they have not chosen
+// any, and the offset of reminders whose type names none.
+const DefaultReminderOffset = time.Hour
+
+// DefaultReminders are the reminders of a guild that has not chosen any.
+var DefaultReminders = []storage.ReminderSetting{{Offset: FormatReminderOffset(DefaultReminderOffset)}}
+
+// RemindersGetRequestPayloadV1 asks for a guild's round reminders.
+type RemindersGetRequestPayloadV1 struct {
+	GuildID string `json:"guild_id"`
+}
+
+// RemindersSetRequestPayloadV1 replaces a guild's round reminders.
+type RemindersSetRequestPayloadV1 struct {
+	GuildID   string                    `json:"guild_id"`
+	Reminders []storage.ReminderSetting `json:"reminders"`
+	UpdatedBy string                    `json:"updated_by"`
+}
+
+// RemindersResponsePayloadV1 is the reply to both reminder requests.
+// Reminders is empty when the guild has not chosen any.
+type RemindersResponsePayloadV1 struct {
+	Reminders []storage.ReminderSetting `json:"reminders"`
+	Error     string                    `json:"error,omitempty"`
+}
+
+// ParseReminderOffset reads an offset such as "24h", "2h", "15m", "1h30m" or
+// "1d". Hyphenated names like "1-hour" and "15-minutes" are read too, as
+// older reminder events use them.
+func ParseReminderOffset(value string) (time.Duration, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	for _, unit := range []struct{ long, short string }{
+		{"-minutes", "m"}, {"-minute", "m"}, {"-hours", "h"}, {"-hour", "h"}, {"-days", "d"}, {"-day", "d"},
+	} {
+		normalized = strings.ReplaceAll(normalized, unit.long, unit.short)
+	}
+
+	var offset time.Duration
+	if days, ok := strings.CutSuffix(normalized, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a reminder time. Use times like 24h, 2h or 15m.", value)
+		}
+		offset = time.Duration(n) * 24 * time.Hour
+	} else {
+		parsed, err := time.ParseDuration(normalized)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a reminder time. Use times like 24h, 2h or 15m.", value)
+		}
+		offset = parsed
+	}
+
+	if offset < minReminderOffset || offset > maxReminderOffset || offset%time.Minute != 0 {
+		return 0, fmt.Errorf("%q is not a reminder time. Reminders can be sent from 5m to 7d before a round, in whole minutes.", value)
+	}
+	return offset, nil
+}
+
+// FormatReminderOffset writes an offset the way ParseReminderOffset reads it,
+// e.g. "24h", "15m" or "1h30m".
+func FormatReminderOffset(offset time.Duration) string {
+	hours := int(offset / time.Hour)
+	minutes := int(offset % time.Hour / time.Minute)
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	}
+}
+
+// ParseReminderOffsets reads a list of offsets separated by commas or
+// spaces, such as "24h, 2h, 15m". Templates already set for an offset in the
+// list are kept. The reminders are returned earliest first.
+func ParseReminderOffsets(value string, current []storage.ReminderSetting) ([]storage.ReminderSetting, error) {
+	templates := make(map[time.Duration]string, len(current))
+	for _, reminder := range current {
+		if offset, err := ParseReminderOffset(reminder.Offset); err == nil {
+			templates[offset] = reminder.Template
+		}
+	}
+
+	seen := map[time.Duration]bool{}
+	var offsets []time.Duration
+	for _, field := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+		offset, err := ParseReminderOffset(field)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[offset] {
+			seen[offset] = true
+			offsets = append(offsets, offset)
+		}
+	}
+	if len(offsets) == 0 {
+		return nil, errors.New("Give at least one reminder time, such as 24h, 2h or 15m.")
+	}
+	if len(offsets) > MaxReminders {
+		return nil, fmt.Errorf("Rounds can have at most %d reminders.", MaxReminders)
+	}
+
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] > offsets[j] })
+	reminders := make([]storage.ReminderSetting, len(offsets))
+	for idx, offset := range offsets {
+		reminders[idx] = storage.ReminderSetting{Offset: FormatReminderOffset(offset), Template: templates[offset]}
+	}
+	return reminders, nil
+}
+
+// FindReminder returns the reminder of reminders sent at offset.
+func FindReminder(reminders []storage.ReminderSetting, offset time.Duration) (storage.ReminderSetting, bool) {
+	for _, reminder := range reminders {
+		if parsed, err := ParseReminderOffset(reminder.Offset); err == nil && parsed == offset {
+			return reminder, true
+		}
+	}
+	return storage.ReminderSetting{}, false
+}
+
+// GuildReminders returns a guild's round reminders. The cached guild config
+// is used when it has them; otherwise the backend is asked and the answer
+// cached. DefaultReminders is returned when neither has any.
+func GuildReminders(ctx context.Context, resolver GuildConfigResolver, eventBus eventbus.EventBus, guildID string) []storage.ReminderSetting {
+	if resolver == nil || guildID == "" {
+		return DefaultReminders
+	}
+	cfg, err := resolver.GetGuildConfigWithContext(ctx, guildID)
+	if err != nil || cfg == nil {
+		return DefaultReminders
+	}
+	if len(cfg.Reminders) > 0 {
+		return cfg.Reminders
+	}
+
+	response, err := RequestReminders(ctx, eventBus, guildID)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load guild reminders, using default",
+			attr.String("guild_id", guildID),
+			attr.Error(err))
+		return DefaultReminders
+	}
+
+	reminders := DefaultReminders
+	if len(response.Reminders) > 0 {
+		reminders = response.Reminders
+	}
+	if !cfg.IsPlaceholder {
+		updated := *cfg
+		updated.Reminders = reminders
+		resolver.HandleGuildConfigReceived(ctx, guildID, &updated)
+	}
+	return reminders
+}
+
+// NewRemindersSource returns GuildReminders backed by eventBus, or nil while
+// cfg holds guild reminders back and every guild gets DefaultReminders.
+func NewRemindersSource(cfg *config.Config, resolver GuildConfigResolver, eventBus eventbus.EventBus) func(ctx context.Context, guildID string) []storage.ReminderSetting {
+	if !cfg.BackendFeatureEnabled(config.BackendFeatureGuildReminders) {
+		return nil
+	}
+	return func(ctx context.Context, guildID string) []storage.ReminderSetting {
+		return GuildReminders(ctx, resolver, eventBus, guildID)
+	}
+}
+
+// RequestReminders asks the backend for a guild's round reminders.
+func RequestReminders(ctx context.Context, eventBus eventbus.EventBus, guildID string) (*RemindersResponsePayloadV1, error) {
+	if guildID == "" {
+		return nil, errors.New("guild id is required")
+	}
+
+	return messagecreator.NATSRequest[RemindersGetRequestPayloadV1, RemindersResponsePayloadV1](
+		ctx,
+		eventBus,
+		RemindersGetRequestV1+"."+guildID,
+		RemindersGetRequestPayloadV1{GuildID: guildID},
+		remindersRequestTimeout,
+	)
+}
+
+// SaveReminders stores a guild's round reminders with the backend and, once
+// they are accepted, in the cached guild config.
+func SaveReminders(ctx context.Context, resolver GuildConfigResolver, eventBus eventbus.EventBus, request RemindersSetRequestPayloadV1) (*RemindersResponsePayloadV1, error) {
+	if request.GuildID == "" {
+		return nil, errors.New("guild id is required")
+	}
+
+	response, err := messagecreator.NATSRequest[RemindersSetRequestPayloadV1, RemindersResponsePayloadV1](
+		ctx,
+		eventBus,
+		RemindersSetRequestV1+"."+request.GuildID,
+		request,
+		remindersRequestTimeout,
+	)
+	if err != nil || response.Error != "" {
+		return response, err
+	}
+	if len(response.Reminders) == 0 {
+		response.Reminders = request.Reminders
+	}
+
+	if resolver != nil {
+		lookupCtx, cancel := context.WithTimeout(ctx, remindersRequestTimeout)
+		defer cancel()
+		if cfg, err := resolver.GetGuildConfigWithContext(lookupCtx, request.GuildID); err == nil && cfg != nil && !cfg.IsPlaceholder {
+			updated := *cfg
+			updated.Reminders = response.Reminders
+			resolver.HandleGuildConfigReceived(ctx, request.GuildID, &updated)
+		}
+	}
+	return response, nil
+}
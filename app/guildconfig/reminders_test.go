@@ -0,0 +1,129 @@
+package guildconfig
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+)
+
+func TestParseReminderOffset(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "24h", want: 24 * time.Hour},
+		{input: " 15M ", want: 15 * time.Minute},
+		{input: "1h30m", want: 90 * time.Minute},
+		{input: "2d", want: 48 * time.Hour},
+		{input: "1-hour", want: time.Hour},
+		{input: "15-minutes", want: 15 * time.Minute},
+		{input: "1m", wantErr: true},
+		{input: "8d", wantErr: true},
+		{input: "90s", wantErr: true},
+		{input: "start", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseReminderOffset(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseReminderOffset(%q) = %v, want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("ParseReminderOffset(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+			}
+			if again, err := ParseReminderOffset(FormatReminderOffset(got)); err != nil || again != got {
+				t.Fatalf("FormatReminderOffset(%v) = %q does not parse back", got, FormatReminderOffset(got))
+			}
+		})
+	}
+}
+
+func TestParseReminderOffsets(t *testing.T) {
+	current := []storage.ReminderSetting{{Offset: "2h", Template: "Two hours to go!"}, {Offset: "1h"}}
+
+	got, err := ParseReminderOffsets("15m, 24h 2h,15m", current)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []storage.ReminderSetting{{Offset: "24h"}, {Offset: "2h", Template: "Two hours to go!"}, {Offset: "15m"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseReminderOffsets() = %+v, want %+v", got, want)
+	}
+
+	for _, input := range []string{"", " , ", "24h, soon", "1h 2h 3h 4h 5h 6h"} {
+		if _, err := ParseReminderOffsets(input, nil); err == nil {
+			t.Errorf("ParseReminderOffsets(%q) expected an error", input)
+		}
+	}
+
+	if reminder, ok := FindReminder(want, 120*time.Minute); !ok || reminder.Template != "Two hours to go!" {
+		t.Fatalf("FindReminder() = %+v, %v", reminder, ok)
+	}
+	if _, ok := FindReminder(want, time.Hour); ok {
+		t.Fatal("expected no 1h reminder")
+	}
+}
+
+func TestGuildReminders(t *testing.T) {
+	ctx := context.Background()
+
+	resolver := &FakeGuildConfigResolver{
+		GetGuildConfigWithContextFunc: func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+			return &storage.GuildConfig{GuildID: guildID, Reminders: []storage.ReminderSetting{{Offset: "24h"}}}, nil
+		},
+	}
+	if got := GuildReminders(ctx, resolver, &fakeEventBus{}, "g"); len(got) != 1 || got[0].Offset != "24h" {
+		t.Fatalf("GuildReminders() = %+v, want the cached reminders", got)
+	}
+
+	resolver.GetGuildConfigWithContextFunc = func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+		return &storage.GuildConfig{GuildID: guildID}, nil
+	}
+	// The fake event bus has no NATS connection, so the backend can't answer.
+	if got := GuildReminders(ctx, resolver, &fakeEventBus{}, "g"); !reflect.DeepEqual(got, DefaultReminders) {
+		t.Fatalf("GuildReminders() = %+v, want the default", got)
+	}
+	if got := GuildReminders(ctx, nil, nil, "g"); !reflect.DeepEqual(got, DefaultReminders) {
+		t.Fatalf("GuildReminders() without a resolver = %+v, want the default", got)
+	}
+}
+
+func TestNewRemindersSource_HeldUntilReleased(t *testing.T) {
+	if NewRemindersSource(&config.Config{}, nil, nil) != nil {
+		t.Fatal("expected guild reminders to be held back by default")
+	}
+	released := &config.Config{BackendFeatures: []config.BackendFeature{config.BackendFeatureGuildReminders}}
+	if NewRemindersSource(released, nil, nil) == nil {
+		t.Fatal("expected guild reminders once released")
+	}
+}
+
+func TestResolver_HandleGuildConfigReceived_KeepsReminders(t *testing.T) {
+	cfg := &ResolverConfig{RequestTimeout: 5 * time.Millisecond, ResponseTimeout: 10 * time.Millisecond}
+	r, err := NewResolver(context.Background(), &fakeEventBus{}, storage.NewInteractionStore[storage.GuildConfig](context.Background(), 1*time.Hour), cfg)
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	reminders := []storage.ReminderSetting{{Offset: "24h"}, {Offset: "15m", Template: "Tee time!"}}
+	r.HandleGuildConfigReceived(context.Background(), "g", &storage.GuildConfig{GuildID: "g", EventChannelID: "e", Reminders: reminders})
+	r.HandleGuildConfigReceived(context.Background(), "g", &storage.GuildConfig{GuildID: "g", EventChannelID: "e2"})
+
+	got, err := r.GetGuildConfigWithContext(context.Background(), "g")
+	if err != nil {
+		t.Fatalf("GetGuildConfigWithContext() error = %v", err)
+	}
+	if got.EventChannelID != "e2" || !reflect.DeepEqual(got.Reminders, reminders) {
+		t.Fatalf("expected the refreshed config to keep its reminders, got %+v", got)
+	}
+}
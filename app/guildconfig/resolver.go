@@ -201,6 +201,24 @@ func (r *Resolver) HandleGuildConfigReceived(ctx context.Context, guildID string
 		attr.Bool("config_nil", config == nil))
 
 	if config != nil {
+		// Backend config events don't carry the timezone, reminders or
+		// scoring mode yet; keep the ones already cached.
+		if config.Timezone == "" || config.Reminders == nil || config.AttestedScoring == nil {
+			if cached, err := r.cache.Get(ctx, guildID); err == nil {
+				merged := *config
+				if merged.Timezone == "" {
+					merged.Timezone = cached.Timezone
+				}
+				if merged.Reminders == nil {
+					merged.Reminders = cached.Reminders
+				}
+				if merged.AttestedScoring == nil {
+					merged.AttestedScoring = cached.AttestedScoring
+				}
+				config = &merged
+			}
+		}
+
 		// Populate the local cache for future Get calls
 		r.cache.Set(ctx, guildID, *config)
 		slog.InfoContext(ctx, "Guild config cached successfully",
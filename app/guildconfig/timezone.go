@@ -0,0 +1,179 @@
+package guildconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+)
+
+// TimezoneSetRequestV1 is the request-reply subject of
+// config.BackendFeatureGuildTimezone.
+const TimezoneSetRequestV1 = "guild.timezone.set.request.v1"
+
+const timezoneRequestTimeout = time.Second
+
+// timezoneFallbackTTL is how long a guild whose config couldn't be loaded
+// keeps the default timezone before its config is looked up again.
+const timezoneFallbackTTL = 30 * time.Second
+
+// DefaultTimezone is used for guilds that have not chosen a timezone.
+const DefaultTimezone = "America/Chicago"
+
+// TimezoneSetRequestPayloadV1 changes a guild's timezone.
+type TimezoneSetRequestPayloadV1 struct {
+	GuildID   string `json:"guild_id"`
+	Timezone  string `json:"timezone"`
+	UpdatedBy string `json:"updated_by"`
+}
+
+// TimezoneResponsePayloadV1 is the reply to TimezoneSetRequestV1.
+type TimezoneResponsePayloadV1 struct {
+	Timezone string `json:"timezone"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ValidateTimezone checks name against the tz database and returns it in its
+// canonical form. Names are matched case-insensitively against the zones the
+// bot suggests, so "america/chicago" is accepted.
+func ValidateTimezone(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("Timezone is required.")
+	}
+	for _, common := range CommonTimezones {
+		if strings.EqualFold(name, common) {
+			name = common
+			break
+		}
+	}
+	if strings.EqualFold(name, "Local") {
+		return "", fmt.Errorf("Timezone %q is not recognised. Use a name like America/Chicago or Europe/London.", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return "", fmt.Errorf("Timezone %q is not recognised. Use a name like America/Chicago or Europe/London.", name)
+	}
+	return loc.String(), nil
+}
+
+// timezoneFallbacks remembers guilds whose config couldn't be loaded, so
+// autocomplete and time parsing don't wait on the resolver for each request.
+var timezoneFallbacks sync.Map // guildID -> time.Time the fallback expires
+
+// GuildTimezone returns the timezone a guild's round times are read in, from
+// the Timezone field of its guild config. DefaultTimezone is returned when the
+// guild hasn't chosen one or its config can't be loaded; a failed load is
+// remembered for timezoneFallbackTTL.
+func GuildTimezone(ctx context.Context, logger *slog.Logger, resolver GuildConfigResolver, guildID string) string {
+	if resolver == nil || guildID == "" {
+		return DefaultTimezone
+	}
+	if until, ok := timezoneFallbacks.Load(guildID); ok {
+		if time.Now().Before(until.(time.Time)) {
+			return DefaultTimezone
+		}
+		timezoneFallbacks.Delete(guildID)
+	}
+
+	cfg, err := resolver.GetGuildConfigWithContext(ctx, guildID)
+	if err != nil || cfg == nil {
+		if logger != nil {
+			logger.WarnContext(ctx, "Failed to load guild config for timezone, using default",
+				attr.String("guild_id", guildID),
+				attr.Error(err))
+		}
+		timezoneFallbacks.Store(guildID, time.Now().Add(timezoneFallbackTTL))
+		return DefaultTimezone
+	}
+	if cfg.Timezone == "" {
+		return DefaultTimezone
+	}
+	return cfg.Timezone
+}
+
+// GuildLocation is GuildTimezone loaded as a location, for formatting times
+// shown to the guild. UTC is returned if the timezone cannot be loaded.
+func GuildLocation(ctx context.Context, logger *slog.Logger, resolver GuildConfigResolver, guildID string) *time.Location {
+	loc, err := time.LoadLocation(GuildTimezone(ctx, logger, resolver, guildID))
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// SaveTimezone stores a guild's timezone with the backend and, once it is
+// accepted, in the cached guild config. The timezone should already have
+// passed ValidateTimezone.
+func SaveTimezone(ctx context.Context, resolver GuildConfigResolver, eventBus eventbus.EventBus, request TimezoneSetRequestPayloadV1) (*TimezoneResponsePayloadV1, error) {
+	if request.GuildID == "" {
+		return nil, errors.New("guild id is required")
+	}
+
+	response, err := messagecreator.NATSRequest[TimezoneSetRequestPayloadV1, TimezoneResponsePayloadV1](
+		ctx,
+		eventBus,
+		TimezoneSetRequestV1+"."+request.GuildID,
+		request,
+		timezoneRequestTimeout,
+	)
+	if err != nil || response.Error != "" {
+		return response, err
+	}
+	if response.Timezone == "" {
+		response.Timezone = request.Timezone
+	}
+
+	if resolver != nil {
+		// Only refresh a config that is already cached; a guild still being set
+		// up loads its timezone with the rest of its config later.
+		lookupCtx, cancel := context.WithTimeout(ctx, timezoneRequestTimeout)
+		defer cancel()
+		if cfg, err := resolver.GetGuildConfigWithContext(lookupCtx, request.GuildID); err == nil && cfg != nil && !cfg.IsPlaceholder {
+			updated := *cfg
+			updated.Timezone = response.Timezone
+			resolver.HandleGuildConfigReceived(ctx, request.GuildID, &updated)
+		}
+	}
+	return response, nil
+}
+
+// CommonTimezones are the zones suggested when choosing a guild timezone. Any
+// other tz database name is accepted too.
+var CommonTimezones = []string{
+	"America/New_York",
+	"America/Chicago",
+	"America/Denver",
+	"America/Phoenix",
+	"America/Los_Angeles",
+	"America/Anchorage",
+	"Pacific/Honolulu",
+	"America/Halifax",
+	"America/St_Johns",
+	"America/Toronto",
+	"America/Vancouver",
+	"America/Mexico_City",
+	"America/Sao_Paulo",
+	"Europe/London",
+	"Europe/Dublin",
+	"Europe/Paris",
+	"Europe/Berlin",
+	"Europe/Stockholm",
+	"Europe/Helsinki",
+	"Africa/Johannesburg",
+	"Asia/Kolkata",
+	"Asia/Singapore",
+	"Asia/Tokyo",
+	"Australia/Perth",
+	"Australia/Adelaide",
+	"Australia/Sydney",
+	"Pacific/Auckland",
+	"UTC",
+}
@@ -0,0 +1,131 @@
+package guildconfig
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+)
+
+func TestValidateTimezone(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/London"); err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "Europe/London", want: "Europe/London"},
+		{input: "  america/chicago ", want: "America/Chicago"},
+		{input: "UTC", want: "UTC"},
+		{input: "", wantErr: true},
+		{input: "Local", wantErr: true},
+		{input: "Mars/Olympus_Mons", wantErr: true},
+		{input: "CST6CDT extra", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ValidateTimezone(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ValidateTimezone(%q) = %q, want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Fatalf("ValidateTimezone(%q) = %q, %v, want %q", tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommonTimezones_AreValid(t *testing.T) {
+	if _, err := time.LoadLocation(DefaultTimezone); err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	for _, name := range CommonTimezones {
+		if _, err := time.LoadLocation(name); err != nil {
+			t.Errorf("%s is not in the tz database: %v", name, err)
+		}
+	}
+}
+
+func TestGuildTimezone(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("uses the guild config timezone", func(t *testing.T) {
+		resolver := &FakeGuildConfigResolver{
+			GetGuildConfigWithContextFunc: func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+				return &storage.GuildConfig{GuildID: guildID, Timezone: "Europe/London"}, nil
+			},
+		}
+		if got := GuildTimezone(ctx, logger, resolver, "tz-config"); got != "Europe/London" {
+			t.Fatalf("GuildTimezone() = %q, want Europe/London", got)
+		}
+	})
+
+	t.Run("defaults when the guild has no timezone", func(t *testing.T) {
+		resolver := &FakeGuildConfigResolver{
+			GetGuildConfigWithContextFunc: func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+				return &storage.GuildConfig{GuildID: guildID}, nil
+			},
+		}
+		if got := GuildTimezone(ctx, logger, resolver, "tz-unset"); got != DefaultTimezone {
+			t.Fatalf("GuildTimezone() = %q, want %q", got, DefaultTimezone)
+		}
+		if got := GuildTimezone(ctx, logger, nil, "tz-unset"); got != DefaultTimezone {
+			t.Fatalf("GuildTimezone() without a resolver = %q, want %q", got, DefaultTimezone)
+		}
+	})
+
+	t.Run("remembers a failed config load", func(t *testing.T) {
+		lookups := 0
+		resolver := &FakeGuildConfigResolver{
+			GetGuildConfigWithContextFunc: func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+				lookups++
+				return nil, errors.New("timeout")
+			},
+		}
+		for range 3 {
+			if got := GuildTimezone(ctx, logger, resolver, "tz-failing"); got != DefaultTimezone {
+				t.Fatalf("GuildTimezone() = %q, want %q", got, DefaultTimezone)
+			}
+		}
+		if lookups != 1 {
+			t.Fatalf("expected the failed load to be remembered, got %d lookups", lookups)
+		}
+
+		timezoneFallbacks.Store("tz-failing", time.Now().Add(-time.Second))
+		GuildTimezone(ctx, logger, resolver, "tz-failing")
+		if lookups != 2 {
+			t.Fatalf("expected the config to be looked up again once the fallback expired, got %d lookups", lookups)
+		}
+	})
+}
+
+func TestResolver_HandleGuildConfigReceived_KeepsTimezone(t *testing.T) {
+	cfg := &ResolverConfig{RequestTimeout: 5 * time.Millisecond, ResponseTimeout: 10 * time.Millisecond}
+	r, err := NewResolver(context.Background(), &fakeEventBus{}, storage.NewInteractionStore[storage.GuildConfig](context.Background(), 1*time.Hour), cfg)
+	if err != nil {
+		t.Fatalf("unexpected constructor error: %v", err)
+	}
+
+	r.HandleGuildConfigReceived(context.Background(), "g", &storage.GuildConfig{GuildID: "g", EventChannelID: "e", Timezone: "Europe/London"})
+	r.HandleGuildConfigReceived(context.Background(), "g", &storage.GuildConfig{GuildID: "g", EventChannelID: "e2"})
+
+	got, err := r.GetGuildConfigWithContext(context.Background(), "g")
+	if err != nil {
+		t.Fatalf("GetGuildConfigWithContext() error = %v", err)
+	}
+	if got.EventChannelID != "e2" || got.Timezone != "Europe/London" {
+		t.Fatalf("expected the refreshed config to keep its timezone, got %+v", got)
+	}
+}
@@ -0,0 +1,153 @@
+// interactions/autocomplete.go
+package interactions
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// Discord drops autocomplete responses that take longer than three seconds.
+	autocompleteTimeout = 2500 * time.Millisecond
+
+	// MaxAutocompleteChoices is the most choices Discord accepts in one response.
+	MaxAutocompleteChoices = 25
+	// MaxAutocompleteChoiceNameLength is the longest choice label Discord accepts.
+	MaxAutocompleteChoiceNameLength = 100
+)
+
+// AutocompleteHandler returns the choices to offer for the focused option of
+// an application command. The focused option's value is what the user has
+// typed so far.
+type AutocompleteHandler func(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error)
+
+// RegisterAutocompleteHandler registers the provider for an option of a
+// command. The option is matched by name in any subcommand, so one provider
+// serves e.g. every challenge_id option of /challenge.
+func (r *Registry) RegisterAutocompleteHandler(command, option string, handler AutocompleteHandler) {
+	if command == "" || option == "" || handler == nil {
+		return
+	}
+	r.autocompleteHandlers[autocompleteKey(command, option)] = handler
+}
+
+func (r *Registry) hasAutocompleteHandler(command, option string) bool {
+	_, ok := r.autocompleteHandlers[autocompleteKey(command, option)]
+	return ok
+}
+
+// handleAutocomplete answers an autocomplete interaction. Autocomplete can't
+// carry an error message, so every failure is answered with no choices; the
+// command handler still enforces setup and permissions when the command runs.
+func (r *Registry) handleAutocomplete(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+	choices := r.autocompleteChoices(ctx, i)
+	if s == nil {
+		return
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: choices},
+	})
+	if err != nil && r.logger != nil {
+		r.logger.Warn("failed to respond to autocomplete",
+			slog.String("guild_id", i.GuildID),
+			slog.Any("error", err))
+	}
+}
+
+func (r *Registry) autocompleteChoices(ctx context.Context, i *discordgo.InteractionCreate) []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0)
+	if i.GuildID == "" {
+		return choices
+	}
+
+	data := i.ApplicationCommandData()
+	focused := FocusedOption(data.Options)
+	if focused == nil {
+		return choices
+	}
+
+	handler, ok := r.autocompleteHandlers[autocompleteKey(data.Name, focused.Name)]
+	if !ok {
+		if r.logger != nil {
+			r.logger.Warn("No autocomplete handler found",
+				slog.String("command", data.Name),
+				slog.String("option", focused.Name))
+		}
+		return choices
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, autocompleteTimeout)
+	defer cancel()
+
+	provided, err := r.runAutocompleteHandler(ctx, data.Name, handler, i, focused)
+	if err != nil {
+		if r.logger != nil {
+			r.logger.Warn("autocomplete provider failed",
+				slog.String("command", data.Name),
+				slog.String("option", focused.Name),
+				slog.String("guild_id", i.GuildID),
+				slog.Any("error", err))
+		}
+		return choices
+	}
+
+	for _, choice := range provided {
+		if choice == nil || choice.Name == "" {
+			continue
+		}
+		if len(choices) == MaxAutocompleteChoices {
+			break
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  TruncateChoiceName(choice.Name),
+			Value: choice.Value,
+		})
+	}
+	return choices
+}
+
+func (r *Registry) runAutocompleteHandler(
+	ctx context.Context,
+	command string,
+	handler AutocompleteHandler,
+	i *discordgo.InteractionCreate,
+	focused *discordgo.ApplicationCommandInteractionDataOption,
+) (choices []*discordgo.ApplicationCommandOptionChoice, err error) {
+	defer r.recoverFromPanic("autocomplete_handler", command, i)
+	return handler(ctx, i, focused)
+}
+
+// FocusedOption returns the option the user is typing in, searching into
+// subcommands and subcommand groups.
+func FocusedOption(options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+		if option.Focused {
+			return option
+		}
+		if focused := FocusedOption(option.Options); focused != nil {
+			return focused
+		}
+	}
+	return nil
+}
+
+// TruncateChoiceName shortens a choice label to the length Discord accepts.
+func TruncateChoiceName(name string) string {
+	runes := []rune(name)
+	if len(runes) <= MaxAutocompleteChoiceNameLength {
+		return name
+	}
+	return string(runes[:MaxAutocompleteChoiceNameLength-1]) + "…"
+}
+
+func autocompleteKey(command, option string) string {
+	return command + "/" + option
+}
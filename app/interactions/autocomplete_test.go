@@ -0,0 +1,112 @@
+package interactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func autocompleteTestInteraction(guildID, command string, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type:    discordgo.InteractionApplicationCommandAutocomplete,
+		GuildID: guildID,
+		Data:    discordgo.ApplicationCommandInteractionData{Name: command, Options: options},
+	}}
+}
+
+func TestAutocomplete_RoutesFocusedSubcommandOption(t *testing.T) {
+	r := NewRegistry()
+	var gotValue string
+	r.RegisterAutocompleteHandler("challenge", "challenge_id", func(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+		gotValue = focused.StringValue()
+		return []*discordgo.ApplicationCommandOptionChoice{{Name: "Alice vs Bob", Value: "challenge-1"}}, nil
+	})
+	r.RegisterAutocompleteHandler("challenge", "round_id", func(context.Context, *discordgo.InteractionCreate, *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+		t.Fatal("unfocused option provider should not run")
+		return nil, nil
+	})
+
+	i := autocompleteTestInteraction("g1", "challenge", &discordgo.ApplicationCommandInteractionDataOption{
+		Name: "link",
+		Type: discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "challenge_id", Type: discordgo.ApplicationCommandOptionString, Value: "ali", Focused: true},
+			{Name: "round_id", Type: discordgo.ApplicationCommandOptionString, Value: ""},
+		},
+	})
+
+	choices := r.autocompleteChoices(context.Background(), i)
+	if gotValue != "ali" {
+		t.Fatalf("expected provider to receive typed value, got %q", gotValue)
+	}
+	if len(choices) != 1 || choices[0].Value != "challenge-1" {
+		t.Fatalf("unexpected choices %+v", choices)
+	}
+
+	// Dispatching through HandleInteraction must not invoke command handlers.
+	r.RegisterCommandHandler(CommandSpec{Command: &discordgo.ApplicationCommand{Name: "challenge"}}, func(context.Context, *discordgo.InteractionCreate) {
+		t.Fatal("command handler should not run for autocomplete")
+	})
+	r.HandleInteraction(nil, i)
+}
+
+func TestAutocomplete_CapsAndTruncatesChoices(t *testing.T) {
+	r := NewRegistry()
+	r.RegisterAutocompleteHandler("season", "season_id", func(context.Context, *discordgo.InteractionCreate, *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+		choices := []*discordgo.ApplicationCommandOptionChoice{{Name: strings.Repeat("x", 150), Value: "long"}, nil, {Name: "", Value: "blank"}}
+		for n := 0; n < 40; n++ {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: fmt.Sprintf("Season %d", n), Value: n})
+		}
+		return choices, nil
+	})
+
+	i := autocompleteTestInteraction("g1", "season", &discordgo.ApplicationCommandInteractionDataOption{
+		Name: "standings",
+		Type: discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: "season_id", Type: discordgo.ApplicationCommandOptionString, Value: "", Focused: true},
+		},
+	})
+
+	choices := r.autocompleteChoices(context.Background(), i)
+	if len(choices) != MaxAutocompleteChoices {
+		t.Fatalf("expected %d choices, got %d", MaxAutocompleteChoices, len(choices))
+	}
+	if got := len([]rune(choices[0].Name)); got != MaxAutocompleteChoiceNameLength {
+		t.Fatalf("expected long label truncated to %d runes, got %d", MaxAutocompleteChoiceNameLength, got)
+	}
+	if choices[1].Value != 0 {
+		t.Fatalf("expected nil and blank choices to be dropped, got %+v", choices[1])
+	}
+}
+
+func TestAutocomplete_ReturnsNoChoicesOnFailure(t *testing.T) {
+	r := NewRegistry()
+	called := false
+	r.RegisterAutocompleteHandler("season", "season_id", func(context.Context, *discordgo.InteractionCreate, *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+		called = true
+		return nil, errors.New("backend unavailable")
+	})
+
+	focused := &discordgo.ApplicationCommandInteractionDataOption{Name: "season_id", Type: discordgo.ApplicationCommandOptionString, Value: "", Focused: true}
+
+	if choices := r.autocompleteChoices(context.Background(), autocompleteTestInteraction("g1", "season", focused)); choices == nil || len(choices) != 0 {
+		t.Fatalf("expected empty, non-nil choices on provider error, got %+v", choices)
+	}
+	if !called {
+		t.Fatal("expected provider to run")
+	}
+
+	called = false
+	if choices := r.autocompleteChoices(context.Background(), autocompleteTestInteraction("", "season", focused)); len(choices) != 0 || called {
+		t.Fatalf("expected DMs to get no choices without calling the provider")
+	}
+
+	if choices := r.autocompleteChoices(context.Background(), autocompleteTestInteraction("g1", "unknown", focused)); len(choices) != 0 {
+		t.Fatalf("expected unregistered options to get no choices")
+	}
+}
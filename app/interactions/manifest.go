@@ -0,0 +1,287 @@
+// interactions/manifest.go
+package interactions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandScope controls where a command is registered with Discord.
+type CommandScope int
+
+const (
+	// CommandScopeGuild commands are registered per guild once setup completes.
+	CommandScopeGuild CommandScope = iota
+	// CommandScopeGlobal commands are registered once for the application.
+	CommandScopeGlobal
+)
+
+// CommandSpec describes one application command contributed by a module: the
+// Discord definition plus the policy its handler is registered with.
+type CommandSpec struct {
+	Command            *discordgo.ApplicationCommand
+	Scope              CommandScope
+	RequiredPermission PermissionLevel
+	RequiresSetup      bool
+	RequiredFeature    guildtypes.ClubFeatureKey
+	IsMutating         bool
+	// BackendFeature keeps the command unregistered until the backend feature
+	// it depends on is released; see config.BackendFeature.
+	BackendFeature config.BackendFeature
+}
+
+// Name returns the command name.
+func (s CommandSpec) Name() string {
+	if s.Command == nil {
+		return ""
+	}
+	return s.Command.Name
+}
+
+// ID returns the command's handler ID. Discord scopes command names per
+// command type, so user and message context-menu commands are namespaced to
+// keep them apart from a slash command with the same name.
+func (s CommandSpec) ID() string {
+	if s.Command == nil {
+		return ""
+	}
+	return CommandHandlerID(s.Command.Type, s.Command.Name)
+}
+
+// CommandHandlerID returns the handler ID for a command of the given type.
+func CommandHandlerID(commandType discordgo.ApplicationCommandType, name string) string {
+	if name == "" {
+		return ""
+	}
+	switch commandType {
+	case discordgo.UserApplicationCommand:
+		return "user:" + name
+	case discordgo.MessageApplicationCommand:
+		return "message:" + name
+	default:
+		return name
+	}
+}
+
+func (s CommandSpec) handlerConfig(handler func(ctx context.Context, i *discordgo.InteractionCreate)) HandlerConfig {
+	return HandlerConfig{
+		Handler:            handler,
+		RequiredPermission: s.RequiredPermission,
+		RequiresSetup:      s.RequiresSetup,
+		RequiredFeature:    s.RequiredFeature,
+		IsMutating:         s.IsMutating,
+	}
+}
+
+// CommandManifest is the full set of application commands the bot exposes.
+// It is built from every module's Commands() so command registration never
+// drifts from the handlers that serve them.
+type CommandManifest struct {
+	specs    []CommandSpec
+	released map[config.BackendFeature]bool
+	version  string
+}
+
+// NewCommandManifest merges module command groups, rejecting empty or
+// duplicate command names. Names only need to be unique per command type.
+func NewCommandManifest(groups ...[]CommandSpec) (*CommandManifest, error) {
+	seen := make(map[string]struct{})
+	specs := make([]CommandSpec, 0)
+	for _, group := range groups {
+		for _, spec := range group {
+			id := spec.ID()
+			if id == "" {
+				return nil, fmt.Errorf("command manifest contains a command with no name")
+			}
+			if _, dup := seen[id]; dup {
+				return nil, fmt.Errorf("command %q is contributed more than once", id)
+			}
+			seen[id] = struct{}{}
+			specs = append(specs, spec)
+		}
+	}
+
+	sort.SliceStable(specs, func(i, j int) bool { return specs[i].ID() < specs[j].ID() })
+
+	m := &CommandManifest{specs: specs}
+	if err := m.updateVersion(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WithBackendFeatures releases the commands of the given backend features.
+// Commands of other backend features stay in the manifest, so their handlers
+// still validate, but are never registered with Discord.
+func (m *CommandManifest) WithBackendFeatures(features []config.BackendFeature) (*CommandManifest, error) {
+	released := make(map[config.BackendFeature]bool, len(features))
+	for _, feature := range features {
+		released[feature] = true
+	}
+	next := &CommandManifest{specs: m.specs, released: released}
+	if err := next.updateVersion(); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// registered reports whether spec is registered with Discord at all.
+func (m *CommandManifest) registered(spec CommandSpec) bool {
+	return spec.BackendFeature == "" || m.released[spec.BackendFeature]
+}
+
+func (m *CommandManifest) updateVersion() error {
+	specs := make([]CommandSpec, 0, len(m.specs))
+	for _, spec := range m.specs {
+		if m.registered(spec) {
+			specs = append(specs, spec)
+		}
+	}
+	version, err := manifestVersion(specs)
+	if err != nil {
+		return err
+	}
+	m.version = version
+	return nil
+}
+
+// Specs returns every command spec in handler ID order.
+func (m *CommandManifest) Specs() []CommandSpec {
+	return append([]CommandSpec(nil), m.specs...)
+}
+
+// Commands returns the desired Discord commands for a registration target.
+// An empty guildID selects global commands; otherwise every guild command,
+// regardless of entitlements (see GuildCommands). Commands of unreleased
+// backend features are left out.
+func (m *CommandManifest) Commands(guildID string) []*discordgo.ApplicationCommand {
+	scope := CommandScopeGuild
+	if guildID == "" {
+		scope = CommandScopeGlobal
+	}
+
+	commands := make([]*discordgo.ApplicationCommand, 0, len(m.specs))
+	for _, spec := range m.specs {
+		if spec.Scope == scope && m.registered(spec) {
+			commands = append(commands, spec.Command)
+		}
+	}
+	return commands
+}
+
+// GuildCommands returns the guild-scoped commands a club should see. Commands
+// gated on a feature are only included when the club's entitlements would let
+// the handler run, so clubs never see commands they cannot use.
+func (m *CommandManifest) GuildCommands(guildConfig *storage.GuildConfig) []*discordgo.ApplicationCommand {
+	commands := make([]*discordgo.ApplicationCommand, 0, len(m.specs))
+	for _, spec := range m.specs {
+		if spec.Scope != CommandScopeGuild || !m.registered(spec) || !spec.availableTo(guildConfig) {
+			continue
+		}
+		commands = append(commands, spec.Command)
+	}
+	return commands
+}
+
+// availableTo mirrors Registry.checkFeatureAccess: enabled features are fully
+// available, frozen features only for read-only commands.
+func (s CommandSpec) availableTo(guildConfig *storage.GuildConfig) bool {
+	if s.RequiredFeature == "" {
+		return true
+	}
+
+	switch guildConfig.FeatureAccess(s.RequiredFeature).State {
+	case guildtypes.FeatureAccessStateEnabled:
+		return true
+	case guildtypes.FeatureAccessStateFrozen:
+		return !s.IsMutating
+	default:
+		return false
+	}
+}
+
+// Version is a content hash of the manifest. It changes whenever a command
+// definition changes or a backend feature is released, which triggers re-sync
+// of already-synced guilds.
+func (m *CommandManifest) Version() string {
+	return m.version
+}
+
+// ValidateHandlers fails when a manifest command has no registered handler, an
+// autocomplete option has no provider, or a command handler was registered for
+// a command missing from the manifest.
+func (m *CommandManifest) ValidateHandlers(registry *Registry) error {
+	var errs []error
+
+	inManifest := make(map[string]struct{}, len(m.specs))
+	for _, spec := range m.specs {
+		inManifest[spec.ID()] = struct{}{}
+		if !registry.hasCommandHandler(spec.ID()) {
+			errs = append(errs, fmt.Errorf("command %q has no registered handler", spec.ID()))
+		}
+		for _, option := range autocompleteOptionNames(spec.Command.Options) {
+			if !registry.hasAutocompleteHandler(spec.Name(), option) {
+				errs = append(errs, fmt.Errorf("command %q option %q has no registered autocomplete handler", spec.Name(), option))
+			}
+		}
+	}
+
+	for _, id := range registry.CommandHandlerIDs() {
+		if _, ok := inManifest[id]; !ok {
+			errs = append(errs, fmt.Errorf("handler %q is registered for a command missing from the manifest", id))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// autocompleteOptionNames returns the distinct names of options, at any
+// subcommand depth, that ask Discord for autocomplete.
+func autocompleteOptionNames(options []*discordgo.ApplicationCommandOption) []string {
+	var names []string
+	seen := make(map[string]struct{})
+	var walk func([]*discordgo.ApplicationCommandOption)
+	walk = func(options []*discordgo.ApplicationCommandOption) {
+		for _, option := range options {
+			if option == nil {
+				continue
+			}
+			if option.Autocomplete {
+				if _, ok := seen[option.Name]; !ok {
+					seen[option.Name] = struct{}{}
+					names = append(names, option.Name)
+				}
+			}
+			walk(option.Options)
+		}
+	}
+	walk(options)
+	return names
+}
+
+func manifestVersion(specs []CommandSpec) (string, error) {
+	type versioned struct {
+		Scope   CommandScope                  `json:"scope"`
+		Command *discordgo.ApplicationCommand `json:"command"`
+	}
+	payload := make([]versioned, 0, len(specs))
+	for _, spec := range specs {
+		payload = append(payload, versioned{Scope: spec.Scope, Command: spec.Command})
+	}
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash command manifest: %w", err)
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
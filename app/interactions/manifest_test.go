@@ -0,0 +1,253 @@
+package interactions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	guildtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/guild"
+	"github.com/bwmarrin/discordgo"
+)
+
+func testSpec(name string, scope CommandScope) CommandSpec {
+	return CommandSpec{
+		Command: &discordgo.ApplicationCommand{Name: name, Description: name + " command"},
+		Scope:   scope,
+	}
+}
+
+func noopHandler(ctx context.Context, i *discordgo.InteractionCreate) {}
+
+func TestNewCommandManifest_RejectsDuplicateNames(t *testing.T) {
+	_, err := NewCommandManifest(
+		[]CommandSpec{testSpec("history", CommandScopeGuild)},
+		[]CommandSpec{testSpec("history", CommandScopeGuild)},
+	)
+	if err == nil || !strings.Contains(err.Error(), "history") {
+		t.Fatalf("expected duplicate command error, got %v", err)
+	}
+}
+
+func TestNewCommandManifest_RejectsUnnamedCommand(t *testing.T) {
+	if _, err := NewCommandManifest([]CommandSpec{{}}); err == nil {
+		t.Fatal("expected error for command without a name")
+	}
+}
+
+func TestCommandManifest_CommandsFilterByScope(t *testing.T) {
+	m, err := NewCommandManifest(
+		[]CommandSpec{testSpec("frolf-setup", CommandScopeGlobal)},
+		[]CommandSpec{testSpec("history", CommandScopeGuild), testSpec("bet", CommandScopeGuild)},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	global := m.Commands("")
+	if len(global) != 1 || global[0].Name != "frolf-setup" {
+		t.Fatalf("unexpected global commands: %v", global)
+	}
+
+	guild := m.Commands("g1")
+	if len(guild) != 2 || guild[0].Name != "bet" || guild[1].Name != "history" {
+		t.Fatalf("expected guild commands sorted by name, got %v", guild)
+	}
+}
+
+func TestCommandManifest_VersionTracksDefinitions(t *testing.T) {
+	a, _ := NewCommandManifest([]CommandSpec{testSpec("history", CommandScopeGuild)})
+	b, _ := NewCommandManifest([]CommandSpec{testSpec("history", CommandScopeGuild)})
+	if a.Version() == "" || a.Version() != b.Version() {
+		t.Fatalf("expected stable non-empty version, got %q and %q", a.Version(), b.Version())
+	}
+
+	changed := testSpec("history", CommandScopeGuild)
+	changed.Command.Description = "updated"
+	c, _ := NewCommandManifest([]CommandSpec{changed})
+	if c.Version() == a.Version() {
+		t.Fatal("expected version to change when a command definition changes")
+	}
+}
+
+func TestCommandManifest_ValidateHandlers(t *testing.T) {
+	m, err := NewCommandManifest([]CommandSpec{testSpec("history", CommandScopeGuild), testSpec("bet", CommandScopeGuild)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := NewRegistry()
+	r.RegisterCommandHandler(testSpec("history", CommandScopeGuild), noopHandler)
+	r.RegisterCommandHandler(testSpec("legacy", CommandScopeGuild), noopHandler)
+	r.RegisterHandler("history_page|", noopHandler) // component handlers are not commands
+
+	err = m.ValidateHandlers(r)
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	if !strings.Contains(err.Error(), `"bet" has no registered handler`) {
+		t.Fatalf("expected missing handler error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `"legacy" is registered for a command missing from the manifest`) {
+		t.Fatalf("expected orphan handler error, got %v", err)
+	}
+
+	r2 := NewRegistry()
+	r2.RegisterCommandHandler(testSpec("history", CommandScopeGuild), noopHandler)
+	r2.RegisterCommandHandler(testSpec("bet", CommandScopeGuild), noopHandler)
+	if err := m.ValidateHandlers(r2); err != nil {
+		t.Fatalf("expected valid registry, got %v", err)
+	}
+}
+
+func TestRegisterCommandHandler_AppliesSpecPolicy(t *testing.T) {
+	spec := testSpec("bet", CommandScopeGuild)
+	spec.RequiredPermission = PlayerRequired
+	spec.RequiresSetup = true
+	spec.RequiredFeature = guildtypes.ClubFeatureBetting
+
+	r := NewRegistry()
+	r.RegisterCommandHandler(spec, noopHandler)
+
+	cfg, ok := r.handlers["bet"]
+	if !ok {
+		t.Fatal("expected handler to be registered under the command name")
+	}
+	if cfg.RequiredPermission != PlayerRequired || !cfg.RequiresSetup || cfg.RequiredFeature != guildtypes.ClubFeatureBetting || cfg.IsMutating {
+		t.Fatalf("unexpected handler config: %+v", cfg)
+	}
+}
+
+func TestCommandManifest_GuildCommandsFilterByFeatureAccess(t *testing.T) {
+	bet := testSpec("bet", CommandScopeGuild)
+	bet.RequiredFeature = guildtypes.ClubFeatureBetting
+	wager := testSpec("wager", CommandScopeGuild)
+	wager.RequiredFeature = guildtypes.ClubFeatureBetting
+	wager.IsMutating = true
+
+	m, err := NewCommandManifest([]CommandSpec{
+		testSpec("frolf-setup", CommandScopeGlobal),
+		testSpec("history", CommandScopeGuild),
+		bet,
+		wager,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	withBetting := func(access guildtypes.ClubFeatureAccess) *storage.GuildConfig {
+		access.Key = guildtypes.ClubFeatureBetting
+		return &storage.GuildConfig{
+			GuildID: "g1",
+			Entitlements: guildtypes.ResolvedClubEntitlements{
+				Features: map[guildtypes.ClubFeatureKey]guildtypes.ClubFeatureAccess{
+					guildtypes.ClubFeatureBetting: access,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		config *storage.GuildConfig
+		want   []string
+	}{
+		{name: "no config", config: nil, want: []string{"history"}},
+		{name: "disabled", config: withBetting(guildtypes.ClubFeatureAccess{State: guildtypes.FeatureAccessStateDisabled}), want: []string{"history"}},
+		{name: "frozen keeps read-only", config: withBetting(guildtypes.ClubFeatureAccess{State: guildtypes.FeatureAccessStateFrozen}), want: []string{"bet", "history"}},
+		{name: "enabled", config: withBetting(guildtypes.ClubFeatureAccess{State: guildtypes.FeatureAccessStateEnabled}), want: []string{"bet", "history", "wager"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, cmd := range m.GuildCommands(tt.config) {
+				got = append(got, cmd.Name)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("GuildCommands() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandManifest_HoldsUnreleasedBackendFeatures(t *testing.T) {
+	templates := testSpec("template", CommandScopeGuild)
+	templates.BackendFeature = config.BackendFeatureRoundTemplates
+
+	held, err := NewCommandManifest([]CommandSpec{testSpec("history", CommandScopeGuild), templates})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := held.GuildCommands(nil); len(got) != 1 || got[0].Name != "history" {
+		t.Fatalf("expected the held command to be left out, got %v", got)
+	}
+	if got := held.Commands("g1"); len(got) != 1 {
+		t.Fatalf("expected the held command to be left out, got %v", got)
+	}
+	if len(held.Specs()) != 2 {
+		t.Fatal("expected the held command to stay in the manifest for handler validation")
+	}
+
+	released, err := held.WithBackendFeatures([]config.BackendFeature{config.BackendFeatureRoundTemplates})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := released.GuildCommands(nil); len(got) != 2 {
+		t.Fatalf("expected the released command to be registered, got %v", got)
+	}
+	if released.Version() == held.Version() {
+		t.Fatal("expected releasing a backend feature to change the version")
+	}
+}
+
+func TestCommandManifest_ValidateHandlers_RequiresAutocompleteProviders(t *testing.T) {
+	spec := testSpec("challenge", CommandScopeGuild)
+	spec.Command.Options = []*discordgo.ApplicationCommandOption{
+		{
+			Type: discordgo.ApplicationCommandOptionSubCommand,
+			Name: "withdraw",
+			Options: []*discordgo.ApplicationCommandOption{
+				{Type: discordgo.ApplicationCommandOptionString, Name: "challenge_id", Autocomplete: true},
+			},
+		},
+	}
+	m, err := NewCommandManifest([]CommandSpec{spec})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	r := NewRegistry()
+	r.RegisterCommandHandler(spec, noopHandler)
+	err = m.ValidateHandlers(r)
+	if err == nil || !strings.Contains(err.Error(), `option "challenge_id" has no registered autocomplete handler`) {
+		t.Fatalf("expected missing autocomplete error, got %v", err)
+	}
+
+	r.RegisterAutocompleteHandler("challenge", "challenge_id", func(context.Context, *discordgo.InteractionCreate, *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+		return nil, nil
+	})
+	if err := m.ValidateHandlers(r); err != nil {
+		t.Fatalf("expected valid registry, got %v", err)
+	}
+}
+
+func TestNewCommandManifest_AllowsSameNameAcrossCommandTypes(t *testing.T) {
+	userSpec := testSpec("history", CommandScopeGuild)
+	userSpec.Command = &discordgo.ApplicationCommand{Name: "history", Type: discordgo.UserApplicationCommand}
+
+	m, err := NewCommandManifest([]CommandSpec{testSpec("history", CommandScopeGuild), userSpec})
+	if err != nil {
+		t.Fatalf("expected slash and user commands to share a name, got %v", err)
+	}
+	if got := len(m.Commands("g1")); got != 2 {
+		t.Fatalf("expected 2 commands, got %d", got)
+	}
+
+	r := NewRegistry()
+	r.RegisterCommandHandler(testSpec("history", CommandScopeGuild), noopHandler)
+	if err := m.ValidateHandlers(r); err == nil || !strings.Contains(err.Error(), "user:history") {
+		t.Fatalf("expected missing user command handler error, got %v", err)
+	}
+}
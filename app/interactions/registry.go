@@ -42,24 +42,31 @@ type MutatingHandlerPolicy struct {
 }
 
 type Registry struct {
-	handlers            map[string]HandlerConfig
-	handlerPrefixes     []string
-	dmSafePrefixes      []string
-	guildConfigResolver guildconfig.GuildConfigResolver
-	logger              *slog.Logger
+	handlers             map[string]HandlerConfig
+	commandHandlers      map[string]struct{}
+	autocompleteHandlers map[string]AutocompleteHandler
+	handlerPrefixes      []string
+	dmSafePrefixes       []string
+	guildConfigResolver  guildconfig.GuildConfigResolver
+	logger               *slog.Logger
 }
 
 func NewRegistry() *Registry {
 	r := &Registry{
-		handlers:        make(map[string]HandlerConfig),
-		handlerPrefixes: make([]string, 0),
-		dmSafePrefixes:  make([]string, 0),
+		handlers:             make(map[string]HandlerConfig),
+		commandHandlers:      make(map[string]struct{}),
+		autocompleteHandlers: make(map[string]AutocompleteHandler),
+		handlerPrefixes:      make([]string, 0),
+		dmSafePrefixes:       make([]string, 0),
 	}
 
 	// Explicitly allow known DM-safe interaction IDs/prefixes.
 	r.addDMSafePrefix("signup_button|")
 	r.addDMSafePrefix("signup_modal")
 	r.addDMSafePrefix("set-udisc-name")
+	// Scorecard import previews are sent to the uploader by DM.
+	r.addDMSafePrefix("scorecard_preview_confirm|")
+	r.addDMSafePrefix("scorecard_preview_cancel|")
 
 	return r
 }
@@ -114,6 +121,33 @@ func (r *Registry) RegisterFeatureHandler(id string, handler func(ctx context.Co
 	})
 }
 
+// RegisterCommandHandler registers the handler for an application command,
+// taking its permission, setup and feature policy from the command spec.
+// Slash, user and message context-menu commands are all registered this way.
+func (r *Registry) RegisterCommandHandler(spec CommandSpec, handler func(ctx context.Context, i *discordgo.InteractionCreate)) {
+	id := spec.ID()
+	if id == "" {
+		return
+	}
+	r.registerHandlerConfig(id, spec.handlerConfig(handler))
+	r.commandHandlers[id] = struct{}{}
+}
+
+// CommandHandlerIDs returns the IDs of all registered command handlers.
+func (r *Registry) CommandHandlerIDs() []string {
+	ids := make([]string, 0, len(r.commandHandlers))
+	for id := range r.commandHandlers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (r *Registry) hasCommandHandler(name string) bool {
+	_, ok := r.commandHandlers[name]
+	return ok
+}
+
 func (r *Registry) HandleInteraction(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	if i == nil || i.Interaction == nil {
 		if r.logger != nil {
@@ -139,15 +173,21 @@ func (r *Registry) HandleInteraction(s *discordgo.Session, i *discordgo.Interact
 
 	switch i.Type {
 	case discordgo.InteractionApplicationCommand:
-		id = i.ApplicationCommandData().Name
+		data := i.ApplicationCommandData()
+		id = CommandHandlerID(data.CommandType, data.Name)
 		if r.logger != nil {
-			r.logger.Info("application command", slog.String("command", id))
+			r.logger.Info("application command",
+				slog.String("command", data.Name),
+				slog.Int("command_type", int(data.CommandType)))
 		}
 	case discordgo.InteractionMessageComponent:
 		id = i.MessageComponentData().CustomID
 		if r.logger != nil {
 			r.logger.Info("message component", slog.String("custom_id", id))
 		}
+	case discordgo.InteractionApplicationCommandAutocomplete:
+		r.handleAutocomplete(ctx, s, i)
+		return
 	case discordgo.InteractionModalSubmit:
 		modalData := i.ModalSubmitData()
 		if modalData.CustomID == "" {
@@ -181,7 +221,8 @@ func (r *Registry) HandleInteraction(s *discordgo.Session, i *discordgo.Interact
 	if handlerConfig, ok := r.handlers[id]; ok {
 		config = handlerConfig
 		found = true
-	} else {
+	} else if i.Type != discordgo.InteractionApplicationCommand {
+		// Commands match by exact name only; prefixes are for custom IDs.
 		for _, key := range r.handlerPrefixes {
 			if strings.HasPrefix(id, key) {
 				config = r.handlers[key]
@@ -50,6 +50,49 @@ func TestPrefixMatching_LongestPrefixWins(t *testing.T) {
 	}
 }
 
+func TestContextMenuCommand_RoutesByCommandType(t *testing.T) {
+	r := NewRegistry()
+	called := ""
+	r.RegisterCommandHandler(CommandSpec{Command: &discordgo.ApplicationCommand{Name: "history"}}, func(ctx context.Context, i *discordgo.InteractionCreate) { called = "slash" })
+	r.RegisterCommandHandler(CommandSpec{Command: &discordgo.ApplicationCommand{Name: "history", Type: discordgo.UserApplicationCommand}}, func(ctx context.Context, i *discordgo.InteractionCreate) { called = "user" })
+
+	i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type:    discordgo.InteractionApplicationCommand,
+		GuildID: "g1",
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name:        "history",
+			CommandType: discordgo.UserApplicationCommand,
+			TargetID:    "u2",
+		},
+	}}
+	r.HandleInteraction(nil, i)
+	if called != "user" {
+		t.Fatalf("expected user command handler, got %q", called)
+	}
+
+	i.Data = discordgo.ApplicationCommandInteractionData{Name: "history"}
+	r.HandleInteraction(nil, i)
+	if called != "slash" {
+		t.Fatalf("expected slash command handler, got %q", called)
+	}
+}
+
+func TestApplicationCommand_DoesNotPrefixMatch(t *testing.T) {
+	r := NewRegistry()
+	called := false
+	r.RegisterHandler("history", func(ctx context.Context, i *discordgo.InteractionCreate) { called = true })
+
+	i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type:    discordgo.InteractionApplicationCommand,
+		GuildID: "g1",
+		Data:    discordgo.ApplicationCommandInteractionData{Name: "history-export"},
+	}}
+	r.HandleInteraction(nil, i)
+	if called {
+		t.Fatal("expected application commands to match by exact name only")
+	}
+}
+
 func TestDMInteraction_NotAllowlisted_Blocked(t *testing.T) {
 	r := NewRegistry()
 	called := false
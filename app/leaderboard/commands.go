@@ -0,0 +1,22 @@
+package leaderboard
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	claimtag "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/claim_tag"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/history"
+	leaderboardupdated "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/leaderboard_updated"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/season"
+	tagswap "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/tag_swap"
+)
+
+// Commands returns the application commands contributed by the leaderboard module.
+func Commands() []interactions.CommandSpec {
+	return []interactions.CommandSpec{
+		claimtag.CommandSpec(),
+		season.CommandSpec(),
+		history.CommandSpec(),
+		history.UserCommandSpec(),
+		tagswap.CommandSpec(),
+		leaderboardupdated.CommandSpec(),
+	}
+}
@@ -0,0 +1,30 @@
+package claimtag
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /claimtag command.
+func CommandSpec() interactions.CommandSpec {
+	minTag := 1.0
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "claimtag",
+			Description: "Claim a specific tag number on the leaderboard (Available to all players)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "tag",
+					Description: "Tag number to claim (1-100)",
+					Required:    true,
+					MinValue:    &minTag,
+					MaxValue:    100,
+				},
+			},
+		},
+		RequiredPermission: interactions.PlayerRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+	}
+}
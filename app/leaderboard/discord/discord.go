@@ -10,6 +10,8 @@ import (
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/history"
 	leaderboardupdated "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/leaderboard_updated"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/season"
+	tagswap "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/tag_swap"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
@@ -24,6 +26,8 @@ type LeaderboardDiscordInterface interface {
 	GetClaimTagManager() claimtag.ClaimTagManager
 	GetSeasonManager() season.SeasonManager
 	GetHistoryManager() history.HistoryManager
+	GetTagSwapManager() tagswap.TagSwapManager
+	GetNotifier() notify.Notifier
 }
 
 // LeaderboardDiscord encapsulates all leaderboard-related Discord services.
@@ -32,6 +36,8 @@ type LeaderboardDiscord struct {
 	ClaimTagManager          claimtag.ClaimTagManager
 	SeasonManager            season.SeasonManager
 	HistoryManager           history.HistoryManager
+	TagSwapManager           tagswap.TagSwapManager
+	Notifier                 notify.Notifier
 }
 
 // NewLeaderboardDiscord creates a new LeaderboardDiscord instance.
@@ -56,11 +62,25 @@ func NewLeaderboardDiscord(
 	seasonManager := season.NewSeasonManager(session, publisher, logger, helper, config, guildConfigResolver, interactionStore, guildConfigCache, tracer, metrics)
 	historyManager := history.NewHistoryManager(session, publisher, logger, helper, interactionStore, metrics)
 
+	// Tag swap prompts outlive the worker that posted them, so they are kept in
+	// JetStream rather than the per-process interaction store.
+	var tagSwapPrompts tagswap.PromptStore
+	if publisher != nil {
+		prompts, err := tagswap.NewPromptStore(ctx, publisher.GetJetStream(), config)
+		if err != nil {
+			return nil, err
+		}
+		tagSwapPrompts = prompts
+	}
+	tagSwapManager := tagswap.NewTagSwapManager(session, publisher, logger, helper, config, tagSwapPrompts)
+
 	return &LeaderboardDiscord{
 		LeaderboardUpdateManager: leaderboardUpdateManager,
 		ClaimTagManager:          claimTagManager,
 		SeasonManager:            seasonManager,
 		HistoryManager:           historyManager,
+		TagSwapManager:           tagSwapManager,
+		Notifier:                 notify.NewNotifier(session, publisher, logger, config),
 	}, nil
 }
 
@@ -83,3 +103,13 @@ func (ld *LeaderboardDiscord) GetSeasonManager() season.SeasonManager {
 func (ld *LeaderboardDiscord) GetHistoryManager() history.HistoryManager {
 	return ld.HistoryManager
 }
+
+// GetTagSwapManager returns the TagSwapManager.
+func (ld *LeaderboardDiscord) GetTagSwapManager() tagswap.TagSwapManager {
+	return ld.TagSwapManager
+}
+
+// GetNotifier returns the Notifier used for tag change alerts.
+func (ld *LeaderboardDiscord) GetNotifier() notify.Notifier {
+	return ld.Notifier
+}
@@ -42,6 +42,12 @@ func TestNewLeaderboardDiscord_ConstructsAndExposesManagers(t *testing.T) {
 	if ld.GetClaimTagManager() == nil {
 		t.Fatalf("expected non-nil ClaimTagManager")
 	}
+	if ld.GetTagSwapManager() == nil {
+		t.Fatalf("expected non-nil TagSwapManager")
+	}
+	if ld.GetNotifier() == nil {
+		t.Fatalf("expected non-nil Notifier")
+	}
 }
 
 // testingLogger is a minimal placeholder to satisfy *slog.Logger type via nil; not used.
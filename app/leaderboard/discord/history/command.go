@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
 	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
@@ -13,9 +14,10 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
-// HistoryManager handles /history Discord commands.
+// HistoryManager handles /history and the "View tag history" context menu.
 type HistoryManager interface {
 	HandleHistoryCommand(ctx context.Context, i *discordgo.InteractionCreate)
+	HandleViewTagHistoryUserCommand(ctx context.Context, i *discordgo.InteractionCreate)
 	HandleTagHistoryResponse(ctx context.Context, payload *leaderboardevents.TagHistoryResponsePayloadV1)
 	HandleTagHistoryFailed(ctx context.Context, payload *leaderboardevents.TagHistoryFailedPayloadV1)
 	HandleTagGraphResponse(ctx context.Context, payload *leaderboardevents.TagGraphResponsePayloadV1)
@@ -95,3 +97,24 @@ func HistoryCommand() *discordgo.ApplicationCommand {
 		},
 	}
 }
+
+// CommandSpec returns the manifest entry for /history. It is read-only and
+// usable before setup completes, matching its handler registration.
+func CommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command:            HistoryCommand(),
+		RequiredPermission: interactions.NoPermissionRequired,
+	}
+}
+
+// UserCommandSpec returns the "View tag history" user context-menu command,
+// which shares the /history policy.
+func UserCommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name: "View tag history",
+			Type: discordgo.UserApplicationCommand,
+		},
+		RequiredPermission: interactions.NoPermissionRequired,
+	}
+}
@@ -15,7 +15,10 @@ import (
 	"github.com/google/uuid"
 )
 
-const correlationIDKey = "correlation_id"
+const (
+	correlationIDKey    = "correlation_id"
+	defaultHistoryLimit = 50
+)
 
 // HandleHistoryCommand dispatches /history subcommands.
 func (hm *historyManager) HandleHistoryCommand(ctx context.Context, i *discordgo.InteractionCreate) {
@@ -51,7 +54,7 @@ func (hm *historyManager) HandleHistoryCommand(ctx context.Context, i *discordgo
 // handleMemberHistory requests tag history for a specific member.
 func (hm *historyManager) handleMemberHistory(ctx context.Context, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) {
 	var userID string
-	limit := 50 // Default limit
+	limit := defaultHistoryLimit
 
 	for _, opt := range options {
 		if opt.Name == "user" {
@@ -70,6 +73,35 @@ func (hm *historyManager) handleMemberHistory(ctx context.Context, i *discordgo.
 		userID = i.Member.User.ID
 	}
 
+	hm.requestMemberHistory(ctx, i, userID, limit)
+}
+
+// HandleViewTagHistoryUserCommand handles the "View tag history" user
+// context-menu command by looking up the right-clicked member.
+func (hm *historyManager) HandleViewTagHistoryUserCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	if i.Member == nil || i.Member.User == nil {
+		hm.logger.WarnContext(ctx, "View tag history received without member context (DM?)")
+		return
+	}
+
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "history")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "user_command")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, i.Member.User.ID)
+
+	targetID := i.ApplicationCommandData().TargetID
+	if targetID == "" {
+		if err := hm.respondWithError(ctx, i, "Couldn't tell which member to look up."); err != nil {
+			hm.logger.ErrorContext(ctx, "Failed to respond with error", attr.Error(err))
+		}
+		return
+	}
+
+	hm.requestMemberHistory(ctx, i, targetID, defaultHistoryLimit)
+}
+
+// requestMemberHistory defers an ephemeral reply and requests tag history for
+// userID; HandleTagHistoryResponse fills in the reply.
+func (hm *historyManager) requestMemberHistory(ctx context.Context, i *discordgo.InteractionCreate, userID string, limit int) {
 	// Defer the response since this may take a moment
 	err := hm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
@@ -9,11 +9,18 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
-// RegisterHandlers registers the history command handlers.
+// RegisterHandlers registers the /history command and its user context-menu handlers.
 func RegisterHandlers(registry *interactions.Registry, manager HistoryManager) {
-	registry.RegisterHandler("history", func(ctx context.Context, i *discordgo.InteractionCreate) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling history command",
 			attr.String("interaction_id", i.ID))
 		manager.HandleHistoryCommand(ctx, i)
 	})
+
+	registry.RegisterCommandHandler(UserCommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling View tag history user command",
+			attr.String("interaction_id", i.ID),
+			attr.String("target_id", i.ApplicationCommandData().TargetID))
+		manager.HandleViewTagHistoryUserCommand(ctx, i)
+	})
 }
@@ -0,0 +1,63 @@
+package history
+
+import (
+	"context"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestHandleViewTagHistoryUserCommand_RequestsTargetHistory(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	fakeBus := &testutils.FakeEventBus{}
+	fakeHelpers := &testutils.FakeHelpers{}
+	store := testutils.NewFakeStorage[any]()
+
+	var requested *leaderboardevents.TagHistoryRequestedPayloadV1
+	fakeHelpers.CreateNewMessageFunc = func(payload any, topic string) (*message.Message, error) {
+		requested, _ = payload.(*leaderboardevents.TagHistoryRequestedPayloadV1)
+		return message.NewMessage("msg-1", nil), nil
+	}
+	published := false
+	fakeBus.PublishFunc = func(topic string, messages ...*message.Message) error {
+		published = topic == leaderboardevents.LeaderboardTagHistoryRequestedV1
+		return nil
+	}
+	var deferred bool
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		deferred = resp.Type == discordgo.InteractionResponseDeferredChannelMessageWithSource &&
+			resp.Data.Flags == discordgo.MessageFlagsEphemeral
+		return nil
+	}
+
+	hm := NewHistoryManager(fakeSession, fakeBus, testutils.NoOpLogger(), fakeHelpers, store, discordmetrics.NewNoop())
+
+	i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "interaction-1",
+		GuildID: "guild-1",
+		Type:    discordgo.InteractionApplicationCommand,
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "invoker"}},
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name:        "View tag history",
+			CommandType: discordgo.UserApplicationCommand,
+			TargetID:    "target-user",
+		},
+	}}
+
+	hm.HandleViewTagHistoryUserCommand(context.Background(), i)
+
+	if !deferred {
+		t.Fatal("expected an ephemeral deferred reply")
+	}
+	if requested == nil || requested.MemberID != "target-user" || requested.GuildID != "guild-1" || requested.Limit != defaultHistoryLimit {
+		t.Fatalf("unexpected history request %+v", requested)
+	}
+	if !published {
+		t.Fatal("expected tag history request to be published")
+	}
+}
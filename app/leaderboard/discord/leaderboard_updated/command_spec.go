@@ -0,0 +1,34 @@
+package leaderboardupdated
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /leaderboard command.
+func CommandSpec() interactions.CommandSpec {
+	minPage := 1.0
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "leaderboard",
+			Description: "View the leaderboard privately (Available to all players)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "page",
+					Description: "Page to open (defaults to the top)",
+					Required:    false,
+					MinValue:    &minPage,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "around",
+					Description: "Open the page containing this player",
+					Required:    false,
+				},
+			},
+		},
+		RequiredPermission: interactions.PlayerRequired,
+		RequiresSetup:      true,
+	}
+}
@@ -16,8 +16,15 @@ func (lum *leaderboardUpdateManager) resolveLeaderboardDisplayNames(
 		return leaderboard
 	}
 
-	guildID := lum.lookupGuildIDForChannel(channelID)
-	if guildID == "" {
+	return lum.resolveLeaderboardDisplayNamesForGuild(ctx, lum.lookupGuildIDForChannel(channelID), leaderboard)
+}
+
+func (lum *leaderboardUpdateManager) resolveLeaderboardDisplayNamesForGuild(
+	ctx context.Context,
+	guildID string,
+	leaderboard []LeaderboardEntry,
+) []LeaderboardEntry {
+	if guildID == "" || !leaderboardHasMissingDisplayNames(leaderboard) {
 		return leaderboard
 	}
 
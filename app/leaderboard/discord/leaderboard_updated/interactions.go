@@ -25,22 +25,28 @@ func (lum *leaderboardUpdateManager) HandleLeaderboardPagination(ctx context.Con
 
 	return lum.operationWrapper(ctx, "handle_leaderboard_pagination", func(ctx context.Context) (LeaderboardUpdateOperationResult, error) {
 		customIDParts := strings.Split(i.MessageComponentData().CustomID, "|")
-		if len(customIDParts) != 2 {
+		if len(customIDParts) != 2 && len(customIDParts) != 3 {
 			err := fmt.Errorf("invalid CustomID format: %s", i.MessageComponentData().CustomID)
 			lum.logger.ErrorContext(ctx, err.Error())
 			return LeaderboardUpdateOperationResult{Error: err}, nil
 		}
 
-		if _, err := strconv.Atoi(customIDParts[1]); err != nil {
+		page, err := strconv.Atoi(customIDParts[1])
+		if err != nil {
 			err := fmt.Errorf("error parsing page number: %w", err)
 			lum.logger.ErrorContext(ctx, err.Error())
 			return LeaderboardUpdateOperationResult{Error: err}, nil
 		}
 
-		// Leaderboard pagination has been replaced with a single-page description embed.
+		// Private /leaderboard views carry their view ID as a third segment.
+		if len(customIDParts) == 3 {
+			return lum.showLeaderboardViewPage(ctx, i, customIDParts[2], page)
+		}
+
+		// The channel leaderboard is a single-page description embed.
 		// Old embeds with pagination buttons may still exist; respond ephemerally.
 		lum.logger.InfoContext(ctx, "Leaderboard pagination button pressed on old embed — pagination no longer used")
-		err := lum.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		err = lum.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
 				Content: "ℹ️ The leaderboard has been updated and no longer uses pagination. The next leaderboard refresh will replace this embed.",
@@ -30,14 +30,22 @@ type LeaderboardEntry struct {
 // No pagination — entries beyond the character cap are silently truncated
 // with a note.
 func buildLeaderboardDescription(leaderboard []LeaderboardEntry) string {
-	if len(leaderboard) == 0 {
+	return buildLeaderboardRangeDescription(leaderboard, 0, len(leaderboard), "")
+}
+
+// buildLeaderboardRangeDescription formats leaderboard[start:end]. Medals are
+// based on overall position, so a later page still shows the last-place marker
+// on the right entry. Lines for highlightUserID are marked with an arrow.
+func buildLeaderboardRangeDescription(leaderboard []LeaderboardEntry, start, end int, highlightUserID string) string {
+	if len(leaderboard) == 0 || start >= end {
 		return "*No entries yet.*"
 	}
 
 	totalEntries := len(leaderboard)
 	var sb strings.Builder
 
-	for i, entry := range leaderboard {
+	for i := start; i < end; i++ {
+		entry := leaderboard[i]
 		position := i + 1
 		userLabel := formatLeaderboardUser(entry)
 
@@ -54,6 +62,9 @@ func buildLeaderboardDescription(leaderboard []LeaderboardEntry) string {
 		default:
 			emoji = "🏷️"
 		}
+		if highlightUserID != "" && string(entry.UserID) == highlightUserID {
+			emoji = "👉 " + emoji
+		}
 
 		var line string
 		if entry.TotalPoints > 0 {
@@ -170,8 +181,10 @@ func buildLeaderboardEmbed(leaderboard []LeaderboardEntry, _ int32) (*discordgo.
 		Description: desc,
 		Color:       0xFFD700, // Gold
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("Frolf Leaderboard • Updated: %s", time.Now().Format(time.RFC1123)),
+			Text: "Frolf Leaderboard • Updated",
 		},
+		// Discord shows the timestamp next to the footer in each reader's timezone.
+		Timestamp: time.Now().Format(time.RFC3339),
 	}
 
 	// No pagination buttons — the embed is a shared channel message and
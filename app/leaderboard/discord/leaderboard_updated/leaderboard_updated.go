@@ -26,6 +26,8 @@ import (
 type LeaderboardUpdateManager interface {
 	HandleLeaderboardPagination(ctx context.Context, i *discordgo.InteractionCreate) (LeaderboardUpdateOperationResult, error)
 	SendLeaderboardEmbed(ctx context.Context, channelID string, leaderboard []LeaderboardEntry, page int32) (LeaderboardUpdateOperationResult, error)
+	HandleLeaderboardCommand(ctx context.Context, i *discordgo.InteractionCreate) (LeaderboardUpdateOperationResult, error)
+	SendLeaderboardView(ctx context.Context, correlationID string, leaderboard []LeaderboardEntry) (bool, error)
 }
 
 type leaderboardUpdateManager struct {
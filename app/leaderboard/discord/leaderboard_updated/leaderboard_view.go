@@ -0,0 +1,255 @@
+package leaderboardupdated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	discordleaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/leaderboard"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+const (
+	leaderboardViewPageSize  = 10
+	leaderboardViewKeyPrefix = "leaderboard_view:"
+)
+
+// leaderboardViewRequest is stored under the correlation ID while the snapshot
+// for a /leaderboard command is being fetched from the backend.
+type leaderboardViewRequest struct {
+	Interaction  *discordgo.Interaction
+	Page         int
+	AroundUserID string
+}
+
+// leaderboardView is the snapshot behind a private /leaderboard message, kept so
+// its buttons can page without another backend round-trip.
+type leaderboardView struct {
+	Entries         []LeaderboardEntry
+	HighlightUserID string
+}
+
+func (v leaderboardView) indexOf(userID string) int {
+	for i, entry := range v.Entries {
+		if string(entry.UserID) == userID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (v leaderboardView) totalPages() int {
+	if len(v.Entries) == 0 {
+		return 1
+	}
+	return (len(v.Entries) + leaderboardViewPageSize - 1) / leaderboardViewPageSize
+}
+
+// HandleLeaderboardCommand defers an ephemeral reply and requests a fresh
+// snapshot; SendLeaderboardView fills in the reply when it arrives.
+func (lum *leaderboardUpdateManager) HandleLeaderboardCommand(ctx context.Context, i *discordgo.InteractionCreate) (LeaderboardUpdateOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "leaderboard")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "command")
+
+	return lum.operationWrapper(ctx, "handle_leaderboard_command", func(ctx context.Context) (LeaderboardUpdateOperationResult, error) {
+		req := leaderboardViewRequest{Interaction: i.Interaction, Page: 1}
+		for _, option := range i.ApplicationCommandData().Options {
+			switch option.Name {
+			case "page":
+				req.Page = int(option.IntValue())
+			case "around":
+				if user := option.UserValue(nil); user != nil {
+					req.AroundUserID = user.ID
+				}
+			}
+		}
+
+		err := lum.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Flags: discordgo.MessageFlagsEphemeral,
+			},
+		})
+		if err != nil {
+			return LeaderboardUpdateOperationResult{Error: err}, err
+		}
+
+		if lum.interactionStore == nil {
+			err := errors.New("interaction store is nil; cannot track leaderboard request")
+			lum.editLeaderboardViewError(ctx, i.Interaction)
+			return LeaderboardUpdateOperationResult{Error: err}, nil
+		}
+
+		correlationID := uuid.NewString()
+		if err := lum.interactionStore.Set(ctx, correlationID, req); err != nil {
+			lum.editLeaderboardViewError(ctx, i.Interaction)
+			return LeaderboardUpdateOperationResult{Error: fmt.Errorf("failed to store leaderboard request: %w", err)}, nil
+		}
+
+		payload := discordleaderboardevents.LeaderboardRetrieveRequestPayloadV1{GuildID: i.GuildID}
+		msg, err := lum.helper.CreateNewMessage(payload, discordleaderboardevents.LeaderboardRetrieveRequestV1)
+		if err != nil {
+			lum.interactionStore.Delete(ctx, correlationID)
+			lum.editLeaderboardViewError(ctx, i.Interaction)
+			return LeaderboardUpdateOperationResult{Error: fmt.Errorf("failed to create leaderboard request: %w", err)}, nil
+		}
+		if msg.Metadata == nil {
+			msg.Metadata = message.Metadata{}
+		}
+		msg.Metadata.Set("correlation_id", correlationID)
+		msg.Metadata.Set("guild_id", i.GuildID)
+
+		if err := lum.publisher.Publish(discordleaderboardevents.LeaderboardRetrieveRequestV1, msg); err != nil {
+			lum.interactionStore.Delete(ctx, correlationID)
+			lum.editLeaderboardViewError(ctx, i.Interaction)
+			return LeaderboardUpdateOperationResult{Error: fmt.Errorf("failed to publish leaderboard request: %w", err)}, nil
+		}
+
+		return LeaderboardUpdateOperationResult{Success: "leaderboard requested"}, nil
+	})
+}
+
+// SendLeaderboardView answers a pending /leaderboard command with the snapshot.
+// It reports false when correlationID does not belong to a /leaderboard request,
+// e.g. for snapshots triggered by leaderboard updates.
+func (lum *leaderboardUpdateManager) SendLeaderboardView(ctx context.Context, correlationID string, leaderboard []LeaderboardEntry) (bool, error) {
+	if correlationID == "" || lum.interactionStore == nil {
+		return false, nil
+	}
+
+	stored, err := lum.interactionStore.Get(ctx, correlationID)
+	if err != nil {
+		return false, nil
+	}
+	req, ok := stored.(leaderboardViewRequest)
+	if !ok || req.Interaction == nil {
+		return false, nil
+	}
+	lum.interactionStore.Delete(ctx, correlationID)
+
+	_, err = lum.operationWrapper(ctx, "send_leaderboard_view", func(ctx context.Context) (LeaderboardUpdateOperationResult, error) {
+		view := leaderboardView{
+			Entries:         lum.resolveLeaderboardDisplayNamesForGuild(ctx, req.Interaction.GuildID, leaderboard),
+			HighlightUserID: req.AroundUserID,
+		}
+
+		page := req.Page
+		var note string
+		if req.AroundUserID != "" {
+			if idx := view.indexOf(req.AroundUserID); idx >= 0 {
+				page = idx/leaderboardViewPageSize + 1
+			} else {
+				note = fmt.Sprintf("<@%s> isn't on the leaderboard yet.", req.AroundUserID)
+				view.HighlightUserID = ""
+			}
+		}
+
+		viewID := correlationID
+		if err := lum.interactionStore.Set(ctx, leaderboardViewKeyPrefix+viewID, view); err != nil {
+			lum.logger.WarnContext(ctx, "Failed to store leaderboard view; sending without page buttons", attr.Error(err))
+			viewID = ""
+		}
+
+		embed, components := buildLeaderboardViewEmbed(view, page, viewID)
+		edit := &discordgo.WebhookEdit{
+			Embeds:     &[]*discordgo.MessageEmbed{embed},
+			Components: &components,
+		}
+		if note != "" {
+			edit.Content = &note
+		}
+
+		if _, err := lum.session.InteractionResponseEdit(req.Interaction, edit); err != nil {
+			return LeaderboardUpdateOperationResult{Error: err}, fmt.Errorf("failed to send leaderboard view: %w", err)
+		}
+		return LeaderboardUpdateOperationResult{Success: "leaderboard view sent"}, nil
+	})
+	return true, err
+}
+
+// showLeaderboardViewPage re-renders a private /leaderboard message at page.
+func (lum *leaderboardUpdateManager) showLeaderboardViewPage(ctx context.Context, i *discordgo.InteractionCreate, viewID string, page int) (LeaderboardUpdateOperationResult, error) {
+	var (
+		view  leaderboardView
+		found bool
+	)
+	if lum.interactionStore != nil {
+		if stored, err := lum.interactionStore.Get(ctx, leaderboardViewKeyPrefix+viewID); err == nil {
+			view, found = stored.(leaderboardView)
+		}
+	}
+	if !found {
+		err := lum.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: "This leaderboard view has expired. Run `/leaderboard` again.",
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+		if err != nil {
+			lum.logger.ErrorContext(ctx, "Failed to respond to expired leaderboard view", attr.Error(err))
+		}
+		return LeaderboardUpdateOperationResult{Failure: "leaderboard view expired"}, nil
+	}
+
+	embed, components := buildLeaderboardViewEmbed(view, page, viewID)
+	err := lum.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+	if err != nil {
+		return LeaderboardUpdateOperationResult{Error: err}, fmt.Errorf("failed to update leaderboard view: %w", err)
+	}
+	return LeaderboardUpdateOperationResult{Success: "leaderboard page updated"}, nil
+}
+
+func (lum *leaderboardUpdateManager) editLeaderboardViewError(ctx context.Context, interaction *discordgo.Interaction) {
+	content := "Unable to load the leaderboard right now. Please try again."
+	if _, err := lum.session.InteractionResponseEdit(interaction, &discordgo.WebhookEdit{Content: &content}); err != nil {
+		lum.logger.ErrorContext(ctx, "Failed to report leaderboard request failure", attr.Error(err))
+	}
+}
+
+// buildLeaderboardViewEmbed renders one page of a private leaderboard view.
+// Page buttons are omitted when viewID is empty or everything fits on one page.
+func buildLeaderboardViewEmbed(view leaderboardView, page int, viewID string) (*discordgo.MessageEmbed, []discordgo.MessageComponent) {
+	totalPages := view.totalPages()
+	page = max(1, min(page, totalPages))
+
+	start := (page - 1) * leaderboardViewPageSize
+	end := min(start+leaderboardViewPageSize, len(view.Entries))
+
+	embed, _ := buildLeaderboardEmbed(nil, int32(page))
+	embed.Description = buildLeaderboardRangeDescription(view.Entries, start, end, view.HighlightUserID)
+	embed.Footer = &discordgo.MessageEmbedFooter{
+		Text: fmt.Sprintf("Page %d of %d • %d players", page, totalPages, len(view.Entries)),
+	}
+
+	if viewID == "" || totalPages == 1 {
+		return embed, []discordgo.MessageComponent{}
+	}
+
+	return embed, []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "◀ Prev",
+				Style:    discordgo.SecondaryButton,
+				CustomID: fmt.Sprintf("leaderboard_prev|%d|%s", page-1, viewID),
+				Disabled: page <= 1,
+			},
+			discordgo.Button{
+				Label:    "Next ▶",
+				Style:    discordgo.SecondaryButton,
+				CustomID: fmt.Sprintf("leaderboard_next|%d|%s", page+1, viewID),
+				Disabled: page >= totalPages,
+			},
+		}},
+	}
+}
@@ -0,0 +1,196 @@
+package leaderboardupdated
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/bwmarrin/discordgo"
+)
+
+func namedTestLeaderboard(count int) []LeaderboardEntry {
+	entries := createTestLeaderboard(count)
+	for i := range entries {
+		entries[i].DisplayName = fmt.Sprintf("Player %d", i+1)
+	}
+	return entries
+}
+
+func TestSendLeaderboardView_IgnoresUnknownCorrelation(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	fakeSession.InteractionResponseEditFunc = func(*discordgo.Interaction, *discordgo.WebhookEdit, ...discordgo.RequestOption) (*discordgo.Message, error) {
+		t.Fatal("no reply should be edited for an unknown correlation")
+		return nil, nil
+	}
+
+	lum := &leaderboardUpdateManager{
+		session:          fakeSession,
+		logger:           slog.Default(),
+		operationWrapper: testOperationWrapper,
+		interactionStore: testutils.NewFakeStorage[any](),
+	}
+
+	handled, err := lum.SendLeaderboardView(context.Background(), "from-update", namedTestLeaderboard(3))
+	if err != nil {
+		t.Fatalf("SendLeaderboardView() error = %v", err)
+	}
+	if handled {
+		t.Fatal("expected unknown correlation to be left for the channel refresh")
+	}
+}
+
+func TestSendLeaderboardView_OpensPageAroundUser(t *testing.T) {
+	store := testutils.NewFakeStorage[any]()
+	ctx := context.Background()
+	interaction := &discordgo.Interaction{ID: "cmd", GuildID: "guild-1"}
+	if err := store.Set(ctx, "corr-1", leaderboardViewRequest{Interaction: interaction, Page: 1, AroundUserID: "user23"}); err != nil {
+		t.Fatalf("seed store: %v", err)
+	}
+
+	var edit *discordgo.WebhookEdit
+	fakeSession := discord.NewFakeSession()
+	fakeSession.InteractionResponseEditFunc = func(_ *discordgo.Interaction, newresp *discordgo.WebhookEdit, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		edit = newresp
+		return &discordgo.Message{}, nil
+	}
+
+	lum := &leaderboardUpdateManager{
+		session:          fakeSession,
+		logger:           slog.Default(),
+		operationWrapper: testOperationWrapper,
+		interactionStore: store,
+	}
+
+	handled, err := lum.SendLeaderboardView(ctx, "corr-1", namedTestLeaderboard(25))
+	if err != nil {
+		t.Fatalf("SendLeaderboardView() error = %v", err)
+	}
+	if !handled {
+		t.Fatal("expected pending /leaderboard request to be handled")
+	}
+	if edit == nil || edit.Embeds == nil || len(*edit.Embeds) != 1 {
+		t.Fatalf("expected reply to be edited with one embed, got %+v", edit)
+	}
+
+	embed := (*edit.Embeds)[0]
+	if embed.Footer == nil || !strings.HasPrefix(embed.Footer.Text, "Page 3 of 3") {
+		t.Fatalf("expected page 3 of 3, got footer %+v", embed.Footer)
+	}
+	if !strings.Contains(embed.Description, "👉") || !strings.Contains(embed.Description, "user23") {
+		t.Errorf("expected highlighted entry for user23, got %q", embed.Description)
+	}
+	if _, err := store.Get(ctx, "corr-1"); err == nil {
+		t.Error("expected pending request to be cleared")
+	}
+	if _, err := store.Get(ctx, leaderboardViewKeyPrefix+"corr-1"); err != nil {
+		t.Errorf("expected view to be stored for paging: %v", err)
+	}
+}
+
+func TestSendLeaderboardView_NotesMissingAroundUser(t *testing.T) {
+	store := testutils.NewFakeStorage[any]()
+	ctx := context.Background()
+	interaction := &discordgo.Interaction{ID: "cmd", GuildID: "guild-1"}
+	_ = store.Set(ctx, "corr-1", leaderboardViewRequest{Interaction: interaction, Page: 2, AroundUserID: "stranger"})
+
+	var edit *discordgo.WebhookEdit
+	fakeSession := discord.NewFakeSession()
+	fakeSession.InteractionResponseEditFunc = func(_ *discordgo.Interaction, newresp *discordgo.WebhookEdit, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		edit = newresp
+		return &discordgo.Message{}, nil
+	}
+
+	lum := &leaderboardUpdateManager{
+		session:          fakeSession,
+		logger:           slog.Default(),
+		operationWrapper: testOperationWrapper,
+		interactionStore: store,
+	}
+
+	if _, err := lum.SendLeaderboardView(ctx, "corr-1", namedTestLeaderboard(15)); err != nil {
+		t.Fatalf("SendLeaderboardView() error = %v", err)
+	}
+	if edit == nil || edit.Content == nil || !strings.Contains(*edit.Content, "<@stranger>") {
+		t.Fatalf("expected note about missing user, got %+v", edit)
+	}
+	if footer := (*edit.Embeds)[0].Footer.Text; !strings.HasPrefix(footer, "Page 2 of 2") {
+		t.Errorf("expected requested page to be kept, got %q", footer)
+	}
+}
+
+func TestHandleLeaderboardPagination_PrivateView(t *testing.T) {
+	store := testutils.NewFakeStorage[any]()
+	ctx := context.Background()
+	_ = store.Set(ctx, leaderboardViewKeyPrefix+"view-1", leaderboardView{Entries: namedTestLeaderboard(25)})
+
+	var resp *discordgo.InteractionResponse
+	fakeSession := discord.NewFakeSession()
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, r *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		resp = r
+		return nil
+	}
+
+	lum := &leaderboardUpdateManager{
+		session:          fakeSession,
+		logger:           slog.Default(),
+		operationWrapper: testOperationWrapper,
+		interactionStore: store,
+	}
+
+	i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type: discordgo.InteractionMessageComponent,
+		Data: discordgo.MessageComponentInteractionData{CustomID: "leaderboard_next|2|view-1"},
+	}}
+
+	if _, err := lum.HandleLeaderboardPagination(ctx, i); err != nil {
+		t.Fatalf("HandleLeaderboardPagination() error = %v", err)
+	}
+	if resp == nil || resp.Type != discordgo.InteractionResponseUpdateMessage {
+		t.Fatalf("expected message update, got %+v", resp)
+	}
+	if footer := resp.Data.Embeds[0].Footer.Text; !strings.HasPrefix(footer, "Page 2 of 3") {
+		t.Errorf("expected page 2 of 3, got %q", footer)
+	}
+
+	row := resp.Data.Components[0].(discordgo.ActionsRow)
+	prev := row.Components[0].(discordgo.Button)
+	if prev.CustomID != "leaderboard_prev|1|view-1" || prev.Disabled {
+		t.Errorf("unexpected prev button %+v", prev)
+	}
+}
+
+func TestHandleLeaderboardPagination_ExpiredPrivateView(t *testing.T) {
+	var resp *discordgo.InteractionResponse
+	fakeSession := discord.NewFakeSession()
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, r *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		resp = r
+		return nil
+	}
+
+	lum := &leaderboardUpdateManager{
+		session:          fakeSession,
+		logger:           slog.Default(),
+		operationWrapper: testOperationWrapper,
+		interactionStore: testutils.NewFakeStorage[any](),
+	}
+
+	i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type: discordgo.InteractionMessageComponent,
+		Data: discordgo.MessageComponentInteractionData{CustomID: "leaderboard_prev|1|gone"},
+	}}
+
+	result, err := lum.HandleLeaderboardPagination(context.Background(), i)
+	if err != nil {
+		t.Fatalf("HandleLeaderboardPagination() error = %v", err)
+	}
+	if result.Failure == nil {
+		t.Error("expected failure result for expired view")
+	}
+	if resp == nil || resp.Data.Flags != discordgo.MessageFlagsEphemeral || !strings.Contains(resp.Data.Content, "expired") {
+		t.Fatalf("expected ephemeral expiry notice, got %+v", resp)
+	}
+}
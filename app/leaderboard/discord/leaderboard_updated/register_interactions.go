@@ -10,6 +10,22 @@ import (
 )
 
 func RegisterHandlers(registry *interactions.Registry, manager LeaderboardUpdateManager) {
+	// Private, paginated leaderboard view
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling /leaderboard command",
+			attr.String("guild_id", i.GuildID),
+			attr.String("user", i.Member.User.Username))
+
+		result, err := manager.HandleLeaderboardCommand(ctx, i)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to handle leaderboard command", attr.Error(err))
+			return
+		}
+		if result.Error != nil {
+			slog.ErrorContext(ctx, "Leaderboard command returned error", attr.Error(result.Error))
+		}
+	})
+
 	// Button handler for leaderboard pagination
 	registry.RegisterHandler("leaderboard_prev|", func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling leaderboard previous button press",
@@ -0,0 +1,106 @@
+package season
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/bwmarrin/discordgo"
+)
+
+// SeasonListRequestV1 is the request-reply subject of
+// config.BackendFeatureSeasonLists.
+const SeasonListRequestV1 = "leaderboard.season.list.request.v1"
+
+const seasonListTimeout = 2 * time.Second
+
+// SeasonListRequestPayloadV1 asks for a guild's seasons, newest first.
+type SeasonListRequestPayloadV1 struct {
+	GuildID string `json:"guild_id"`
+}
+
+// SeasonListResponsePayloadV1 is the reply to SeasonListRequestV1.
+type SeasonListResponsePayloadV1 struct {
+	Seasons []SeasonSummaryV1 `json:"seasons"`
+}
+
+// SeasonSummaryV1 is the subset of a season needed to label it.
+type SeasonSummaryV1 struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	IsActive  bool       `json:"is_active"`
+	StartDate time.Time  `json:"start_date"`
+	EndDate   *time.Time `json:"end_date,omitempty"`
+}
+
+// AutocompleteSeasonID offers the guild's seasons for the season_id option,
+// labelled by name and dates, or nothing while season lists are held back.
+func (sm *seasonManager) AutocompleteSeasonID(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	if sm.listSeasons == nil {
+		return nil, nil
+	}
+	response, err := sm.listSeasons(ctx, i.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, nil
+	}
+	return seasonIDChoices(response.Seasons, focused.StringValue()), nil
+}
+
+func (sm *seasonManager) requestSeasonList(ctx context.Context, guildID string) (*SeasonListResponsePayloadV1, error) {
+	if guildID == "" {
+		return nil, fmt.Errorf("guild id is required")
+	}
+
+	return messagecreator.NATSRequest[SeasonListRequestPayloadV1, SeasonListResponsePayloadV1](
+		ctx,
+		sm.publisher,
+		SeasonListRequestV1+"."+guildID,
+		SeasonListRequestPayloadV1{GuildID: guildID},
+		seasonListTimeout,
+	)
+}
+
+func seasonIDChoices(seasons []SeasonSummaryV1, query string) []*discordgo.ApplicationCommandOptionChoice {
+	query = strings.ToLower(strings.TrimSpace(query))
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(seasons))
+	for _, season := range seasons {
+		if season.ID == "" {
+			continue
+		}
+		label := seasonLabel(season)
+		if query != "" && !strings.Contains(strings.ToLower(label), query) && !strings.HasPrefix(strings.ToLower(season.ID), query) {
+			continue
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  interactions.TruncateChoiceName(label),
+			Value: season.ID,
+		})
+		if len(choices) == interactions.MaxAutocompleteChoices {
+			break
+		}
+	}
+	return choices
+}
+
+func seasonLabel(season SeasonSummaryV1) string {
+	name := strings.TrimSpace(season.Name)
+	if name == "" {
+		name = "Unnamed season"
+	}
+
+	const dateLayout = "Jan 2, 2006"
+	switch {
+	case season.IsActive:
+		return fmt.Sprintf("%s · current, since %s", name, season.StartDate.Format(dateLayout))
+	case season.EndDate != nil:
+		return fmt.Sprintf("%s · %s – %s", name, season.StartDate.Format(dateLayout), season.EndDate.Format(dateLayout))
+	default:
+		return fmt.Sprintf("%s · started %s", name, season.StartDate.Format(dateLayout))
+	}
+}
@@ -0,0 +1,87 @@
+package season
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestSeasonManager_AutocompleteSeasonID(t *testing.T) {
+	started := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	ended := time.Date(2026, time.March, 30, 0, 0, 0, 0, time.UTC)
+
+	var gotGuildID string
+	sm := &seasonManager{
+		listSeasons: func(ctx context.Context, guildID string) (*SeasonListResponsePayloadV1, error) {
+			gotGuildID = guildID
+			return &SeasonListResponsePayloadV1{Seasons: []SeasonSummaryV1{
+				{ID: "season-2", Name: "Spring League", IsActive: true, StartDate: ended},
+				{ID: "season-1", Name: "Winter League", StartDate: started, EndDate: &ended},
+			}}, nil
+		},
+	}
+
+	i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{GuildID: "guild-1"}}
+
+	choices, err := sm.AutocompleteSeasonID(context.Background(), i, &discordgo.ApplicationCommandInteractionDataOption{
+		Name:  "season_id",
+		Type:  discordgo.ApplicationCommandOptionString,
+		Value: "winter",
+	})
+	if err != nil {
+		t.Fatalf("AutocompleteSeasonID() error = %v", err)
+	}
+	if gotGuildID != "guild-1" {
+		t.Errorf("expected seasons for guild-1, got %q", gotGuildID)
+	}
+	if len(choices) != 1 {
+		t.Fatalf("expected 1 matching choice, got %d", len(choices))
+	}
+	if want := "Winter League · Jan 5, 2026 – Mar 30, 2026"; choices[0].Name != want {
+		t.Errorf("label = %q, want %q", choices[0].Name, want)
+	}
+	if choices[0].Value != "season-1" {
+		t.Errorf("value = %v, want season-1", choices[0].Value)
+	}
+}
+
+func TestSeasonManager_AutocompleteSeasonID_LookupError(t *testing.T) {
+	sm := &seasonManager{
+		listSeasons: func(ctx context.Context, guildID string) (*SeasonListResponsePayloadV1, error) {
+			return nil, errors.New("timeout")
+		},
+	}
+
+	i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{GuildID: "guild-1"}}
+	if _, err := sm.AutocompleteSeasonID(context.Background(), i, &discordgo.ApplicationCommandInteractionDataOption{
+		Type:  discordgo.ApplicationCommandOptionString,
+		Value: "",
+	}); err == nil {
+		t.Fatal("expected lookup error to be returned")
+	}
+}
+
+func TestNewSeasonManager_HoldsSeasonListsUntilReleased(t *testing.T) {
+	held := NewSeasonManager(nil, nil, nil, nil, &config.Config{}, nil, nil, nil, nil, nil).(*seasonManager)
+	if held.listSeasons != nil {
+		t.Fatal("expected season lists to be held back by default")
+	}
+	choices, err := held.AutocompleteSeasonID(context.Background(), &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{GuildID: "guild-1"}}, &discordgo.ApplicationCommandInteractionDataOption{
+		Type:  discordgo.ApplicationCommandOptionString,
+		Value: "",
+	})
+	if err != nil || len(choices) != 0 {
+		t.Fatalf("expected no choices while held, got %v, %v", choices, err)
+	}
+
+	released := NewSeasonManager(nil, nil, nil, nil, &config.Config{
+		BackendFeatures: []config.BackendFeature{config.BackendFeatureSeasonLists},
+	}, nil, nil, nil, nil, nil).(*seasonManager)
+	if released.listSeasons == nil {
+		t.Fatal("expected season lists once released")
+	}
+}
@@ -25,6 +25,7 @@ type SeasonManager interface {
 	HandleSeasonStandingsFailed(ctx context.Context, payload *leaderboardevents.AdminFailedPayloadV1)
 	HandleSeasonEnded(ctx context.Context, payload *leaderboardevents.EndSeasonSuccessPayloadV1)
 	HandleSeasonEndFailed(ctx context.Context, payload *leaderboardevents.AdminFailedPayloadV1)
+	AutocompleteSeasonID(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error)
 }
 
 // seasonManager implements SeasonManager.
@@ -39,6 +40,7 @@ type seasonManager struct {
 	guildConfigCache    storage.ISInterface[storage.GuildConfig]
 	tracer              trace.Tracer
 	metrics             discordmetrics.DiscordMetrics
+	listSeasons         func(ctx context.Context, guildID string) (*SeasonListResponsePayloadV1, error)
 }
 
 // NewSeasonManager creates a new SeasonManager.
@@ -47,23 +49,27 @@ func NewSeasonManager(
 	publisher eventbus.EventBus,
 	logger *slog.Logger,
 	helper utils.Helpers,
-	config *config.Config,
+	cfg *config.Config,
 	guildConfigResolver guildconfig.GuildConfigResolver,
 	interactionStore storage.ISInterface[any],
 	guildConfigCache storage.ISInterface[storage.GuildConfig],
 	tracer trace.Tracer,
 	metrics discordmetrics.DiscordMetrics,
 ) SeasonManager {
-	return &seasonManager{
+	sm := &seasonManager{
 		session:             session,
 		publisher:           publisher,
 		logger:              logger,
 		helper:              helper,
-		config:              config,
+		config:              cfg,
 		guildConfigResolver: guildConfigResolver,
 		interactionStore:    interactionStore,
 		guildConfigCache:    guildConfigCache,
 		tracer:              tracer,
 		metrics:             metrics,
 	}
+	if cfg.BackendFeatureEnabled(config.BackendFeatureSeasonLists) {
+		sm.listSeasons = sm.requestSeasonList
+	}
+	return sm
 }
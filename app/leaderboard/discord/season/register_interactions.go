@@ -9,12 +9,13 @@ import (
 	"github.com/bwmarrin/discordgo"
 )
 
-// RegisterHandlers registers the season command handlers.
+// RegisterHandlers registers the season command and season ID autocomplete handlers.
 func RegisterHandlers(registry *interactions.Registry, manager SeasonManager) {
-	registry.RegisterMutatingHandler("season", func(ctx context.Context, i *discordgo.InteractionCreate) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling season command",
 			attr.String("interaction_id", i.ID),
 			attr.String("user", i.Member.User.Username))
 		manager.HandleSeasonCommand(ctx, i)
-	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.AdminRequired, RequiresSetup: true})
+	})
+	registry.RegisterAutocompleteHandler(CommandSpec().Name(), "season_id", manager.AutocompleteSeasonID)
 }
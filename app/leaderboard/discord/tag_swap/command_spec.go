@@ -0,0 +1,27 @@
+package tagswap
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /tagswap command.
+func CommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "tagswap",
+			Description: "Ask another player to swap leaderboard tags with you (Available to all players)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The player you want to swap tags with",
+					Required:    true,
+				},
+			},
+		},
+		RequiredPermission: interactions.PlayerRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+	}
+}
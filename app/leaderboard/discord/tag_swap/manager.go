@@ -0,0 +1,447 @@
+package tagswap
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	discordleaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/leaderboard"
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/Black-And-White-Club/frolf-bot-shared/utils"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	tagSwapAcceptPrefix  = "tagswap_accept|"
+	tagSwapDeclinePrefix = "tagswap_decline|"
+
+	promptBucket = "discord-tag-swaps"
+	// promptRetention is how long an accepted swap waits for the backend
+	// result after its prompt's window closed.
+	promptRetention     = time.Hour
+	openPromptKeyPrefix = "open."
+)
+
+// TagSwapManager drives the /tagswap prompt: the requestor asks, the target
+// accepts or declines, and the backend result is reported to both players.
+type TagSwapManager interface {
+	HandleTagSwapCommand(ctx context.Context, i *discordgo.InteractionCreate) error
+	HandleAcceptButton(ctx context.Context, i *discordgo.InteractionCreate) error
+	HandleDeclineButton(ctx context.Context, i *discordgo.InteractionCreate) error
+	HandleTagSwapped(ctx context.Context, payload *leaderboardevents.TagSwapProcessedPayloadV1) error
+	HandleTagSwapFailed(ctx context.Context, payload *leaderboardevents.TagSwapFailedPayloadV1) error
+	// RunExpiry closes prompts whose window has passed, every interval until
+	// ctx is done.
+	RunExpiry(ctx context.Context, every time.Duration)
+}
+
+// swapPrompt locates a posted /tagswap prompt. It is kept under its open
+// prompt key until the target responds or it expires, and under its pending
+// swap key while an accepted swap waits for the backend result.
+type swapPrompt struct {
+	ChannelID string         `json:"channel_id"`
+	MessageID string         `json:"message_id"`
+	Request   tagSwapRequest `json:"request"`
+}
+
+// PromptStore keeps tag swap prompts where a restarted or standby worker can
+// still expire them and report their results.
+type PromptStore interface {
+	storage.ISInterface[swapPrompt]
+	Keys(ctx context.Context) ([]string, error)
+}
+
+// NewPromptStore opens the JetStream bucket tag swap prompts are kept in.
+func NewPromptStore(ctx context.Context, js jetstream.JetStream, cfg *config.Config) (PromptStore, error) {
+	timeout := (&config.DiscordConfig{}).TagSwapTimeout()
+	if cfg != nil {
+		timeout = cfg.Discord.TagSwapTimeout()
+	}
+	store, err := storage.NewKVStore[swapPrompt](ctx, js, promptBucket, timeout+promptRetention)
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+type tagSwapManager struct {
+	session  discord.Session
+	eventBus eventbus.EventBus
+	logger   *slog.Logger
+	helper   utils.Helpers
+	config   *config.Config
+	prompts  PromptStore
+	now      func() time.Time
+}
+
+func NewTagSwapManager(
+	session discord.Session,
+	eventBus eventbus.EventBus,
+	logger *slog.Logger,
+	helper utils.Helpers,
+	config *config.Config,
+	prompts PromptStore,
+) TagSwapManager {
+	return &tagSwapManager{
+		session:  session,
+		eventBus: eventBus,
+		logger:   logger,
+		helper:   helper,
+		config:   config,
+		prompts:  prompts,
+		now:      time.Now,
+	}
+}
+
+func (m *tagSwapManager) HandleTagSwapCommand(ctx context.Context, i *discordgo.InteractionCreate) error {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "tagswap")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "application_command")
+
+	requestorID, err := interactionUserID(i)
+	if err != nil {
+		return err
+	}
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, requestorID)
+
+	data := i.ApplicationCommandData()
+	target := commandUserOption(data.Options, "user")
+	if target != nil && data.Resolved != nil {
+		if resolved, ok := data.Resolved.Users[target.ID]; ok && resolved != nil {
+			target = resolved
+		}
+	}
+	switch {
+	case target == nil || target.ID == "":
+		return m.respondEphemeral(i, "Choose a player to swap tags with.")
+	case target.ID == requestorID:
+		return m.respondEphemeral(i, "You can't swap tags with yourself.")
+	case target.Bot:
+		return m.respondEphemeral(i, "Bots don't hold leaderboard tags.")
+	}
+
+	timeout := m.timeout()
+	req := tagSwapRequest{
+		RequestorID: requestorID,
+		TargetID:    target.ID,
+		ExpiresAt:   m.now().Add(timeout).UTC(),
+	}
+
+	prompt, err := m.session.ChannelMessageSendComplex(i.ChannelID, &discordgo.MessageSend{
+		Content:         promptContent(req),
+		Components:      buildTagSwapComponents(req),
+		AllowedMentions: &discordgo.MessageAllowedMentions{Users: []string{req.TargetID}},
+	})
+	if err != nil {
+		_ = m.respondEphemeral(i, "Unable to post the tag swap request right now.")
+		return fmt.Errorf("failed to send tag swap prompt: %w", err)
+	}
+	if prompt != nil && m.prompts != nil {
+		open := swapPrompt{ChannelID: prompt.ChannelID, MessageID: prompt.ID, Request: req}
+		if err := m.prompts.Set(ctx, openPromptKey(prompt.ID), open); err != nil {
+			m.logger.WarnContext(ctx, "Failed to store tag swap prompt; it will not be expired", attr.Error(err))
+		}
+	}
+
+	m.logger.InfoContext(ctx, "Posted tag swap prompt",
+		attr.String("guild_id", i.GuildID),
+		attr.String("requestor_id", req.RequestorID),
+		attr.String("target_id", req.TargetID))
+
+	return m.respondEphemeral(i, fmt.Sprintf("Tag swap request sent to <@%s>.", req.TargetID))
+}
+
+func (m *tagSwapManager) HandleAcceptButton(ctx context.Context, i *discordgo.InteractionCreate) error {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "tagswap_accept")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+	req, ok, err := m.authorizeResponse(i, tagSwapAcceptPrefix)
+	if err != nil || !ok {
+		return err
+	}
+
+	channelID, messageID := promptLocation(i)
+	m.closePrompt(ctx, messageID)
+	if m.now().After(req.ExpiresAt) {
+		return m.updatePrompt(i, expiredContent(req))
+	}
+
+	if err := m.updatePrompt(i, acceptedContent(req)); err != nil {
+		return err
+	}
+
+	key := pendingSwapKey(i.GuildID, req.RequestorID, req.TargetID)
+	if m.prompts != nil {
+		if err := m.prompts.Set(ctx, key, swapPrompt{ChannelID: channelID, MessageID: messageID, Request: req}); err != nil {
+			m.logger.WarnContext(ctx, "Failed to store pending tag swap; result will not be reported", attr.Error(err))
+		}
+	}
+
+	if err := m.publishSwapRequest(ctx, i.GuildID, req, channelID, messageID); err != nil {
+		if m.prompts != nil {
+			m.prompts.Delete(ctx, key)
+		}
+		if editErr := m.editPrompt(channelID, messageID, swapFailedContent(req.RequestorID, req.TargetID, "please try again")); editErr != nil {
+			m.logger.WarnContext(ctx, "Failed to update tag swap prompt", attr.Error(editErr))
+		}
+		return err
+	}
+
+	m.logger.InfoContext(ctx, "Published tag swap request",
+		attr.String("guild_id", i.GuildID),
+		attr.String("requestor_id", req.RequestorID),
+		attr.String("target_id", req.TargetID))
+	return nil
+}
+
+func (m *tagSwapManager) HandleDeclineButton(ctx context.Context, i *discordgo.InteractionCreate) error {
+	req, ok, err := m.authorizeResponse(i, tagSwapDeclinePrefix)
+	if err != nil || !ok {
+		return err
+	}
+
+	_, messageID := promptLocation(i)
+	m.closePrompt(ctx, messageID)
+	if m.now().After(req.ExpiresAt) {
+		return m.updatePrompt(i, expiredContent(req))
+	}
+	return m.updatePrompt(i, declinedContent(req))
+}
+
+func (m *tagSwapManager) HandleTagSwapped(ctx context.Context, payload *leaderboardevents.TagSwapProcessedPayloadV1) error {
+	if payload == nil {
+		return nil
+	}
+	requestorID, targetID := string(payload.RequestorID), string(payload.TargetID)
+	return m.reportResult(ctx, string(payload.GuildID), requestorID, targetID, swappedContent(requestorID, targetID))
+}
+
+func (m *tagSwapManager) HandleTagSwapFailed(ctx context.Context, payload *leaderboardevents.TagSwapFailedPayloadV1) error {
+	if payload == nil {
+		return nil
+	}
+	requestorID, targetID := string(payload.RequestorID), string(payload.TargetID)
+	return m.reportResult(ctx, string(payload.GuildID), requestorID, targetID, swapFailedContent(requestorID, targetID, payload.Reason))
+}
+
+// authorizeResponse parses the button and rejects presses from anyone but the target.
+func (m *tagSwapManager) authorizeResponse(i *discordgo.InteractionCreate, prefix string) (tagSwapRequest, bool, error) {
+	req, err := parseTagSwapCustomID(i.MessageComponentData().CustomID, prefix)
+	if err != nil {
+		return tagSwapRequest{}, false, err
+	}
+
+	userID, err := interactionUserID(i)
+	if err != nil {
+		return tagSwapRequest{}, false, err
+	}
+	if userID != req.TargetID {
+		return req, false, m.respondEphemeral(i, fmt.Sprintf("Only <@%s> can respond to this tag swap.", req.TargetID))
+	}
+	return req, true, nil
+}
+
+func (m *tagSwapManager) publishSwapRequest(ctx context.Context, guildID string, req tagSwapRequest, channelID, messageID string) error {
+	payload := discordleaderboardevents.LeaderboardTagSwapRequestPayloadV1{
+		GuildID:     guildID,
+		User1ID:     sharedtypes.DiscordID(req.RequestorID),
+		User2ID:     sharedtypes.DiscordID(req.TargetID),
+		RequestorID: sharedtypes.DiscordID(req.RequestorID),
+		ChannelID:   channelID,
+		MessageID:   messageID,
+	}
+
+	msg, err := m.helper.CreateNewMessage(payload, discordleaderboardevents.LeaderboardTagSwapRequestV1)
+	if err != nil {
+		return fmt.Errorf("failed to create tag swap request message: %w", err)
+	}
+	if msg.Metadata == nil {
+		msg.Metadata = message.Metadata{}
+	}
+	msg.Metadata.Set("guild_id", guildID)
+	msg.Metadata.Set("correlation_id", uuid.NewString())
+
+	if err := m.eventBus.Publish(discordleaderboardevents.LeaderboardTagSwapRequestV1, msg); err != nil {
+		return fmt.Errorf("failed to publish tag swap request: %w", err)
+	}
+	return nil
+}
+
+// reportResult closes out an accepted swap prompted from Discord. Swaps that
+// did not originate from /tagswap have no pending prompt and are ignored.
+func (m *tagSwapManager) reportResult(ctx context.Context, guildID, requestorID, targetID, content string) error {
+	if m.prompts == nil {
+		return nil
+	}
+
+	key := pendingSwapKey(guildID, requestorID, targetID)
+	prompt, err := m.prompts.Get(ctx, key)
+	if err != nil {
+		m.logger.DebugContext(ctx, "No pending tag swap prompt for result",
+			attr.String("guild_id", guildID),
+			attr.String("requestor_id", requestorID),
+			attr.String("target_id", targetID),
+			attr.Error(err))
+		return nil
+	}
+	m.prompts.Delete(ctx, key)
+
+	if err := m.editPrompt(prompt.ChannelID, prompt.MessageID, content); err != nil {
+		m.logger.WarnContext(ctx, "Failed to update tag swap prompt", attr.Error(err))
+	}
+
+	_, err = m.session.ChannelMessageSendComplex(prompt.ChannelID, &discordgo.MessageSend{
+		Content: content,
+		Reference: &discordgo.MessageReference{
+			MessageID: prompt.MessageID,
+			ChannelID: prompt.ChannelID,
+			GuildID:   guildID,
+		},
+		AllowedMentions: &discordgo.MessageAllowedMentions{Users: []string{requestorID, targetID}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to report tag swap result: %w", err)
+	}
+	return nil
+}
+
+// RunExpiry strips the buttons from prompts whose window has closed. The
+// expiry embedded in the custom ID is what actually gates a response; this
+// only tidies prompts nobody answered.
+func (m *tagSwapManager) RunExpiry(ctx context.Context, every time.Duration) {
+	if m.prompts == nil {
+		return
+	}
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.expirePrompts(ctx)
+		}
+	}
+}
+
+func (m *tagSwapManager) expirePrompts(ctx context.Context) {
+	keys, err := m.prompts.Keys(ctx)
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to list tag swap prompts", attr.Error(err))
+		return
+	}
+
+	for _, key := range keys {
+		if !strings.HasPrefix(key, openPromptKeyPrefix) {
+			continue
+		}
+		prompt, err := m.prompts.Get(ctx, key)
+		if err != nil || !m.now().After(prompt.Request.ExpiresAt) {
+			continue
+		}
+		m.prompts.Delete(ctx, key)
+		if err := m.editPrompt(prompt.ChannelID, prompt.MessageID, expiredContent(prompt.Request)); err != nil {
+			m.logger.WarnContext(ctx, "Failed to expire tag swap prompt",
+				attr.Error(err),
+				attr.String("message_id", prompt.MessageID))
+		}
+	}
+}
+
+// closePrompt stops a prompt the target responded to from being expired.
+func (m *tagSwapManager) closePrompt(ctx context.Context, messageID string) {
+	if m.prompts != nil && messageID != "" {
+		m.prompts.Delete(ctx, openPromptKey(messageID))
+	}
+}
+
+func (m *tagSwapManager) timeout() time.Duration {
+	if m.config == nil {
+		return (&config.DiscordConfig{}).TagSwapTimeout()
+	}
+	return m.config.Discord.TagSwapTimeout()
+}
+
+func (m *tagSwapManager) updatePrompt(i *discordgo.InteractionCreate, content string) error {
+	return m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
+
+func (m *tagSwapManager) editPrompt(channelID, messageID, content string) error {
+	if channelID == "" || messageID == "" {
+		return nil
+	}
+	_, err := m.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		Channel:    channelID,
+		ID:         messageID,
+		Content:    &content,
+		Components: &[]discordgo.MessageComponent{},
+	})
+	return err
+}
+
+func (m *tagSwapManager) respondEphemeral(i *discordgo.InteractionCreate, content string) error {
+	return m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+func openPromptKey(messageID string) string {
+	return openPromptKeyPrefix + messageID
+}
+
+func pendingSwapKey(guildID, requestorID, targetID string) string {
+	return fmt.Sprintf("pending.%s.%s.%s", guildID, requestorID, targetID)
+}
+
+func promptLocation(i *discordgo.InteractionCreate) (string, string) {
+	if i.Message == nil {
+		return i.ChannelID, ""
+	}
+	channelID := i.Message.ChannelID
+	if channelID == "" {
+		channelID = i.ChannelID
+	}
+	return channelID, i.Message.ID
+}
+
+func interactionUserID(i *discordgo.InteractionCreate) (string, error) {
+	switch {
+	case i != nil && i.Member != nil && i.Member.User != nil:
+		return i.Member.User.ID, nil
+	case i != nil && i.User != nil:
+		return i.User.ID, nil
+	default:
+		return "", fmt.Errorf("interaction user unavailable")
+	}
+}
+
+func commandUserOption(options []*discordgo.ApplicationCommandInteractionDataOption, name string) *discordgo.User {
+	for _, option := range options {
+		if option.Name == name {
+			return option.UserValue(nil)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,401 @@
+package tagswap
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	discordpkg "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	discordleaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/leaderboard"
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+)
+
+var testNow = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// fakePromptStore is a PromptStore kept in memory, as the JetStream bucket
+// would keep it.
+type fakePromptStore struct {
+	mu      sync.Mutex
+	prompts map[string]swapPrompt
+}
+
+func newFakePromptStore() *fakePromptStore {
+	return &fakePromptStore{prompts: map[string]swapPrompt{}}
+}
+
+func (f *fakePromptStore) Set(ctx context.Context, key string, prompt swapPrompt) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.prompts[key] = prompt
+	return nil
+}
+
+func (f *fakePromptStore) Get(ctx context.Context, key string) (swapPrompt, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	prompt, ok := f.prompts[key]
+	if !ok {
+		return swapPrompt{}, storage.ErrNotFound
+	}
+	return prompt, nil
+}
+
+func (f *fakePromptStore) Delete(ctx context.Context, key string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.prompts, key)
+}
+
+func (f *fakePromptStore) Keys(ctx context.Context) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	keys := make([]string, 0, len(f.prompts))
+	for key := range f.prompts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func newTestManager(session *discordpkg.FakeSession, bus *testutils.FakeEventBus, helper *testutils.FakeHelpers, store *fakePromptStore) *tagSwapManager {
+	mgr := NewTagSwapManager(
+		session,
+		bus,
+		testutils.NoOpLogger(),
+		helper,
+		&config.Config{Discord: config.DiscordConfig{TagSwapTimeoutMinutes: 10}},
+		store,
+	).(*tagSwapManager)
+	mgr.now = func() time.Time { return testNow }
+	return mgr
+}
+
+func tagSwapCommandInteraction(requestorID, targetID string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			GuildID:   "guild-1",
+			ChannelID: "channel-1",
+			Member:    &discordgo.Member{User: &discordgo.User{ID: requestorID}},
+			Type:      discordgo.InteractionApplicationCommand,
+			Data: discordgo.ApplicationCommandInteractionData{
+				Name: "tagswap",
+				Options: []*discordgo.ApplicationCommandInteractionDataOption{
+					{Name: "user", Type: discordgo.ApplicationCommandOptionUser, Value: targetID},
+				},
+			},
+		},
+	}
+}
+
+func tagSwapButtonInteraction(userID, customID string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			GuildID:   "guild-1",
+			ChannelID: "channel-1",
+			Member:    &discordgo.Member{User: &discordgo.User{ID: userID}},
+			Type:      discordgo.InteractionMessageComponent,
+			Message:   &discordgo.Message{ID: "prompt-1", ChannelID: "channel-1"},
+			Data:      discordgo.MessageComponentInteractionData{CustomID: customID},
+		},
+	}
+}
+
+func TestHandleTagSwapCommand_PostsPromptWithExpiringButtons(t *testing.T) {
+	session := discordpkg.NewFakeSession()
+	var sent *discordgo.MessageSend
+	session.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		if channelID != "channel-1" {
+			t.Fatalf("expected prompt in channel-1, got %q", channelID)
+		}
+		sent = data
+		return &discordgo.Message{ID: "prompt-1", ChannelID: channelID}, nil
+	}
+	var reply *discordgo.InteractionResponse
+	session.InteractionRespondFunc = func(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+		reply = resp
+		return nil
+	}
+
+	store := newFakePromptStore()
+	mgr := newTestManager(session, &testutils.FakeEventBus{}, &testutils.FakeHelpers{}, store)
+	if err := mgr.HandleTagSwapCommand(context.Background(), tagSwapCommandInteraction("requestor-1", "target-1")); err != nil {
+		t.Fatalf("HandleTagSwapCommand returned error: %v", err)
+	}
+
+	if sent == nil {
+		t.Fatal("expected prompt to be posted")
+	}
+	if !strings.Contains(sent.Content, "<@target-1>") || !strings.Contains(sent.Content, "<@requestor-1>") {
+		t.Fatalf("expected prompt to mention both players, got %q", sent.Content)
+	}
+	wantExpiry := testNow.Add(10 * time.Minute)
+	row := sent.Components[0].(discordgo.ActionsRow)
+	accept := row.Components[0].(discordgo.Button)
+	req, err := parseTagSwapCustomID(accept.CustomID, tagSwapAcceptPrefix)
+	if err != nil {
+		t.Fatalf("accept button custom id did not parse: %v", err)
+	}
+	if req.RequestorID != "requestor-1" || req.TargetID != "target-1" || !req.ExpiresAt.Equal(wantExpiry) {
+		t.Fatalf("unexpected request encoded in button: %+v", req)
+	}
+	if reply == nil || reply.Data.Flags != discordgo.MessageFlagsEphemeral {
+		t.Fatalf("expected ephemeral confirmation to requestor, got %+v", reply)
+	}
+	open, err := store.Get(context.Background(), openPromptKey("prompt-1"))
+	if err != nil {
+		t.Fatalf("expected the open prompt to be stored for expiry: %v", err)
+	}
+	if open.ChannelID != "channel-1" || open.Request.TargetID != "target-1" || !open.Request.ExpiresAt.Equal(wantExpiry) {
+		t.Fatalf("unexpected stored prompt: %+v", open)
+	}
+}
+
+func TestHandleTagSwapCommand_RejectsSelfSwap(t *testing.T) {
+	session := discordpkg.NewFakeSession()
+	session.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		t.Fatal("prompt should not be posted for a self swap")
+		return nil, nil
+	}
+	var content string
+	session.InteractionRespondFunc = func(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+		content = resp.Data.Content
+		return nil
+	}
+
+	mgr := newTestManager(session, &testutils.FakeEventBus{}, &testutils.FakeHelpers{}, newFakePromptStore())
+	if err := mgr.HandleTagSwapCommand(context.Background(), tagSwapCommandInteraction("user-1", "user-1")); err != nil {
+		t.Fatalf("HandleTagSwapCommand returned error: %v", err)
+	}
+	if !strings.Contains(content, "yourself") {
+		t.Fatalf("expected self-swap rejection, got %q", content)
+	}
+}
+
+func TestHandleAcceptButton_PublishesSwapRequest(t *testing.T) {
+	session := discordpkg.NewFakeSession()
+	var update *discordgo.InteractionResponse
+	session.InteractionRespondFunc = func(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+		update = resp
+		return nil
+	}
+	helper := &testutils.FakeHelpers{}
+	var published discordleaderboardevents.LeaderboardTagSwapRequestPayloadV1
+	helper.CreateNewMessageFunc = func(payload any, topic string) (*message.Message, error) {
+		published = payload.(discordleaderboardevents.LeaderboardTagSwapRequestPayloadV1)
+		return message.NewMessage("msg-1", []byte("{}")), nil
+	}
+	bus := &testutils.FakeEventBus{}
+	var publishedTopic string
+	bus.PublishFunc = func(topic string, messages ...*message.Message) error {
+		publishedTopic = topic
+		return nil
+	}
+	store := newFakePromptStore()
+	req := tagSwapRequest{RequestorID: "requestor-1", TargetID: "target-1", ExpiresAt: testNow.Add(time.Minute)}
+	_ = store.Set(context.Background(), openPromptKey("prompt-1"), swapPrompt{ChannelID: "channel-1", MessageID: "prompt-1", Request: req})
+
+	mgr := newTestManager(session, bus, helper, store)
+	if err := mgr.HandleAcceptButton(context.Background(), tagSwapButtonInteraction("target-1", req.customID(tagSwapAcceptPrefix))); err != nil {
+		t.Fatalf("HandleAcceptButton returned error: %v", err)
+	}
+
+	if publishedTopic != discordleaderboardevents.LeaderboardTagSwapRequestV1 {
+		t.Fatalf("expected swap request topic, got %q", publishedTopic)
+	}
+	if published.User1ID != sharedtypes.DiscordID("requestor-1") || published.User2ID != sharedtypes.DiscordID("target-1") ||
+		published.RequestorID != sharedtypes.DiscordID("requestor-1") || published.ChannelID != "channel-1" || published.MessageID != "prompt-1" {
+		t.Fatalf("unexpected swap request payload: %+v", published)
+	}
+	if update == nil || update.Type != discordgo.InteractionResponseUpdateMessage || len(update.Data.Components) != 0 {
+		t.Fatalf("expected prompt to be updated without buttons, got %+v", update)
+	}
+	if _, err := store.Get(context.Background(), pendingSwapKey("guild-1", "requestor-1", "target-1")); err != nil {
+		t.Fatalf("expected pending swap to be stored: %v", err)
+	}
+	if _, err := store.Get(context.Background(), openPromptKey("prompt-1")); err == nil {
+		t.Fatal("expected the answered prompt to no longer be expired")
+	}
+}
+
+func TestHandleAcceptButton_OnlyTargetMayAccept(t *testing.T) {
+	session := discordpkg.NewFakeSession()
+	var reply *discordgo.InteractionResponse
+	session.InteractionRespondFunc = func(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+		reply = resp
+		return nil
+	}
+	bus := &testutils.FakeEventBus{}
+	bus.PublishFunc = func(topic string, messages ...*message.Message) error {
+		t.Fatal("swap should not be published when someone else accepts")
+		return nil
+	}
+
+	mgr := newTestManager(session, bus, &testutils.FakeHelpers{}, newFakePromptStore())
+	req := tagSwapRequest{RequestorID: "requestor-1", TargetID: "target-1", ExpiresAt: testNow.Add(time.Minute)}
+	if err := mgr.HandleAcceptButton(context.Background(), tagSwapButtonInteraction("requestor-1", req.customID(tagSwapAcceptPrefix))); err != nil {
+		t.Fatalf("HandleAcceptButton returned error: %v", err)
+	}
+	if reply == nil || reply.Data.Flags != discordgo.MessageFlagsEphemeral || !strings.Contains(reply.Data.Content, "Only <@target-1>") {
+		t.Fatalf("expected ephemeral rejection, got %+v", reply)
+	}
+}
+
+func TestHandleAcceptButton_ExpiredDoesNotPublish(t *testing.T) {
+	session := discordpkg.NewFakeSession()
+	var update *discordgo.InteractionResponse
+	session.InteractionRespondFunc = func(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+		update = resp
+		return nil
+	}
+	bus := &testutils.FakeEventBus{}
+	bus.PublishFunc = func(topic string, messages ...*message.Message) error {
+		t.Fatal("expired swap should not be published")
+		return nil
+	}
+
+	mgr := newTestManager(session, bus, &testutils.FakeHelpers{}, newFakePromptStore())
+	req := tagSwapRequest{RequestorID: "requestor-1", TargetID: "target-1", ExpiresAt: testNow.Add(-time.Second)}
+	if err := mgr.HandleAcceptButton(context.Background(), tagSwapButtonInteraction("target-1", req.customID(tagSwapAcceptPrefix))); err != nil {
+		t.Fatalf("HandleAcceptButton returned error: %v", err)
+	}
+	if update == nil || !strings.Contains(update.Data.Content, "expired") {
+		t.Fatalf("expected prompt to show expiry, got %+v", update)
+	}
+}
+
+func TestHandleDeclineButton_ClosesPrompt(t *testing.T) {
+	session := discordpkg.NewFakeSession()
+	var update *discordgo.InteractionResponse
+	session.InteractionRespondFunc = func(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+		update = resp
+		return nil
+	}
+
+	mgr := newTestManager(session, &testutils.FakeEventBus{}, &testutils.FakeHelpers{}, newFakePromptStore())
+	req := tagSwapRequest{RequestorID: "requestor-1", TargetID: "target-1", ExpiresAt: testNow.Add(time.Minute)}
+	if err := mgr.HandleDeclineButton(context.Background(), tagSwapButtonInteraction("target-1", req.customID(tagSwapDeclinePrefix))); err != nil {
+		t.Fatalf("HandleDeclineButton returned error: %v", err)
+	}
+	if update == nil || update.Type != discordgo.InteractionResponseUpdateMessage || !strings.Contains(update.Data.Content, "declined") {
+		t.Fatalf("expected prompt to show decline, got %+v", update)
+	}
+}
+
+func TestHandleTagSwapped_ReportsToBothPlayers(t *testing.T) {
+	session := discordpkg.NewFakeSession()
+	var edited *discordgo.MessageEdit
+	session.ChannelMessageEditComplexFunc = func(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		edited = m
+		return &discordgo.Message{ID: m.ID}, nil
+	}
+	var sent *discordgo.MessageSend
+	session.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		sent = data
+		return &discordgo.Message{ID: "result-1"}, nil
+	}
+	store := newFakePromptStore()
+	key := pendingSwapKey("guild-1", "requestor-1", "target-1")
+	if err := store.Set(context.Background(), key, swapPrompt{ChannelID: "channel-1", MessageID: "prompt-1"}); err != nil {
+		t.Fatalf("failed to seed store: %v", err)
+	}
+
+	mgr := newTestManager(session, &testutils.FakeEventBus{}, &testutils.FakeHelpers{}, store)
+	err := mgr.HandleTagSwapped(context.Background(), &leaderboardevents.TagSwapProcessedPayloadV1{
+		GuildID:     sharedtypes.GuildID("guild-1"),
+		RequestorID: sharedtypes.DiscordID("requestor-1"),
+		TargetID:    sharedtypes.DiscordID("target-1"),
+	})
+	if err != nil {
+		t.Fatalf("HandleTagSwapped returned error: %v", err)
+	}
+
+	if edited == nil || edited.ID != "prompt-1" {
+		t.Fatalf("expected prompt to be edited, got %+v", edited)
+	}
+	if sent == nil || sent.Reference == nil || sent.Reference.MessageID != "prompt-1" {
+		t.Fatalf("expected result reply to the prompt, got %+v", sent)
+	}
+	if len(sent.AllowedMentions.Users) != 2 {
+		t.Fatalf("expected both players to be pinged, got %+v", sent.AllowedMentions)
+	}
+	if _, err := store.Get(context.Background(), key); err == nil {
+		t.Fatal("expected pending swap to be cleared")
+	}
+}
+
+func TestHandleTagSwapFailed_IgnoresSwapsWithoutPrompt(t *testing.T) {
+	session := discordpkg.NewFakeSession()
+	session.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return nil, errors.New("should not send")
+	}
+
+	mgr := newTestManager(session, &testutils.FakeEventBus{}, &testutils.FakeHelpers{}, newFakePromptStore())
+	err := mgr.HandleTagSwapFailed(context.Background(), &leaderboardevents.TagSwapFailedPayloadV1{
+		GuildID:     sharedtypes.GuildID("guild-1"),
+		RequestorID: sharedtypes.DiscordID("requestor-1"),
+		TargetID:    sharedtypes.DiscordID("target-1"),
+		Reason:      "no tag",
+	})
+	if err != nil {
+		t.Fatalf("expected swaps without a prompt to be ignored, got %v", err)
+	}
+}
+
+func TestExpirePrompts_ClosesOnlyExpiredPrompts(t *testing.T) {
+	session := discordpkg.NewFakeSession()
+	var edited []*discordgo.MessageEdit
+	session.ChannelMessageEditComplexFunc = func(m *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		edited = append(edited, m)
+		return &discordgo.Message{ID: m.ID}, nil
+	}
+
+	store := newFakePromptStore()
+	ctx := context.Background()
+	expired := tagSwapRequest{RequestorID: "requestor-1", TargetID: "target-1", ExpiresAt: testNow.Add(-time.Second)}
+	open := tagSwapRequest{RequestorID: "requestor-2", TargetID: "target-2", ExpiresAt: testNow.Add(time.Minute)}
+	_ = store.Set(ctx, openPromptKey("prompt-1"), swapPrompt{ChannelID: "channel-1", MessageID: "prompt-1", Request: expired})
+	_ = store.Set(ctx, openPromptKey("prompt-2"), swapPrompt{ChannelID: "channel-1", MessageID: "prompt-2", Request: open})
+	// An accepted swap waiting for its result is past its window too, but
+	// must stay until the backend reports.
+	pending := pendingSwapKey("guild-1", "requestor-3", "target-3")
+	_ = store.Set(ctx, pending, swapPrompt{ChannelID: "channel-1", MessageID: "prompt-3", Request: expired})
+
+	mgr := newTestManager(session, &testutils.FakeEventBus{}, &testutils.FakeHelpers{}, store)
+	mgr.expirePrompts(ctx)
+
+	if len(edited) != 1 || edited[0].ID != "prompt-1" || !strings.Contains(*edited[0].Content, "expired") {
+		t.Fatalf("expected only prompt-1 to be expired, got %+v", edited)
+	}
+	if _, err := store.Get(ctx, openPromptKey("prompt-1")); err == nil {
+		t.Fatal("expected the expired prompt to be removed")
+	}
+	if _, err := store.Get(ctx, openPromptKey("prompt-2")); err != nil {
+		t.Fatalf("expected the open prompt to be kept: %v", err)
+	}
+	if _, err := store.Get(ctx, pending); err != nil {
+		t.Fatalf("expected the pending swap to be kept: %v", err)
+	}
+}
+
+func TestParseTagSwapCustomID_RejectsMalformed(t *testing.T) {
+	for _, customID := range []string{
+		"tagswap_accept|",
+		"tagswap_accept|a|b",
+		"tagswap_accept|a|b|soon",
+		"challenge_accept|a|b|1",
+	} {
+		if _, err := parseTagSwapCustomID(customID, tagSwapAcceptPrefix); err == nil {
+			t.Fatalf("expected %q to be rejected", customID)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package tagswap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// tagSwapRequest is the state carried in the prompt's button custom IDs, so a
+// button press can be validated without any server-side lookup.
+type tagSwapRequest struct {
+	RequestorID string    `json:"requestor_id"`
+	TargetID    string    `json:"target_id"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+func (r tagSwapRequest) customID(prefix string) string {
+	return fmt.Sprintf("%s%s|%s|%d", prefix, r.RequestorID, r.TargetID, r.ExpiresAt.Unix())
+}
+
+func parseTagSwapCustomID(customID, prefix string) (tagSwapRequest, error) {
+	trimmed := strings.TrimPrefix(customID, prefix)
+	if trimmed == customID {
+		return tagSwapRequest{}, fmt.Errorf("invalid tag swap button custom id: %s", customID)
+	}
+
+	parts := strings.Split(trimmed, "|")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return tagSwapRequest{}, fmt.Errorf("invalid tag swap button custom id: %s", customID)
+	}
+
+	expiresUnix, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return tagSwapRequest{}, fmt.Errorf("invalid tag swap expiry in custom id %s: %w", customID, err)
+	}
+
+	return tagSwapRequest{
+		RequestorID: parts[0],
+		TargetID:    parts[1],
+		ExpiresAt:   time.Unix(expiresUnix, 0).UTC(),
+	}, nil
+}
+
+func buildTagSwapComponents(req tagSwapRequest) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Accept",
+				Style:    discordgo.SuccessButton,
+				CustomID: req.customID(tagSwapAcceptPrefix),
+			},
+			discordgo.Button{
+				Label:    "Decline",
+				Style:    discordgo.DangerButton,
+				CustomID: req.customID(tagSwapDeclinePrefix),
+			},
+		}},
+	}
+}
+
+func promptContent(req tagSwapRequest) string {
+	return fmt.Sprintf("<@%s>, <@%s> wants to swap leaderboard tags with you. This request expires <t:%d:R>.",
+		req.TargetID, req.RequestorID, req.ExpiresAt.Unix())
+}
+
+func acceptedContent(req tagSwapRequest) string {
+	return fmt.Sprintf("🔄 <@%s> accepted <@%s>'s tag swap. Swapping tags…", req.TargetID, req.RequestorID)
+}
+
+func declinedContent(req tagSwapRequest) string {
+	return fmt.Sprintf("❌ <@%s> declined <@%s>'s tag swap.", req.TargetID, req.RequestorID)
+}
+
+func expiredContent(req tagSwapRequest) string {
+	return fmt.Sprintf("⌛ <@%s>'s tag swap request to <@%s> expired.", req.RequestorID, req.TargetID)
+}
+
+func swappedContent(requestorID, targetID string) string {
+	return fmt.Sprintf("✅ <@%s> and <@%s> swapped leaderboard tags.", requestorID, targetID)
+}
+
+func swapFailedContent(requestorID, targetID, reason string) string {
+	if reason == "" {
+		return fmt.Sprintf("⚠️ The tag swap between <@%s> and <@%s> failed.", requestorID, targetID)
+	}
+	return fmt.Sprintf("⚠️ The tag swap between <@%s> and <@%s> failed: %s", requestorID, targetID, reason)
+}
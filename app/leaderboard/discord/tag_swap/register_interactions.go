@@ -0,0 +1,45 @@
+package tagswap
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RegisterHandlers registers the /tagswap command and its accept/decline buttons.
+func RegisterHandlers(registry *interactions.Registry, manager TagSwapManager) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling /tagswap command",
+			attr.String("guild_id", i.GuildID),
+			attr.String("user_id", interactionUserIDForLog(i)))
+		if err := manager.HandleTagSwapCommand(ctx, i); err != nil {
+			slog.ErrorContext(ctx, "Tag swap command failed", attr.Error(err))
+		}
+	})
+
+	registry.RegisterMutatingHandler(tagSwapAcceptPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling tag swap accept button",
+			attr.String("custom_id", i.MessageComponentData().CustomID),
+			attr.String("user_id", interactionUserIDForLog(i)))
+		if err := manager.HandleAcceptButton(ctx, i); err != nil {
+			slog.ErrorContext(ctx, "Tag swap accept failed", attr.Error(err))
+		}
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	registry.RegisterMutatingHandler(tagSwapDeclinePrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling tag swap decline button",
+			attr.String("custom_id", i.MessageComponentData().CustomID),
+			attr.String("user_id", interactionUserIDForLog(i)))
+		if err := manager.HandleDeclineButton(ctx, i); err != nil {
+			slog.ErrorContext(ctx, "Tag swap decline failed", attr.Error(err))
+		}
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+}
+
+func interactionUserIDForLog(i *discordgo.InteractionCreate) string {
+	userID, _ := interactionUserID(i)
+	return userID
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/history"
 	leaderboardupdated "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/leaderboard_updated"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/season"
+	tagswap "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/tag_swap"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -20,12 +22,16 @@ type FakeLeaderboardDiscord struct {
 	GetClaimTagManagerFunc          func() claimtag.ClaimTagManager
 	GetSeasonManagerFunc            func() season.SeasonManager
 	GetHistoryManagerFunc           func() history.HistoryManager
+	GetTagSwapManagerFunc           func() tagswap.TagSwapManager
+	GetNotifierFunc                 func() notify.Notifier
 
 	// Holds the sub-fakes
 	LeaderboardUpdateManager FakeLeaderboardUpdateManager
 	ClaimTagManager          FakeClaimTagManager
 	SeasonMgr                FakeSeasonManager
 	HistoryMgr               FakeHistoryManager
+	TagSwapMgr               FakeTagSwapManager
+	Notifier                 notify.FakeNotifier
 }
 
 func (f *FakeLeaderboardDiscord) GetLeaderboardUpdateManager() leaderboardupdated.LeaderboardUpdateManager {
@@ -56,10 +62,26 @@ func (f *FakeLeaderboardDiscord) GetHistoryManager() history.HistoryManager {
 	return &f.HistoryMgr
 }
 
+func (f *FakeLeaderboardDiscord) GetTagSwapManager() tagswap.TagSwapManager {
+	if f.GetTagSwapManagerFunc != nil {
+		return f.GetTagSwapManagerFunc()
+	}
+	return &f.TagSwapMgr
+}
+
+func (f *FakeLeaderboardDiscord) GetNotifier() notify.Notifier {
+	if f.GetNotifierFunc != nil {
+		return f.GetNotifierFunc()
+	}
+	return &f.Notifier
+}
+
 // FakeLeaderboardUpdateManager implements leaderboardupdated.LeaderboardUpdateManager
 type FakeLeaderboardUpdateManager struct {
 	HandleLeaderboardPaginationFunc func(ctx context.Context, i *discordgo.InteractionCreate) (leaderboardupdated.LeaderboardUpdateOperationResult, error)
 	SendLeaderboardEmbedFunc        func(ctx context.Context, channelID string, leaderboard []leaderboardupdated.LeaderboardEntry, page int32) (leaderboardupdated.LeaderboardUpdateOperationResult, error)
+	HandleLeaderboardCommandFunc    func(ctx context.Context, i *discordgo.InteractionCreate) (leaderboardupdated.LeaderboardUpdateOperationResult, error)
+	SendLeaderboardViewFunc         func(ctx context.Context, correlationID string, leaderboard []leaderboardupdated.LeaderboardEntry) (bool, error)
 }
 
 func (f *FakeLeaderboardUpdateManager) HandleLeaderboardPagination(ctx context.Context, i *discordgo.InteractionCreate) (leaderboardupdated.LeaderboardUpdateOperationResult, error) {
@@ -76,6 +98,20 @@ func (f *FakeLeaderboardUpdateManager) SendLeaderboardEmbed(ctx context.Context,
 	return leaderboardupdated.LeaderboardUpdateOperationResult{}, nil
 }
 
+func (f *FakeLeaderboardUpdateManager) HandleLeaderboardCommand(ctx context.Context, i *discordgo.InteractionCreate) (leaderboardupdated.LeaderboardUpdateOperationResult, error) {
+	if f.HandleLeaderboardCommandFunc != nil {
+		return f.HandleLeaderboardCommandFunc(ctx, i)
+	}
+	return leaderboardupdated.LeaderboardUpdateOperationResult{}, nil
+}
+
+func (f *FakeLeaderboardUpdateManager) SendLeaderboardView(ctx context.Context, correlationID string, leaderboard []leaderboardupdated.LeaderboardEntry) (bool, error) {
+	if f.SendLeaderboardViewFunc != nil {
+		return f.SendLeaderboardViewFunc(ctx, correlationID, leaderboard)
+	}
+	return false, nil
+}
+
 // FakeClaimTagManager implements claimtag.ClaimTagManager
 type FakeClaimTagManager struct {
 	HandleClaimTagCommandFunc     func(ctx context.Context, i *discordgo.InteractionCreate) (claimtag.ClaimTagOperationResult, error)
@@ -105,6 +141,7 @@ type FakeSeasonManager struct {
 	HandleSeasonStandingsFailedFunc func(ctx context.Context, payload *leaderboardevents.AdminFailedPayloadV1)
 	HandleSeasonEndedFunc           func(ctx context.Context, payload *leaderboardevents.EndSeasonSuccessPayloadV1)
 	HandleSeasonEndFailedFunc       func(ctx context.Context, payload *leaderboardevents.AdminFailedPayloadV1)
+	AutocompleteSeasonIDFunc        func(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error)
 }
 
 func (f *FakeSeasonManager) HandleSeasonCommand(ctx context.Context, i *discordgo.InteractionCreate) {
@@ -149,16 +186,25 @@ func (f *FakeSeasonManager) HandleSeasonEndFailed(ctx context.Context, payload *
 	}
 }
 
+func (f *FakeSeasonManager) AutocompleteSeasonID(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	if f.AutocompleteSeasonIDFunc != nil {
+		return f.AutocompleteSeasonIDFunc(ctx, i, focused)
+	}
+	return nil, nil
+}
+
 // Ensure interface compliance
 var _ leaderboarddiscord.LeaderboardDiscordInterface = (*FakeLeaderboardDiscord)(nil)
 var _ leaderboardupdated.LeaderboardUpdateManager = (*FakeLeaderboardUpdateManager)(nil)
 var _ claimtag.ClaimTagManager = (*FakeClaimTagManager)(nil)
 var _ season.SeasonManager = (*FakeSeasonManager)(nil)
 var _ history.HistoryManager = (*FakeHistoryManager)(nil)
+var _ tagswap.TagSwapManager = (*FakeTagSwapManager)(nil)
 
 // FakeHistoryManager implements history.HistoryManager
 type FakeHistoryManager struct {
 	HandleHistoryCommandFunc     func(ctx context.Context, i *discordgo.InteractionCreate)
+	HandleViewTagHistoryFunc     func(ctx context.Context, i *discordgo.InteractionCreate)
 	HandleTagHistoryResponseFunc func(ctx context.Context, payload *leaderboardevents.TagHistoryResponsePayloadV1)
 	HandleTagHistoryFailedFunc   func(ctx context.Context, payload *leaderboardevents.TagHistoryFailedPayloadV1)
 	HandleTagGraphResponseFunc   func(ctx context.Context, payload *leaderboardevents.TagGraphResponsePayloadV1)
@@ -171,6 +217,12 @@ func (f *FakeHistoryManager) HandleHistoryCommand(ctx context.Context, i *discor
 	}
 }
 
+func (f *FakeHistoryManager) HandleViewTagHistoryUserCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	if f.HandleViewTagHistoryFunc != nil {
+		f.HandleViewTagHistoryFunc(ctx, i)
+	}
+}
+
 func (f *FakeHistoryManager) HandleTagHistoryResponse(ctx context.Context, payload *leaderboardevents.TagHistoryResponsePayloadV1) {
 	if f.HandleTagHistoryResponseFunc != nil {
 		f.HandleTagHistoryResponseFunc(ctx, payload)
@@ -195,6 +247,50 @@ func (f *FakeHistoryManager) HandleTagGraphFailed(ctx context.Context, payload *
 	}
 }
 
+// FakeTagSwapManager implements tagswap.TagSwapManager
+type FakeTagSwapManager struct {
+	HandleTagSwapCommandFunc func(ctx context.Context, i *discordgo.InteractionCreate) error
+	HandleAcceptButtonFunc   func(ctx context.Context, i *discordgo.InteractionCreate) error
+	HandleDeclineButtonFunc  func(ctx context.Context, i *discordgo.InteractionCreate) error
+	HandleTagSwappedFunc     func(ctx context.Context, payload *leaderboardevents.TagSwapProcessedPayloadV1) error
+	HandleTagSwapFailedFunc  func(ctx context.Context, payload *leaderboardevents.TagSwapFailedPayloadV1) error
+}
+
+func (f *FakeTagSwapManager) HandleTagSwapCommand(ctx context.Context, i *discordgo.InteractionCreate) error {
+	if f.HandleTagSwapCommandFunc != nil {
+		return f.HandleTagSwapCommandFunc(ctx, i)
+	}
+	return nil
+}
+
+func (f *FakeTagSwapManager) HandleAcceptButton(ctx context.Context, i *discordgo.InteractionCreate) error {
+	if f.HandleAcceptButtonFunc != nil {
+		return f.HandleAcceptButtonFunc(ctx, i)
+	}
+	return nil
+}
+
+func (f *FakeTagSwapManager) HandleDeclineButton(ctx context.Context, i *discordgo.InteractionCreate) error {
+	if f.HandleDeclineButtonFunc != nil {
+		return f.HandleDeclineButtonFunc(ctx, i)
+	}
+	return nil
+}
+
+func (f *FakeTagSwapManager) HandleTagSwapped(ctx context.Context, payload *leaderboardevents.TagSwapProcessedPayloadV1) error {
+	if f.HandleTagSwappedFunc != nil {
+		return f.HandleTagSwappedFunc(ctx, payload)
+	}
+	return nil
+}
+
+func (f *FakeTagSwapManager) HandleTagSwapFailed(ctx context.Context, payload *leaderboardevents.TagSwapFailedPayloadV1) error {
+	if f.HandleTagSwapFailedFunc != nil {
+		return f.HandleTagSwapFailedFunc(ctx, payload)
+	}
+	return nil
+}
+
 // FakeHelpers provides a programmable stub for utils.Helpers
 type FakeHelpers struct {
 	CreateNewMessageFunc    func(payload any, topic string) (*message.Message, error)
@@ -60,6 +60,7 @@ type LeaderboardHandlers struct {
 	config              *config.Config
 	guildConfigResolver guildconfig.GuildConfigResolver
 	logger              *slog.Logger
+	tagAlerts           tagAlerts
 }
 
 // NewLeaderboardHandlers creates a new LeaderboardHandlers instance.
@@ -83,8 +83,7 @@ func (h *LeaderboardHandlers) HandleLeaderboardResponse(ctx context.Context,
 		GuildID:     string(payloadData.GuildID),
 	}
 
-	channelID := h.resolveLeaderboardChannelID(ctx, string(payloadData.GuildID))
-	if channelID != "" && h.service != nil {
+	if h.service != nil {
 		entries := make([]leaderboardupdated.LeaderboardEntry, 0, len(leaderboardData))
 		for _, entry := range leaderboardData {
 			entries = append(entries, leaderboardupdated.LeaderboardEntry{
@@ -100,25 +99,12 @@ func (h *LeaderboardHandlers) HandleLeaderboardResponse(ctx context.Context,
 			return entries[i].Rank < entries[j].Rank
 		})
 
-		manager := h.service.GetLeaderboardUpdateManager()
-		if manager != nil {
-			result, err := manager.SendLeaderboardEmbed(ctx, channelID, entries, 1)
-			if err != nil {
-				h.logger.ErrorContext(ctx, "Failed to send leaderboard embed from full snapshot",
-					attr.Error(err),
-					attr.String("guild_id", string(payloadData.GuildID)),
-					attr.String("channel_id", channelID),
-				)
+		h.notifyTagChanges(ctx, string(payloadData.GuildID), leaderboardData)
+
+		if manager := h.service.GetLeaderboardUpdateManager(); manager != nil {
+			if err := h.updateLeaderboardDisplays(ctx, manager, string(payloadData.GuildID), entries); err != nil {
 				return nil, err
 			}
-			if result.Error != nil {
-				h.logger.ErrorContext(ctx, "Failed to send leaderboard embed from full snapshot",
-					attr.Error(result.Error),
-					attr.String("guild_id", string(payloadData.GuildID)),
-					attr.String("channel_id", channelID),
-				)
-				return nil, result.Error
-			}
 		}
 	}
 
@@ -134,6 +120,53 @@ func (h *LeaderboardHandlers) HandleLeaderboardResponse(ctx context.Context,
 	}, nil
 }
 
+// updateLeaderboardDisplays answers a pending /leaderboard command when the
+// snapshot was requested by one; otherwise it refreshes the channel leaderboard.
+func (h *LeaderboardHandlers) updateLeaderboardDisplays(
+	ctx context.Context,
+	manager leaderboardupdated.LeaderboardUpdateManager,
+	guildID string,
+	entries []leaderboardupdated.LeaderboardEntry,
+) error {
+	if correlationID, ok := ctx.Value("correlation_id").(string); ok && correlationID != "" {
+		handled, err := manager.SendLeaderboardView(ctx, correlationID, entries)
+		if err != nil {
+			h.logger.ErrorContext(ctx, "Failed to send leaderboard view",
+				attr.Error(err),
+				attr.String("guild_id", guildID),
+				attr.String("correlation_id", correlationID),
+			)
+		}
+		if handled {
+			return nil
+		}
+	}
+
+	channelID := h.resolveLeaderboardChannelID(ctx, guildID)
+	if channelID == "" {
+		return nil
+	}
+
+	result, err := manager.SendLeaderboardEmbed(ctx, channelID, entries, 1)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to send leaderboard embed from full snapshot",
+			attr.Error(err),
+			attr.String("guild_id", guildID),
+			attr.String("channel_id", channelID),
+		)
+		return err
+	}
+	if result.Error != nil {
+		h.logger.ErrorContext(ctx, "Failed to send leaderboard embed from full snapshot",
+			attr.Error(result.Error),
+			attr.String("guild_id", guildID),
+			attr.String("channel_id", channelID),
+		)
+		return result.Error
+	}
+	return nil
+}
+
 func (h *LeaderboardHandlers) resolveLeaderboardChannelID(ctx context.Context, guildID string) string {
 	// 1. Guild config (authoritative)
 	if h.guildConfigResolver != nil && guildID != "" {
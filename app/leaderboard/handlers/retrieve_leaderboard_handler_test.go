@@ -498,3 +498,77 @@ func TestHandleLeaderboardResponse_SyntheticDefaultProfileDoesNotBlockTagFallbac
 		t.Fatalf("unexpected display names: got %v want [muffinmaster123]", gotDisplayNames)
 	}
 }
+
+func TestHandleLeaderboardResponse_AnswersPendingLeaderboardCommand(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	cfg := &config.Config{}
+	cfg.Discord.LeaderboardChannelID = "leaderboard-channel"
+
+	fakeDiscord := &FakeLeaderboardDiscord{}
+	var gotCorrelationID string
+	fakeDiscord.LeaderboardUpdateManager.SendLeaderboardViewFunc = func(
+		ctx context.Context,
+		correlationID string,
+		leaderboard []leaderboardupdated.LeaderboardEntry,
+	) (bool, error) {
+		gotCorrelationID = correlationID
+		return true, nil
+	}
+	fakeDiscord.LeaderboardUpdateManager.SendLeaderboardEmbedFunc = func(
+		ctx context.Context,
+		channelID string,
+		leaderboard []leaderboardupdated.LeaderboardEntry,
+		page int32,
+	) (leaderboardupdated.LeaderboardUpdateOperationResult, error) {
+		t.Fatal("channel leaderboard should not be refreshed for a /leaderboard request")
+		return leaderboardupdated.LeaderboardUpdateOperationResult{}, nil
+	}
+
+	h := NewLeaderboardHandlers(logger, cfg, nil, fakeDiscord, nil)
+
+	ctx := context.WithValue(context.Background(), "correlation_id", "corr-1")
+	payload := &leaderboardevents.GetLeaderboardResponsePayloadV1{
+		GuildID:     sharedtypes.GuildID("guild123"),
+		Leaderboard: []leaderboardtypes.LeaderboardEntry{{TagNumber: 1, UserID: "user1"}},
+	}
+	if _, err := h.HandleLeaderboardResponse(ctx, payload); err != nil {
+		t.Fatalf("HandleLeaderboardResponse() error = %v", err)
+	}
+	if gotCorrelationID != "corr-1" {
+		t.Fatalf("expected view lookup by correlation id, got %q", gotCorrelationID)
+	}
+}
+
+func TestHandleLeaderboardResponse_UnclaimedCorrelationRefreshesChannel(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	cfg := &config.Config{}
+	cfg.Discord.LeaderboardChannelID = "leaderboard-channel"
+
+	fakeDiscord := &FakeLeaderboardDiscord{}
+	embedSent := false
+	fakeDiscord.LeaderboardUpdateManager.SendLeaderboardEmbedFunc = func(
+		ctx context.Context,
+		channelID string,
+		leaderboard []leaderboardupdated.LeaderboardEntry,
+		page int32,
+	) (leaderboardupdated.LeaderboardUpdateOperationResult, error) {
+		embedSent = true
+		return leaderboardupdated.LeaderboardUpdateOperationResult{Success: "ok"}, nil
+	}
+
+	h := NewLeaderboardHandlers(logger, cfg, nil, fakeDiscord, nil)
+
+	ctx := context.WithValue(context.Background(), "correlation_id", "corr-from-update")
+	payload := &leaderboardevents.GetLeaderboardResponsePayloadV1{
+		GuildID:     sharedtypes.GuildID("guild123"),
+		Leaderboard: []leaderboardtypes.LeaderboardEntry{{TagNumber: 1, UserID: "user1"}},
+	}
+	if _, err := h.HandleLeaderboardResponse(ctx, payload); err != nil {
+		t.Fatalf("HandleLeaderboardResponse() error = %v", err)
+	}
+	if !embedSent {
+		t.Fatal("expected channel leaderboard to be refreshed")
+	}
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// tagAlerts remembers each guild's tags from its last leaderboard, so players
+// whose tag changed can be DMed when the next one arrives.
+type tagAlerts struct {
+	mu      sync.Mutex
+	tags    map[string]map[sharedtypes.DiscordID]sharedtypes.TagNumber
+	swapped map[string]map[sharedtypes.DiscordID]bool
+}
+
+// tagChange is a player's tag before and after a leaderboard update. A zero
+// tag means the player had none.
+type tagChange struct {
+	userID   sharedtypes.DiscordID
+	from, to sharedtypes.TagNumber
+}
+
+// noteSwap records players whose tag swap was already announced, so the
+// leaderboard update that follows doesn't announce it again.
+func (a *tagAlerts) noteSwap(guildID string, userIDs ...sharedtypes.DiscordID) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.swapped == nil {
+		a.swapped = map[string]map[sharedtypes.DiscordID]bool{}
+	}
+	if a.swapped[guildID] == nil {
+		a.swapped[guildID] = map[sharedtypes.DiscordID]bool{}
+	}
+	for _, userID := range userIDs {
+		a.swapped[guildID][userID] = true
+	}
+}
+
+// changes records the guild's leaderboard and returns the tags that changed
+// since the last one, leaving out announced swaps. A guild's first
+// leaderboard has nothing to compare to, so it returns nothing.
+func (a *tagAlerts) changes(guildID string, entries []leaderboardtypes.LeaderboardEntry) []tagChange {
+	current := make(map[sharedtypes.DiscordID]sharedtypes.TagNumber, len(entries))
+	for _, entry := range entries {
+		if entry.UserID != "" && entry.TagNumber > 0 {
+			current[entry.UserID] = entry.TagNumber
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.tags == nil {
+		a.tags = map[string]map[sharedtypes.DiscordID]sharedtypes.TagNumber{}
+	}
+	previous, seen := a.tags[guildID]
+	a.tags[guildID] = current
+	swapped := a.swapped[guildID]
+	delete(a.swapped, guildID)
+	if !seen {
+		return nil
+	}
+
+	var changes []tagChange
+	for userID, to := range current {
+		if from := previous[userID]; from != to && !swapped[userID] {
+			changes = append(changes, tagChange{userID: userID, from: from, to: to})
+		}
+	}
+	for userID, from := range previous {
+		if _, ok := current[userID]; !ok && !swapped[userID] {
+			changes = append(changes, tagChange{userID: userID, from: from})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].userID < changes[j].userID })
+	return changes
+}
+
+// content is the DM telling the player about the change.
+func (c tagChange) content() string {
+	switch {
+	case c.from == 0:
+		return fmt.Sprintf("🏷️ You now hold tag **#%d**.", c.to)
+	case c.to == 0:
+		return fmt.Sprintf("🏷️ You no longer hold a tag. You had **#%d**.", c.from)
+	case c.to < c.from:
+		return fmt.Sprintf("📈 You moved up to tag **#%d** (was #%d).", c.to, c.from)
+	default:
+		return fmt.Sprintf("📉 You lost tag #%d and now hold **#%d**.", c.from, c.to)
+	}
+}
+
+// notifier returns the notifier of the leaderboard's Discord services, if any.
+func (h *LeaderboardHandlers) notifier() notify.Notifier {
+	if h.service == nil {
+		return nil
+	}
+	return h.service.GetNotifier()
+}
+
+// notifyTagChanges DMs the players whose tag changed since the guild's last
+// leaderboard, if they opted in to tag alerts.
+func (h *LeaderboardHandlers) notifyTagChanges(ctx context.Context, guildID string, entries []leaderboardtypes.LeaderboardEntry) {
+	changes := h.tagAlerts.changes(guildID, entries)
+	notifier := h.notifier()
+	if notifier == nil {
+		return
+	}
+	for _, change := range changes {
+		notifier.Notify(ctx, notify.Alert{
+			GuildID:  guildID,
+			Category: notify.TagChanges,
+			UserIDs:  []string{string(change.userID)},
+			Content:  change.content(),
+		})
+	}
+}
+
+// notifyTagSwap DMs both players of a tag swap that went through, if they
+// opted in to tag alerts.
+func (h *LeaderboardHandlers) notifyTagSwap(ctx context.Context, guildID string, requestorID, targetID sharedtypes.DiscordID) {
+	notifier := h.notifier()
+	if notifier == nil || requestorID == "" || targetID == "" {
+		return
+	}
+	h.tagAlerts.noteSwap(guildID, requestorID, targetID)
+	for _, pair := range [][2]sharedtypes.DiscordID{{requestorID, targetID}, {targetID, requestorID}} {
+		notifier.Notify(ctx, notify.Alert{
+			GuildID:  guildID,
+			Category: notify.TagChanges,
+			UserIDs:  []string{string(pair[0])},
+			Content:  fmt.Sprintf("🔄 You swapped tags with <@%s>.", pair[1]),
+		})
+	}
+}
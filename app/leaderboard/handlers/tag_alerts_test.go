@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	leaderboardtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/leaderboard"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+func TestLeaderboardHandlers_TagChangeAlerts(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	fakeDiscord := &FakeLeaderboardDiscord{}
+	h := NewLeaderboardHandlers(logger, nil, nil, fakeDiscord, nil)
+	ctx := context.Background()
+
+	leaderboard := func(tags map[sharedtypes.DiscordID]sharedtypes.TagNumber) {
+		t.Helper()
+		payload := &leaderboardevents.GetLeaderboardResponsePayloadV1{GuildID: "guild123"}
+		for userID, tag := range tags {
+			payload.Leaderboard = append(payload.Leaderboard, leaderboardtypes.LeaderboardEntry{UserID: userID, TagNumber: tag})
+		}
+		if _, err := h.HandleLeaderboardResponse(ctx, payload); err != nil {
+			t.Fatalf("HandleLeaderboardResponse() error = %v", err)
+		}
+	}
+	alert := func(userID, content string) notify.Alert {
+		return notify.Alert{GuildID: "guild123", Category: notify.TagChanges, UserIDs: []string{userID}, Content: content}
+	}
+	expectAlerts := func(step string, want ...notify.Alert) {
+		t.Helper()
+		if !reflect.DeepEqual(fakeDiscord.Notifier.Alerts, want) {
+			t.Fatalf("%s: alerts = %+v, want %+v", step, fakeDiscord.Notifier.Alerts, want)
+		}
+		fakeDiscord.Notifier.Alerts = nil
+	}
+
+	// The first leaderboard has nothing to compare to.
+	leaderboard(map[sharedtypes.DiscordID]sharedtypes.TagNumber{"a": 1, "b": 2, "c": 3, "d": 4})
+	expectAlerts("first leaderboard")
+
+	if _, err := h.HandleTagSwappedResponse(ctx, &leaderboardevents.TagSwapProcessedPayloadV1{
+		GuildID:     "guild123",
+		RequestorID: "c",
+		TargetID:    "a",
+	}); err != nil {
+		t.Fatalf("HandleTagSwappedResponse() error = %v", err)
+	}
+	expectAlerts("swap",
+		alert("c", "🔄 You swapped tags with <@a>."),
+		alert("a", "🔄 You swapped tags with <@c>."),
+	)
+
+	// The swap was already announced, so only d and e hear about this one.
+	leaderboard(map[sharedtypes.DiscordID]sharedtypes.TagNumber{"c": 1, "b": 2, "a": 3, "e": 4})
+	expectAlerts("after swap",
+		alert("d", "🏷️ You no longer hold a tag. You had **#4**."),
+		alert("e", "🏷️ You now hold tag **#4**."),
+	)
+
+	leaderboard(map[sharedtypes.DiscordID]sharedtypes.TagNumber{"b": 1, "c": 2, "a": 3, "e": 4})
+	expectAlerts("after round",
+		alert("b", "📈 You moved up to tag **#1** (was #2)."),
+		alert("c", "📉 You lost tag #1 and now hold **#2**."),
+	)
+}
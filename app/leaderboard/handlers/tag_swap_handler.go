@@ -7,6 +7,7 @@ import (
 
 	discordleaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/leaderboard"
 	leaderboardevents "github.com/Black-And-White-Club/frolf-bot-shared/events/leaderboard"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
 )
@@ -62,6 +63,15 @@ func (h *LeaderboardHandlers) HandleTagSwappedResponse(ctx context.Context,
 
 	backendPayload := payload
 
+	if h.service != nil {
+		if tagSwapManager := h.service.GetTagSwapManager(); tagSwapManager != nil {
+			if err := tagSwapManager.HandleTagSwapped(ctx, backendPayload); err != nil {
+				h.logger.WarnContext(ctx, "Failed to report tag swap result", attr.Error(err))
+			}
+		}
+		h.notifyTagSwap(ctx, string(backendPayload.GuildID), backendPayload.RequestorID, backendPayload.TargetID)
+	}
+
 	discordPayload := discordleaderboardevents.LeaderboardTagSwappedPayloadV1{
 		User1ID: backendPayload.RequestorID,
 		User2ID: backendPayload.TargetID,
@@ -88,6 +98,14 @@ func (h *LeaderboardHandlers) HandleTagSwapFailedResponse(ctx context.Context,
 
 	backendPayload := payload
 
+	if h.service != nil {
+		if tagSwapManager := h.service.GetTagSwapManager(); tagSwapManager != nil {
+			if err := tagSwapManager.HandleTagSwapFailed(ctx, backendPayload); err != nil {
+				h.logger.WarnContext(ctx, "Failed to report tag swap failure", attr.Error(err))
+			}
+		}
+	}
+
 	discordPayload := discordleaderboardevents.LeaderboardTagSwapFailedPayloadV1{
 		User1ID: backendPayload.RequestorID,
 		User2ID: backendPayload.TargetID,
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log/slog"
 	"testing"
@@ -172,3 +173,53 @@ func TestHandleTagSwapFailedResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleTagSwapResponses_ReportToTagSwapManager(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	var swapped *leaderboardevents.TagSwapProcessedPayloadV1
+	var failed *leaderboardevents.TagSwapFailedPayloadV1
+	fakeDiscord := &FakeLeaderboardDiscord{
+		TagSwapMgr: FakeTagSwapManager{
+			HandleTagSwappedFunc: func(ctx context.Context, payload *leaderboardevents.TagSwapProcessedPayloadV1) error {
+				swapped = payload
+				return nil
+			},
+			HandleTagSwapFailedFunc: func(ctx context.Context, payload *leaderboardevents.TagSwapFailedPayloadV1) error {
+				failed = payload
+				return errors.New("discord unavailable")
+			},
+		},
+	}
+
+	h := NewLeaderboardHandlers(logger, nil, nil, fakeDiscord, nil)
+
+	processed := &leaderboardevents.TagSwapProcessedPayloadV1{
+		GuildID:     sharedtypes.GuildID("guild123"),
+		RequestorID: sharedtypes.DiscordID("requestor"),
+		TargetID:    sharedtypes.DiscordID("target"),
+	}
+	if _, err := h.HandleTagSwappedResponse(context.Background(), processed); err != nil {
+		t.Fatalf("HandleTagSwappedResponse() unexpected error: %v", err)
+	}
+	if swapped != processed {
+		t.Fatalf("expected tag swap manager to receive processed payload, got %+v", swapped)
+	}
+
+	failure := &leaderboardevents.TagSwapFailedPayloadV1{
+		GuildID:     sharedtypes.GuildID("guild123"),
+		RequestorID: sharedtypes.DiscordID("requestor"),
+		TargetID:    sharedtypes.DiscordID("target"),
+		Reason:      "no tag",
+	}
+	results, err := h.HandleTagSwapFailedResponse(context.Background(), failure)
+	if err != nil {
+		t.Fatalf("reporting errors should not fail the handler, got %v", err)
+	}
+	if failed != failure {
+		t.Fatalf("expected tag swap manager to receive failed payload, got %+v", failed)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected translated failure result, got %d", len(results))
+	}
+}
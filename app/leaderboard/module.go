@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
@@ -13,6 +14,7 @@ import (
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/history"
 	leaderboardupdated "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/leaderboard_updated"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/season"
+	tagswap "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/discord/tag_swap"
 	leaderboardhandlers "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/handlers"
 	leaderboardrouter "github.com/Black-And-White-Club/discord-frolf-bot/app/leaderboard/router"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
@@ -25,6 +27,9 @@ import (
 	"go.opentelemetry.io/otel"
 )
 
+// tagSwapExpiryInterval is how often unanswered /tagswap prompts are closed.
+const tagSwapExpiryInterval = time.Minute
+
 func InitializeLeaderboardModule(
 	ctx context.Context,
 	session discord.Session,
@@ -64,6 +69,8 @@ func InitializeLeaderboardModule(
 	claimtag.RegisterHandlers(interactionRegistry, leaderboardDiscord.GetClaimTagManager()) // Add this line
 	season.RegisterHandlers(interactionRegistry, leaderboardDiscord.GetSeasonManager())
 	history.RegisterHandlers(interactionRegistry, leaderboardDiscord.GetHistoryManager())
+	tagswap.RegisterHandlers(interactionRegistry, leaderboardDiscord.GetTagSwapManager())
+	go leaderboardDiscord.GetTagSwapManager().RunExpiry(ctx, tagSwapExpiryInterval)
 
 	// Initialize Watermill handlers
 	leaderboardHandlers := leaderboardhandlers.NewLeaderboardHandlers(
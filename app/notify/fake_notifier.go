@@ -0,0 +1,17 @@
+package notify
+
+import "context"
+
+// FakeNotifier records the alerts it is given, for tests of the code that
+// sends them.
+type FakeNotifier struct {
+	Alerts     []Alert
+	NotifyFunc func(ctx context.Context, alert Alert)
+}
+
+func (f *FakeNotifier) Notify(ctx context.Context, alert Alert) {
+	f.Alerts = append(f.Alerts, alert)
+	if f.NotifyFunc != nil {
+		f.NotifyFunc(ctx, alert)
+	}
+}
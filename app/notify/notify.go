@@ -0,0 +1,225 @@
+// Package notify sends players the DM alerts they have opted in to with
+// /notifications, and reads and saves those choices with the backend.
+package notify
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+)
+
+// Category is a kind of DM alert players opt in to or out of.
+type Category string
+
+const (
+	RoundReminders Category = "round_reminders"
+	Challenges     Category = "challenges"
+	TagChanges     Category = "tag_changes"
+	NewRounds      Category = "new_rounds"
+)
+
+// Categories lists every category in the order /notifications shows them.
+var Categories = []Category{RoundReminders, Challenges, TagChanges, NewRounds}
+
+// Label describes the category to players.
+func (c Category) Label() string {
+	switch c {
+	case RoundReminders:
+		return "Round reminders"
+	case Challenges:
+		return "Challenges received or accepted"
+	case TagChanges:
+		return "Gaining or losing a tag"
+	case NewRounds:
+		return "New rounds posted"
+	default:
+		return string(c)
+	}
+}
+
+// Request-reply subjects of config.BackendFeatureNotifications.
+const (
+	PreferencesGetRequestV1 = "user.notifications.get.request.v1"
+	PreferencesSetRequestV1 = "user.notifications.set.request.v1"
+	SubscribersRequestV1    = "user.notifications.subscribers.request.v1"
+)
+
+const notifyRequestTimeout = time.Second
+
+// Preferences are a player's choices per category. Categories a player has
+// not chosen are off, so nobody is DMed without opting in.
+type Preferences map[Category]bool
+
+// PreferencesGetRequestPayloadV1 asks for a player's preferences in a guild.
+type PreferencesGetRequestPayloadV1 struct {
+	GuildID string `json:"guild_id"`
+	UserID  string `json:"user_id"`
+}
+
+// PreferencesSetRequestPayloadV1 changes the categories in Preferences and
+// leaves the others as they are.
+type PreferencesSetRequestPayloadV1 struct {
+	GuildID     string      `json:"guild_id"`
+	UserID      string      `json:"user_id"`
+	Preferences Preferences `json:"preferences"`
+}
+
+// PreferencesResponsePayloadV1 is the reply to both preference requests and
+// holds every category the player has chosen.
+type PreferencesResponsePayloadV1 struct {
+	Preferences Preferences `json:"preferences"`
+	Error       string      `json:"error,omitempty"`
+}
+
+// SubscribersRequestPayloadV1 asks which players opted in to a category.
+// When UserIDs is set, only those players are considered.
+type SubscribersRequestPayloadV1 struct {
+	GuildID  string   `json:"guild_id"`
+	Category Category `json:"category"`
+	UserIDs  []string `json:"user_ids,omitempty"`
+}
+
+// SubscribersResponsePayloadV1 lists the players who opted in.
+type SubscribersResponsePayloadV1 struct {
+	UserIDs []string `json:"user_ids"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// RequestPreferences asks the backend for a player's preferences.
+func RequestPreferences(ctx context.Context, eventBus eventbus.EventBus, guildID, userID string) (*PreferencesResponsePayloadV1, error) {
+	if guildID == "" || userID == "" {
+		return nil, errors.New("guild id and user id are required")
+	}
+
+	return messagecreator.NATSRequest[PreferencesGetRequestPayloadV1, PreferencesResponsePayloadV1](
+		ctx,
+		eventBus,
+		PreferencesGetRequestV1+"."+guildID,
+		PreferencesGetRequestPayloadV1{GuildID: guildID, UserID: userID},
+		notifyRequestTimeout,
+	)
+}
+
+// SavePreferences stores a player's preferences with the backend.
+func SavePreferences(ctx context.Context, eventBus eventbus.EventBus, request PreferencesSetRequestPayloadV1) (*PreferencesResponsePayloadV1, error) {
+	if request.GuildID == "" || request.UserID == "" {
+		return nil, errors.New("guild id and user id are required")
+	}
+
+	return messagecreator.NATSRequest[PreferencesSetRequestPayloadV1, PreferencesResponsePayloadV1](
+		ctx,
+		eventBus,
+		PreferencesSetRequestV1+"."+request.GuildID,
+		request,
+		notifyRequestTimeout,
+	)
+}
+
+// RequestSubscribers asks the backend which players opted in to a category.
+func RequestSubscribers(ctx context.Context, eventBus eventbus.EventBus, request SubscribersRequestPayloadV1) (*SubscribersResponsePayloadV1, error) {
+	if request.GuildID == "" {
+		return nil, errors.New("guild id is required")
+	}
+
+	return messagecreator.NATSRequest[SubscribersRequestPayloadV1, SubscribersResponsePayloadV1](
+		ctx,
+		eventBus,
+		SubscribersRequestV1+"."+request.GuildID,
+		request,
+		notifyRequestTimeout,
+	)
+}
+
+// Alert is a DM sent to the players who opted in to its category.
+type Alert struct {
+	GuildID  string
+	Category Category
+	// UserIDs are the players the alert is for. When empty it goes to every
+	// player who opted in to the category.
+	UserIDs []string
+	// Exclude are players who never get the alert, such as whoever caused it.
+	Exclude []string
+	Content string
+}
+
+// Notifier sends alerts to the players who want them.
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert)
+}
+
+type notifier struct {
+	operations  discord.Operations
+	logger      *slog.Logger
+	subscribers func(ctx context.Context, request SubscribersRequestPayloadV1) (*SubscribersResponsePayloadV1, error)
+}
+
+// NewNotifier creates a Notifier that asks the backend who opted in and DMs
+// them through the session. While cfg holds notifications back nobody has
+// opted in, so no alert is sent.
+func NewNotifier(session discord.Session, eventBus eventbus.EventBus, logger *slog.Logger, cfg *config.Config) Notifier {
+	n := &notifier{
+		operations: discord.NewOperations(session, logger, nil),
+		logger:     logger,
+	}
+	if cfg.BackendFeatureEnabled(config.BackendFeatureNotifications) {
+		n.subscribers = func(ctx context.Context, request SubscribersRequestPayloadV1) (*SubscribersResponsePayloadV1, error) {
+			return RequestSubscribers(ctx, eventBus, request)
+		}
+	}
+	return n
+}
+
+// Notify DMs the alert to its players who opted in. Players are left alone
+// when the backend can't say who opted in.
+func (n *notifier) Notify(ctx context.Context, alert Alert) {
+	if n.subscribers == nil || alert.GuildID == "" || alert.Content == "" {
+		return
+	}
+
+	excluded := make(map[string]bool, len(alert.Exclude))
+	for _, userID := range alert.Exclude {
+		excluded[userID] = true
+	}
+	request := SubscribersRequestPayloadV1{GuildID: alert.GuildID, Category: alert.Category}
+	for _, userID := range alert.UserIDs {
+		if userID != "" && !excluded[userID] {
+			request.UserIDs = append(request.UserIDs, userID)
+		}
+	}
+	if len(alert.UserIDs) > 0 && len(request.UserIDs) == 0 {
+		return
+	}
+
+	response, err := n.subscribers(ctx, request)
+	if err == nil && response.Error != "" {
+		err = errors.New(response.Error)
+	}
+	if err != nil {
+		n.logger.WarnContext(ctx, "Failed to load notification subscribers, not sending alert",
+			attr.String("guild_id", alert.GuildID),
+			attr.String("category", string(alert.Category)),
+			attr.Error(err))
+		return
+	}
+
+	wanted := make(map[string]bool, len(request.UserIDs))
+	for _, userID := range request.UserIDs {
+		wanted[userID] = true
+	}
+	sent := make(map[string]bool, len(response.UserIDs))
+	for _, userID := range response.UserIDs {
+		if userID == "" || excluded[userID] || sent[userID] || (len(wanted) > 0 && !wanted[userID]) {
+			continue
+		}
+		sent[userID] = true
+		// SendDM logs its own failures; one closed DM shouldn't stop the rest.
+		_, _ = n.operations.SendDM(ctx, userID, alert.Content)
+	}
+}
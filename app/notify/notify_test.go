@@ -0,0 +1,121 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/bwmarrin/discordgo"
+)
+
+// newTestNotifier returns a notifier whose subscribers are those given, and a
+// pointer to the users it DMed.
+func newTestNotifier(subscribers func(ctx context.Context, request SubscribersRequestPayloadV1) (*SubscribersResponsePayloadV1, error)) (*notifier, *[]string) {
+	fakeSession := discord.NewFakeSession()
+	var dmed []string
+	fakeSession.UserChannelCreateFunc = func(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		return &discordgo.Channel{ID: "dm-" + recipientID}, nil
+	}
+	fakeSession.ChannelMessageSendFunc = func(channelID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		if channelID == "dm-closed" {
+			return nil, errors.New("cannot send messages to this user")
+		}
+		dmed = append(dmed, channelID[len("dm-"):])
+		return &discordgo.Message{ID: "m", ChannelID: channelID}, nil
+	}
+
+	logger := testutils.NoOpLogger()
+	return &notifier{
+		operations:  discord.NewOperations(fakeSession, logger, nil),
+		logger:      logger,
+		subscribers: subscribers,
+	}, &dmed
+}
+
+func TestNotifier_Notify(t *testing.T) {
+	var requested SubscribersRequestPayloadV1
+	n, dmed := newTestNotifier(func(ctx context.Context, request SubscribersRequestPayloadV1) (*SubscribersResponsePayloadV1, error) {
+		requested = request
+		return &SubscribersResponsePayloadV1{UserIDs: []string{"2", "closed", "3", "2", "9"}}, nil
+	})
+
+	n.Notify(context.Background(), Alert{
+		GuildID:  "guild",
+		Category: RoundReminders,
+		UserIDs:  []string{"1", "2", "closed", "3"},
+		Exclude:  []string{"1"},
+		Content:  "Tee time!",
+	})
+
+	want := SubscribersRequestPayloadV1{GuildID: "guild", Category: RoundReminders, UserIDs: []string{"2", "closed", "3"}}
+	if !reflect.DeepEqual(requested, want) {
+		t.Fatalf("subscribers request = %+v, want %+v", requested, want)
+	}
+	// "9" wasn't one of the alert's players, and the closed DM doesn't stop the rest.
+	if !reflect.DeepEqual(*dmed, []string{"2", "3"}) {
+		t.Fatalf("DMed %v, want [2 3]", *dmed)
+	}
+}
+
+func TestNotifier_NotifyEverySubscriber(t *testing.T) {
+	n, dmed := newTestNotifier(func(ctx context.Context, request SubscribersRequestPayloadV1) (*SubscribersResponsePayloadV1, error) {
+		if len(request.UserIDs) != 0 {
+			t.Errorf("expected every subscriber to be asked for, got %v", request.UserIDs)
+		}
+		return &SubscribersResponsePayloadV1{UserIDs: []string{"creator", "4", "5"}}, nil
+	})
+
+	n.Notify(context.Background(), Alert{GuildID: "guild", Category: NewRounds, Exclude: []string{"creator"}, Content: "New round!"})
+	if !reflect.DeepEqual(*dmed, []string{"4", "5"}) {
+		t.Fatalf("DMed %v, want [4 5]", *dmed)
+	}
+}
+
+func TestNotifier_NotifySendsNothingWhenUnsure(t *testing.T) {
+	tests := []struct {
+		name     string
+		alert    Alert
+		response *SubscribersResponsePayloadV1
+		err      error
+	}{
+		{name: "backend unavailable", alert: Alert{GuildID: "guild", Category: Challenges, UserIDs: []string{"1"}, Content: "hi"}, err: errors.New("timeout")},
+		{name: "backend rejects", alert: Alert{GuildID: "guild", Category: Challenges, UserIDs: []string{"1"}, Content: "hi"}, response: &SubscribersResponsePayloadV1{UserIDs: []string{"1"}, Error: "unknown guild"}},
+		{name: "everyone excluded", alert: Alert{GuildID: "guild", Category: Challenges, UserIDs: []string{"1"}, Exclude: []string{"1"}, Content: "hi"}},
+		{name: "no guild", alert: Alert{Category: Challenges, UserIDs: []string{"1"}, Content: "hi"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			asked := false
+			n, dmed := newTestNotifier(func(ctx context.Context, request SubscribersRequestPayloadV1) (*SubscribersResponsePayloadV1, error) {
+				asked = true
+				if tt.response == nil && tt.err == nil {
+					t.Error("did not expect the backend to be asked")
+				}
+				return tt.response, tt.err
+			})
+
+			n.Notify(context.Background(), tt.alert)
+			if len(*dmed) != 0 {
+				t.Fatalf("expected no DMs, got %v (asked=%v)", *dmed, asked)
+			}
+		})
+	}
+}
+
+func TestNotifier_NotifySendsNothingWhileHeld(t *testing.T) {
+	n, dmed := newTestNotifier(nil)
+	n.Notify(context.Background(), Alert{GuildID: "guild", Category: NewRounds, Content: "New round!"})
+	if len(*dmed) != 0 {
+		t.Fatalf("expected no DMs while notifications are held back, got %v", *dmed)
+	}
+
+	held := NewNotifier(discord.NewFakeSession(), nil, testutils.NoOpLogger(), &config.Config{}).(*notifier)
+	if held.subscribers != nil {
+		t.Fatal("expected notifications to be held back by default")
+	}
+}
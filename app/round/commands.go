@@ -0,0 +1,19 @@
+package round
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	createround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/create_round"
+	roundtemplate "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_template"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
+	scorecardupload "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/scorecard_upload"
+)
+
+// Commands returns the application commands contributed by the round module.
+func Commands() []interactions.CommandSpec {
+	return []interactions.CommandSpec{
+		createround.CommandSpec(),
+		roundtemplate.CommandSpec(),
+		scorecardupload.MessageCommandSpec(),
+		scoreaudit.CommandSpec(),
+	}
+}
@@ -0,0 +1,68 @@
+package createround
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	roundcapacity "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_capacity"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /createround command.
+func CommandSpec() interactions.CommandSpec {
+	minPlayers := 1.0
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "createround",
+			Description: "Create a new frolf round (Available to all players)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "repeat",
+					Description: "Repeat the round to create a series, e.g. for league nights",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: roundseries.RecurrenceWeekly.Label(), Value: string(roundseries.RecurrenceWeekly)},
+						{Name: roundseries.RecurrenceBiweekly.Label(), Value: string(roundseries.RecurrenceBiweekly)},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "until",
+					Description: "Last date of the series (YYYY-MM-DD), required with repeat",
+					Required:    false,
+					MaxLength:   10,
+				},
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         "template",
+					Description:  "Start from a saved round template (see /roundtemplate)",
+					Required:     false,
+					Autocomplete: true,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "max_players",
+					Description: "Cap the round; later accepts join a waitlist",
+					Required:    false,
+					MinValue:    &minPlayers,
+					MaxValue:    roundcapacity.MaxPlayersLimit,
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "cards",
+					Description: "Split players into cards of 3-5 when the round starts",
+					Required:    false,
+					Choices: []*discordgo.ApplicationCommandOptionChoice{
+						{Name: roundcards.ModeTagOrder.Label(), Value: string(roundcards.ModeTagOrder)},
+						{Name: roundcards.ModeRandom.Label(), Value: string(roundcards.ModeRandom)},
+						{Name: roundcards.ModeBalanced.Label(), Value: string(roundcards.ModeBalanced)},
+					},
+				},
+			},
+		},
+		RequiredPermission: interactions.PlayerRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+	}
+}
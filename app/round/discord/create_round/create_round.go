@@ -6,11 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	roundtemplate "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_template"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
@@ -34,10 +40,14 @@ type CreateRoundManager interface {
 	UpdateInteractionResponse(ctx context.Context, correlationID, message string, edit ...*discordgo.WebhookEdit) (CreateRoundOperationResult, error)
 	UpdateInteractionResponseWithRetryButton(ctx context.Context, correlationID, message string) (CreateRoundOperationResult, error)
 	HandleCreateRoundModalCancel(ctx context.Context, i *discordgo.InteractionCreate) (CreateRoundOperationResult, error)
-	SendRoundEventEmbed(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (CreateRoundOperationResult, error)
+	SendRoundEventEmbed(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options RoundEmbedOptions) (CreateRoundOperationResult, error)
+	EmbedOptions(ctx context.Context, correlationID string) RoundEmbedOptions
 	SendRoundEventURL(guildID string, channelID string, eventID string) (CreateRoundOperationResult, error)
 	SendCreateRoundModal(ctx context.Context, i *discordgo.InteractionCreate) (CreateRoundOperationResult, error)
 	HandleRetryCreateRound(ctx context.Context, i *discordgo.InteractionCreate) (CreateRoundOperationResult, error)
+	HandleStartTimeConfirmButton(ctx context.Context, i *discordgo.InteractionCreate) (CreateRoundOperationResult, error)
+	HandleStartTimeAdjustButton(ctx context.Context, i *discordgo.InteractionCreate) (CreateRoundOperationResult, error)
+	HandleRunItBackButton(ctx context.Context, i *discordgo.InteractionCreate) (CreateRoundOperationResult, error)
 	CreateNativeEvent(ctx context.Context, guildID string, roundID sharedtypes.RoundID, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, userID sharedtypes.DiscordID) (CreateRoundOperationResult, error)
 }
 
@@ -54,6 +64,12 @@ type createRoundManager struct {
 	operationWrapper    func(ctx context.Context, opName string, fn func(ctx context.Context) (CreateRoundOperationResult, error)) (CreateRoundOperationResult, error)
 	guildConfigResolver guildconfig.GuildConfigResolver
 	challengeValidator  ChallengeScheduleValidator
+	findTemplate        func(ctx context.Context, guildID, name string) (*roundtemplate.Template, error)
+	listRounds          roundautocomplete.RoundLister
+	notifier            notify.Notifier
+	// embedOptions keeps create requests' embed options until their rounds
+	// are created. Without it rounds are created without them.
+	embedOptions EmbedOptionsStore
 }
 
 // NewCreateRoundManager creates a new CreateRoundManager instance.
@@ -86,6 +102,9 @@ func NewCreateRoundManager(
 			return wrapCreateRoundOperation(ctx, opName, fn, logger, tracer, metrics)
 		},
 		guildConfigResolver: guildConfigResolver, // <-- Set field
+		findTemplate:        roundtemplate.NewTemplateFinder(config, publisher),
+		listRounds:          roundautocomplete.NewRoundLister(config, publisher),
+		notifier:            notify.NewNotifier(session, publisher, logger, config),
 	}
 }
 
@@ -170,6 +189,9 @@ type ChallengeScheduleValidator func(ctx context.Context, i *discordgo.Interacti
 type ModalConfig struct {
 	CustomID string
 	Title    string
+	// Prefill holds initial values for the modal's inputs, keyed by input
+	// custom ID.
+	Prefill map[string]string
 }
 
 type modalConfigContextKey struct{}
@@ -178,6 +200,10 @@ const (
 	defaultCreateRoundModalID    = "create_round_modal"
 	defaultCreateRoundModalTitle = "Create Round"
 	challengeScheduleModalPrefix = defaultCreateRoundModalID + "|challenge_id="
+	seriesModalPrefix            = defaultCreateRoundModalID + "|series="
+	seriesModalTitle             = "Create Recurring Rounds"
+	cardModeModalSuffix          = "|cards="
+	maxPlayersModalSuffix        = "|max_players="
 )
 
 func WithModalConfig(ctx context.Context, cfg ModalConfig) context.Context {
@@ -194,6 +220,80 @@ func ChallengeScheduleModalCustomID(challengeID string) string {
 	return challengeScheduleModalPrefix + challengeID
 }
 
+// SeriesModalCustomID returns the create round modal custom ID for a series
+// repeating at recurrence through until.
+func SeriesModalCustomID(recurrence roundseries.Recurrence, until time.Time) string {
+	return seriesModalPrefix + string(recurrence) + "," + until.Format(roundseries.UntilLayout)
+}
+
+// withCardMode adds a card mode to a create round modal custom ID. It must be
+// added before any player cap.
+func withCardMode(customID string, mode roundcards.Mode) string {
+	if mode == "" {
+		return customID
+	}
+	return customID + cardModeModalSuffix + string(mode)
+}
+
+// cardModeFromCustomID splits the card mode off a create round modal custom
+// ID, dropping any player cap, and returns "" when the round has none.
+func cardModeFromCustomID(customID string) (string, roundcards.Mode) {
+	customID, _ = maxPlayersFromCustomID(customID)
+	idx := strings.LastIndex(customID, cardModeModalSuffix)
+	if idx == -1 {
+		return customID, ""
+	}
+	mode, ok := roundcards.ParseMode(customID[idx+len(cardModeModalSuffix):])
+	if !ok {
+		return customID, ""
+	}
+	return customID[:idx], mode
+}
+
+// withMaxPlayers adds a player cap to a create round modal custom ID.
+func withMaxPlayers(customID string, maxPlayers int) string {
+	if maxPlayers <= 0 {
+		return customID
+	}
+	return customID + maxPlayersModalSuffix + strconv.Itoa(maxPlayers)
+}
+
+// maxPlayersFromCustomID splits the player cap off a create round modal
+// custom ID, returning 0 when the round has none.
+func maxPlayersFromCustomID(customID string) (string, int) {
+	idx := strings.LastIndex(customID, maxPlayersModalSuffix)
+	if idx == -1 {
+		return customID, 0
+	}
+	maxPlayers, err := strconv.Atoi(customID[idx+len(maxPlayersModalSuffix):])
+	if err != nil || maxPlayers <= 0 {
+		return customID, 0
+	}
+	return customID[:idx], maxPlayers
+}
+
+// seriesFromCustomID reads the recurrence and last date back out of a series
+// modal custom ID.
+func seriesFromCustomID(customID string) (roundseries.Recurrence, time.Time, bool) {
+	customID, _ = cardModeFromCustomID(customID)
+	if !strings.HasPrefix(customID, seriesModalPrefix) {
+		return "", time.Time{}, false
+	}
+	recurrenceValue, untilValue, found := strings.Cut(strings.TrimPrefix(customID, seriesModalPrefix), ",")
+	if !found {
+		return "", time.Time{}, false
+	}
+	recurrence, err := roundseries.ParseRecurrence(recurrenceValue)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	until, err := time.Parse(roundseries.UntilLayout, untilValue)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return recurrence, until, true
+}
+
 func modalConfigFromContext(ctx context.Context) ModalConfig {
 	cfg, _ := ctx.Value(modalConfigContextKey{}).(ModalConfig)
 	if cfg.CustomID == "" {
@@ -206,6 +306,7 @@ func modalConfigFromContext(ctx context.Context) ModalConfig {
 }
 
 func challengeScheduleIDFromCustomID(customID string) string {
+	customID, _ = cardModeFromCustomID(customID)
 	if strings.HasPrefix(customID, challengeScheduleModalPrefix) {
 		if id := strings.TrimPrefix(customID, challengeScheduleModalPrefix); id != "" {
 			return id
@@ -221,6 +322,12 @@ func (crm *createRoundManager) SetChallengeScheduleValidator(validator Challenge
 	crm.challengeValidator = validator
 }
 
+// guildTimezone returns the timezone start times are read in when the modal
+// leaves it blank.
+func (crm *createRoundManager) guildTimezone(ctx context.Context, guildID string) string {
+	return guildconfig.GuildTimezone(ctx, crm.logger, crm.guildConfigResolver, guildID)
+}
+
 // createEvent creates and marshals a Watermill message and assigns a correlation ID.
 func (crm *createRoundManager) createEvent(ctx context.Context, topic string, payload interface{}, i *discordgo.InteractionCreate) (*message.Message, string, error) {
 	correlationID := watermill.NewUUID()
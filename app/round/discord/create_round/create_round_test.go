@@ -10,6 +10,7 @@ import (
 	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"go.opentelemetry.io/otel/trace/noop"
@@ -187,3 +188,35 @@ func Test_wrapCreateRoundOperation(t *testing.T) {
 		})
 	}
 }
+
+func Test_maxPlayersFromCustomID(t *testing.T) {
+	base, maxPlayers := maxPlayersFromCustomID("create_round_modal|series=weekly,2099-06-30|max_players=12")
+	if base != "create_round_modal|series=weekly,2099-06-30" || maxPlayers != 12 {
+		t.Fatalf("maxPlayersFromCustomID() = %q, %d", base, maxPlayers)
+	}
+	if _, _, ok := seriesFromCustomID(withMaxPlayers(SeriesModalCustomID("weekly", time.Date(2099, 6, 30, 0, 0, 0, 0, time.UTC)), 12)); !ok {
+		t.Fatal("expected a capped series custom ID to still read as a series")
+	}
+	if _, maxPlayers := maxPlayersFromCustomID(defaultCreateRoundModalID); maxPlayers != 0 {
+		t.Fatalf("uncapped custom ID read as %d players", maxPlayers)
+	}
+	if got := challengeScheduleIDFromCustomID(withMaxPlayers(ChallengeScheduleModalCustomID("challenge-1"), 4)); got != "challenge-1" {
+		t.Fatalf("challengeScheduleIDFromCustomID() = %q", got)
+	}
+}
+
+func Test_cardModeFromCustomID(t *testing.T) {
+	customID := withMaxPlayers(withCardMode(SeriesModalCustomID("weekly", time.Date(2099, 6, 30, 0, 0, 0, 0, time.UTC)), roundcards.ModeRandom), 12)
+	if _, mode := cardModeFromCustomID(customID); mode != roundcards.ModeRandom {
+		t.Fatalf("cardModeFromCustomID() mode = %q", mode)
+	}
+	if _, maxPlayers := maxPlayersFromCustomID(customID); maxPlayers != 12 {
+		t.Fatalf("maxPlayersFromCustomID() = %d, want 12", maxPlayers)
+	}
+	if _, _, ok := seriesFromCustomID(customID); !ok {
+		t.Fatal("expected a series with cards to still read as a series")
+	}
+	if _, mode := cardModeFromCustomID(defaultCreateRoundModalID + "|cards=nope"); mode != "" {
+		t.Fatalf("unknown card mode read as %q", mode)
+	}
+}
@@ -2,16 +2,99 @@ package createround
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	roundcapacity "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_capacity"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/bwmarrin/discordgo"
+	"github.com/nats-io/nats.go/jetstream"
 )
 
-func (crm *createRoundManager) SendRoundEventEmbed(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (CreateRoundOperationResult, error) {
+// Embed options are kept in a JetStream bucket under the correlation ID of
+// their create request, which the backend puts on the round created event as
+// it does on the create failure events. The bucket expires them once the
+// round has long been created.
+const (
+	embedOptionsBucket = "round-create-options"
+	embedOptionsTTL    = 24 * time.Hour
+)
+
+// RoundEmbedOptions are the settings of a new round's embed that come from
+// its create request rather than from the backend round.
+type RoundEmbedOptions struct {
+	// MaxPlayers caps the round; 0 means no cap.
+	MaxPlayers int `json:"max_players,omitempty"`
+	// CardMode is how the round is split into cards; "" means it isn't.
+	CardMode roundcards.Mode `json:"card_mode,omitempty"`
+	// RunItBack is the finalized round the new round runs back, if any.
+	RunItBack RunItBack `json:"run_it_back"`
+}
+
+// EmbedOptionsStore keeps the embed options of create requests by their
+// correlation IDs until the rounds' embeds are posted.
+type EmbedOptionsStore = storage.ISInterface[RoundEmbedOptions]
+
+// NewEmbedOptionsStore opens the JetStream bucket embed options are kept in,
+// so they still reach the embed when a restarted or standby worker takes the
+// round created event.
+func NewEmbedOptionsStore(ctx context.Context, js jetstream.JetStream) (EmbedOptionsStore, error) {
+	store, err := storage.NewKVStore[RoundEmbedOptions](ctx, js, embedOptionsBucket, embedOptionsTTL)
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// SetEmbedOptionsStore sets the store create requests keep their embed
+// options in.
+func (crm *createRoundManager) SetEmbedOptionsStore(store EmbedOptionsStore) {
+	crm.embedOptions = store
+}
+
+// keepEmbedOptions stores the embed options of the create request with
+// correlationID. Requests without options store nothing.
+func (crm *createRoundManager) keepEmbedOptions(ctx context.Context, correlationID string, options RoundEmbedOptions) error {
+	if options == (RoundEmbedOptions{}) {
+		return nil
+	}
+	if crm.embedOptions == nil {
+		crm.logger.WarnContext(ctx, "No store for round embed options; the round is created without them",
+			attr.String("correlation_id", correlationID))
+		return nil
+	}
+	if err := crm.embedOptions.Set(ctx, correlationID, options); err != nil {
+		return fmt.Errorf("failed to store round embed options: %w", err)
+	}
+	return nil
+}
+
+// EmbedOptions returns the embed options of the create request with
+// correlationID, or none when it had none. They are left for the bucket to
+// expire, so a redelivered round created event still finds them.
+func (crm *createRoundManager) EmbedOptions(ctx context.Context, correlationID string) RoundEmbedOptions {
+	if crm.embedOptions == nil || correlationID == "" {
+		return RoundEmbedOptions{}
+	}
+	options, err := crm.embedOptions.Get(ctx, correlationID)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			crm.logger.WarnContext(ctx, "Failed to load round embed options",
+				attr.String("correlation_id", correlationID),
+				attr.Error(err))
+		}
+		return RoundEmbedOptions{}
+	}
+	return options
+}
+
+func (crm *createRoundManager) SendRoundEventEmbed(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options RoundEmbedOptions) (CreateRoundOperationResult, error) {
 	return crm.operationWrapper(context.Background(), "SendRoundEventEmbed", func(ctx context.Context) (CreateRoundOperationResult, error) {
 		// Validate channel type to debug HTTP 405 errors
 		channel, err := crm.session.GetChannel(channelID)
@@ -48,9 +131,24 @@ func (crm *createRoundManager) SendRoundEventEmbed(guildID string, channelID str
 			creatorName = member.Nick
 		}
 
+		embedDescription := string(description)
+		var previous previousRound
+		runningBack := false
+		if options.RunItBack.RoundID != "" {
+			loaded, err := crm.loadPreviousRound(guildID, options.RunItBack)
+			if err != nil {
+				crm.logger.WarnContext(ctx, "Failed to load round to run back",
+					attr.String("round_id", options.RunItBack.RoundID),
+					attr.Error(err))
+			} else {
+				previous, runningBack = loaded, true
+				embedDescription = runItBackDescription(description, previous)
+			}
+		}
+
 		embed := &discordgo.MessageEmbed{
 			Title:       fmt.Sprintf("**%s**", string(title)),
-			Description: string(description),
+			Description: embedDescription,
 			Color:       0xFF0000,
 			Fields: []*discordgo.MessageEmbedField{
 				{
@@ -66,13 +164,13 @@ func (crm *createRoundManager) SendRoundEventEmbed(guildID string, channelID str
 				// {Name: "❌ Declined", Value: "-", Inline: true},
 				// {Name: "🤔 Tentative", Value: "-", Inline: true},
 				{
-					Name:   "👥 Participants",
+					Name:   roundcapacity.FieldName("👥 Participants", 0, options.MaxPlayers),
 					Value:  "-",
 					Inline: false,
 				},
 			},
 			Footer: &discordgo.MessageEmbedFooter{
-				Text: fmt.Sprintf("Created by %s", creatorName),
+				Text: roundcards.Footer(fmt.Sprintf("Created by %s", creatorName), options.CardMode),
 			},
 			Timestamp: timeValue.Format(time.RFC3339),
 		}
@@ -102,6 +200,21 @@ func (crm *createRoundManager) SendRoundEventEmbed(guildID string, channelID str
 			return CreateRoundOperationResult{Error: fmt.Errorf("failed to send embed message: %w", err)}, nil
 		}
 
+		if runningBack {
+			crm.rsvpPreviousPlayers(ctx, guildID, channelID, msg.ID, roundID, creatorID, previous)
+		}
+
+		if crm.notifier != nil {
+			content := fmt.Sprintf("📅 New round: **%s** <t:%d:f> (<t:%d:R>) at %s, posted by %s. RSVP on the round:\nhttps://discord.com/channels/%s/%s/%s",
+				title, unixTimestamp, unixTimestamp, location, creatorName, guildID, channelID, msg.ID)
+			crm.notifier.Notify(ctx, notify.Alert{
+				GuildID:  guildID,
+				Category: notify.NewRounds,
+				Exclude:  []string{string(creatorID)},
+				Content:  content,
+			})
+		}
+
 		return CreateRoundOperationResult{Success: msg}, nil
 	})
 }
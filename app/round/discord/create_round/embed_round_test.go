@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
@@ -111,6 +115,7 @@ func Test_createRoundManager_SendRoundEventEmbed(t *testing.T) {
 				roundtypes.Location("Test Park"),
 				sharedtypes.DiscordID("user-123"),
 				sharedtypes.RoundID(uuid.New()),
+				RoundEmbedOptions{},
 			)
 
 			if tt.expectedErr {
@@ -143,6 +148,108 @@ func Test_createRoundManager_SendRoundEventEmbed(t *testing.T) {
 	}
 }
 
+func Test_createRoundManager_SendRoundEventEmbed_NotifiesNewRound(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	fakeSession.UserFunc = func(userID string, options ...discordgo.RequestOption) (*discordgo.User, error) {
+		return &discordgo.User{ID: userID, Username: "TestUser"}, nil
+	}
+	fakeSession.GuildMemberFunc = func(guildID, userID string, options ...discordgo.RequestOption) (*discordgo.Member, error) {
+		return &discordgo.Member{Nick: "NickName"}, nil
+	}
+	fakeSession.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return &discordgo.Message{ID: "msg-1"}, nil
+	}
+	fakeNotifier := &notify.FakeNotifier{}
+	manager := &createRoundManager{
+		session:  fakeSession,
+		logger:   slog.Default(),
+		notifier: fakeNotifier,
+		operationWrapper: func(ctx context.Context, name string, fn func(context.Context) (CreateRoundOperationResult, error)) (CreateRoundOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	if _, err := manager.SendRoundEventEmbed("guild-id", "channel-123", "League Night", "", sharedtypes.StartTime(time.Date(2099, 6, 1, 18, 0, 0, 0, time.UTC)),
+		"Pier Park", "user-123", sharedtypes.RoundID(uuid.New()), RoundEmbedOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := notify.Alert{
+		GuildID:  "guild-id",
+		Category: notify.NewRounds,
+		Exclude:  []string{"user-123"},
+		Content: "📅 New round: **League Night** <t:4084020000:f> (<t:4084020000:R>) at Pier Park, posted by NickName. RSVP on the round:\n" +
+			"https://discord.com/channels/guild-id/channel-123/msg-1",
+	}
+	if len(fakeNotifier.Alerts) != 1 || !reflect.DeepEqual(fakeNotifier.Alerts[0], want) {
+		t.Fatalf("alerts = %+v, want %+v", fakeNotifier.Alerts, want)
+	}
+}
+
+func Test_createRoundManager_SendRoundEventEmbed_MaxPlayers(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	var sent []*discordgo.MessageSend
+	fakeSession.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		sent = append(sent, data)
+		return &discordgo.Message{ID: "msg-1"}, nil
+	}
+
+	manager := &createRoundManager{
+		session:          fakeSession,
+		operationWrapper: testOperationWrapper,
+		logger:           slog.Default(),
+	}
+
+	for _, options := range []RoundEmbedOptions{{MaxPlayers: 20, CardMode: roundcards.ModeBalanced}, {}} {
+		if _, err := manager.SendRoundEventEmbed("guild-id", "channel-123", "League Night", "", sharedtypes.StartTime(time.Date(2099, 3, 14, 15, 0, 0, 0, time.UTC)), "Test Park", "user-123", sharedtypes.RoundID(uuid.New()), options); err != nil {
+			t.Fatalf("SendRoundEventEmbed() error = %v", err)
+		}
+	}
+
+	if got := sent[0].Embeds[0].Fields[2].Name; got != "👥 Participants (0/20)" {
+		t.Fatalf("capped round participants field = %q", got)
+	}
+	if got := sent[1].Embeds[0].Fields[2].Name; got != "👥 Participants" {
+		t.Fatalf("a round without a cap should show no count, got %q", got)
+	}
+	if got := roundcards.ModeFromFooter(sent[0].Embeds[0].Footer.Text); got != roundcards.ModeBalanced {
+		t.Fatalf("card mode in footer = %q, want balanced", got)
+	}
+	if got := roundcards.ModeFromFooter(sent[1].Embeds[0].Footer.Text); got != "" {
+		t.Fatalf("a round without cards should show no card mode, got %q", got)
+	}
+}
+
+func TestCreateRoundManager_EmbedOptions(t *testing.T) {
+	crm := &createRoundManager{
+		logger:       testutils.NoOpLogger(),
+		embedOptions: testutils.NewFakeStorage[RoundEmbedOptions](),
+	}
+	ctx := context.Background()
+	options := RoundEmbedOptions{MaxPlayers: 12, CardMode: roundcards.ModeRandom}
+
+	if err := crm.keepEmbedOptions(ctx, "correlation-1", options); err != nil {
+		t.Fatalf("keepEmbedOptions() error = %v", err)
+	}
+	if got := crm.EmbedOptions(ctx, "correlation-1"); got != options {
+		t.Fatalf("EmbedOptions() = %+v, want %+v", got, options)
+	}
+	// A redelivered created event still finds them.
+	if got := crm.EmbedOptions(ctx, "correlation-1"); got != options {
+		t.Fatalf("EmbedOptions() on redelivery = %+v, want %+v", got, options)
+	}
+	if got := crm.EmbedOptions(ctx, "correlation-2"); got != (RoundEmbedOptions{}) {
+		t.Fatalf("expected no options for another request, got %+v", got)
+	}
+
+	if err := crm.keepEmbedOptions(ctx, "correlation-3", RoundEmbedOptions{}); err != nil {
+		t.Fatalf("keepEmbedOptions() without options error = %v", err)
+	}
+	if calls := crm.embedOptions.(*testutils.FakeStorage[RoundEmbedOptions]).GetCalls(); len(calls) != 4 || calls[0] != "Set" {
+		t.Fatalf("expected a request without options to store nothing, got calls %v", calls)
+	}
+}
+
 func Test_createRoundManager_SendRoundEventURL(t *testing.T) {
 	tests := []struct {
 		name          string
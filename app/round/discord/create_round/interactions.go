@@ -3,7 +3,11 @@ package createround
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/discordutils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/utils"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
@@ -23,6 +27,57 @@ func (crm *createRoundManager) HandleCreateRoundCommand(ctx context.Context, i *
 	go utils.PublishUserProfile(context.WithoutCancel(ctx), crm.publisher, crm.logger, i.Member.User, i.Member, i.GuildID)
 
 	return crm.operationWrapper(ctx, "handle_create_round_command", func(ctx context.Context) (CreateRoundOperationResult, error) {
+		cfg, problem := seriesModalConfig(i)
+		if problem != "" {
+			err := crm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{
+					Content: "❌ " + problem,
+					Flags:   discordgo.MessageFlagsEphemeral,
+				},
+			})
+			if err != nil {
+				return CreateRoundOperationResult{Error: err}, err
+			}
+			return CreateRoundOperationResult{Failure: problem}, nil
+		}
+		prefill, problem, err := crm.templatePrefill(ctx, i)
+		if err != nil {
+			crm.logger.ErrorContext(ctx, "Failed to load round template", attr.Error(err), attr.String("guild_id", i.GuildID))
+			problem = "Couldn't load that round template right now. Please try again."
+		}
+		if problem != "" {
+			err := crm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Data: &discordgo.InteractionResponseData{
+					Content: "❌ " + problem,
+					Flags:   discordgo.MessageFlagsEphemeral,
+				},
+			})
+			if err != nil {
+				return CreateRoundOperationResult{Error: err}, err
+			}
+			return CreateRoundOperationResult{Failure: problem}, nil
+		}
+		if prefill != nil {
+			if cfg == nil {
+				cfg = &ModalConfig{}
+			}
+			cfg.Prefill = prefill
+		}
+		if mode, maxPlayers := cardModeOption(i), maxPlayersOption(i); mode != "" || maxPlayers > 0 {
+			if cfg == nil {
+				cfg = &ModalConfig{}
+			}
+			if cfg.CustomID == "" {
+				cfg.CustomID = defaultCreateRoundModalID
+			}
+			cfg.CustomID = withMaxPlayers(withCardMode(cfg.CustomID, mode), maxPlayers)
+		}
+		if cfg != nil {
+			ctx = WithModalConfig(ctx, *cfg)
+		}
+
 		result, err := crm.SendCreateRoundModal(ctx, i)
 		if err != nil {
 			crm.logger.ErrorContext(ctx, "Failed to send create round modal", attr.Error(err), attr.UserID(sharedtypes.DiscordID(i.Member.User.ID)))
@@ -151,3 +206,120 @@ func (crm *createRoundManager) UpdateInteractionResponseWithRetryButton(ctx cont
 		return CreateRoundOperationResult{Success: "response updated with retry"}, nil
 	})
 }
+
+// seriesModalConfig reads the repeat and until options. It returns the modal
+// config for a series, nil for a one-off round, or a problem to show the user.
+func seriesModalConfig(i *discordgo.InteractionCreate) (*ModalConfig, string) {
+	data, ok := i.Data.(discordgo.ApplicationCommandInteractionData)
+	if !ok {
+		return nil, ""
+	}
+
+	var repeatValue, untilValue string
+	for _, option := range data.Options {
+		switch option.Name {
+		case "repeat":
+			repeatValue = option.StringValue()
+		case "until":
+			untilValue = strings.TrimSpace(option.StringValue())
+		}
+	}
+	if repeatValue == "" {
+		if untilValue != "" {
+			return nil, "The until option only applies with repeat."
+		}
+		return nil, ""
+	}
+
+	recurrence, err := roundseries.ParseRecurrence(repeatValue)
+	if err != nil {
+		return nil, "Repeat must be weekly or biweekly."
+	}
+	if untilValue == "" {
+		return nil, "Recurring rounds need an until date (YYYY-MM-DD)."
+	}
+	until, err := time.Parse(roundseries.UntilLayout, untilValue)
+	if err != nil {
+		return nil, "Until must be a date in the format YYYY-MM-DD."
+	}
+	now := time.Now().UTC()
+	if until.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)) {
+		return nil, "Until must not be in the past."
+	}
+
+	return &ModalConfig{
+		CustomID: SeriesModalCustomID(recurrence, until),
+		Title:    seriesModalTitle,
+	}, ""
+}
+
+// maxPlayersOption returns the max_players option, or 0 when it wasn't given.
+func maxPlayersOption(i *discordgo.InteractionCreate) int {
+	data, ok := i.Data.(discordgo.ApplicationCommandInteractionData)
+	if !ok {
+		return 0
+	}
+	for _, option := range data.Options {
+		if option.Name == "max_players" {
+			return int(option.IntValue())
+		}
+	}
+	return 0
+}
+
+// cardModeOption returns the cards option, or "" when it wasn't given.
+func cardModeOption(i *discordgo.InteractionCreate) roundcards.Mode {
+	data, ok := i.Data.(discordgo.ApplicationCommandInteractionData)
+	if !ok {
+		return ""
+	}
+	for _, option := range data.Options {
+		if option.Name == "cards" {
+			mode, _ := roundcards.ParseMode(option.StringValue())
+			return mode
+		}
+	}
+	return ""
+}
+
+// templatePrefill reads the template option and returns the modal values it
+// fills in, nil when no template was chosen, or a problem to show the user.
+func (crm *createRoundManager) templatePrefill(ctx context.Context, i *discordgo.InteractionCreate) (map[string]string, string, error) {
+	data, ok := i.Data.(discordgo.ApplicationCommandInteractionData)
+	if !ok {
+		return nil, "", nil
+	}
+
+	var name string
+	for _, option := range data.Options {
+		if option.Name == "template" {
+			name = strings.TrimSpace(option.StringValue())
+		}
+	}
+	if name == "" {
+		return nil, "", nil
+	}
+	if crm.findTemplate == nil {
+		return nil, "Round templates aren't available yet.", nil
+	}
+
+	template, err := crm.findTemplate(ctx, i.GuildID, name)
+	if err != nil {
+		return nil, "", err
+	}
+	if template == nil {
+		return nil, fmt.Sprintf("No round template named %q. See `/roundtemplate list`.", name), nil
+	}
+
+	prefill := map[string]string{
+		"description": template.Description,
+		"timezone":    template.Timezone,
+		"location":    template.Location,
+	}
+	if template.StartTime != "" {
+		if startTime, ok := template.NextStart(time.Now(), crm.guildTimezone(ctx, i.GuildID)); ok {
+			prefill["start_time"] = startTime
+		}
+	}
+	return prefill, "", nil
+}
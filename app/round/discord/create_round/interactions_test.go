@@ -6,8 +6,11 @@ import (
 	"log/slog"
 	"strings"
 	"testing"
+	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	roundtemplate "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_template"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/bwmarrin/discordgo"
@@ -86,6 +89,260 @@ func Test_createRoundManager_HandleCreateRoundCommand(t *testing.T) {
 	}
 }
 
+func Test_createRoundManager_HandleCreateRoundCommand_SeriesOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		options       []*discordgo.ApplicationCommandInteractionDataOption
+		wantCustomID  string
+		wantTitle     string
+		wantEphemeral string
+	}{
+		{
+			name:         "one-off round",
+			wantCustomID: defaultCreateRoundModalID,
+			wantTitle:    defaultCreateRoundModalTitle,
+		},
+		{
+			name: "weekly series",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "repeat", Type: discordgo.ApplicationCommandOptionString, Value: "weekly"},
+				{Name: "until", Type: discordgo.ApplicationCommandOptionString, Value: "2099-06-30"},
+			},
+			wantCustomID: "create_round_modal|series=weekly,2099-06-30",
+			wantTitle:    seriesModalTitle,
+		},
+		{
+			name: "capped round",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "max_players", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(20)},
+			},
+			wantCustomID: "create_round_modal|max_players=20",
+			wantTitle:    defaultCreateRoundModalTitle,
+		},
+		{
+			name: "capped series",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "repeat", Type: discordgo.ApplicationCommandOptionString, Value: "weekly"},
+				{Name: "until", Type: discordgo.ApplicationCommandOptionString, Value: "2099-06-30"},
+				{Name: "max_players", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(12)},
+			},
+			wantCustomID: "create_round_modal|series=weekly,2099-06-30|max_players=12",
+			wantTitle:    seriesModalTitle,
+		},
+		{
+			name: "capped round with cards",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "max_players", Type: discordgo.ApplicationCommandOptionInteger, Value: float64(20)},
+				{Name: "cards", Type: discordgo.ApplicationCommandOptionString, Value: "balanced"},
+			},
+			wantCustomID: "create_round_modal|cards=balanced|max_players=20",
+			wantTitle:    defaultCreateRoundModalTitle,
+		},
+		{
+			name: "repeat without until",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "repeat", Type: discordgo.ApplicationCommandOptionString, Value: "biweekly"},
+			},
+			wantEphemeral: "❌ Recurring rounds need an until date (YYYY-MM-DD).",
+		},
+		{
+			name: "until in the past",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "repeat", Type: discordgo.ApplicationCommandOptionString, Value: "weekly"},
+				{Name: "until", Type: discordgo.ApplicationCommandOptionString, Value: "2001-01-01"},
+			},
+			wantEphemeral: "❌ Until must not be in the past.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSession := discord.NewFakeSession()
+			var response *discordgo.InteractionResponse
+			fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+				response = r
+				return nil
+			}
+
+			crm := &createRoundManager{
+				session:          fakeSession,
+				logger:           slog.Default(),
+				operationWrapper: testOperationWrapper,
+			}
+
+			interaction := &discordgo.InteractionCreate{
+				Interaction: &discordgo.Interaction{
+					Type:   discordgo.InteractionApplicationCommand,
+					Member: &discordgo.Member{User: &discordgo.User{ID: "user-123"}},
+					Data: discordgo.ApplicationCommandInteractionData{
+						Name:    "createround",
+						Options: tt.options,
+					},
+				},
+			}
+
+			if _, err := crm.HandleCreateRoundCommand(context.Background(), interaction); err != nil {
+				t.Fatalf("HandleCreateRoundCommand() error = %v", err)
+			}
+			if response == nil {
+				t.Fatal("expected an interaction response")
+			}
+
+			if tt.wantEphemeral != "" {
+				if response.Data.Content != tt.wantEphemeral || response.Data.Flags != discordgo.MessageFlagsEphemeral {
+					t.Fatalf("expected ephemeral %q, got %+v", tt.wantEphemeral, response.Data)
+				}
+				return
+			}
+			if response.Type != discordgo.InteractionResponseModal {
+				t.Fatalf("expected modal response, got %v", response.Type)
+			}
+			if response.Data.CustomID != tt.wantCustomID || response.Data.Title != tt.wantTitle {
+				t.Fatalf("modal = %q/%q, want %q/%q", response.Data.CustomID, response.Data.Title, tt.wantCustomID, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func Test_createRoundManager_HandleCreateRoundCommand_Template(t *testing.T) {
+	leagueNight := &roundtemplate.Template{
+		Name:        "League Night",
+		Location:    "Pier Park",
+		Description: "Doubles, bring a partner",
+		StartTime:   "18:30",
+		Timezone:    "America/Los_Angeles",
+	}
+
+	tests := []struct {
+		name          string
+		options       []*discordgo.ApplicationCommandInteractionDataOption
+		findErr       error
+		held          bool
+		wantEphemeral string
+		wantCustomID  string
+	}{
+		{
+			name: "template pre-fills the modal",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "template", Type: discordgo.ApplicationCommandOptionString, Value: "league night"},
+			},
+			wantCustomID: defaultCreateRoundModalID,
+		},
+		{
+			name: "template with a series",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "repeat", Type: discordgo.ApplicationCommandOptionString, Value: "weekly"},
+				{Name: "until", Type: discordgo.ApplicationCommandOptionString, Value: "2099-06-30"},
+				{Name: "template", Type: discordgo.ApplicationCommandOptionString, Value: "League Night"},
+			},
+			wantCustomID: "create_round_modal|series=weekly,2099-06-30",
+		},
+		{
+			name: "unknown template",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "template", Type: discordgo.ApplicationCommandOptionString, Value: "Glow"},
+			},
+			wantEphemeral: "❌ No round template named \"Glow\". See `/roundtemplate list`.",
+		},
+		{
+			name: "template lookup fails",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "template", Type: discordgo.ApplicationCommandOptionString, Value: "League Night"},
+			},
+			findErr:       errors.New("timeout"),
+			wantEphemeral: "❌ Couldn't load that round template right now. Please try again.",
+		},
+		{
+			name: "templates held back",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: "template", Type: discordgo.ApplicationCommandOptionString, Value: "League Night"},
+			},
+			held:          true,
+			wantEphemeral: "❌ Round templates aren't available yet.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeSession := discord.NewFakeSession()
+			var response *discordgo.InteractionResponse
+			fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+				response = r
+				return nil
+			}
+
+			crm := &createRoundManager{
+				session:          fakeSession,
+				logger:           slog.Default(),
+				operationWrapper: testOperationWrapper,
+				findTemplate: func(ctx context.Context, guildID, name string) (*roundtemplate.Template, error) {
+					if guildID != "guild-1" {
+						t.Fatalf("unexpected guild %q", guildID)
+					}
+					if tt.findErr != nil {
+						return nil, tt.findErr
+					}
+					if strings.EqualFold(name, leagueNight.Name) {
+						return leagueNight, nil
+					}
+					return nil, nil
+				},
+			}
+			if tt.held {
+				crm.findTemplate = nil
+			}
+
+			interaction := &discordgo.InteractionCreate{
+				Interaction: &discordgo.Interaction{
+					Type:    discordgo.InteractionApplicationCommand,
+					GuildID: "guild-1",
+					Member:  &discordgo.Member{User: &discordgo.User{ID: "user-123"}},
+					Data: discordgo.ApplicationCommandInteractionData{
+						Name:    "createround",
+						Options: tt.options,
+					},
+				},
+			}
+
+			if _, err := crm.HandleCreateRoundCommand(context.Background(), interaction); err != nil {
+				t.Fatalf("HandleCreateRoundCommand() error = %v", err)
+			}
+			if response == nil {
+				t.Fatal("expected an interaction response")
+			}
+
+			if tt.wantEphemeral != "" {
+				if response.Data.Content != tt.wantEphemeral || response.Data.Flags != discordgo.MessageFlagsEphemeral {
+					t.Fatalf("expected ephemeral %q, got %+v", tt.wantEphemeral, response.Data)
+				}
+				return
+			}
+			if response.Type != discordgo.InteractionResponseModal || response.Data.CustomID != tt.wantCustomID {
+				t.Fatalf("expected modal %q, got %v %q", tt.wantCustomID, response.Type, response.Data.CustomID)
+			}
+
+			values := map[string]string{}
+			for _, row := range response.Data.Components {
+				input := row.(discordgo.ActionsRow).Components[0].(discordgo.TextInput)
+				values[input.CustomID] = input.Value
+			}
+			wantStart, _ := leagueNight.NextStart(time.Now(), guildconfig.DefaultTimezone)
+			want := map[string]string{
+				"title":       "",
+				"description": "Doubles, bring a partner",
+				"start_time":  wantStart,
+				"timezone":    "America/Los_Angeles",
+				"location":    "Pier Park",
+			}
+			for id, value := range want {
+				if values[id] != value {
+					t.Errorf("input %s = %q, want %q", id, values[id], value)
+				}
+			}
+		})
+	}
+}
+
 func Test_createRoundManager_HandleRetryCreateRound(t *testing.T) {
 	tests := []struct {
 		name                        string
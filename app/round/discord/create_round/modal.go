@@ -3,13 +3,18 @@ package createround
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	roundtime "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_time"
 	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill"
 	"github.com/bwmarrin/discordgo"
 )
 
@@ -66,6 +71,7 @@ func (crm *createRoundManager) SendCreateRoundModal(ctx context.Context, i *disc
 								Placeholder: "Enter the round title",
 								Required:    true,
 								MaxLength:   100,
+								Value:       modalConfig.Prefill["title"],
 							},
 						},
 					},
@@ -78,6 +84,7 @@ func (crm *createRoundManager) SendCreateRoundModal(ctx context.Context, i *disc
 								Placeholder: "Enter a description (optional)",
 								Required:    false,
 								MaxLength:   500,
+								Value:       modalConfig.Prefill["description"],
 							},
 						},
 					},
@@ -85,11 +92,12 @@ func (crm *createRoundManager) SendCreateRoundModal(ctx context.Context, i *disc
 						Components: []discordgo.MessageComponent{
 							discordgo.TextInput{
 								CustomID:    "start_time",
-								Label:       "Start Time (YYYY-MM-DD HH:MM)",
+								Label:       "Start Time",
 								Style:       discordgo.TextInputShort,
-								Placeholder: "YYYY-MM-DD HH:MM",
+								Placeholder: "YYYY-MM-DD HH:MM, or e.g. tomorrow 6pm",
 								Required:    true,
 								MaxLength:   30,
+								Value:       modalConfig.Prefill["start_time"],
 							},
 						},
 					},
@@ -97,11 +105,12 @@ func (crm *createRoundManager) SendCreateRoundModal(ctx context.Context, i *disc
 						Components: []discordgo.MessageComponent{
 							discordgo.TextInput{
 								CustomID:    "timezone",
-								Label:       "Timezone (Optional Default: CST)",
+								Label:       "Timezone (Optional)",
 								Style:       discordgo.TextInputShort,
-								Placeholder: "America/Chicago (CST)",
+								Placeholder: "Defaults to the server timezone",
 								Required:    false,
 								MaxLength:   50,
+								Value:       modalConfig.Prefill["timezone"],
 							},
 						},
 					},
@@ -114,6 +123,7 @@ func (crm *createRoundManager) SendCreateRoundModal(ctx context.Context, i *disc
 								Placeholder: "Enter the location",
 								Required:    true,
 								MaxLength:   100,
+								Value:       modalConfig.Prefill["location"],
 							},
 						},
 					},
@@ -183,9 +193,9 @@ func (crm *createRoundManager) HandleCreateRoundModalSubmit(ctx context.Context,
 			attr.String("timezone", timezone),
 			attr.String("location", string(location)))
 
-		// Set default timezone to CST if the user didn't provide one
+		// Fall back to the server's timezone if the user didn't provide one
 		if timezone == "" {
-			timezone = "America/Chicago" // CST
+			timezone = crm.guildTimezone(ctx, i.GuildID)
 		}
 
 		// Basic validation (check required fields and length)
@@ -206,6 +216,31 @@ func (crm *createRoundManager) HandleCreateRoundModalSubmit(ctx context.Context,
 			validationErrors = append(validationErrors, "Location must be less than 100 characters.")
 		}
 
+		// Phrases like "tomorrow 6pm" are resolved in the round's timezone and
+		// previewed for confirmation before anything is published.
+		rawStartTime := startTimeStr
+		preview := false
+		if startTimeStr != "" && !roundtime.Exact(startTimeStr) {
+			if loc, err := time.LoadLocation(timezone); err != nil {
+				validationErrors = append(validationErrors, "Timezone is not recognised.")
+			} else if start, err := roundtime.Parse(startTimeStr, time.Now(), loc); err != nil {
+				validationErrors = append(validationErrors, err.Error())
+			} else {
+				startTimeStr = start.Format(roundtime.Layout)
+				preview = true
+			}
+		}
+
+		var series *roundseries.Series
+		if recurrence, until, ok := seriesFromCustomID(data.CustomID); ok && startTimeStr != "" && len(validationErrors) == 0 {
+			planned, problem := newSeries(i.GuildID, title, startTimeStr, timezone, recurrence, until)
+			if problem != "" {
+				validationErrors = append(validationErrors, problem)
+			} else {
+				series = &planned
+			}
+		}
+
 		// If there are validation errors, respond once
 		if len(validationErrors) > 0 {
 			errorMessage := "❌ Round creation failed: " + strings.Join(validationErrors, " ")
@@ -224,90 +259,200 @@ func (crm *createRoundManager) HandleCreateRoundModalSubmit(ctx context.Context,
 			return CreateRoundOperationResult{Error: validationErr}, validationErr
 		}
 
-		// Acknowledge receipt of the modal submission
-		err := crm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-			Type: discordgo.InteractionResponseChannelMessageWithSource,
-			Data: &discordgo.InteractionResponseData{
-				Content:    "Round creation request received",
-				Flags:      discordgo.MessageFlagsEphemeral,
-				Components: []discordgo.MessageComponent{},
-			},
-		})
-		if err != nil {
-			acknowledgeErr := fmt.Errorf("failed to acknowledge submission: %w", err)
-			return CreateRoundOperationResult{Error: acknowledgeErr}, acknowledgeErr
+		submission := roundSubmission{
+			CustomID:     data.CustomID,
+			Title:        title,
+			Description:  string(description),
+			StartTime:    startTimeStr,
+			RawStartTime: rawStartTime,
+			Timezone:     timezone,
+			Location:     string(location),
 		}
+		if preview {
+			return crm.sendStartTimePreview(ctx, i, submission)
+		}
+		return crm.submitRound(ctx, i, userID, submission, series, discordgo.InteractionResponseChannelMessageWithSource)
+	})
+}
+
+// roundSubmission is a validated create round modal submission.
+type roundSubmission struct {
+	CustomID    string
+	Title       string
+	Description string
+	// StartTime is in roundtime.Layout; RawStartTime is what the user typed.
+	StartTime    string
+	RawStartTime string
+	Timezone     string
+	Location     string
+}
+
+// submitRound acknowledges a validated submission with a response of
+// responseType and publishes its creation requests, one per series round.
+func (crm *createRoundManager) submitRound(ctx context.Context, i *discordgo.InteractionCreate, userID string, submission roundSubmission, series *roundseries.Series, responseType discordgo.InteractionResponseType) (CreateRoundOperationResult, error) {
+	title := submission.Title
+	description := roundtypes.Description(submission.Description)
+	startTimeStr := submission.StartTime
+	timezone := submission.Timezone
+	location := roundtypes.Location(submission.Location)
+
+	ack := "Round creation request received"
+	if series != nil {
+		first, last := series.Occurrences[0].StartTime, series.Occurrences[len(series.Occurrences)-1].StartTime
+		ack = fmt.Sprintf("Round creation request received for %d rounds (%s, %s – %s)",
+			len(series.Occurrences), series.Recurrence.Label(), first.Format("Jan 2"), last.Format("Jan 2"))
+	}
+
+	// Acknowledge receipt of the modal submission
+	err := crm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: responseType,
+		Data: &discordgo.InteractionResponseData{
+			Content:    ack,
+			Flags:      discordgo.MessageFlagsEphemeral,
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+	if err != nil {
+		acknowledgeErr := fmt.Errorf("failed to acknowledge submission: %w", err)
+		return CreateRoundOperationResult{Error: acknowledgeErr}, acknowledgeErr
+	}
 
-		if challengeID := challengeScheduleIDFromCustomID(data.CustomID); challengeID != "" {
-			if validationErr := crm.validateChallengeScheduleSubmission(ctx, i, challengeID); validationErr != nil {
-				errorMessage := "❌ Round creation failed: " + validationErr.Error()
-				if editErr := crm.updateCurrentInteractionResponse(i, errorMessage); editErr != nil {
-					return CreateRoundOperationResult{Error: fmt.Errorf("failed to update challenge validation response: %w", editErr)}, fmt.Errorf("failed to update challenge validation response: %w", editErr)
-				}
-				return CreateRoundOperationResult{Error: validationErr}, nil
+	if challengeID := challengeScheduleIDFromCustomID(submission.CustomID); challengeID != "" {
+		if validationErr := crm.validateChallengeScheduleSubmission(ctx, i, challengeID); validationErr != nil {
+			errorMessage := "❌ Round creation failed: " + validationErr.Error()
+			if editErr := crm.updateCurrentInteractionResponse(i, errorMessage); editErr != nil {
+				return CreateRoundOperationResult{Error: fmt.Errorf("failed to update challenge validation response: %w", editErr)}, fmt.Errorf("failed to update challenge validation response: %w", editErr)
 			}
+			return CreateRoundOperationResult{Error: validationErr}, nil
 		}
+	}
 
-		// Lookup the event channel ID from guildconfig
-		var eventChannelID string
-		if crm.guildConfigResolver != nil {
-			guildConfig, err := crm.guildConfigResolver.GetGuildConfigWithContext(ctx, i.GuildID)
-			if err == nil && guildConfig != nil && guildConfig.EventChannelID != "" {
-				eventChannelID = guildConfig.EventChannelID
-			} else {
-				crm.logger.WarnContext(ctx, "Failed to resolve event channel ID, falling back to interaction channel", attr.Error(err))
-				eventChannelID = i.ChannelID
-			}
+	// Lookup the event channel ID from guildconfig
+	var eventChannelID string
+	if crm.guildConfigResolver != nil {
+		guildConfig, err := crm.guildConfigResolver.GetGuildConfigWithContext(ctx, i.GuildID)
+		if err == nil && guildConfig != nil && guildConfig.EventChannelID != "" {
+			eventChannelID = guildConfig.EventChannelID
 		} else {
+			crm.logger.WarnContext(ctx, "Failed to resolve event channel ID, falling back to interaction channel", attr.Error(err))
 			eventChannelID = i.ChannelID
 		}
+	} else {
+		eventChannelID = i.ChannelID
+	}
 
-		// Publish event for backend validation
-		payload := discordroundevents.CreateRoundModalPayloadV1{
-			UserID:      sharedtypes.DiscordID(userID),
-			Title:       roundtypes.Title(title),
-			Description: description,
-			StartTime:   startTimeStr,
-			Location:    location,
-			Timezone:    roundtypes.Timezone(timezone),
-			ChannelID:   eventChannelID,
-			GuildID:     sharedtypes.GuildID(i.GuildID),
-		}
+	// Publish event for backend validation
+	payload := discordroundevents.CreateRoundModalPayloadV1{
+		UserID:      sharedtypes.DiscordID(userID),
+		Title:       roundtypes.Title(title),
+		Description: description,
+		StartTime:   startTimeStr,
+		Location:    location,
+		Timezone:    roundtypes.Timezone(timezone),
+		ChannelID:   eventChannelID,
+		GuildID:     sharedtypes.GuildID(i.GuildID),
+	}
 
-		if challengeID := challengeScheduleIDFromCustomID(data.CustomID); challengeID != "" {
-			payload.ChallengeID = &challengeID
-		}
+	if challengeID := challengeScheduleIDFromCustomID(submission.CustomID); challengeID != "" {
+		payload.ChallengeID = &challengeID
+	}
 
-		crm.logger.InfoContext(ctx, "Publishing event for Modal validation", attr.Any("payload", payload))
+	var options RoundEmbedOptions
+	_, options.MaxPlayers = maxPlayersFromCustomID(submission.CustomID)
+	_, options.CardMode = cardModeFromCustomID(submission.CustomID)
+	if roundID, messageID := runItBackFromCustomID(submission.CustomID); roundID != "" && messageID != "" {
+		options.RunItBack = RunItBack{RoundID: roundID, ChannelID: i.ChannelID, MessageID: messageID}
+	}
 
-		msg, correlationID, err := crm.createEvent(ctx, discordroundevents.RoundCreateModalSubmittedV1, payload, i)
-		if err != nil {
-			createErr := fmt.Errorf("failed to create event: %w", err)
-			return CreateRoundOperationResult{Error: createErr}, createErr
+	if series != nil {
+		for idx, occurrence := range series.Occurrences {
+			payload.StartTime = occurrence.StartTime.Format(roundseries.StartTimeLayout)
+			metadata := map[string]string{
+				"series_id":    series.ID,
+				"series_index": strconv.Itoa(idx),
+			}
+			if err := crm.publishRoundCreate(ctx, i, payload, userID, metadata, options); err != nil {
+				return CreateRoundOperationResult{Error: err}, err
+			}
 		}
+		crm.logger.InfoContext(ctx, "Round series creation requests published",
+			attr.UserID(sharedtypes.DiscordID(userID)),
+			attr.String("series_id", series.ID),
+			attr.Int("occurrences", len(series.Occurrences)))
+		return CreateRoundOperationResult{Success: "round series creation requests published"}, nil
+	}
 
-		if err := crm.interactionStore.Set(ctx, correlationID, i.Interaction); err != nil {
-			storeErr := fmt.Errorf("failed to store interaction: %w", err)
-			return CreateRoundOperationResult{Error: storeErr}, storeErr
-		}
+	metadata := map[string]string{}
+	if challengeID := challengeScheduleIDFromCustomID(submission.CustomID); challengeID != "" {
+		metadata["challenge_id"] = challengeID
+		metadata["challenge_actor_external_id"] = userID
+	}
+	if err := crm.publishRoundCreate(ctx, i, payload, userID, metadata, options); err != nil {
+		return CreateRoundOperationResult{Error: err}, err
+	}
 
-		// Set the correlation ID in the message metadata before publishing
-		msg.Metadata.Set("correlation_id", correlationID)
-		msg.Metadata.Set("user_id", userID)
+	crm.logger.InfoContext(ctx, "Round creation request published", attr.UserID(sharedtypes.DiscordID(userID)))
+	return CreateRoundOperationResult{Success: "round creation request published"}, nil
+}
 
-		if challengeID := challengeScheduleIDFromCustomID(data.CustomID); challengeID != "" {
-			msg.Metadata.Set("challenge_id", challengeID)
-			msg.Metadata.Set("challenge_actor_external_id", userID)
-		}
+// publishRoundCreate publishes one round creation request, storing the
+// interaction and the embed options under its correlation ID so the backend's
+// reply can update the one and post the embed with the other.
+func (crm *createRoundManager) publishRoundCreate(ctx context.Context, i *discordgo.InteractionCreate, payload discordroundevents.CreateRoundModalPayloadV1, userID string, metadata map[string]string, options RoundEmbedOptions) error {
+	crm.logger.InfoContext(ctx, "Publishing event for Modal validation", attr.Any("payload", payload))
 
-		if err := crm.publisher.Publish(discordroundevents.RoundCreateModalSubmittedV1, msg); err != nil {
-			publishErr := fmt.Errorf("failed to publish event: %w", err)
-			return CreateRoundOperationResult{Error: publishErr}, publishErr
-		}
+	msg, correlationID, err := crm.createEvent(ctx, discordroundevents.RoundCreateModalSubmittedV1, payload, i)
+	if err != nil {
+		return fmt.Errorf("failed to create event: %w", err)
+	}
 
-		crm.logger.InfoContext(ctx, "Round creation request published", attr.UserID(sharedtypes.DiscordID(userID)))
-		return CreateRoundOperationResult{Success: "round creation request published"}, nil
-	})
+	if err := crm.interactionStore.Set(ctx, correlationID, i.Interaction); err != nil {
+		return fmt.Errorf("failed to store interaction: %w", err)
+	}
+	if err := crm.keepEmbedOptions(ctx, correlationID, options); err != nil {
+		return err
+	}
+
+	// Set the correlation ID in the message metadata before publishing
+	msg.Metadata.Set("correlation_id", correlationID)
+	msg.Metadata.Set("user_id", userID)
+	for key, value := range metadata {
+		msg.Metadata.Set(key, value)
+	}
+
+	if err := crm.publisher.Publish(discordroundevents.RoundCreateModalSubmittedV1, msg); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	return nil
+}
+
+// newSeries plans the occurrences of a recurring round, or returns a problem
+// to show the user.
+func newSeries(guildID, title, startTimeStr, timezone string, recurrence roundseries.Recurrence, until time.Time) (roundseries.Series, string) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return roundseries.Series{}, "Timezone is not recognised."
+	}
+	first, err := time.ParseInLocation(roundseries.StartTimeLayout, startTimeStr, loc)
+	if err != nil {
+		return roundseries.Series{}, "Recurring rounds need Start Time as YYYY-MM-DD HH:MM."
+	}
+
+	starts := roundseries.Occurrences(first, recurrence, until)
+	if len(starts) < 2 {
+		return roundseries.Series{}, "The until date must leave room for at least two rounds."
+	}
+
+	series := roundseries.Series{
+		ID:         watermill.NewUUID(),
+		GuildID:    sharedtypes.GuildID(guildID),
+		Title:      title,
+		Recurrence: recurrence,
+	}
+	for _, start := range starts {
+		series.Occurrences = append(series.Occurrences, roundseries.Occurrence{StartTime: start})
+	}
+	return series, ""
 }
 
 func (crm *createRoundManager) validateChallengeScheduleSubmission(ctx context.Context, i *discordgo.InteractionCreate, challengeID string) error {
@@ -4,10 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	roundtime "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_time"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
@@ -470,6 +476,251 @@ func Test_createRoundManager_HandleCreateRoundModalSubmit_RejectsInvalidChalleng
 	}
 }
 
+func Test_createRoundManager_HandleCreateRoundModalSubmit_PublishesEachSeriesOccurrence(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	fakePublisher := &testutils.FakeEventBus{}
+	fakeStorage := testutils.NewFakeStorage[any]()
+
+	var ackContent string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		ackContent = r.Data.Content
+		return nil
+	}
+	stored := 0
+	fakeStorage.SetFunc = func(ctx context.Context, key string, value any) error {
+		stored++
+		return nil
+	}
+
+	var startTimes []string
+	seriesIDs := map[string]bool{}
+	fakePublisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		var payload discordroundevents.CreateRoundModalPayloadV1
+		if err := json.Unmarshal(messages[0].Payload, &payload); err != nil {
+			t.Fatalf("failed to unmarshal payload: %v", err)
+		}
+		startTimes = append(startTimes, payload.StartTime)
+		seriesIDs[messages[0].Metadata.Get("series_id")] = true
+		return nil
+	}
+
+	crm := &createRoundManager{
+		session:          fakeSession,
+		publisher:        fakePublisher,
+		logger:           testutils.NoOpLogger(),
+		helper:           &testutils.FakeHelpers{},
+		config:           &config.Config{},
+		interactionStore: fakeStorage,
+		operationWrapper: testOperationWrapper,
+	}
+
+	until := time.Date(2026, time.March, 24, 0, 0, 0, 0, time.UTC)
+	if _, err := crm.HandleCreateRoundModalSubmit(context.Background(), createTestInteractionWithCustomID(
+		SeriesModalCustomID(roundseries.RecurrenceWeekly, until),
+		"League Night",
+		"",
+		"2026-03-03 18:30",
+		"America/Chicago",
+		"Pier Park",
+	)); err != nil {
+		t.Fatalf("HandleCreateRoundModalSubmit() error = %v", err)
+	}
+
+	want := []string{"2026-03-03 18:30", "2026-03-10 18:30", "2026-03-17 18:30", "2026-03-24 18:30"}
+	if strings.Join(startTimes, ",") != strings.Join(want, ",") {
+		t.Fatalf("published start times = %v, want %v", startTimes, want)
+	}
+	if stored != len(want) {
+		t.Fatalf("expected an interaction stored per occurrence, got %d", stored)
+	}
+	if len(seriesIDs) != 1 || seriesIDs[""] {
+		t.Fatalf("expected all occurrences to share one series ID, got %v", seriesIDs)
+	}
+	if ackContent != "Round creation request received for 4 rounds (Weekly, Mar 3 – Mar 24)" {
+		t.Fatalf("unexpected ack %q", ackContent)
+	}
+}
+
+func Test_createRoundManager_HandleCreateRoundModalSubmit_StartTimePreview(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	tomorrow := time.Now().In(loc).AddDate(0, 0, 1)
+	wantStart := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 18, 0, 0, 0, loc)
+
+	fakeSession := discord.NewFakeSession()
+	var responses []*discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		responses = append(responses, r)
+		return nil
+	}
+	var published []discordroundevents.CreateRoundModalPayloadV1
+	fakePublisher := &testutils.FakeEventBus{}
+	fakePublisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		var payload discordroundevents.CreateRoundModalPayloadV1
+		if err := json.Unmarshal(messages[0].Payload, &payload); err != nil {
+			t.Fatalf("failed to unmarshal payload: %v", err)
+		}
+		published = append(published, payload)
+		return nil
+	}
+
+	crm := &createRoundManager{
+		session:          fakeSession,
+		publisher:        fakePublisher,
+		logger:           testutils.NoOpLogger(),
+		helper:           &testutils.FakeHelpers{},
+		config:           &config.Config{},
+		interactionStore: testutils.NewFakeStorage[any](),
+		operationWrapper: testOperationWrapper,
+	}
+
+	submit := func() []discordgo.MessageComponent {
+		t.Helper()
+		responses = nil
+		result, err := crm.HandleCreateRoundModalSubmit(context.Background(), createTestInteraction("League Night", "", "tomorrow 6pm", "America/Chicago", "Pier Park"))
+		if err != nil || result.Success != "start time preview sent" {
+			t.Fatalf("HandleCreateRoundModalSubmit() = %+v, %v", result, err)
+		}
+		if len(published) != 0 {
+			t.Fatal("expected nothing to be published before the start time is confirmed")
+		}
+		preview := responses[0].Data
+		if preview.Flags != discordgo.MessageFlagsEphemeral || !strings.Contains(preview.Content, fmt.Sprintf("<t:%d:F>", wantStart.Unix())) {
+			t.Fatalf("unexpected preview %+v", preview)
+		}
+		return preview.Components[0].(discordgo.ActionsRow).Components
+	}
+	click := func(button discordgo.MessageComponent) *discordgo.InteractionCreate {
+		return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			ID:      "button-interaction",
+			GuildID: "test-guild",
+			Type:    discordgo.InteractionMessageComponent,
+			Member:  &discordgo.Member{User: &discordgo.User{ID: "user-123"}},
+			Data:    discordgo.MessageComponentInteractionData{CustomID: button.(discordgo.Button).CustomID},
+		}}
+	}
+
+	t.Run("adjust reopens the modal", func(t *testing.T) {
+		buttons := submit()
+		responses = nil
+		if _, err := crm.HandleStartTimeAdjustButton(context.Background(), click(buttons[1])); err != nil {
+			t.Fatalf("HandleStartTimeAdjustButton() error = %v", err)
+		}
+		modal := responses[0]
+		if modal.Type != discordgo.InteractionResponseModal || modal.Data.CustomID != defaultCreateRoundModalID {
+			t.Fatalf("expected the create round modal, got %+v", modal)
+		}
+		startInput := modal.Data.Components[2].(discordgo.ActionsRow).Components[0].(discordgo.TextInput)
+		titleInput := modal.Data.Components[0].(discordgo.ActionsRow).Components[0].(discordgo.TextInput)
+		if startInput.Value != wantStart.Format(roundtime.Layout) || titleInput.Value != "League Night" {
+			t.Fatalf("expected the modal pre-filled with the submission, got %q / %q", titleInput.Value, startInput.Value)
+		}
+		if len(published) != 0 {
+			t.Fatal("expected adjusting not to publish")
+		}
+	})
+
+	t.Run("confirm publishes the resolved time", func(t *testing.T) {
+		buttons := submit()
+		responses = nil
+		if _, err := crm.HandleStartTimeConfirmButton(context.Background(), click(buttons[0])); err != nil {
+			t.Fatalf("HandleStartTimeConfirmButton() error = %v", err)
+		}
+		if responses[0].Type != discordgo.InteractionResponseUpdateMessage || responses[0].Data.Content != "Round creation request received" {
+			t.Fatalf("expected the preview to become the ack, got %+v", responses[0])
+		}
+		if len(published) != 1 || published[0].StartTime != wantStart.Format(roundtime.Layout) || published[0].Title != "League Night" {
+			t.Fatalf("unexpected published payloads %+v", published)
+		}
+
+		responses = nil
+		if _, err := crm.HandleStartTimeConfirmButton(context.Background(), click(buttons[0])); err != nil {
+			t.Fatalf("HandleStartTimeConfirmButton() error = %v", err)
+		}
+		if responses[0].Data.Content != "This start time preview has expired. Run /createround again." || len(published) != 1 {
+			t.Fatalf("expected a second confirmation to be rejected, got %q", responses[0].Data.Content)
+		}
+	})
+}
+
+func Test_createRoundManager_HandleCreateRoundModalSubmit_UnrecognisedStartTime(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	fakePublisher := &testutils.FakeEventBus{}
+
+	var content string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		content = r.Data.Content
+		return nil
+	}
+	fakePublisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		t.Fatal("expected an unrecognised start time not to publish")
+		return nil
+	}
+
+	crm := &createRoundManager{
+		session:          fakeSession,
+		publisher:        fakePublisher,
+		logger:           testutils.NoOpLogger(),
+		interactionStore: testutils.NewFakeStorage[any](),
+		operationWrapper: testOperationWrapper,
+	}
+
+	until := time.Date(2099, time.June, 30, 0, 0, 0, 0, time.UTC)
+	_, err := crm.HandleCreateRoundModalSubmit(context.Background(), createTestInteractionWithCustomID(
+		SeriesModalCustomID(roundseries.RecurrenceBiweekly, until),
+		"League Night",
+		"",
+		"whenever works",
+		"",
+		"Pier Park",
+	))
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	if content != "❌ Round creation failed: "+roundtime.ErrUnrecognised.Error() {
+		t.Fatalf("unexpected response %q", content)
+	}
+}
+
+func Test_createRoundManager_HandleCreateRoundModalSubmit_GuildTimezone(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	tomorrow := time.Now().In(london).AddDate(0, 0, 1)
+	wantStart := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 18, 0, 0, 0, london)
+
+	fakeSession := discord.NewFakeSession()
+	var content string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		content = r.Data.Content
+		return nil
+	}
+
+	crm := &createRoundManager{
+		session:          fakeSession,
+		publisher:        &testutils.FakeEventBus{},
+		logger:           testutils.NoOpLogger(),
+		interactionStore: testutils.NewFakeStorage[any](),
+		operationWrapper: testOperationWrapper,
+		guildConfigResolver: &guildconfig.FakeGuildConfigResolver{
+			GetGuildConfigWithContextFunc: func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+				return &storage.GuildConfig{GuildID: guildID, Timezone: "Europe/London"}, nil
+			},
+		},
+	}
+
+	result, err := crm.HandleCreateRoundModalSubmit(context.Background(), createTestInteraction("League Night", "", "tomorrow 6pm", "", "Pier Park"))
+	if err != nil || result.Success != "start time preview sent" {
+		t.Fatalf("HandleCreateRoundModalSubmit() = %+v, %v", result, err)
+	}
+	if !strings.Contains(content, fmt.Sprintf("<t:%d:F>", wantStart.Unix())) || !strings.Contains(content, "in Europe/London") {
+		t.Fatalf("expected the start time to be read in the server timezone, got %q", content)
+	}
+}
+
 func createTestInteraction(title, description, startTime, timezone, location string) *discordgo.InteractionCreate {
 	return createTestInteractionWithCustomID(defaultCreateRoundModalID, title, description, startTime, timezone, location)
 }
@@ -11,10 +11,10 @@ import (
 
 func RegisterHandlers(registry *interactions.Registry, manager CreateRoundManager) {
 	// createround command available to all players
-	registry.RegisterMutatingHandler("createround", func(ctx context.Context, i *discordgo.InteractionCreate) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling /createround command", attr.String("command_name", i.ApplicationCommandData().Name))
 		manager.HandleCreateRoundCommand(ctx, i)
-	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+	})
 
 	// Modal submissions require same permission as the command
 	registry.RegisterMutatingHandler("create_round_modal", func(ctx context.Context, i *discordgo.InteractionCreate) {
@@ -27,4 +27,21 @@ func RegisterHandlers(registry *interactions.Registry, manager CreateRoundManage
 		slog.Info("Handling retry_create_round button press", attr.String("custom_id", i.MessageComponentData().CustomID))
 		manager.HandleRetryCreateRound(ctx, i)
 	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	// Start time preview buttons require same permission as the command
+	registry.RegisterMutatingHandler(startTimeConfirmPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling create round start time confirmation", attr.String("custom_id", i.MessageComponentData().CustomID))
+		manager.HandleStartTimeConfirmButton(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	registry.RegisterMutatingHandler(startTimeAdjustPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling create round start time adjustment", attr.String("custom_id", i.MessageComponentData().CustomID))
+		manager.HandleStartTimeAdjustButton(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	// Run it back opens the same modal as the command
+	registry.RegisterMutatingHandler(runItBackButtonPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling run it back button press", attr.String("custom_id", i.MessageComponentData().CustomID))
+		manager.HandleRunItBackButton(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
 }
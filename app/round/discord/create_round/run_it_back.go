@@ -0,0 +1,210 @@
+package createround
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	wmmessage "github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+const (
+	runItBackButtonPrefix = "round_run_it_back|"
+	runItBackModalPrefix  = defaultCreateRoundModalID + "|run_it_back="
+	runItBackModalTitle   = "Run It Back"
+
+	finalizedTitleSuffix   = " - Round Finalized"
+	finalizedLocationField = "📍 Location"
+)
+
+var mentionPattern = regexp.MustCompile(`<@!?(\d+)>`)
+
+// RunItBack names a finalized round being run back by a new round.
+type RunItBack struct {
+	RoundID   string `json:"round_id"`
+	ChannelID string `json:"channel_id"`
+	MessageID string `json:"message_id"`
+}
+
+// previousRound is a finalized round being run back.
+type previousRound struct {
+	ID           string
+	Title        string
+	Location     string
+	URL          string
+	Participants []sharedtypes.DiscordID
+}
+
+// runItBackModalCustomID returns the custom ID of the create round modal
+// running back the round roundID finalized in messageID.
+func runItBackModalCustomID(roundID, messageID string) string {
+	return runItBackModalPrefix + roundID + "," + messageID
+}
+
+// runItBackFromCustomID returns the round a create round modal runs back and
+// its finalized message, or "" for both.
+func runItBackFromCustomID(customID string) (roundID, messageID string) {
+	value, found := strings.CutPrefix(customID, runItBackModalPrefix)
+	if !found {
+		return "", ""
+	}
+	roundID, messageID, _ = strings.Cut(value, ",")
+	return roundID, messageID
+}
+
+// HandleRunItBackButton opens the create round modal filled in from a
+// finalized round.
+func (crm *createRoundManager) HandleRunItBackButton(ctx context.Context, i *discordgo.InteractionCreate) (CreateRoundOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "round_run_it_back")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+	return crm.operationWrapper(ctx, "handle_run_it_back_button", func(ctx context.Context) (CreateRoundOperationResult, error) {
+		roundID := strings.TrimPrefix(i.MessageComponentData().CustomID, runItBackButtonPrefix)
+		if _, err := uuid.Parse(roundID); err != nil || i.Message == nil || len(i.Message.Embeds) == 0 {
+			err := fmt.Errorf("invalid run it back button %q", i.MessageComponentData().CustomID)
+			crm.logger.ErrorContext(ctx, "Invalid run it back button", attr.Error(err))
+			return CreateRoundOperationResult{Error: err}, nil
+		}
+
+		prefill := crm.runItBackPrefill(ctx, i, roundID)
+
+		ctx = WithModalConfig(ctx, ModalConfig{
+			CustomID: runItBackModalCustomID(roundID, i.Message.ID),
+			Title:    runItBackModalTitle,
+			Prefill:  prefill,
+		})
+		return crm.SendCreateRoundModal(ctx, i)
+	})
+}
+
+// runItBackPrefill returns the create round modal values for running back
+// the finalized round on the button's message, with its description from
+// the backend.
+func (crm *createRoundManager) runItBackPrefill(ctx context.Context, i *discordgo.InteractionCreate, roundID string) map[string]string {
+	previous := previousRoundFromMessage(i.GuildID, i.ChannelID, i.Message, roundID)
+
+	var description string
+	if crm.listRounds != nil {
+		rounds, err := crm.listRounds(ctx, i.GuildID, []string{roundautocomplete.RoundStateFinalized})
+		if err != nil {
+			crm.logger.WarnContext(ctx, "Failed to look up round to run back", attr.String("round_id", roundID), attr.Error(err))
+		} else if rounds != nil {
+			for _, round := range rounds.Rounds {
+				if round.ID != roundID {
+					continue
+				}
+				description = round.Description
+				if round.Title != "" {
+					previous.Title = round.Title
+				}
+				if round.Location != "" {
+					previous.Location = round.Location
+				}
+			}
+		}
+	}
+
+	return map[string]string{
+		"title":       previous.Title,
+		"description": description,
+		"location":    previous.Location,
+	}
+}
+
+// loadPreviousRound reads the round run back off its finalized message.
+func (crm *createRoundManager) loadPreviousRound(guildID string, runItBack RunItBack) (previousRound, error) {
+	message, err := crm.session.ChannelMessage(runItBack.ChannelID, runItBack.MessageID)
+	if err != nil {
+		return previousRound{}, fmt.Errorf("failed to fetch finalized round message: %w", err)
+	}
+	if message == nil || len(message.Embeds) == 0 {
+		return previousRound{}, fmt.Errorf("finalized round message %s has no embed", runItBack.MessageID)
+	}
+	return previousRoundFromMessage(guildID, runItBack.ChannelID, message, runItBack.RoundID), nil
+}
+
+// previousRoundFromMessage reads a finalized round off its message.
+func previousRoundFromMessage(guildID, channelID string, message *discordgo.Message, roundID string) previousRound {
+	embed := message.Embeds[0]
+	fields := embed.Fields
+	// Only the shown page of a paginated scorecard is on the message.
+	if snapshot, found := embedpagination.Get(message.ID); found && len(snapshot.FieldItems) > 0 {
+		fields = append(append([]*discordgo.MessageEmbedField{}, snapshot.StaticFields...), snapshot.FieldItems...)
+	}
+
+	previous := previousRound{
+		ID:    roundID,
+		Title: strings.Trim(strings.TrimSuffix(embed.Title, finalizedTitleSuffix), "*"),
+		URL:   fmt.Sprintf("https://discord.com/channels/%s/%s/%s", guildID, channelID, message.ID),
+	}
+	seen := make(map[string]bool)
+	for _, field := range fields {
+		if field == nil {
+			continue
+		}
+		if field.Name == finalizedLocationField {
+			previous.Location = field.Value
+			continue
+		}
+		for _, match := range mentionPattern.FindAllStringSubmatch(field.Value, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				previous.Participants = append(previous.Participants, sharedtypes.DiscordID(match[1]))
+			}
+		}
+	}
+	return previous
+}
+
+// runItBackDescription adds a link to the round run back to a new round's
+// description.
+func runItBackDescription(description roundtypes.Description, previous previousRound) string {
+	link := fmt.Sprintf("🔁 Running back [%s](%s)", previous.Title, previous.URL)
+	if description == "" {
+		return link
+	}
+	return string(description) + "\n\n" + link
+}
+
+// rsvpPreviousPlayers RSVPs the players of the round run back as tentative
+// on the new round, leaving out its creator.
+func (crm *createRoundManager) rsvpPreviousPlayers(ctx context.Context, guildID, channelID, messageID string, roundID sharedtypes.RoundID, creatorID sharedtypes.DiscordID, previous previousRound) {
+	for _, userID := range previous.Participants {
+		if userID == creatorID {
+			continue
+		}
+		payload := discordroundevents.RoundParticipantJoinRequestDiscordPayloadV1{
+			RoundID:   roundID,
+			UserID:    userID,
+			ChannelID: channelID,
+			GuildID:   guildID,
+		}
+		msg := &wmmessage.Message{
+			Metadata: wmmessage.Metadata{
+				"discord_message_id": messageID,
+				"topic":              discordroundevents.RoundParticipantJoinRequestDiscordV1,
+				"response":           string(roundtypes.ResponseTentative),
+			},
+		}
+		resultMsg, err := crm.helper.CreateResultMessage(msg, payload, discordroundevents.RoundParticipantJoinRequestDiscordV1)
+		if err == nil {
+			err = crm.publisher.Publish(discordroundevents.RoundParticipantJoinRequestDiscordV1, resultMsg)
+		}
+		if err != nil {
+			crm.logger.WarnContext(ctx, "Failed to RSVP previous player to run back round",
+				attr.RoundID("round_id", roundID),
+				attr.String("user_id", string(userID)),
+				attr.Error(err))
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package createround
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+func TestRunItBack_PrefillsModalAndRSVPsPreviousPlayers(t *testing.T) {
+	previousID := uuid.NewString()
+	newRoundID := sharedtypes.RoundID(uuid.New())
+
+	fakeSession := discord.NewFakeSession()
+	var modal *discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, r *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		if r.Type == discordgo.InteractionResponseModal {
+			modal = r
+		}
+		return nil
+	}
+	fakeSession.UserFunc = func(userID string, _ ...discordgo.RequestOption) (*discordgo.User, error) {
+		return &discordgo.User{ID: userID, Username: "creator"}, nil
+	}
+	var posted *discordgo.MessageSend
+	fakeSession.ChannelMessageSendComplexFunc = func(_ string, data *discordgo.MessageSend, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		posted = data
+		return &discordgo.Message{ID: "new-msg"}, nil
+	}
+
+	var createMetadata message.Metadata
+	tentative := map[sharedtypes.DiscordID]string{}
+	fakePublisher := &testutils.FakeEventBus{}
+	fakePublisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		switch topic {
+		case discordroundevents.RoundCreateModalSubmittedV1:
+			createMetadata = messages[0].Metadata
+		case discordroundevents.RoundParticipantJoinRequestDiscordV1:
+			var payload discordroundevents.RoundParticipantJoinRequestDiscordPayloadV1
+			if err := json.Unmarshal(messages[0].Payload, &payload); err != nil {
+				t.Fatalf("failed to unmarshal join request: %v", err)
+			}
+			if payload.RoundID != newRoundID {
+				t.Errorf("join request for round %s, want %s", payload.RoundID, newRoundID)
+			}
+			tentative[payload.UserID] = messages[0].Metadata.Get("response")
+		}
+		return nil
+	}
+	fakeHelper := &testutils.FakeHelpers{
+		CreateResultMessageFunc: func(original *message.Message, payload any, topic string) (*message.Message, error) {
+			body, err := json.Marshal(payload)
+			if err != nil {
+				return nil, err
+			}
+			msg := message.NewMessage(uuid.NewString(), body)
+			for key, value := range original.Metadata {
+				msg.Metadata.Set(key, value)
+			}
+			return msg, nil
+		},
+	}
+
+	crm := &createRoundManager{
+		session:          fakeSession,
+		publisher:        fakePublisher,
+		logger:           testutils.NoOpLogger(),
+		helper:           fakeHelper,
+		config:           &config.Config{},
+		interactionStore: testutils.NewFakeStorage[any](),
+		embedOptions:     testutils.NewFakeStorage[RoundEmbedOptions](),
+		operationWrapper: testOperationWrapper,
+		listRounds: func(_ context.Context, _ string, states []string) (*roundautocomplete.RoundListResponsePayloadV1, error) {
+			if len(states) != 1 || states[0] != roundautocomplete.RoundStateFinalized {
+				t.Errorf("expected a finalized round lookup, got %v", states)
+			}
+			return &roundautocomplete.RoundListResponsePayloadV1{Rounds: []roundautocomplete.RoundSummaryV1{
+				{ID: uuid.NewString(), Title: "Other Round"},
+				{ID: previousID, Title: "League Night", Location: "Pier Park", Description: "Bring a headlamp"},
+			}}, nil
+		},
+	}
+
+	finalized := &discordgo.Message{ID: "finalized-msg", Embeds: []*discordgo.MessageEmbed{{
+		Title: "**League Night** - Round Finalized",
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "📅 Started", Value: "<t:4000000000:f>"},
+			{Name: "📍 Location", Value: "Pier Park"},
+			{Name: "🥇 Alice", Value: "Score: -3 • 10 pts (<@111>)"},
+			{Name: "🥈 Bob", Value: "Score: +1 • 5 pts (<@222>)"},
+			{Name: "🗑️ creator", Value: "Score: +4 (<@user-123>)"},
+		},
+	}}}
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		if channelID != "events" || messageID != finalized.ID {
+			t.Errorf("fetched message %s/%s, want events/%s", channelID, messageID, finalized.ID)
+		}
+		return finalized, nil
+	}
+
+	press := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:        "button-interaction",
+		GuildID:   "test-guild",
+		ChannelID: "events",
+		Type:      discordgo.InteractionMessageComponent,
+		Member:    &discordgo.Member{User: &discordgo.User{ID: "user-123"}},
+		Message:   finalized,
+		Data:      discordgo.MessageComponentInteractionData{CustomID: "round_run_it_back|" + previousID},
+	}}
+	if _, err := crm.HandleRunItBackButton(context.Background(), press); err != nil {
+		t.Fatalf("HandleRunItBackButton() error = %v", err)
+	}
+
+	if modal == nil || modal.Data.CustomID != runItBackModalCustomID(previousID, finalized.ID) || modal.Data.Title != runItBackModalTitle {
+		t.Fatalf("expected the run it back modal, got %+v", modal)
+	}
+	for idx, want := range map[int]string{0: "League Night", 1: "Bring a headlamp", 2: "", 4: "Pier Park"} {
+		if got := modal.Data.Components[idx].(discordgo.ActionsRow).Components[0].(discordgo.TextInput).Value; got != want {
+			t.Errorf("modal input %d = %q, want %q", idx, got, want)
+		}
+	}
+
+	submit := createTestInteractionWithCustomID(modal.Data.CustomID, "League Night", "Bring a headlamp", "2099-06-08 18:30", "America/Chicago", "Pier Park")
+	submit.ChannelID = "events"
+	if result, err := crm.HandleCreateRoundModalSubmit(context.Background(), submit); err != nil || result.Error != nil {
+		t.Fatalf("HandleCreateRoundModalSubmit() = %+v, %v", result, err)
+	}
+	// The created event carries the create request's correlation ID.
+	options := crm.EmbedOptions(context.Background(), createMetadata.Get("correlation_id"))
+	if want := (RunItBack{RoundID: previousID, ChannelID: "events", MessageID: "finalized-msg"}); options.RunItBack != want {
+		t.Errorf("kept run it back = %+v, want %+v", options.RunItBack, want)
+	}
+	start := sharedtypes.StartTime(time.Date(2099, time.June, 8, 23, 30, 0, 0, time.UTC))
+	if _, err := crm.SendRoundEventEmbed("test-guild", "events", "League Night", "Bring a headlamp", start, "Pier Park", "user-123", newRoundID, options); err != nil {
+		t.Fatalf("SendRoundEventEmbed() error = %v", err)
+	}
+
+	wantLink := "🔁 Running back [League Night](https://discord.com/channels/test-guild/events/finalized-msg)"
+	if description := posted.Embeds[0].Description; !strings.HasPrefix(description, "Bring a headlamp") || !strings.HasSuffix(description, wantLink) {
+		t.Errorf("expected the new round to link back to the old one, got %q", description)
+	}
+	if len(tentative) != 2 || tentative["111"] != "TENTATIVE" || tentative["222"] != "TENTATIVE" {
+		t.Errorf("expected the previous players but the creator to be RSVPed tentative, got %v", tentative)
+	}
+
+	// A round created without the metadata doesn't run anything back.
+	posted = nil
+	if _, err := crm.SendRoundEventEmbed("test-guild", "events", "League Night", "", start, "Pier Park", "user-123", sharedtypes.RoundID(uuid.New()), RoundEmbedOptions{}); err != nil {
+		t.Fatalf("SendRoundEventEmbed() error = %v", err)
+	}
+	if posted.Embeds[0].Description != "" {
+		t.Errorf("expected a round created without run it back metadata not to link back, got %q", posted.Embeds[0].Description)
+	}
+}
+
+func TestRunItBackFromCustomID(t *testing.T) {
+	if roundID, messageID := runItBackFromCustomID(runItBackModalCustomID("abc", "123")); roundID != "abc" || messageID != "123" {
+		t.Errorf("runItBackFromCustomID() = %q, %q, want abc, 123", roundID, messageID)
+	}
+	if roundID, messageID := runItBackFromCustomID(defaultCreateRoundModalID); roundID != "" || messageID != "" {
+		t.Errorf("runItBackFromCustomID() = %q, %q, want empty", roundID, messageID)
+	}
+	if got := len(runItBackModalCustomID(uuid.NewString(), "1234567890123456789")); got > 100 {
+		t.Errorf("modal custom ID is %d characters, over Discord's 100", got)
+	}
+}
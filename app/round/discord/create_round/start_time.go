@@ -0,0 +1,154 @@
+package createround
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	roundtime "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_time"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+const (
+	startTimeConfirmPrefix = "create_round_time_confirm|"
+	startTimeAdjustPrefix  = "create_round_time_adjust|"
+	startTimeKeyPrefix     = "create_round_time:"
+)
+
+// sendStartTimePreview holds a submission whose start time was typed as a
+// phrase and asks the user to confirm or adjust the time it resolved to.
+func (crm *createRoundManager) sendStartTimePreview(ctx context.Context, i *discordgo.InteractionCreate, submission roundSubmission) (CreateRoundOperationResult, error) {
+	loc, err := time.LoadLocation(submission.Timezone)
+	if err != nil {
+		return CreateRoundOperationResult{Error: err}, err
+	}
+	start, err := time.ParseInLocation(roundtime.Layout, submission.StartTime, loc)
+	if err != nil {
+		return CreateRoundOperationResult{Error: err}, err
+	}
+
+	token := uuid.NewString()
+	if err := crm.interactionStore.Set(ctx, startTimeKeyPrefix+token, submission); err != nil {
+		storeErr := fmt.Errorf("failed to store start time preview: %w", err)
+		return CreateRoundOperationResult{Error: storeErr}, storeErr
+	}
+
+	err = crm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content:    roundtime.PreviewContent(submission.RawStartTime, start),
+			Flags:      discordgo.MessageFlagsEphemeral,
+			Components: roundtime.PreviewComponents(startTimeConfirmPrefix+token, startTimeAdjustPrefix+token),
+		},
+	})
+	if err != nil {
+		previewErr := fmt.Errorf("failed to send start time preview: %w", err)
+		return CreateRoundOperationResult{Error: previewErr}, previewErr
+	}
+	return CreateRoundOperationResult{Success: "start time preview sent"}, nil
+}
+
+// HandleStartTimeConfirmButton creates the round held by a start time preview.
+func (crm *createRoundManager) HandleStartTimeConfirmButton(ctx context.Context, i *discordgo.InteractionCreate) (CreateRoundOperationResult, error) {
+	userID := ""
+	if i.Member != nil && i.Member.User != nil {
+		userID = i.Member.User.ID
+	} else if i.User != nil {
+		userID = i.User.ID
+	}
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, userID)
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_create_round_time_confirm")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+	return crm.operationWrapper(ctx, "handle_create_round_time_confirm", func(ctx context.Context) (CreateRoundOperationResult, error) {
+		key := startTimeKeyPrefix + strings.TrimPrefix(i.MessageComponentData().CustomID, startTimeConfirmPrefix)
+		submission, ok := crm.heldSubmission(ctx, i, key)
+		if !ok {
+			return CreateRoundOperationResult{Failure: "start time preview expired"}, nil
+		}
+		crm.interactionStore.Delete(ctx, key)
+
+		var series *roundseries.Series
+		if recurrence, until, ok := seriesFromCustomID(submission.CustomID); ok {
+			planned, problem := newSeries(i.GuildID, submission.Title, submission.StartTime, submission.Timezone, recurrence, until)
+			if problem != "" {
+				crm.respondStartTimePreview(ctx, i, "❌ Round creation failed: "+problem)
+				return CreateRoundOperationResult{Failure: problem}, nil
+			}
+			series = &planned
+		}
+
+		crm.logger.InfoContext(ctx, "Start time confirmed",
+			attr.String("raw_start_time", submission.RawStartTime),
+			attr.String("start_time", submission.StartTime))
+		return crm.submitRound(ctx, i, userID, submission, series, discordgo.InteractionResponseUpdateMessage)
+	})
+}
+
+// HandleStartTimeAdjustButton reopens the create round modal with a start
+// time preview's values so the user can correct them.
+func (crm *createRoundManager) HandleStartTimeAdjustButton(ctx context.Context, i *discordgo.InteractionCreate) (CreateRoundOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_create_round_time_adjust")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+	return crm.operationWrapper(ctx, "handle_create_round_time_adjust", func(ctx context.Context) (CreateRoundOperationResult, error) {
+		key := startTimeKeyPrefix + strings.TrimPrefix(i.MessageComponentData().CustomID, startTimeAdjustPrefix)
+		submission, ok := crm.heldSubmission(ctx, i, key)
+		if !ok {
+			return CreateRoundOperationResult{Failure: "start time preview expired"}, nil
+		}
+		crm.interactionStore.Delete(ctx, key)
+
+		title := defaultCreateRoundModalTitle
+		if _, _, ok := seriesFromCustomID(submission.CustomID); ok {
+			title = seriesModalTitle
+		}
+		ctx = WithModalConfig(ctx, ModalConfig{
+			CustomID: submission.CustomID,
+			Title:    title,
+			Prefill: map[string]string{
+				"title":       submission.Title,
+				"description": submission.Description,
+				"start_time":  submission.StartTime,
+				"timezone":    submission.Timezone,
+				"location":    submission.Location,
+			},
+		})
+		return crm.SendCreateRoundModal(ctx, i)
+	})
+}
+
+// heldSubmission loads the submission behind a start time preview, telling
+// the user when it has expired.
+func (crm *createRoundManager) heldSubmission(ctx context.Context, i *discordgo.InteractionCreate, key string) (roundSubmission, bool) {
+	stored, err := crm.interactionStore.Get(ctx, key)
+	if err != nil {
+		crm.respondStartTimePreview(ctx, i, "This start time preview has expired. Run /createround again.")
+		return roundSubmission{}, false
+	}
+	submission, ok := stored.(roundSubmission)
+	if !ok {
+		crm.logger.WarnContext(ctx, "Unexpected start time preview type", attr.String("type", fmt.Sprintf("%T", stored)))
+		crm.respondStartTimePreview(ctx, i, "This start time preview has expired. Run /createround again.")
+		return roundSubmission{}, false
+	}
+	return submission, true
+}
+
+func (crm *createRoundManager) respondStartTimePreview(ctx context.Context, i *discordgo.InteractionCreate, content string) {
+	err := crm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+	if err != nil {
+		crm.logger.ErrorContext(ctx, "Failed to respond to start time preview", attr.Error(err))
+	}
+}
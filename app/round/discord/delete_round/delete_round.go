@@ -9,6 +9,7 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
@@ -25,6 +26,7 @@ import (
 type DeleteRoundManager interface {
 	HandleDeleteRoundButton(ctx context.Context, i *discordgo.InteractionCreate) (DeleteRoundOperationResult, error)
 	DeleteRoundEventEmbed(ctx context.Context, discordMessageID string, channelID string) (DeleteRoundOperationResult, error)
+	HandleCancelSeriesButton(ctx context.Context, i *discordgo.InteractionCreate) (DeleteRoundOperationResult, error)
 }
 
 type deleteRoundManager struct {
@@ -39,6 +41,7 @@ type deleteRoundManager struct {
 	metrics             discordmetrics.DiscordMetrics
 	operationWrapper    func(ctx context.Context, opName string, fn func(ctx context.Context) (DeleteRoundOperationResult, error)) (DeleteRoundOperationResult, error)
 	guildConfigResolver guildconfig.GuildConfigResolver
+	seriesStore         roundseries.Store
 }
 
 // NewDeleteRoundManager creates a new DeleteRoundManager instance.
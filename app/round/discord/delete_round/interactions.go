@@ -95,6 +95,9 @@ func (drm *deleteRoundManager) HandleDeleteRoundButton(ctx context.Context, i *d
 			eventChannelID = i.ChannelID
 		}
 
+		// Look the series up while the round still exists on the backend.
+		seriesNote, seriesComponents := drm.seriesCancelComponents(ctx, i.GuildID, sharedtypes.RoundID(roundUUID))
+
 		// Send delete request
 		err = drm.sendDeleteRequest(ctx, sharedtypes.RoundID(roundUUID), userID, i.Interaction.ID, discordMessageID, i.GuildID, eventChannelID)
 		if err != nil {
@@ -114,8 +117,9 @@ func (drm *deleteRoundManager) HandleDeleteRoundButton(ctx context.Context, i *d
 
 		// Send an ephemeral success message to the user indicating the request was sent
 		_, err = drm.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-			Content: "✅ Delete request sent successfully! The round will be deleted shortly.",
-			Flags:   discordgo.MessageFlagsEphemeral,
+			Content:    "✅ Delete request sent successfully! The round will be deleted shortly." + seriesNote,
+			Flags:      discordgo.MessageFlagsEphemeral,
+			Components: seriesComponents,
 		})
 		if err != nil {
 			err = fmt.Errorf("failed to send followup message: %w", err)
@@ -46,4 +46,9 @@ func RegisterHandlers(registry *interactions.Registry, manager DeleteRoundManage
 		slog.Info("✅ Button matched! Processing delete request.", attr.String("round_id", roundUUID.String()))
 		manager.HandleDeleteRoundButton(ctx, i)
 	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.EditorRequired, RequiresSetup: true})
+
+	registry.RegisterMutatingHandler(seriesCancelPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling series cancel button", attr.String("custom_id", i.MessageComponentData().CustomID))
+		manager.HandleCancelSeriesButton(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.EditorRequired, RequiresSetup: true})
 }
@@ -0,0 +1,148 @@
+package deleteround
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+)
+
+// seriesCancelPrefix starts the custom ID of the button cancelling the rest
+// of a series: round_series_cancel|<series id>|<deleted round's start unix>.
+// The later rounds are looked up again when it is pressed, so it never
+// expires.
+const seriesCancelPrefix = "round_series_cancel|"
+
+// seriesLookupFailedNote tells the user later rounds were left alone because
+// the series could not be looked up.
+const seriesLookupFailedNote = "\n⚠️ Couldn't check whether this round is part of a series, so any later rounds were left as they are."
+
+// SetSeriesStore sets the store used to find the rest of a round's series.
+func (drm *deleteRoundManager) SetSeriesStore(store roundseries.Store) {
+	if drm == nil {
+		return
+	}
+	drm.seriesStore = store
+}
+
+// seriesCancelComponents returns a button offering to cancel the rounds after
+// roundID in its series, or nil when there are none. When the series can't
+// be looked up it returns a note saying so instead.
+func (drm *deleteRoundManager) seriesCancelComponents(ctx context.Context, guildID string, roundID sharedtypes.RoundID) (string, []discordgo.MessageComponent) {
+	if drm.seriesStore == nil {
+		return "", nil
+	}
+	series, ok, err := drm.seriesStore.Lookup(ctx, sharedtypes.GuildID(guildID), roundID)
+	if err != nil {
+		drm.logger.WarnContext(ctx, "Failed to look up round series",
+			attr.String("round_id", roundID.String()),
+			attr.Error(err))
+		return seriesLookupFailedNote, nil
+	}
+	if !ok {
+		return "", nil
+	}
+	current, ok := series.Find(roundID)
+	if !ok {
+		return "", nil
+	}
+	later := series.Later(current.StartTime)
+	if len(later) == 0 {
+		return "", nil
+	}
+
+	return "", []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    fmt.Sprintf("Cancel %s in this series", roundseries.LaterRounds(len(later))),
+				Style:    discordgo.DangerButton,
+				CustomID: fmt.Sprintf("%s%s|%d", seriesCancelPrefix, series.ID, current.StartTime.Unix()),
+			},
+		}},
+	}
+}
+
+// HandleCancelSeriesButton deletes the later rounds of a series after one of
+// its rounds was deleted.
+func (drm *deleteRoundManager) HandleCancelSeriesButton(ctx context.Context, i *discordgo.InteractionCreate) (DeleteRoundOperationResult, error) {
+	return drm.operationWrapper(ctx, "HandleCancelSeriesButton", func(ctx context.Context) (DeleteRoundOperationResult, error) {
+		ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_cancel_round_series")
+		ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+		var userID sharedtypes.DiscordID
+		if i.Member != nil && i.Member.User != nil {
+			userID = sharedtypes.DiscordID(i.Member.User.ID)
+		} else if i.User != nil {
+			userID = sharedtypes.DiscordID(i.User.ID)
+		} else {
+			err := fmt.Errorf("unable to determine user ID from interaction")
+			drm.logger.ErrorContext(ctx, "Unable to determine user ID", attr.Error(err))
+			return DeleteRoundOperationResult{Error: err}, nil
+		}
+
+		seriesID, afterValue, found := strings.Cut(strings.TrimPrefix(i.MessageComponentData().CustomID, seriesCancelPrefix), "|")
+		afterUnix, err := strconv.ParseInt(afterValue, 10, 64)
+		if !found || seriesID == "" || err != nil {
+			err := fmt.Errorf("invalid series cancel custom_id %q", i.MessageComponentData().CustomID)
+			drm.logger.ErrorContext(ctx, "Invalid series cancel button", attr.Error(err))
+			return DeleteRoundOperationResult{Error: err}, nil
+		}
+		if drm.seriesStore == nil {
+			drm.respondSeriesCancel(ctx, i, "Couldn't look up the rest of this series. Delete the later rounds individually.")
+			return DeleteRoundOperationResult{Failure: "series store unavailable"}, nil
+		}
+
+		series, ok, err := drm.seriesStore.Get(ctx, sharedtypes.GuildID(i.GuildID), seriesID)
+		if err != nil {
+			drm.logger.ErrorContext(ctx, "Failed to look up round series", attr.String("series_id", seriesID), attr.Error(err))
+			drm.respondSeriesCancel(ctx, i, "Couldn't look up the rest of this series. Try again, or delete the later rounds individually.")
+			return DeleteRoundOperationResult{Failure: "series lookup failed"}, nil
+		}
+		var later []roundseries.Occurrence
+		if ok {
+			later = series.Later(time.Unix(afterUnix, 0))
+		}
+		if len(later) == 0 {
+			drm.respondSeriesCancel(ctx, i, "There are no later rounds left in this series.")
+			return DeleteRoundOperationResult{Success: "no later series rounds"}, nil
+		}
+
+		failed := 0
+		for _, occurrence := range later {
+			if err := drm.sendDeleteRequest(ctx, occurrence.RoundID, userID, i.Interaction.ID, occurrence.MessageID, i.GuildID, occurrence.ChannelID); err != nil {
+				drm.logger.ErrorContext(ctx, "Failed to send series delete request",
+					attr.String("round_id", occurrence.RoundID.String()),
+					attr.Error(err))
+				failed++
+			}
+		}
+
+		content := fmt.Sprintf("✅ Delete requests sent for %s in this series.", roundseries.LaterRounds(len(later)))
+		if failed > 0 {
+			content = fmt.Sprintf("⚠️ Delete requests sent for %d of the %d later rounds in this series. Delete the rest individually.", len(later)-failed, len(later))
+		}
+		drm.respondSeriesCancel(ctx, i, content)
+
+		return DeleteRoundOperationResult{Success: "Series delete requests processed"}, nil
+	})
+}
+
+func (drm *deleteRoundManager) respondSeriesCancel(ctx context.Context, i *discordgo.InteractionCreate, content string) {
+	err := drm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+	if err != nil {
+		drm.logger.ErrorContext(ctx, "Failed to respond to series cancellation", attr.Error(err))
+	}
+}
@@ -0,0 +1,155 @@
+package deleteround
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+func Test_deleteRoundManager_CancelsLaterRoundsInSeries(t *testing.T) {
+	deletedRound := sharedtypes.RoundID(uuid.MustParse("00000000-0000-0000-0000-0000000003b1"))
+	laterRound := sharedtypes.RoundID(uuid.New())
+
+	first := time.Date(2026, time.March, 3, 18, 30, 0, 0, time.UTC)
+	starts := roundseries.Occurrences(first, roundseries.RecurrenceWeekly, first.AddDate(0, 0, 7))
+	store := &roundseries.FakeStore{Series: []roundseries.Series{{
+		ID:      "series-1",
+		GuildID: "guild-1",
+		Title:   "League Night",
+		Occurrences: []roundseries.Occurrence{
+			{StartTime: starts[0], RoundID: deletedRound, ChannelID: "events", MessageID: "message-1"},
+			{StartTime: starts[1], RoundID: laterRound, ChannelID: "events", MessageID: "message-2"},
+		},
+	}}}
+
+	fakeSession := discord.NewFakeSession()
+	var followup *discordgo.WebhookParams
+	fakeSession.FollowupMessageCreateFunc = func(i *discordgo.Interaction, wait bool, data *discordgo.WebhookParams, opts ...discordgo.RequestOption) (*discordgo.Message, error) {
+		followup = data
+		return &discordgo.Message{ID: "followup"}, nil
+	}
+	var updated string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		if r.Type == discordgo.InteractionResponseUpdateMessage {
+			updated = r.Data.Content
+		}
+		return nil
+	}
+
+	var deleted []discordroundevents.RoundDeleteRequestDiscordPayloadV1
+	fakeHelper := &testutils.FakeHelpers{}
+	fakeHelper.CreateResultMessageFunc = func(originalMsg *message.Message, payload interface{}, topic string) (*message.Message, error) {
+		deleted = append(deleted, payload.(discordroundevents.RoundDeleteRequestDiscordPayloadV1))
+		return &message.Message{UUID: "msg"}, nil
+	}
+	fakePublisher := &testutils.FakeEventBus{}
+	fakePublisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		return nil
+	}
+
+	drm := &deleteRoundManager{
+		session:     fakeSession,
+		publisher:   fakePublisher,
+		logger:      loggerfrolfbot.NoOpLogger,
+		helper:      fakeHelper,
+		seriesStore: store,
+		operationWrapper: func(ctx context.Context, operationName string, operationFunc func(ctx context.Context) (DeleteRoundOperationResult, error)) (DeleteRoundOperationResult, error) {
+			return operationFunc(ctx)
+		},
+	}
+
+	newButtonInteraction := func(customID string) *discordgo.InteractionCreate {
+		return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			ID:      "interaction-1",
+			GuildID: "guild-1",
+			Type:    discordgo.InteractionMessageComponent,
+			Member:  &discordgo.Member{User: &discordgo.User{ID: "user-1"}},
+			Message: &discordgo.Message{ID: "message-1"},
+			Data:    discordgo.MessageComponentInteractionData{CustomID: customID},
+		}}
+	}
+
+	if _, err := drm.HandleDeleteRoundButton(context.Background(), newButtonInteraction("round_delete|"+deletedRound.String())); err != nil {
+		t.Fatalf("HandleDeleteRoundButton() error = %v", err)
+	}
+	if followup == nil || len(followup.Components) != 1 {
+		t.Fatalf("expected a series cancel button on the followup, got %+v", followup)
+	}
+	button := followup.Components[0].(discordgo.ActionsRow).Components[0].(discordgo.Button)
+	if button.Label != "Cancel the next round in this series" {
+		t.Fatalf("unexpected button label %q", button.Label)
+	}
+
+	if _, err := drm.HandleCancelSeriesButton(context.Background(), newButtonInteraction(button.CustomID)); err != nil {
+		t.Fatalf("HandleCancelSeriesButton() error = %v", err)
+	}
+	if len(deleted) != 2 {
+		t.Fatalf("expected the series round to be deleted too, got %d requests", len(deleted))
+	}
+	if deleted[1].RoundID != laterRound || deleted[1].MessageID != "message-2" || deleted[1].ChannelID != "events" {
+		t.Fatalf("unexpected series delete request %+v", deleted[1])
+	}
+	if updated != "✅ Delete requests sent for the next round in this series." {
+		t.Fatalf("unexpected response %q", updated)
+	}
+
+	// The button holds no state, so it keeps working; the rounds are looked
+	// up again on each press.
+	deleted = nil
+	if _, err := drm.HandleCancelSeriesButton(context.Background(), newButtonInteraction(button.CustomID)); err != nil {
+		t.Fatalf("HandleCancelSeriesButton() error = %v", err)
+	}
+	if len(deleted) != 1 || deleted[0].RoundID != laterRound {
+		t.Fatalf("expected the later round to be looked up again, got %+v", deleted)
+	}
+}
+
+func Test_deleteRoundManager_SaysSoWhenSeriesLookupFails(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	var followup *discordgo.WebhookParams
+	fakeSession.FollowupMessageCreateFunc = func(i *discordgo.Interaction, wait bool, data *discordgo.WebhookParams, opts ...discordgo.RequestOption) (*discordgo.Message, error) {
+		followup = data
+		return &discordgo.Message{ID: "followup"}, nil
+	}
+	fakeHelper := &testutils.FakeHelpers{}
+	fakeHelper.CreateResultMessageFunc = func(originalMsg *message.Message, payload interface{}, topic string) (*message.Message, error) {
+		return &message.Message{UUID: "msg"}, nil
+	}
+
+	drm := &deleteRoundManager{
+		session:     fakeSession,
+		publisher:   &testutils.FakeEventBus{},
+		logger:      loggerfrolfbot.NoOpLogger,
+		helper:      fakeHelper,
+		seriesStore: &roundseries.FakeStore{Err: errors.New("nats: timeout")},
+		operationWrapper: func(ctx context.Context, operationName string, operationFunc func(ctx context.Context) (DeleteRoundOperationResult, error)) (DeleteRoundOperationResult, error) {
+			return operationFunc(ctx)
+		},
+	}
+
+	if _, err := drm.HandleDeleteRoundButton(context.Background(), &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "interaction-1",
+		GuildID: "guild-1",
+		Type:    discordgo.InteractionMessageComponent,
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "user-1"}},
+		Message: &discordgo.Message{ID: "message-1"},
+		Data:    discordgo.MessageComponentInteractionData{CustomID: "round_delete|" + uuid.NewString()},
+	}}); err != nil {
+		t.Fatalf("HandleDeleteRoundButton() error = %v", err)
+	}
+	if followup == nil || len(followup.Components) != 0 || !strings.Contains(followup.Content, "Couldn't check whether this round is part of a series") {
+		t.Fatalf("expected the followup to say the series was not checked, got %+v", followup)
+	}
+}
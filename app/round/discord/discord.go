@@ -12,6 +12,8 @@ import (
 	finalizeround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/finalize_round"
 	roundreminder "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_reminder"
 	roundrsvp "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_rsvp"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
 	scoreround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_round"
 	scorecardupload "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/scorecard_upload"
 	startround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/start_round"
@@ -184,6 +186,8 @@ type RoundDiscordInterface interface {
 	GetNativeEventMap() NativeEventMap
 	GetMessageMap() MessageMap
 	GetPendingNativeEventMap() PendingNativeEventMap
+	GetRoundSeriesStore() roundseries.Store
+	GetScoreAuditLog() scoreaudit.Log
 }
 
 // RoundDiscord encapsulates all Round Discord services.
@@ -192,6 +196,8 @@ type RoundDiscord struct {
 	nativeEventMap         NativeEventMap
 	messageMap             MessageMap
 	pendingNativeEventMap  PendingNativeEventMap
+	roundSeriesStore       roundseries.Store
+	scoreAuditLog          scoreaudit.Log
 	CreateRoundManager     createround.CreateRoundManager
 	RoundRsvpManager       roundrsvp.RoundRsvpManager
 	RoundReminderManager   roundreminder.RoundReminderManager
@@ -204,6 +210,18 @@ type RoundDiscord struct {
 	ScorecardUploadManager scorecardupload.ScorecardUploadManager
 }
 
+type seriesStoreConfigurer interface {
+	SetSeriesStore(roundseries.Store)
+}
+
+type scoreAuditConfigurer interface {
+	SetScoreAuditLog(scoreaudit.Log)
+}
+
+type embedOptionsConfigurer interface {
+	SetEmbedOptionsStore(createround.EmbedOptionsStore)
+}
+
 // NewRoundDiscord creates a new RoundDiscord instance.
 // It now accepts tracer and metrics dependencies.
 func NewRoundDiscord(
@@ -212,7 +230,7 @@ func NewRoundDiscord(
 	publisher eventbus.EventBus,
 	logger *slog.Logger,
 	helper utils.Helpers,
-	config *config.Config,
+	cfg *config.Config,
 	interactionStore storage.ISInterface[any],
 	guildConfigCache storage.ISInterface[storage.GuildConfig],
 	guildConfigResolver guildconfig.GuildConfigResolver,
@@ -220,22 +238,61 @@ func NewRoundDiscord(
 	metrics discordmetrics.DiscordMetrics,
 ) (RoundDiscordInterface, error) {
 	// Pass the new dependencies to the manager constructors
-	createRoundManager := createround.NewCreateRoundManager(session, publisher, logger, helper, config, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
-	roundRsvpManager := roundrsvp.NewRoundRsvpManager(session, publisher, logger, helper, config, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
-	roundReminderManager := roundreminder.NewRoundReminderManager(session, publisher, logger, helper, config, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
-	startRoundManager := startround.NewStartRoundManager(session, publisher, logger, helper, config, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
-	scoreRoundManager := scoreround.NewScoreRoundManager(session, publisher, logger, helper, config, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
-	finalizeRoundManager := finalizeround.NewFinalizeRoundManager(session, publisher, logger, helper, config, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
-	deleteRoundManager := deleteround.NewDeleteRoundManager(session, publisher, logger, helper, config, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
-	updateRoundManager := updateround.NewUpdateRoundManager(session, publisher, logger, helper, config, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
-	tagUpdateManager := tagupdates.NewTagUpdateManager(session, publisher, logger, helper, config, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
-	scorecardUploadManager := scorecardupload.NewScorecardUploadManager(ctx, session, publisher, logger, config, interactionStore, guildConfigCache, tracer, metrics)
+	createRoundManager := createround.NewCreateRoundManager(session, publisher, logger, helper, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+	roundRsvpManager := roundrsvp.NewRoundRsvpManager(session, publisher, logger, helper, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+	roundReminderManager := roundreminder.NewRoundReminderManager(session, publisher, logger, helper, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+	startRoundManager := startround.NewStartRoundManager(session, publisher, logger, helper, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+	scoreRoundManager := scoreround.NewScoreRoundManager(session, publisher, logger, helper, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+	finalizeRoundManager := finalizeround.NewFinalizeRoundManager(session, publisher, logger, helper, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+	deleteRoundManager := deleteround.NewDeleteRoundManager(session, publisher, logger, helper, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+	updateRoundManager := updateround.NewUpdateRoundManager(session, publisher, logger, helper, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+	tagUpdateManager := tagupdates.NewTagUpdateManager(session, publisher, logger, helper, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+	scorecardUploadManager := scorecardupload.NewScorecardUploadManager(ctx, session, publisher, logger, cfg, interactionStore, guildConfigCache, tracer, metrics, guildConfigResolver)
+
+	// Create requests' embed options wait for the round created event in
+	// JetStream, so a restarted or standby worker still posts them.
+	if publisher != nil {
+		embedOptions, err := createround.NewEmbedOptionsStore(ctx, publisher.GetJetStream())
+		if err != nil {
+			return nil, err
+		}
+		if configurer, ok := createRoundManager.(embedOptionsConfigurer); ok {
+			configurer.SetEmbedOptionsStore(embedOptions)
+		}
+	}
+
+	// Series-level edits and cancellations look the rest of a round's series
+	// up on the backend, which links rounds to the series they were created in.
+	// Without a store they only touch the round itself.
+	var roundSeriesStore roundseries.Store
+	if cfg.BackendFeatureEnabled(config.BackendFeatureRoundSeries) {
+		roundSeriesStore = roundseries.NewStore(publisher)
+		for _, manager := range []any{updateRoundManager, deleteRoundManager} {
+			if configurer, ok := manager.(seriesStoreConfigurer); ok {
+				configurer.SetSeriesStore(roundSeriesStore)
+			}
+		}
+	}
+
+	// Scores are recorded on the backend as they are submitted, so the
+	// history survives restarts. Without a log nothing is recorded.
+	var scoreAuditLog scoreaudit.Log
+	if cfg.BackendFeatureEnabled(config.BackendFeatureScoreAudit) {
+		scoreAuditLog = scoreaudit.NewLog(publisher)
+		for _, manager := range []any{scoreRoundManager, scorecardUploadManager} {
+			if configurer, ok := manager.(scoreAuditConfigurer); ok {
+				configurer.SetScoreAuditLog(scoreAuditLog)
+			}
+		}
+	}
 
 	return &RoundDiscord{
 		session:                session,
 		nativeEventMap:         NewNativeEventMap(),
 		messageMap:             NewMessageMap(),
 		pendingNativeEventMap:  NewPendingNativeEventMap(),
+		roundSeriesStore:       roundSeriesStore,
+		scoreAuditLog:          scoreAuditLog,
 		CreateRoundManager:     createRoundManager,
 		RoundRsvpManager:       roundRsvpManager,
 		RoundReminderManager:   roundReminderManager,
@@ -317,3 +374,15 @@ func (rd *RoundDiscord) GetMessageMap() MessageMap {
 func (rd *RoundDiscord) GetPendingNativeEventMap() PendingNativeEventMap {
 	return rd.pendingNativeEventMap
 }
+
+// GetRoundSeriesStore returns the store looking up recurring round series,
+// or nil while series lookups are held back.
+func (rd *RoundDiscord) GetRoundSeriesStore() roundseries.Store {
+	return rd.roundSeriesStore
+}
+
+// GetScoreAuditLog returns the log of round score changes, or nil while score
+// audit is held back.
+func (rd *RoundDiscord) GetScoreAuditLog() scoreaudit.Log {
+	return rd.scoreAuditLog
+}
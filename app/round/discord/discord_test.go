@@ -64,4 +64,10 @@ func TestNewRoundDiscord_ConstructsAndExposesManagers(t *testing.T) {
 	if rd.GetTagUpdateManager() == nil {
 		t.Fatalf("expected non-nil TagUpdateManager")
 	}
+	if rd.GetRoundSeriesStore() != nil {
+		t.Fatalf("expected no RoundSeriesStore while series lookups are held back")
+	}
+	if rd.GetScoreAuditLog() != nil {
+		t.Fatalf("expected no ScoreAuditLog while score audit is held back")
+	}
 }
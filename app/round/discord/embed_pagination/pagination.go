@@ -14,6 +14,7 @@ const (
 	maxEmbedFieldValueLength  = 1024
 	maxFooterTextLength       = 2048
 	defaultParticipantField   = "👥 Participants"
+	defaultWaitlistField      = "⏳ Waitlist"
 	placeholderNoParticipants = "*No participants*"
 
 	pagerPrefix      = "round_page|"
@@ -40,8 +41,12 @@ type Snapshot struct {
 	ParticipantFieldName string
 	LineItems            []string
 	FieldItems           []*discordgo.MessageEmbedField
-	BaseComponents       []discordgo.MessageComponent
-	CurrentPage          int
+	// WaitlistItems are shown in order in their own field after the
+	// participants on every page.
+	WaitlistFieldName string
+	WaitlistItems     []string
+	BaseComponents    []discordgo.MessageComponent
+	CurrentPage       int
 }
 
 var snapshotStore = struct {
@@ -258,6 +263,9 @@ func renderSnapshot(snapshot *Snapshot, requestedPage int) (*discordgo.MessageEm
 		participantFields = []*discordgo.MessageEmbedField{buildParticipantLinesField(snapshot.ParticipantFieldName, pages[actualPage])}
 		rangeLabel = buildRangeLabelForLines(pages, actualPage)
 	}
+	if len(snapshot.WaitlistItems) > 0 {
+		participantFields = append(participantFields, buildWaitlistField(snapshot.WaitlistFieldName, snapshot.WaitlistItems))
+	}
 
 	allFields := append(staticFields, participantFields...)
 	if len(allFields) > maxEmbedFields {
@@ -295,6 +303,65 @@ func buildParticipantLinesField(name string, lines []string) *discordgo.MessageE
 	}
 }
 
+// buildWaitlistField numbers the waitlist in order. A waitlist too long for
+// one field ends with a count of the players left off.
+func buildWaitlistField(name string, lines []string) *discordgo.MessageEmbedField {
+	fieldName := name
+	if fieldName == "" {
+		fieldName = defaultWaitlistField
+	}
+
+	entries := make([]string, 0, len(lines))
+	length := 0
+	for idx, line := range lines {
+		entry := fmt.Sprintf("%d. %s", idx+1, strings.TrimSpace(line))
+		more := fmt.Sprintf("…and %d more", len(lines)-idx)
+		if idx < len(lines)-1 && length+len(entry)+len(more)+2 > maxEmbedFieldValueLength {
+			entries = append(entries, more)
+			break
+		}
+		entries = append(entries, entry)
+		length += len(entry) + 1
+	}
+
+	return &discordgo.MessageEmbedField{
+		Name:   fieldName,
+		Value:  strings.Join(entries, "\n"),
+		Inline: false,
+	}
+}
+
+// WaitlistLinesFromFieldValue reads the entries back out of a rendered
+// waitlist field, without their numbers.
+func WaitlistLinesFromFieldValue(value string) []string {
+	var lines []string
+	for _, line := range ParticipantLinesFromFieldValue(value) {
+		if strings.HasPrefix(line, "…and ") {
+			continue
+		}
+		if number, rest, found := strings.Cut(line, ". "); found {
+			if _, err := strconv.Atoi(number); err == nil {
+				line = rest
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// TentativeSuffix marks the RSVP line of a player who answered tentative.
+const TentativeSuffix = " (tentative)"
+
+// IsTentativeLine reports whether an RSVP line is a tentative player's.
+func IsTentativeLine(line string) bool {
+	return strings.HasSuffix(strings.TrimSpace(line), TentativeSuffix)
+}
+
+// IsWaitlistFieldName reports whether name is a waitlist field's.
+func IsWaitlistFieldName(name string) bool {
+	return strings.Contains(strings.ToLower(name), "waitlist")
+}
+
 func buildFooter(base *discordgo.MessageEmbedFooter, page, totalPages int, rangeLabel string) *discordgo.MessageEmbedFooter {
 	baseText := ""
 	if base != nil {
@@ -561,6 +628,8 @@ func cloneSnapshot(snapshot *Snapshot) *Snapshot {
 		ParticipantFieldName: snapshot.ParticipantFieldName,
 		LineItems:            cloneLines(snapshot.LineItems),
 		FieldItems:           cloneFields(snapshot.FieldItems),
+		WaitlistFieldName:    snapshot.WaitlistFieldName,
+		WaitlistItems:        cloneLines(snapshot.WaitlistItems),
 		BaseComponents:       cloneComponents(snapshot.BaseComponents),
 		CurrentPage:          snapshot.CurrentPage,
 	}
@@ -0,0 +1,63 @@
+package embedpagination
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestRenderSnapshot_Waitlist(t *testing.T) {
+	t.Parallel()
+
+	lines := make([]string, 0, 120)
+	for n := 0; n < 120; n++ {
+		lines = append(lines, fmt.Sprintf("Player number %03d Tag: %d", n, n+1))
+	}
+	snapshot := NewLineSnapshot("msg-waitlist", &discordgo.MessageEmbed{Title: "Round"}, nil,
+		[]*discordgo.MessageEmbedField{{Name: "📅 Time", Value: "Soon"}}, "👥 Participants (120/120)", lines)
+	snapshot.WaitlistItems = []string{"Late Larry", "Later Lou"}
+
+	for _, page := range []int{0, 1} {
+		embed, _, _, totalPages := renderSnapshot(snapshot, page)
+		if totalPages < 2 {
+			t.Fatalf("expected the participants to need several pages, got %d", totalPages)
+		}
+		last := embed.Fields[len(embed.Fields)-1]
+		if last.Name != defaultWaitlistField || last.Value != "1. Late Larry\n2. Later Lou" {
+			t.Fatalf("page %d waitlist field = %+v", page, last)
+		}
+	}
+
+	snapshot.WaitlistItems = nil
+	embed, _, _, _ := renderSnapshot(snapshot, 0)
+	if IsWaitlistFieldName(embed.Fields[len(embed.Fields)-1].Name) {
+		t.Fatal("expected no waitlist field for an empty waitlist")
+	}
+}
+
+func TestBuildWaitlistField_Truncates(t *testing.T) {
+	t.Parallel()
+
+	lines := make([]string, 200)
+	for n := range lines {
+		lines[n] = strings.Repeat("x", 20)
+	}
+
+	field := buildWaitlistField("", lines)
+	if len(field.Value) > maxEmbedFieldValueLength {
+		t.Fatalf("waitlist value is %d characters", len(field.Value))
+	}
+	if !strings.Contains(field.Value, "more") {
+		t.Fatalf("expected a count of players left off, got %q", field.Value)
+	}
+
+	read := WaitlistLinesFromFieldValue(field.Value)
+	if len(read) == 0 || read[0] != lines[0] {
+		t.Fatalf("WaitlistLinesFromFieldValue() = %v", read)
+	}
+	if strings.Contains(strings.Join(read, "\n"), "more") {
+		t.Fatal("expected the overflow count not to be read back as a player")
+	}
+}
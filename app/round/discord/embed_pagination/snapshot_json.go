@@ -19,6 +19,8 @@ type snapshotJSON struct {
 	ParticipantFieldName string                         `json:"participant_field_name,omitempty"`
 	LineItems            []string                       `json:"line_items,omitempty"`
 	FieldItems           []*discordgo.MessageEmbedField `json:"field_items,omitempty"`
+	WaitlistFieldName    string                         `json:"waitlist_field_name,omitempty"`
+	WaitlistItems        []string                       `json:"waitlist_items,omitempty"`
 	BaseComponents       []json.RawMessage              `json:"base_components,omitempty"`
 	CurrentPage          int                            `json:"current_page"`
 }
@@ -53,6 +55,8 @@ func (s *Snapshot) MarshalJSON() ([]byte, error) {
 		ParticipantFieldName: s.ParticipantFieldName,
 		LineItems:            cloneLines(s.LineItems),
 		FieldItems:           cloneFields(s.FieldItems),
+		WaitlistFieldName:    s.WaitlistFieldName,
+		WaitlistItems:        cloneLines(s.WaitlistItems),
 		BaseComponents:       baseComponents,
 		CurrentPage:          s.CurrentPage,
 	})
@@ -86,6 +90,8 @@ func (s *Snapshot) UnmarshalJSON(data []byte) error {
 		ParticipantFieldName: wire.ParticipantFieldName,
 		LineItems:            cloneLines(wire.LineItems),
 		FieldItems:           cloneFields(wire.FieldItems),
+		WaitlistFieldName:    wire.WaitlistFieldName,
+		WaitlistItems:        cloneLines(wire.WaitlistItems),
 		BaseComponents:       cloneComponents(components),
 		CurrentPage:          wire.CurrentPage,
 	}
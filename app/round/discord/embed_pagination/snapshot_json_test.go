@@ -25,6 +25,8 @@ func TestSnapshotJSONRoundTrip(t *testing.T) {
 		},
 		ParticipantFieldName: "Participants",
 		LineItems:            []string{"1. <@111>", "2. <@222>"},
+		WaitlistFieldName:    "Waitlist",
+		WaitlistItems:        []string{"<@333>"},
 		BaseComponents: []discordgo.MessageComponent{
 			discordgo.ActionsRow{
 				Components: []discordgo.MessageComponent{
@@ -55,6 +57,9 @@ func TestSnapshotJSONRoundTrip(t *testing.T) {
 	if decoded.CurrentPage != original.CurrentPage {
 		t.Fatalf("CurrentPage = %d, want %d", decoded.CurrentPage, original.CurrentPage)
 	}
+	if decoded.WaitlistFieldName != "Waitlist" || len(decoded.WaitlistItems) != 1 || decoded.WaitlistItems[0] != "<@333>" {
+		t.Fatalf("waitlist = %q %v, want Waitlist [<@333>]", decoded.WaitlistFieldName, decoded.WaitlistItems)
+	}
 	if len(decoded.BaseComponents) != 1 {
 		t.Fatalf("BaseComponents len = %d, want 1", len(decoded.BaseComponents))
 	}
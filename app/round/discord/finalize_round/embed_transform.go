@@ -6,6 +6,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
@@ -22,6 +23,9 @@ const (
 	overrideButtonID           = "round_bulk_score_override"
 	finalizedUploadButtonID    = "round_upload_scorecard_finalized"
 	finalizedUploadButtonEmoji = "📋"
+	reopenButtonEmoji          = "🔓"
+	runItBackButtonID          = "round_run_it_back"
+	runItBackButtonEmoji       = "🔁"
 )
 
 type participantWithUser struct {
@@ -188,25 +192,43 @@ func (frm *finalizeRoundManager) TransformRoundToFinalizedScorecard(payload roun
 			Emoji:    &discordgo.ComponentEmoji{Name: finalizedUploadButtonEmoji},
 		}
 
+		reopenButton := discordgo.Button{
+			Label:    "Reopen",
+			Style:    discordgo.SecondaryButton,
+			CustomID: fmt.Sprintf("%s|%s", reopenButtonID, payload.RoundID),
+			Emoji:    &discordgo.ComponentEmoji{Name: reopenButtonEmoji},
+		}
+
+		runItBackButton := discordgo.Button{
+			Label:    "Run It Back",
+			Style:    discordgo.PrimaryButton,
+			CustomID: fmt.Sprintf("%s|%s", runItBackButtonID, payload.RoundID),
+			Emoji:    &discordgo.ComponentEmoji{Name: runItBackButtonEmoji},
+		}
+
+		// Reopening is held back until the backend serves it.
+		buttons := []discordgo.MessageComponent{uploadButton}
+		if frm.config.BackendFeatureEnabled(config.BackendFeatureRoundReopen) {
+			buttons = append(buttons, reopenButton)
+		}
+		buttons = append(buttons, runItBackButton)
+
 		if len(payload.Teams) > 0 {
 			// Teams rounds do not allow score overrides, but still allow scorecard uploads.
 			components = []discordgo.MessageComponent{
-				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{uploadButton},
-				},
+				discordgo.ActionsRow{Components: buttons},
 			}
 		} else {
 			components = []discordgo.MessageComponent{
 				discordgo.ActionsRow{
-					Components: []discordgo.MessageComponent{
+					Components: append([]discordgo.MessageComponent{
 						discordgo.Button{
 							Label:    "Score Override",
 							Style:    discordgo.DangerButton,
 							CustomID: fmt.Sprintf("%s|%s", overrideButtonID, payload.RoundID),
 							Emoji:    &discordgo.ComponentEmoji{Name: "🛠️"},
 						},
-						uploadButton,
-					},
+					}, buttons...),
 				},
 			}
 		}
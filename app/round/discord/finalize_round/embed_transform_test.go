@@ -214,6 +214,8 @@ func TestTransformRoundToFinalizedScorecard(t *testing.T) {
 			if tt.wantButton {
 				require.NotEmpty(t, components)
 				require.True(t, containsButtonWithPrefix(components, "round_upload_scorecard_finalized|"), "expected finalized upload button")
+				require.False(t, containsButtonWithPrefix(components, "round_reopen|"), "reopen button shown while round reopen is held back")
+				require.True(t, containsButtonWithPrefix(components, "round_run_it_back|"+tt.payload.RoundID.String()), "expected run it back button")
 			} else {
 				require.Empty(t, components)
 			}
@@ -221,6 +223,28 @@ func TestTransformRoundToFinalizedScorecard(t *testing.T) {
 	}
 }
 
+func TestTransformRoundToFinalizedScorecard_ReopenReleased(t *testing.T) {
+	roundID := sharedtypes.RoundID(uuid.New())
+	frm := &finalizeRoundManager{
+		session: discord.NewFakeSession(),
+		logger:  loggerfrolfbot.NoOpLogger,
+		config: &config.Config{
+			BackendFeatures: []config.BackendFeature{config.BackendFeatureRoundReopen},
+		},
+		operationWrapper: func(_ context.Context, _ string, fn func(context.Context) (FinalizeRoundOperationResult, error)) (FinalizeRoundOperationResult, error) {
+			return fn(context.Background())
+		},
+	}
+
+	_, components, err := frm.TransformRoundToFinalizedScorecard(roundevents.RoundFinalizedEmbedUpdatePayloadV1{
+		RoundID: roundID,
+		Title:   "Weekly",
+	})
+	require.NoError(t, err)
+	require.True(t, containsButtonWithPrefix(components, "round_reopen|"+roundID.String()), "expected reopen button")
+	require.True(t, containsButtonWithPrefix(components, "round_run_it_back|"+roundID.String()), "expected run it back button")
+}
+
 func fieldValues(embed *discordgo.MessageEmbed) []string {
 	out := make([]string, 0, len(embed.Fields))
 	for _, f := range embed.Fields {
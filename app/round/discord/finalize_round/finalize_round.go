@@ -27,6 +27,9 @@ type FinalizeRoundManager interface {
 	TransformRoundToFinalizedScorecard(payload roundevents.RoundFinalizedEmbedUpdatePayloadV1) (*discordgo.MessageEmbed, []discordgo.MessageComponent, error)
 	FinalizeScorecardEmbed(ctx context.Context, eventMessageID string, channelID string, embedPayload roundevents.RoundFinalizedEmbedUpdatePayloadV1) (FinalizeRoundOperationResult, error)
 	PostFinalizedEmbed(ctx context.Context, channelID string, embedPayload roundevents.RoundFinalizedEmbedUpdatePayloadV1) (FinalizeRoundOperationResult, error)
+	HandleReopenButton(ctx context.Context, i *discordgo.InteractionCreate) (FinalizeRoundOperationResult, error)
+	HandleReopenConfirmButton(ctx context.Context, i *discordgo.InteractionCreate) (FinalizeRoundOperationResult, error)
+	HandleReopenCancelButton(ctx context.Context, i *discordgo.InteractionCreate) (FinalizeRoundOperationResult, error)
 }
 
 type finalizeRoundManager struct {
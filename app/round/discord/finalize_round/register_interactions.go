@@ -0,0 +1,29 @@
+package finalizeround
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RegisterHandlers registers the finalized embed's reopen buttons.
+func RegisterHandlers(registry *interactions.Registry, manager FinalizeRoundManager) {
+	policy := interactions.MutatingHandlerPolicy{RequiredPermission: interactions.AdminRequired, RequiresSetup: true}
+
+	registry.RegisterMutatingHandler(reopenButtonID+"|", func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling reopen round button", attr.String("custom_id", i.MessageComponentData().CustomID))
+		manager.HandleReopenButton(ctx, i)
+	}, policy)
+
+	registry.RegisterMutatingHandler(reopenConfirmButtonID+"|", func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling reopen round confirmation", attr.String("custom_id", i.MessageComponentData().CustomID))
+		manager.HandleReopenConfirmButton(ctx, i)
+	}, policy)
+
+	registry.RegisterMutatingHandler(reopenCancelButtonID, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		manager.HandleReopenCancelButton(ctx, i)
+	}, policy)
+}
@@ -0,0 +1,199 @@
+package finalizeround
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+// Subjects of config.BackendFeatureRoundReopen, for reopening a finalized
+// round. The backend answers a reopen request with RoundReopenedDiscordV1, carrying the round as it was started
+// with the current scores, and finalizes it again once the scores are in.
+const (
+	RoundReopenRequestedV1 = "round.reopen.requested.v1"
+	RoundReopenedDiscordV1 = "round.reopened.discord.v1"
+)
+
+const (
+	reopenButtonID        = "round_reopen"
+	reopenConfirmButtonID = "round_reopen_confirm"
+	reopenCancelButtonID  = "round_reopen_cancel"
+)
+
+// RoundReopenRequestPayloadV1 asks the backend to reopen a finalized round.
+type RoundReopenRequestPayloadV1 struct {
+	GuildID     sharedtypes.GuildID   `json:"guild_id"`
+	RoundID     sharedtypes.RoundID   `json:"round_id"`
+	RequestedBy sharedtypes.DiscordID `json:"requested_by"`
+	ChannelID   string                `json:"channel_id"`
+	MessageID   string                `json:"message_id"`
+}
+
+// HandleReopenButton asks the admin to confirm reopening a finalized round.
+func (frm *finalizeRoundManager) HandleReopenButton(ctx context.Context, i *discordgo.InteractionCreate) (FinalizeRoundOperationResult, error) {
+	return frm.operationWrapper(ctx, "HandleReopenButton", func(ctx context.Context) (FinalizeRoundOperationResult, error) {
+		ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "round_reopen")
+		ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+		roundID, ok := strings.CutPrefix(i.MessageComponentData().CustomID, reopenButtonID+"|")
+		if _, err := uuid.Parse(roundID); !ok || err != nil {
+			err := fmt.Errorf("invalid reopen custom_id %q", i.MessageComponentData().CustomID)
+			frm.logger.ErrorContext(ctx, "Invalid reopen button", attr.Error(err))
+			return FinalizeRoundOperationResult{Error: err}, nil
+		}
+
+		messageID := ""
+		if i.Message != nil {
+			messageID = i.Message.ID
+		}
+
+		err := frm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: "⚠️ Reopen this round?\n\nThe scorecard goes back to in progress so scores can be changed. " +
+					"The round is finalized again once the scores are in, and points and the leaderboard are recomputed then.",
+				Flags: discordgo.MessageFlagsEphemeral,
+				Components: []discordgo.MessageComponent{
+					discordgo.ActionsRow{
+						Components: []discordgo.MessageComponent{
+							discordgo.Button{
+								Label:    "Reopen Round",
+								Style:    discordgo.DangerButton,
+								CustomID: fmt.Sprintf("%s|%s|%s", reopenConfirmButtonID, roundID, messageID),
+							},
+							discordgo.Button{
+								Label:    "Cancel",
+								Style:    discordgo.SecondaryButton,
+								CustomID: reopenCancelButtonID,
+							},
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			frm.logger.ErrorContext(ctx, "Failed to send reopen confirmation", attr.Error(err))
+			return FinalizeRoundOperationResult{Error: err}, nil
+		}
+
+		return FinalizeRoundOperationResult{Success: "reopen confirmation sent"}, nil
+	})
+}
+
+// HandleReopenConfirmButton publishes the reopen request once the admin
+// confirms it.
+func (frm *finalizeRoundManager) HandleReopenConfirmButton(ctx context.Context, i *discordgo.InteractionCreate) (FinalizeRoundOperationResult, error) {
+	return frm.operationWrapper(ctx, "HandleReopenConfirmButton", func(ctx context.Context) (FinalizeRoundOperationResult, error) {
+		ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "round_reopen_confirm")
+		ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+		// round_reopen_confirm|<round id>|<finalized embed message id>
+		parts := strings.Split(i.MessageComponentData().CustomID, "|")
+		if len(parts) != 3 {
+			err := fmt.Errorf("invalid reopen confirm custom_id %q", i.MessageComponentData().CustomID)
+			frm.logger.ErrorContext(ctx, "Invalid reopen confirm button", attr.Error(err))
+			return FinalizeRoundOperationResult{Error: err}, nil
+		}
+		roundUUID, err := uuid.Parse(parts[1])
+		if err != nil {
+			frm.logger.ErrorContext(ctx, "Invalid round ID on reopen confirm button", attr.Error(err))
+			return FinalizeRoundOperationResult{Error: err}, nil
+		}
+
+		var userID sharedtypes.DiscordID
+		if i.Member != nil && i.Member.User != nil {
+			userID = sharedtypes.DiscordID(i.Member.User.ID)
+		} else if i.User != nil {
+			userID = sharedtypes.DiscordID(i.User.ID)
+		}
+
+		payload := RoundReopenRequestPayloadV1{
+			GuildID:     sharedtypes.GuildID(i.GuildID),
+			RoundID:     sharedtypes.RoundID(roundUUID),
+			RequestedBy: userID,
+			ChannelID:   i.ChannelID,
+			MessageID:   parts[2],
+		}
+
+		content := "🔓 Reopen requested. The scorecard will be back in progress shortly."
+		publishErr := frm.publishReopenRequest(ctx, payload)
+		if publishErr != nil {
+			content = "❌ Failed to send the reopen request. Please try again."
+		}
+
+		err = frm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    content,
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+		if err != nil {
+			frm.logger.ErrorContext(ctx, "Failed to update reopen confirmation", attr.Error(err))
+		}
+		if publishErr != nil {
+			return FinalizeRoundOperationResult{Error: publishErr}, nil
+		}
+
+		frm.logger.InfoContext(ctx, "Round reopen requested",
+			attr.RoundID("round_id", payload.RoundID),
+			attr.String("user_id", string(userID)))
+
+		return FinalizeRoundOperationResult{Success: "reopen requested"}, nil
+	})
+}
+
+// HandleReopenCancelButton dismisses the reopen confirmation.
+func (frm *finalizeRoundManager) HandleReopenCancelButton(ctx context.Context, i *discordgo.InteractionCreate) (FinalizeRoundOperationResult, error) {
+	return frm.operationWrapper(ctx, "HandleReopenCancelButton", func(ctx context.Context) (FinalizeRoundOperationResult, error) {
+		ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "round_reopen_cancel")
+		ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+		err := frm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:    "Reopen cancelled. The round stays finalized.",
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+		if err != nil {
+			frm.logger.ErrorContext(ctx, "Failed to cancel reopen", attr.Error(err))
+			return FinalizeRoundOperationResult{Error: err}, nil
+		}
+
+		return FinalizeRoundOperationResult{Success: "reopen cancelled"}, nil
+	})
+}
+
+// publishReopenRequest publishes a reopen request to the backend.
+func (frm *finalizeRoundManager) publishReopenRequest(ctx context.Context, payload RoundReopenRequestPayloadV1) error {
+	msg, err := frm.helper.CreateNewMessage(payload, RoundReopenRequestedV1)
+	if err != nil {
+		return fmt.Errorf("failed to create reopen request message: %w", err)
+	}
+
+	if msg.Metadata == nil {
+		msg.Metadata = make(message.Metadata)
+	}
+	msg.Metadata.Set("guild_id", string(payload.GuildID))
+	msg.Metadata.Set("channel_id", payload.ChannelID)
+	msg.Metadata.Set("discord_message_id", payload.MessageID)
+	msg.Metadata.Set("requesting_user_id", string(payload.RequestedBy))
+
+	if err := frm.publisher.Publish(RoundReopenRequestedV1, msg); err != nil {
+		frm.logger.ErrorContext(ctx, "Failed to publish reopen request",
+			attr.RoundID("round_id", payload.RoundID),
+			attr.String("topic", RoundReopenRequestedV1),
+			attr.Error(err))
+		return fmt.Errorf("failed to publish reopen request: %w", err)
+	}
+
+	return nil
+}
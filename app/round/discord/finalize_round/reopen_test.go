@@ -0,0 +1,149 @@
+package finalizeround
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+func newReopenTestManager(publish func(topic string, messages ...*message.Message) error) (*finalizeRoundManager, *discordgo.InteractionResponse) {
+	fakeSession := discord.NewFakeSession()
+	response := &discordgo.InteractionResponse{}
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		*response = *resp
+		return nil
+	}
+	helper := &testutils.FakeHelpers{
+		CreateNewMessageFunc: func(payload any, topic string) (*message.Message, error) {
+			body, err := json.Marshal(payload)
+			if err != nil {
+				return nil, err
+			}
+			return message.NewMessage("reopen-msg", body), nil
+		},
+	}
+	return &finalizeRoundManager{
+		session:   fakeSession,
+		publisher: &testutils.FakeEventBus{PublishFunc: publish},
+		helper:    helper,
+		logger:    loggerfrolfbot.NoOpLogger,
+		operationWrapper: func(ctx context.Context, _ string, fn func(context.Context) (FinalizeRoundOperationResult, error)) (FinalizeRoundOperationResult, error) {
+			return fn(ctx)
+		},
+	}, response
+}
+
+func newReopenButtonPress(customID string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type:      discordgo.InteractionMessageComponent,
+		GuildID:   "guild-1",
+		ChannelID: "channel-1",
+		Member:    &discordgo.Member{User: &discordgo.User{ID: "admin-1"}},
+		Message:   &discordgo.Message{ID: "confirm-msg"},
+		Data:      discordgo.MessageComponentInteractionData{CustomID: customID},
+	}}
+}
+
+func TestHandleReopenButton_AsksForConfirmation(t *testing.T) {
+	roundID := uuid.New()
+	frm, response := newReopenTestManager(func(string, ...*message.Message) error {
+		t.Fatal("expected nothing to be published before the reopen is confirmed")
+		return nil
+	})
+	press := newReopenButtonPress("round_reopen|" + roundID.String())
+	press.Message.ID = "finalized-msg"
+
+	result, err := frm.HandleReopenButton(context.Background(), press)
+	if err != nil || result.Error != nil {
+		t.Fatalf("unexpected error: %v %v", err, result.Error)
+	}
+	if response.Data.Flags != discordgo.MessageFlagsEphemeral {
+		t.Errorf("expected an ephemeral confirmation, got %+v", response.Data)
+	}
+	buttons := response.Data.Components[0].(discordgo.ActionsRow).Components
+	if got := buttons[0].(discordgo.Button).CustomID; got != "round_reopen_confirm|"+roundID.String()+"|finalized-msg" {
+		t.Errorf("confirm button custom_id = %q", got)
+	}
+	if got := buttons[1].(discordgo.Button).CustomID; got != "round_reopen_cancel" {
+		t.Errorf("cancel button custom_id = %q", got)
+	}
+}
+
+func TestHandleReopenConfirmButton_PublishesRequest(t *testing.T) {
+	roundID := uuid.New()
+	var published *message.Message
+	frm, response := newReopenTestManager(func(topic string, messages ...*message.Message) error {
+		if topic != RoundReopenRequestedV1 {
+			t.Errorf("published to %q", topic)
+		}
+		published = messages[0]
+		return nil
+	})
+
+	result, err := frm.HandleReopenConfirmButton(context.Background(), newReopenButtonPress("round_reopen_confirm|"+roundID.String()+"|finalized-msg"))
+	if err != nil || result.Error != nil {
+		t.Fatalf("unexpected error: %v %v", err, result.Error)
+	}
+	if published == nil {
+		t.Fatal("expected a reopen request to be published")
+	}
+
+	var payload RoundReopenRequestPayloadV1
+	if err := json.Unmarshal(published.Payload, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	want := RoundReopenRequestPayloadV1{
+		GuildID:     "guild-1",
+		RoundID:     sharedtypes.RoundID(roundID),
+		RequestedBy: "admin-1",
+		ChannelID:   "channel-1",
+		MessageID:   "finalized-msg",
+	}
+	if payload != want {
+		t.Errorf("payload = %+v, want %+v", payload, want)
+	}
+	if got := published.Metadata.Get("discord_message_id"); got != "finalized-msg" {
+		t.Errorf("discord_message_id metadata = %q", got)
+	}
+	if response.Type != discordgo.InteractionResponseUpdateMessage || len(response.Data.Components) != 0 || !strings.HasPrefix(response.Data.Content, "🔓") {
+		t.Errorf("expected the confirmation to be replaced, got %+v", response)
+	}
+}
+
+func TestHandleReopenConfirmButton_PublishFailure(t *testing.T) {
+	frm, response := newReopenTestManager(func(string, ...*message.Message) error {
+		return errors.New("nats down")
+	})
+
+	result, _ := frm.HandleReopenConfirmButton(context.Background(), newReopenButtonPress("round_reopen_confirm|"+uuid.NewString()+"|finalized-msg"))
+	if result.Error == nil {
+		t.Error("expected the publish error in the result")
+	}
+	if !strings.HasPrefix(response.Data.Content, "❌") {
+		t.Errorf("expected a failure message, got %q", response.Data.Content)
+	}
+}
+
+func TestHandleReopenCancelButton(t *testing.T) {
+	frm, response := newReopenTestManager(func(string, ...*message.Message) error {
+		t.Fatal("expected nothing to be published on cancel")
+		return nil
+	})
+
+	if _, err := frm.HandleReopenCancelButton(context.Background(), newReopenButtonPress("round_reopen_cancel")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.Type != discordgo.InteractionResponseUpdateMessage || response.Data.Content != "Reopen cancelled. The round stays finalized." {
+		t.Errorf("unexpected response %+v", response)
+	}
+}
@@ -0,0 +1,153 @@
+package roundautocomplete
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RoundListRequestV1 is the request-reply subject of
+// config.BackendFeatureRoundLists.
+const RoundListRequestV1 = "round.list.request.v1"
+
+const roundListTimeout = 2 * time.Second
+
+// Round states offered when picking a round to reference.
+const (
+	RoundStateUpcoming   = "UPCOMING"
+	RoundStateInProgress = "IN_PROGRESS"
+	RoundStateFinalized  = "FINALIZED"
+)
+
+// RoundListRequestPayloadV1 asks for a guild's rounds, newest first.
+type RoundListRequestPayloadV1 struct {
+	GuildID string   `json:"guild_id"`
+	States  []string `json:"states,omitempty"`
+	Limit   int      `json:"limit,omitempty"`
+}
+
+// RoundListResponsePayloadV1 is the reply to RoundListRequestV1.
+type RoundListResponsePayloadV1 struct {
+	Rounds []RoundSummaryV1 `json:"rounds"`
+}
+
+// RoundSummaryV1 is the subset of a round needed to label it, plus its
+// description for copying it into a new round.
+type RoundSummaryV1 struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Location    string     `json:"location,omitempty"`
+	Description string     `json:"description,omitempty"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+	State       string     `json:"state"`
+}
+
+// RoundLister lists a guild's rounds in the given states.
+type RoundLister func(ctx context.Context, guildID string, states []string) (*RoundListResponsePayloadV1, error)
+
+// NewRoundLister returns a RoundLister backed by the backend, or nil while
+// cfg holds round lists back.
+func NewRoundLister(cfg *config.Config, eventBus eventbus.EventBus) RoundLister {
+	if !cfg.BackendFeatureEnabled(config.BackendFeatureRoundLists) {
+		return nil
+	}
+	return func(ctx context.Context, guildID string, states []string) (*RoundListResponsePayloadV1, error) {
+		return ListRounds(ctx, eventBus, guildID, states)
+	}
+}
+
+// ListRounds requests a guild's rounds in the given states from the backend.
+func ListRounds(ctx context.Context, eventBus eventbus.EventBus, guildID string, states []string) (*RoundListResponsePayloadV1, error) {
+	if guildID == "" {
+		return nil, fmt.Errorf("guild id is required")
+	}
+
+	return messagecreator.NATSRequest[RoundListRequestPayloadV1, RoundListResponsePayloadV1](
+		ctx,
+		eventBus,
+		RoundListRequestV1+"."+guildID,
+		RoundListRequestPayloadV1{
+			GuildID: guildID,
+			States:  states,
+			Limit:   interactions.MaxAutocompleteChoices,
+		},
+		roundListTimeout,
+	)
+}
+
+// RoundIDChoices builds autocomplete choices for the rounds matching query,
+// labelled by title and start time with the round ID as the value. Start
+// times are shown in loc, which should be the guild's timezone; nil means UTC.
+func RoundIDChoices(rounds []RoundSummaryV1, query string, loc *time.Location) []*discordgo.ApplicationCommandOptionChoice {
+	if loc == nil {
+		loc = time.UTC
+	}
+	query = strings.ToLower(strings.TrimSpace(query))
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(rounds))
+	for _, round := range rounds {
+		if round.ID == "" {
+			continue
+		}
+		label := roundLabel(round, loc)
+		if query != "" && !strings.Contains(strings.ToLower(label), query) && !strings.HasPrefix(strings.ToLower(round.ID), query) {
+			continue
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  interactions.TruncateChoiceName(label),
+			Value: round.ID,
+		})
+		if len(choices) == interactions.MaxAutocompleteChoices {
+			break
+		}
+	}
+	return choices
+}
+
+// NewRoundIDHandler returns an autocomplete provider for round ID options that
+// offers the guild's upcoming and in-progress rounds, labelled in the guild's
+// timezone. It offers nothing while cfg holds round lists back.
+func NewRoundIDHandler(cfg *config.Config, eventBus eventbus.EventBus, logger *slog.Logger, resolver guildconfig.GuildConfigResolver) interactions.AutocompleteHandler {
+	listRounds := NewRoundLister(cfg, eventBus)
+	return func(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+		if listRounds == nil {
+			return nil, nil
+		}
+		response, err := listRounds(ctx, i.GuildID, []string{RoundStateUpcoming, RoundStateInProgress})
+		if err != nil {
+			return nil, err
+		}
+		loc := guildconfig.GuildLocation(ctx, logger, resolver, i.GuildID)
+		return RoundIDChoices(response.Rounds, focused.StringValue(), loc), nil
+	}
+}
+
+func roundLabel(round RoundSummaryV1, loc *time.Location) string {
+	parts := make([]string, 0, 4)
+
+	title := strings.TrimSpace(round.Title)
+	if title == "" {
+		title = "Untitled round"
+	}
+	parts = append(parts, title)
+
+	if round.StartTime != nil && !round.StartTime.IsZero() {
+		parts = append(parts, round.StartTime.In(loc).Format("Jan 2 15:04 MST"))
+	}
+	if location := strings.TrimSpace(round.Location); location != "" {
+		parts = append(parts, location)
+	}
+	if round.State == RoundStateInProgress {
+		parts = append(parts, "in progress")
+	}
+
+	return strings.Join(parts, " · ")
+}
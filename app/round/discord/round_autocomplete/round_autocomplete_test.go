@@ -0,0 +1,66 @@
+package roundautocomplete
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRoundIDChoices_LabelsAndFilters(t *testing.T) {
+	start := time.Date(2026, time.March, 7, 18, 30, 0, 0, time.UTC)
+	rounds := []RoundSummaryV1{
+		{ID: "round-aaa", Title: "Tuesday Doubles", Location: "Pier Park", StartTime: &start, State: RoundStateUpcoming},
+		{ID: "round-bbb", Title: "League Night", State: RoundStateInProgress},
+		{ID: "", Title: "Missing ID"},
+	}
+
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	choices := RoundIDChoices(rounds, "", chicago)
+	if len(choices) != 2 {
+		t.Fatalf("expected 2 choices, got %d", len(choices))
+	}
+	if got, want := choices[0].Name, "Tuesday Doubles · Mar 7 12:30 CST · Pier Park"; got != want {
+		t.Errorf("label = %q, want %q", got, want)
+	}
+	if choices[0].Value != "round-aaa" {
+		t.Errorf("value = %v, want round-aaa", choices[0].Value)
+	}
+	if got, want := choices[1].Name, "League Night · in progress"; got != want {
+		t.Errorf("label = %q, want %q", got, want)
+	}
+
+	filtered := RoundIDChoices(rounds, "pier", chicago)
+	if len(filtered) != 1 || filtered[0].Value != "round-aaa" {
+		t.Fatalf("expected query to match location, got %+v", filtered)
+	}
+
+	byID := RoundIDChoices(rounds, "ROUND-B", chicago)
+	if len(byID) != 1 || byID[0].Value != "round-bbb" {
+		t.Fatalf("expected query to match id prefix, got %+v", byID)
+	}
+}
+
+func TestRoundIDChoices_CapsResults(t *testing.T) {
+	rounds := make([]RoundSummaryV1, 40)
+	for i := range rounds {
+		rounds[i] = RoundSummaryV1{ID: fmt.Sprintf("round-%d", i), Title: "Round"}
+	}
+
+	if got := len(RoundIDChoices(rounds, "", nil)); got != 25 {
+		t.Fatalf("expected 25 choices, got %d", got)
+	}
+}
+
+func TestRoundIDChoices_NilLocationIsUTC(t *testing.T) {
+	start := time.Date(2026, time.March, 7, 18, 30, 0, 0, time.UTC)
+	rounds := []RoundSummaryV1{{ID: "round-aaa", Title: "Doubles", StartTime: &start}}
+
+	choices := RoundIDChoices(rounds, "", nil)
+	if got, want := choices[0].Name, "Doubles · Mar 7 18:30 UTC"; got != want {
+		t.Errorf("label = %q, want %q", got, want)
+	}
+}
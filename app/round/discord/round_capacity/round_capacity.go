@@ -0,0 +1,95 @@
+// Package roundcapacity caps how many players a round takes. The cap is kept
+// in the round embed's participants field name, e.g. "👥 Participants (12/20)",
+// so it lives as long as the embed does. Accepted players beyond the cap wait
+// on a waitlist in the order they accepted.
+package roundcapacity
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+)
+
+// MaxPlayersLimit is the largest cap a round may have.
+const MaxPlayersLimit = 100
+
+var capacitySuffix = regexp.MustCompile(`\s*\((\d+)/(\d+)\)$`)
+
+// FieldName returns the participants field name showing count of max
+// players. A max of 0 means the round has no cap and the name has no count.
+func FieldName(name string, count, max int) string {
+	name = capacitySuffix.ReplaceAllString(name, "")
+	if max <= 0 {
+		return name
+	}
+	return fmt.Sprintf("%s (%d/%d)", name, count, max)
+}
+
+// MaxFromFieldName returns the cap shown in a participants field name, or 0
+// when the round has none.
+func MaxFromFieldName(name string) int {
+	match := capacitySuffix.FindStringSubmatch(name)
+	if match == nil {
+		return 0
+	}
+	max, err := strconv.Atoi(match[2])
+	if err != nil {
+		return 0
+	}
+	return max
+}
+
+// Full reports whether a participants field name shows a full round.
+func Full(name string) bool {
+	match := capacitySuffix.FindStringSubmatch(name)
+	if match == nil {
+		return false
+	}
+	count, _ := strconv.Atoi(match[1])
+	max, _ := strconv.Atoi(match[2])
+	return max > 0 && count >= max
+}
+
+// Split separates participants into those who hold a spot and the accepted
+// players waiting for one. Accepted players take spots in the order they are
+// listed; tentative and declined players never count towards the cap. A max
+// of 0 puts everyone in the round.
+func Split(participants []roundtypes.Participant, max int) (in []roundtypes.Participant, waitlist []roundtypes.Participant) {
+	if max <= 0 {
+		return participants, nil
+	}
+
+	taken := 0
+	for _, participant := range participants {
+		if !accepted(participant) {
+			in = append(in, participant)
+			continue
+		}
+		if taken < max {
+			taken++
+			in = append(in, participant)
+			continue
+		}
+		waitlist = append(waitlist, participant)
+	}
+	return in, waitlist
+}
+
+// Taken returns how many of participants hold a spot counted against the cap.
+func Taken(participants []roundtypes.Participant) int {
+	count := 0
+	for _, participant := range participants {
+		if accepted(participant) {
+			count++
+		}
+	}
+	return count
+}
+
+// Participants from the backend's accepted list may not carry a response, so
+// only an explicit tentative or decline is left out of the count.
+func accepted(participant roundtypes.Participant) bool {
+	return participant.Response != roundtypes.ResponseTentative && participant.Response != roundtypes.ResponseDecline
+}
@@ -0,0 +1,72 @@
+package roundcapacity
+
+import (
+	"testing"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+)
+
+func TestFieldName(t *testing.T) {
+	if got := FieldName("👥 Participants", 3, 20); got != "👥 Participants (3/20)" {
+		t.Fatalf("FieldName() = %q", got)
+	}
+	if got := FieldName("👥 Participants (3/20)", 4, 20); got != "👥 Participants (4/20)" {
+		t.Fatalf("FieldName() should replace the old count, got %q", got)
+	}
+	if got := FieldName("👥 Participants (3/20)", 4, 0); got != "👥 Participants" {
+		t.Fatalf("FieldName() without a cap = %q", got)
+	}
+}
+
+func TestMaxFromFieldName(t *testing.T) {
+	tests := map[string]int{
+		"👥 Participants (12/20)": 20,
+		"👥 Participants":         0,
+		"Participants (abc/20)":  0,
+	}
+	for name, want := range tests {
+		if got := MaxFromFieldName(name); got != want {
+			t.Errorf("MaxFromFieldName(%q) = %d, want %d", name, got, want)
+		}
+	}
+	if !Full("👥 Participants (20/20)") || Full("👥 Participants (19/20)") || Full("👥 Participants") {
+		t.Fatal("Full() misread the field name")
+	}
+}
+
+func TestSplit(t *testing.T) {
+	participants := []roundtypes.Participant{
+		{UserID: "a", Response: roundtypes.ResponseAccept},
+		{UserID: "b", Response: roundtypes.ResponseTentative},
+		{UserID: "c", Response: roundtypes.ResponseAccept},
+		{UserID: "d"},
+		{UserID: "e", Response: roundtypes.ResponseAccept},
+	}
+
+	in, waitlist := Split(participants, 2)
+	if got := userIDs(in); got != "a,b,c" {
+		t.Fatalf("in = %s, want a,b,c", got)
+	}
+	if got := userIDs(waitlist); got != "d,e" {
+		t.Fatalf("waitlist = %s, want d,e", got)
+	}
+	if Taken(in) != 2 {
+		t.Fatalf("Taken() = %d, want 2", Taken(in))
+	}
+
+	in, waitlist = Split(participants, 0)
+	if len(in) != len(participants) || waitlist != nil {
+		t.Fatal("expected no waitlist without a cap")
+	}
+}
+
+func userIDs(participants []roundtypes.Participant) string {
+	ids := ""
+	for idx, participant := range participants {
+		if idx > 0 {
+			ids += ","
+		}
+		ids += string(participant.UserID)
+	}
+	return ids
+}
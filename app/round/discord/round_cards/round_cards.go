@@ -0,0 +1,236 @@
+// Package roundcards splits a started round's players into cards (groups) of
+// three to five. The mode a round uses is chosen when it is created and kept
+// in the round embed's footer, e.g. "Created by Jace • 🃏 Cards: Balanced", so
+// it lives as long as the embed does. Once cards are assigned they are kept as
+// header lines among the scorecard's participant lines.
+package roundcards
+
+import (
+	"math/rand/v2"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// Card sizes. Rounds with no more than MaxCardSize players stay on one card.
+const (
+	MinCardSize = 3
+	MaxCardSize = 5
+)
+
+// Mode is how players are split into cards.
+type Mode string
+
+const (
+	// ModeTagOrder puts players with neighbouring tags on the same card.
+	ModeTagOrder Mode = "tag"
+	// ModeRandom deals players onto cards at random.
+	ModeRandom Mode = "random"
+	// ModeBalanced snake-drafts players by tag so every card mixes low and
+	// high tags.
+	ModeBalanced Mode = "balanced"
+)
+
+// Modes lists every mode in the order they are offered.
+var Modes = []Mode{ModeTagOrder, ModeRandom, ModeBalanced}
+
+// ParseMode returns the mode named by value.
+func ParseMode(value string) (Mode, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	for _, mode := range Modes {
+		if string(mode) == value {
+			return mode, true
+		}
+	}
+	return "", false
+}
+
+// Label returns the name the mode is shown with.
+func (m Mode) Label() string {
+	switch m {
+	case ModeTagOrder:
+		return "Tag order"
+	case ModeRandom:
+		return "Random"
+	case ModeBalanced:
+		return "Balanced"
+	default:
+		return ""
+	}
+}
+
+const footerMarker = "🃏 Cards: "
+
+// Footer appends mode to an embed footer text. Footers without a mode are
+// returned unchanged.
+func Footer(text string, mode Mode) string {
+	if mode.Label() == "" {
+		return text
+	}
+	if text == "" {
+		return footerMarker + mode.Label()
+	}
+	return text + " • " + footerMarker + mode.Label()
+}
+
+// ModeFromFooter returns the mode kept in an embed footer text, or "" when the
+// round doesn't use cards.
+func ModeFromFooter(text string) Mode {
+	_, label, found := strings.Cut(text, footerMarker)
+	if !found {
+		return ""
+	}
+	for _, mode := range Modes {
+		if strings.HasPrefix(label, mode.Label()) {
+			return mode
+		}
+	}
+	return ""
+}
+
+// Sizes returns the size of each card for players players, aiming for cards
+// of four. It returns nil when everyone fits on one card.
+func Sizes(players int) []int {
+	if players <= MaxCardSize {
+		return nil
+	}
+	cards := (players + 2) / 4
+	if least := (players + MaxCardSize - 1) / MaxCardSize; cards < least {
+		cards = least
+	}
+	if most := players / MinCardSize; cards > most {
+		cards = most
+	}
+
+	sizes := make([]int, cards)
+	for idx := range sizes {
+		sizes[idx] = players / cards
+		if idx < players%cards {
+			sizes[idx]++
+		}
+	}
+	return sizes
+}
+
+// Assign splits participants into cards using mode. Only accepted players are
+// put on a card. It returns nil when the accepted players fit on one card or
+// mode is not a known mode.
+func Assign(participants []roundtypes.Participant, mode Mode) [][]roundtypes.Participant {
+	var players []roundtypes.Participant
+	for _, participant := range participants {
+		if participant.Response == roundtypes.ResponseTentative || participant.Response == roundtypes.ResponseDecline {
+			continue
+		}
+		players = append(players, participant)
+	}
+
+	sizes := Sizes(len(players))
+	if sizes == nil || mode.Label() == "" {
+		return nil
+	}
+
+	cards := make([][]roundtypes.Participant, len(sizes))
+	switch mode {
+	case ModeRandom:
+		rand.Shuffle(len(players), func(i, j int) { players[i], players[j] = players[j], players[i] })
+		deal(cards, sizes, players)
+	case ModeBalanced:
+		sortByTag(players)
+		// Snake draft: 1, 2, …, n, n, …, 2, 1, 1, 2, … so card sizes still
+		// differ by at most one.
+		for idx, player := range players {
+			pass, pos := idx/len(cards), idx%len(cards)
+			if pass%2 == 1 {
+				pos = len(cards) - 1 - pos
+			}
+			cards[pos] = append(cards[pos], player)
+		}
+	default:
+		sortByTag(players)
+		deal(cards, sizes, players)
+	}
+	return cards
+}
+
+// deal fills cards in order, each up to its size.
+func deal(cards [][]roundtypes.Participant, sizes []int, players []roundtypes.Participant) {
+	next := 0
+	for idx, size := range sizes {
+		cards[idx] = append(cards[idx], players[next:next+size]...)
+		next += size
+	}
+}
+
+// sortByTag orders players by tag, untagged players last by user ID.
+func sortByTag(players []roundtypes.Participant) {
+	sort.SliceStable(players, func(i, j int) bool {
+		a, b := players[i].TagNumber, players[j].TagNumber
+		switch {
+		case a != nil && b != nil && *a != *b:
+			return *a < *b
+		case a != nil && b == nil:
+			return true
+		case a == nil && b != nil:
+			return false
+		default:
+			return players[i].UserID < players[j].UserID
+		}
+	})
+}
+
+// NoCardLine heads the players who are not on a card, such as tentative
+// players, after the last card.
+const NoCardLine = "🃏 **No card**"
+
+var headerLine = regexp.MustCompile(`^🃏 \*\*Card (\d+)\*\*$`)
+
+// HeaderLine returns the participant line that starts card number card.
+func HeaderLine(card int) string {
+	return "🃏 **Card " + strconv.Itoa(card) + "**"
+}
+
+// IsHeaderLine reports whether line starts a card or the players on none.
+func IsHeaderLine(line string) bool {
+	line = strings.TrimSpace(line)
+	return line == NoCardLine || headerLine.MatchString(line)
+}
+
+var mention = regexp.MustCompile(`<@!?(\d+)>`)
+
+// FromLines reads the cards back out of participant lines, returning the
+// players of each card in order. Lines before the first card header or after
+// NoCardLine belong to no card. It returns nil when the lines have no cards.
+func FromLines(lines []string) [][]sharedtypes.DiscordID {
+	var cards [][]sharedtypes.DiscordID
+	onCard := false
+	for _, line := range lines {
+		if IsHeaderLine(line) {
+			onCard = strings.TrimSpace(line) != NoCardLine
+			if onCard {
+				cards = append(cards, []sharedtypes.DiscordID{})
+			}
+			continue
+		}
+		if !onCard {
+			continue
+		}
+		if match := mention.FindStringSubmatch(line); match != nil {
+			cards[len(cards)-1] = append(cards[len(cards)-1], sharedtypes.DiscordID(match[1]))
+		}
+	}
+	return cards
+}
+
+// HasCards reports whether participant lines are grouped into cards.
+func HasCards(lines []string) bool {
+	for _, line := range lines {
+		if IsHeaderLine(line) {
+			return true
+		}
+	}
+	return false
+}
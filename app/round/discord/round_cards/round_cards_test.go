@@ -0,0 +1,129 @@
+package roundcards
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+func TestSizes(t *testing.T) {
+	tests := map[int][]int{
+		0:  nil,
+		5:  nil,
+		6:  {3, 3},
+		7:  {4, 3},
+		9:  {5, 4},
+		10: {4, 3, 3},
+		13: {5, 4, 4},
+		16: {4, 4, 4, 4},
+	}
+	for players, want := range tests {
+		if got := Sizes(players); !reflect.DeepEqual(got, want) {
+			t.Errorf("Sizes(%d) = %v, want %v", players, got, want)
+		}
+	}
+	for players := MaxCardSize + 1; players <= 100; players++ {
+		for _, size := range Sizes(players) {
+			if size < MinCardSize || size > MaxCardSize {
+				t.Fatalf("Sizes(%d) has a card of %d", players, size)
+			}
+		}
+	}
+}
+
+func TestFooter(t *testing.T) {
+	footer := Footer("Created by Jace", ModeBalanced)
+	if footer != "Created by Jace • 🃏 Cards: Balanced" {
+		t.Fatalf("Footer() = %q", footer)
+	}
+	if got := ModeFromFooter(footer + " | Page 1/2"); got != ModeBalanced {
+		t.Fatalf("ModeFromFooter() = %q, want balanced", got)
+	}
+	if got := Footer("Created by Jace", ""); got != "Created by Jace" {
+		t.Fatalf("Footer() without a mode = %q", got)
+	}
+	if got := ModeFromFooter("Created by Jace"); got != "" {
+		t.Fatalf("ModeFromFooter() without a mode = %q", got)
+	}
+}
+
+func TestAssign(t *testing.T) {
+	participants := []roundtypes.Participant{
+		player("u7", 7), player("u2", 2), player("u5", 5), player("u1", 1),
+		player("u3", 3), player("u6", 6), player("u4", 4),
+		{UserID: "u9", Response: roundtypes.ResponseTentative},
+	}
+
+	if got := cardIDs(Assign(participants, ModeTagOrder)); got != "[u1 u2 u3 u4] [u5 u6 u7]" {
+		t.Errorf("tag order cards = %s", got)
+	}
+	if got := cardIDs(Assign(participants, ModeBalanced)); got != "[u1 u4 u5] [u2 u3 u6 u7]" {
+		t.Errorf("balanced cards = %s", got)
+	}
+
+	random := Assign(participants, ModeRandom)
+	var ids []string
+	for _, card := range random {
+		for _, participant := range card {
+			ids = append(ids, string(participant.UserID))
+		}
+	}
+	sort.Strings(ids)
+	if len(random) != 2 || fmt.Sprint(ids) != "[u1 u2 u3 u4 u5 u6 u7]" {
+		t.Errorf("random cards = %s", cardIDs(random))
+	}
+
+	if cards := Assign(participants[:5], ModeTagOrder); cards != nil {
+		t.Errorf("expected one card to mean no cards, got %s", cardIDs(cards))
+	}
+	if cards := Assign(participants, ""); cards != nil {
+		t.Errorf("expected no cards without a mode, got %s", cardIDs(cards))
+	}
+}
+
+func TestFromLines(t *testing.T) {
+	lines := []string{
+		HeaderLine(1),
+		"<@111> Tag: 1 — Score: --",
+		"<@222> — Score: -2",
+		HeaderLine(2),
+		"<@333> — Score: --",
+		NoCardLine,
+		"<@444> — Score: --",
+	}
+
+	want := [][]sharedtypes.DiscordID{{"111", "222"}, {"333"}}
+	if got := FromLines(lines); !reflect.DeepEqual(got, want) {
+		t.Fatalf("FromLines() = %v, want %v", got, want)
+	}
+	if !HasCards(lines) || HasCards(lines[1:3]) {
+		t.Fatal("HasCards() misread the lines")
+	}
+	if FromLines(lines[1:3]) != nil {
+		t.Fatal("expected no cards without headers")
+	}
+}
+
+func player(userID string, tag int) roundtypes.Participant {
+	tagNumber := sharedtypes.TagNumber(tag)
+	return roundtypes.Participant{UserID: sharedtypes.DiscordID(userID), TagNumber: &tagNumber, Response: roundtypes.ResponseAccept}
+}
+
+func cardIDs(cards [][]roundtypes.Participant) string {
+	out := ""
+	for idx, card := range cards {
+		if idx > 0 {
+			out += " "
+		}
+		ids := make([]string, len(card))
+		for i, participant := range card {
+			ids[i] = string(participant.UserID)
+		}
+		out += fmt.Sprint(ids)
+	}
+	return out
+}
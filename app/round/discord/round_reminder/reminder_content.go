@@ -0,0 +1,226 @@
+package roundreminder
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// reminderHistoryLimit is how many messages of a reminder thread are searched
+// for a reminder that was already sent.
+const reminderHistoryLimit = 100
+
+var tagSuffix = regexp.MustCompile(`\s+Tag:\s*\d+$`)
+
+// reminderOffset returns how long before the round a reminder of
+// reminderType is sent. Types that name no offset are the original one-hour
+// reminder.
+func reminderOffset(reminderType string) time.Duration {
+	if offset, err := guildconfig.ParseReminderOffset(reminderType); err == nil {
+		return offset
+	}
+	return guildconfig.DefaultReminderOffset
+}
+
+// reminderHeading is the first line of a reminder, e.g. "**2 HOUR REMINDER** 🏆".
+// It tells a round's reminders apart in their shared thread.
+func reminderHeading(offset time.Duration) string {
+	return fmt.Sprintf("**%s REMINDER** 🏆", strings.ToUpper(offsetLabel(offset, false)))
+}
+
+// offsetLabel spells out an offset, e.g. "1 hour 30 minutes", or
+// "1 hour 30 minute" when not plural.
+func offsetLabel(offset time.Duration, plural bool) string {
+	unit := func(n int, name string) string {
+		if plural && n != 1 {
+			name += "s"
+		}
+		return fmt.Sprintf("%d %s", n, name)
+	}
+
+	hours := int(offset / time.Hour)
+	minutes := int(offset % time.Hour / time.Minute)
+	switch {
+	case hours == 0:
+		return unit(minutes, "minute")
+	case minutes == 0:
+		return unit(hours, "hour")
+	default:
+		return unit(hours, "hour") + " " + unit(minutes, "minute")
+	}
+}
+
+// defaultReminderTemplate is the message of a reminder the guild has not
+// written its own for. Reminders a day or so out nudge players to RSVP, and
+// the last ones before a round send them to the tee.
+func defaultReminderTemplate(offset time.Duration) string {
+	switch {
+	case offset >= 12*time.Hour:
+		return `Round "{title}" is {relative} ({start}) at {location}. Update your RSVP on the round if your plans have changed.`
+	case offset <= 30*time.Minute:
+		return `Round "{title}" tees off in {countdown} ({start}) at {location}. Head to the first tee!`
+	default:
+		return `Round "{title}" is starting in {countdown} ({start}) at {location}!`
+	}
+}
+
+// guildReminders returns the reminders the guild sends, or the default
+// one-hour reminder when they can't be loaded or are held back.
+func (rm *roundReminderManager) guildReminders(ctx context.Context, guildID string) []storage.ReminderSetting {
+	if rm.reminders == nil {
+		return guildconfig.DefaultReminders
+	}
+	return rm.reminders(ctx, guildID)
+}
+
+// reminderSent reports whether a reminder with heading was already posted in
+// the thread.
+func (rm *roundReminderManager) reminderSent(ctx context.Context, threadID, heading string) bool {
+	messages, err := rm.session.ChannelMessages(threadID, reminderHistoryLimit, "", "", "")
+	if err != nil {
+		rm.logger.WarnContext(ctx, "Failed to read reminder thread, sending reminder",
+			attr.String("thread_id", threadID), attr.Error(err))
+		return false
+	}
+	for _, message := range messages {
+		if message != nil && strings.Contains(message.Content, heading) {
+			return true
+		}
+	}
+	return false
+}
+
+// roundPlayers reads the round's RSVP lines off its snapshot or embed and
+// returns its tentative players, as mentions when they can be matched to the
+// reminder's users and as names otherwise, and the reminder's users who are
+// on its waitlist. The embed shows names, so lines are matched to users by
+// their guild names.
+func (rm *roundReminderManager) roundPlayers(channelID string, payload *roundevents.DiscordReminderPayloadV1) ([]string, map[sharedtypes.DiscordID]bool) {
+	var lines, waitlist []string
+	if snapshot, found := embedpagination.Get(payload.EventMessageID); found {
+		lines, waitlist = snapshot.LineItems, snapshot.WaitlistItems
+	} else {
+		message, err := rm.session.ChannelMessage(channelID, payload.EventMessageID)
+		if err != nil || len(message.Embeds) == 0 {
+			return nil, nil
+		}
+		for _, field := range message.Embeds[0].Fields {
+			switch {
+			case field == nil:
+			case embedpagination.IsWaitlistFieldName(field.Name):
+				waitlist = append(waitlist, embedpagination.WaitlistLinesFromFieldValue(field.Value)...)
+			case embedpagination.IsParticipantFieldName(field.Name):
+				lines = append(lines, embedpagination.ParticipantLinesFromFieldValue(field.Value)...)
+			}
+		}
+	}
+
+	var names []string
+	for _, line := range lines {
+		if embedpagination.IsTentativeLine(line) {
+			names = append(names, lineName(strings.TrimSuffix(strings.TrimSpace(line), embedpagination.TentativeSuffix)))
+		}
+	}
+	if len(names) == 0 && len(waitlist) == 0 {
+		return nil, nil
+	}
+
+	users := rm.usersByName(payload)
+
+	var callouts []string
+	for _, name := range names {
+		if userID, ok := users[name]; ok {
+			callouts = append(callouts, fmt.Sprintf("<@%s>", userID))
+		} else {
+			callouts = append(callouts, "**"+name+"**")
+		}
+	}
+
+	waitlisted := make(map[sharedtypes.DiscordID]bool, len(waitlist))
+	for _, line := range waitlist {
+		if userID, ok := users[lineName(line)]; ok {
+			waitlisted[userID] = true
+		}
+	}
+	return callouts, waitlisted
+}
+
+// usersByName maps the guild names of the reminder's users to their IDs. A
+// name shared by two users goes to the first.
+func (rm *roundReminderManager) usersByName(payload *roundevents.DiscordReminderPayloadV1) map[string]sharedtypes.DiscordID {
+	users := make(map[string]sharedtypes.DiscordID, len(payload.UserIDs))
+	for _, userID := range payload.UserIDs {
+		member, err := rm.session.GuildMember(payload.DiscordGuildID, string(userID))
+		if err != nil || member == nil {
+			continue
+		}
+		memberNames := []string{member.Nick}
+		if member.User != nil {
+			memberNames = append(memberNames, member.User.GlobalName, member.User.Username)
+		}
+		for _, name := range memberNames {
+			if name = strings.TrimSpace(name); name != "" {
+				if _, taken := users[name]; !taken {
+					users[name] = userID
+				}
+			}
+		}
+	}
+	return users
+}
+
+// lineName strips the tag off an RSVP line, leaving the player's name.
+func lineName(line string) string {
+	return tagSuffix.ReplaceAllString(strings.TrimSpace(line), "")
+}
+
+// withoutUsers returns a copy of payload that leaves out users.
+func withoutUsers(payload *roundevents.DiscordReminderPayloadV1, users map[sharedtypes.DiscordID]bool) *roundevents.DiscordReminderPayloadV1 {
+	if len(users) == 0 {
+		return payload
+	}
+	filtered := *payload
+	filtered.UserIDs = nil
+	for _, userID := range payload.UserIDs {
+		if !users[userID] {
+			filtered.UserIDs = append(filtered.UserIDs, userID)
+		}
+	}
+	return &filtered
+}
+
+// dmReminder DMs the reminder, without its mentions and call-outs, to the
+// round's players who opted in to reminder alerts.
+func (rm *roundReminderManager) dmReminder(ctx context.Context, payload *roundevents.DiscordReminderPayloadV1, channelID string, offset time.Duration, template string) {
+	if rm.notifier == nil || len(payload.UserIDs) == 0 {
+		return
+	}
+
+	userIDs := make([]string, len(payload.UserIDs))
+	for idx, userID := range payload.UserIDs {
+		userIDs[idx] = string(userID)
+	}
+	dm := *payload
+	dm.UserIDs = nil
+	content := rm.buildReminderMessage(&dm, offset, template, nil)
+	if payload.DiscordGuildID != "" && channelID != "" && payload.EventMessageID != "" {
+		content += fmt.Sprintf("\nhttps://discord.com/channels/%s/%s/%s", payload.DiscordGuildID, channelID, payload.EventMessageID)
+	}
+
+	rm.notifier.Notify(ctx, notify.Alert{
+		GuildID:  payload.DiscordGuildID,
+		Category: notify.RoundReminders,
+		UserIDs:  userIDs,
+		Content:  content,
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
@@ -37,6 +38,8 @@ type roundReminderManager struct {
 	metrics             discordmetrics.DiscordMetrics
 	operationWrapper    func(ctx context.Context, opName string, fn func(ctx context.Context) (RoundReminderOperationResult, error)) (RoundReminderOperationResult, error)
 	guildConfigResolver guildconfig.GuildConfigResolver
+	reminders           func(ctx context.Context, guildID string) []storage.ReminderSetting
+	notifier            notify.Notifier
 }
 
 // NewRoundReminderManager creates a new RoundReminderManager instance.
@@ -69,6 +72,8 @@ func NewRoundReminderManager(
 		guildConfigResolver: guildConfigResolver,
 		guildConfigCache:    guildConfigCache,
 		interactionStore:    interactionStore,
+		reminders:           guildconfig.NewRemindersSource(config, guildConfigResolver, publisher),
+		notifier:            notify.NewNotifier(session, publisher, logger, config),
 	}
 }
 
@@ -6,12 +6,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	"github.com/bwmarrin/discordgo"
 )
 
-// SendRoundReminder sends a 1-hour round reminder to the appropriate Discord thread or channel.
+// SendRoundReminder sends a round reminder to the appropriate Discord thread or
+// channel, if the guild sends reminders at the payload's offset.
 func (rm *roundReminderManager) SendRoundReminder(ctx context.Context, payload *roundevents.DiscordReminderPayloadV1) (RoundReminderOperationResult, error) {
 	return rm.operationWrapper(ctx, "SendRoundReminder", func(ctx context.Context) (RoundReminderOperationResult, error) {
 		rm.logPayloadDetails(ctx, payload)
@@ -21,6 +23,16 @@ func (rm *roundReminderManager) SendRoundReminder(ctx context.Context, payload *
 			return RoundReminderOperationResult{Error: err}, err
 		}
 
+		// Only send the reminders the guild asked for, each with its message.
+		offset := reminderOffset(payload.ReminderType)
+		reminder, ok := guildconfig.FindReminder(rm.guildReminders(ctx, payload.DiscordGuildID), offset)
+		if !ok {
+			rm.logger.InfoContext(ctx, "Guild does not send this reminder, skipping",
+				attr.RoundID("round_id", payload.RoundID),
+				attr.String("reminder_type", payload.ReminderType))
+			return RoundReminderOperationResult{Success: true}, nil
+		}
+
 		// Resolve the channel ID to send the reminder to
 		resolvedChannelID := rm.resolveChannelID(ctx, payload)
 
@@ -31,12 +43,29 @@ func (rm *roundReminderManager) SendRoundReminder(ctx context.Context, payload *
 			return RoundReminderOperationResult{Error: err}, err
 		}
 
-		// Build message content
-		reminderMessage := rm.buildReminderMessage(payload)
-		threadName := fmt.Sprintf("⏰ 1 Hour Reminder: %s", payload.RoundTitle)
+		heading := reminderHeading(offset)
+		threadName := fmt.Sprintf("⏰ Round Reminders: %s", payload.RoundTitle)
 
 		// Find or create the thread
 		thread, created, err := rm.findOrCreateThread(ctx, resolvedChannelID, payload.EventMessageID, threadName)
+
+		// Idempotency Check: every reminder of a round goes to the same thread,
+		// so an existing thread is searched for this reminder's heading. A
+		// reminder that was already sent is treated as a success and not re-sent.
+		if err == nil && !created && rm.reminderSent(ctx, thread.ID, heading) {
+			rm.logger.InfoContext(ctx, "Reminder already sent, skipping duplicate reminder",
+				attr.RoundID("round_id", payload.RoundID),
+				attr.String("thread_id", thread.ID),
+				attr.String("reminder_type", payload.ReminderType))
+			return RoundReminderOperationResult{Success: true}, nil
+		}
+
+		// Build message content. Waitlisted players don't hold a spot, so
+		// they aren't reminded to play.
+		tentative, waitlisted := rm.roundPlayers(resolvedChannelID, payload)
+		payload = withoutUsers(payload, waitlisted)
+		reminderMessage := rm.buildReminderMessage(payload, offset, reminder.Template, tentative)
+
 		if err != nil {
 			// If thread creation fails, fallback to sending to main channel
 			rm.logger.WarnContext(ctx, "Thread unavailable, sending to main channel as fallback")
@@ -45,16 +74,7 @@ func (rm *roundReminderManager) SendRoundReminder(ctx context.Context, payload *
 				rm.logger.ErrorContext(ctx, err.Error())
 				return RoundReminderOperationResult{Error: err}, err
 			}
-			return RoundReminderOperationResult{Success: true}, nil
-		}
-
-		// Idempotency Check: If thread was NOT created (meaning it already existed),
-		// it implies the reminder was already sent (or at least the thread for it exists).
-		// We treat this as a success and do not re-send the message.
-		if !created {
-			rm.logger.InfoContext(ctx, "Reminder thread already exists, skipping duplicate reminder",
-				attr.RoundID("round_id", payload.RoundID),
-				attr.String("thread_id", thread.ID))
+			rm.dmReminder(ctx, payload, resolvedChannelID, offset, reminder.Template)
 			return RoundReminderOperationResult{Success: true}, nil
 		}
 
@@ -65,6 +85,8 @@ func (rm *roundReminderManager) SendRoundReminder(ctx context.Context, payload *
 			return RoundReminderOperationResult{Error: err}, err
 		}
 
+		rm.dmReminder(ctx, payload, resolvedChannelID, offset, reminder.Template)
+
 		rm.logger.InfoContext(ctx, "Successfully sent round reminder",
 			attr.RoundID("round_id", payload.RoundID),
 			attr.String("thread_id", thread.ID),
@@ -130,8 +152,9 @@ func (rm *roundReminderManager) resolveChannelID(ctx context.Context, payload *r
 	return ""
 }
 
-// buildReminderMessage constructs the reminder message with mentions and formatted start time/location
-func (rm *roundReminderManager) buildReminderMessage(payload *roundevents.DiscordReminderPayloadV1) string {
+// buildReminderMessage constructs the reminder message with mentions, the
+// reminder's heading and template, and a call-out of tentative players.
+func (rm *roundReminderManager) buildReminderMessage(payload *roundevents.DiscordReminderPayloadV1, offset time.Duration, template string, tentative []string) string {
 	var sb strings.Builder
 
 	if len(payload.UserIDs) > 0 {
@@ -141,9 +164,10 @@ func (rm *roundReminderManager) buildReminderMessage(payload *roundevents.Discor
 		sb.WriteString("\n\n")
 	}
 
-	startTimeStr := "TBD"
+	startTimeStr, relativeStr := "TBD", "coming up"
 	if payload.StartTime != nil {
 		startTimeStr = fmt.Sprintf("<t:%d:f>", payload.StartTime.AsTime().Unix())
+		relativeStr = fmt.Sprintf("<t:%d:R>", payload.StartTime.AsTime().Unix())
 	}
 
 	locationStr := "TBD"
@@ -151,8 +175,25 @@ func (rm *roundReminderManager) buildReminderMessage(payload *roundevents.Discor
 		locationStr = string(payload.Location)
 	}
 
-	sb.WriteString(fmt.Sprintf("**1 HOUR REMINDER** 🏆\n\nRound \"%s\" is starting in 1 hour (%s) at %s!",
-		payload.RoundTitle, startTimeStr, locationStr))
+	if strings.TrimSpace(template) == "" {
+		template = defaultReminderTemplate(offset)
+	}
+	body := strings.NewReplacer(
+		"{title}", string(payload.RoundTitle),
+		"{start}", startTimeStr,
+		"{relative}", relativeStr,
+		"{countdown}", offsetLabel(offset, true),
+		"{location}", locationStr,
+	).Replace(template)
+
+	sb.WriteString(reminderHeading(offset))
+	sb.WriteString("\n\n")
+	sb.WriteString(body)
+
+	if len(tentative) > 0 {
+		sb.WriteString(fmt.Sprintf("\n\n❓ Still tentative: %s. Update your RSVP on the round so everyone knows who's playing.",
+			strings.Join(tentative, ", ")))
+	}
 
 	return sb.String()
 }
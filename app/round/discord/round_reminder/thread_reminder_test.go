@@ -3,11 +3,14 @@ package roundreminder
 import (
 	"context"
 	"errors"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
@@ -203,3 +206,186 @@ func Test_roundReminderManager_SendRoundReminder(t *testing.T) {
 		})
 	}
 }
+
+func Test_roundReminderManager_SendRoundReminder_Offsets(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	startTime := time.Date(2099, 6, 1, 18, 0, 0, 0, time.UTC)
+	payload := func(reminderType string) *roundevents.DiscordReminderPayloadV1 {
+		return &roundevents.DiscordReminderPayloadV1{
+			RoundID:          sharedtypes.RoundID(uuid.New()),
+			RoundTitle:       "League Night",
+			UserIDs:          []sharedtypes.DiscordID{"1", "2"},
+			ReminderType:     reminderType,
+			DiscordChannelID: "channel-123",
+			DiscordGuildID:   "guild-id",
+			EventMessageID:   "event-message",
+			StartTime:        (*sharedtypes.StartTime)(&startTime),
+			Location:         "Pier Park",
+		}
+	}
+
+	fakeSession.GetChannelFunc = func(channelID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		return &discordgo.Channel{}, nil
+	}
+	var threadMessages []*discordgo.Message
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		message := &discordgo.Message{
+			ID: messageID,
+			Embeds: []*discordgo.MessageEmbed{{
+				Fields: []*discordgo.MessageEmbedField{
+					{Name: "⏰ When", Value: "Soon"},
+					{Name: "👥 Participants (2)", Value: "Ace Tag: 1\nBirdie Tag: 4 (tentative)\nGuest (tentative)"},
+				},
+			}},
+		}
+		if threadMessages != nil {
+			message.Thread = &discordgo.Channel{ID: "thread-123"}
+		}
+		return message, nil
+	}
+	fakeSession.MessageThreadStartComplexFunc = func(channelID, messageID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		if data.Name != "⏰ Round Reminders: League Night" {
+			t.Errorf("unexpected thread name %q", data.Name)
+		}
+		return &discordgo.Channel{ID: "thread-123"}, nil
+	}
+	fakeSession.ChannelMessagesFunc = func(channelID string, limit int, beforeID, afterID, aroundID string, options ...discordgo.RequestOption) ([]*discordgo.Message, error) {
+		return threadMessages, nil
+	}
+	fakeSession.GuildMemberFunc = func(guildID, userID string, options ...discordgo.RequestOption) (*discordgo.Member, error) {
+		names := map[string]string{"1": "Ace", "2": "Birdie"}
+		return &discordgo.Member{User: &discordgo.User{ID: userID, Username: names[userID]}}, nil
+	}
+	var sent []string
+	fakeSession.ChannelMessageSendFunc = func(channelID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		sent = append(sent, content)
+		threadMessages = append(threadMessages, &discordgo.Message{Content: content})
+		return &discordgo.Message{}, nil
+	}
+
+	fakeNotifier := &notify.FakeNotifier{}
+	rm := &roundReminderManager{
+		session:  fakeSession,
+		logger:   loggerfrolfbot.NoOpLogger,
+		notifier: fakeNotifier,
+		operationWrapper: func(ctx context.Context, _ string, fn func(ctx context.Context) (RoundReminderOperationResult, error)) (RoundReminderOperationResult, error) {
+			return fn(ctx)
+		},
+		reminders: func(ctx context.Context, guildID string) []storage.ReminderSetting {
+			return []storage.ReminderSetting{{Offset: "24h", Template: "{title} is {relative} at {location}. Bring a friend!"}, {Offset: "15m"}}
+		},
+	}
+
+	// The guild doesn't send one-hour reminders.
+	if _, err := rm.SendRoundReminder(context.Background(), payload("1-hour")); err != nil || len(sent) != 0 {
+		t.Fatalf("expected the 1h reminder to be skipped, err=%v sent=%q", err, sent)
+	}
+
+	if _, err := rm.SendRoundReminder(context.Background(), payload("24h")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "<@1> <@2> \n\n**24 HOUR REMINDER** 🏆\n\nLeague Night is <t:4084020000:R> at Pier Park. Bring a friend!" +
+		"\n\n❓ Still tentative: <@2>, **Guest**. Update your RSVP on the round so everyone knows who's playing."
+	if len(sent) != 1 || sent[0] != want {
+		t.Fatalf("unexpected 24h reminder %q", sent)
+	}
+	wantDM := "**24 HOUR REMINDER** 🏆\n\nLeague Night is <t:4084020000:R> at Pier Park. Bring a friend!" +
+		"\nhttps://discord.com/channels/guild-id/channel-123/event-message"
+	if len(fakeNotifier.Alerts) != 1 || fakeNotifier.Alerts[0].Content != wantDM || fakeNotifier.Alerts[0].Category != notify.RoundReminders ||
+		!reflect.DeepEqual(fakeNotifier.Alerts[0].UserIDs, []string{"1", "2"}) {
+		t.Fatalf("unexpected reminder alerts %+v", fakeNotifier.Alerts)
+	}
+
+	if _, err := rm.SendRoundReminder(context.Background(), payload("15m")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sent) != 2 || !strings.Contains(sent[1], "**15 MINUTE REMINDER** 🏆\n\nRound \"League Night\" tees off in 15 minutes (<t:4084020000:f>) at Pier Park.") {
+		t.Fatalf("unexpected 15m reminder %q", sent)
+	}
+
+	// A redelivered reminder finds its heading in the thread and isn't re-sent.
+	if _, err := rm.SendRoundReminder(context.Background(), payload("15m")); err != nil || len(sent) != 2 {
+		t.Fatalf("expected the duplicate reminder to be skipped, err=%v sent=%d", err, len(sent))
+	}
+	if len(fakeNotifier.Alerts) != 2 {
+		t.Fatalf("expected one alert per reminder sent, got %d", len(fakeNotifier.Alerts))
+	}
+}
+
+func Test_roundReminderManager_SendRoundReminder_SkipsWaitlist(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	startTime := time.Date(2099, 6, 1, 18, 0, 0, 0, time.UTC)
+
+	fakeSession.GetChannelFunc = func(channelID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		return &discordgo.Channel{}, nil
+	}
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return &discordgo.Message{
+			ID: messageID,
+			Embeds: []*discordgo.MessageEmbed{{
+				Fields: []*discordgo.MessageEmbedField{
+					{Name: "👥 Participants (1/1)", Value: "Ace Tag: 1"},
+					{Name: "⏳ Waitlist", Value: "1. Birdie Tag: 4"},
+				},
+			}},
+		}, nil
+	}
+	fakeSession.MessageThreadStartComplexFunc = func(channelID, messageID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		return &discordgo.Channel{ID: "thread-123"}, nil
+	}
+	fakeSession.GuildMemberFunc = func(guildID, userID string, options ...discordgo.RequestOption) (*discordgo.Member, error) {
+		names := map[string]string{"1": "Ace", "2": "Birdie"}
+		return &discordgo.Member{User: &discordgo.User{ID: userID, Username: names[userID]}}, nil
+	}
+	var sent []string
+	fakeSession.ChannelMessageSendFunc = func(channelID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		sent = append(sent, content)
+		return &discordgo.Message{}, nil
+	}
+
+	fakeNotifier := &notify.FakeNotifier{}
+	rm := &roundReminderManager{
+		session:  fakeSession,
+		logger:   loggerfrolfbot.NoOpLogger,
+		notifier: fakeNotifier,
+		operationWrapper: func(ctx context.Context, _ string, fn func(ctx context.Context) (RoundReminderOperationResult, error)) (RoundReminderOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	if _, err := rm.SendRoundReminder(context.Background(), &roundevents.DiscordReminderPayloadV1{
+		RoundID:          sharedtypes.RoundID(uuid.New()),
+		RoundTitle:       "League Night",
+		UserIDs:          []sharedtypes.DiscordID{"1", "2"},
+		ReminderType:     "1-hour",
+		DiscordChannelID: "channel-123",
+		DiscordGuildID:   "guild-id",
+		EventMessageID:   "waitlist-message",
+		StartTime:        (*sharedtypes.StartTime)(&startTime),
+		Location:         "Pier Park",
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(sent) != 1 || !strings.HasPrefix(sent[0], "<@1> \n\n") {
+		t.Fatalf("expected only the player holding a spot to be mentioned, got %q", sent)
+	}
+	if len(fakeNotifier.Alerts) != 1 || !reflect.DeepEqual(fakeNotifier.Alerts[0].UserIDs, []string{"1"}) {
+		t.Fatalf("expected only the player holding a spot to be DMed, got %+v", fakeNotifier.Alerts)
+	}
+}
+
+func Test_roundReminderManager_buildReminderMessage_Default(t *testing.T) {
+	startTime := time.Unix(4084538400, 0)
+	rm := &roundReminderManager{}
+	got := rm.buildReminderMessage(&roundevents.DiscordReminderPayloadV1{
+		RoundTitle: "League Night",
+		StartTime:  (*sharedtypes.StartTime)(&startTime),
+		Location:   "Pier Park",
+	}, time.Hour, "", nil)
+
+	want := "**1 HOUR REMINDER** 🏆\n\nRound \"League Night\" is starting in 1 hour (<t:4084538400:f>) at Pier Park!"
+	if got != want {
+		t.Fatalf("buildReminderMessage() = %q, want %q", got, want)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundcapacity "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_capacity"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	"github.com/bwmarrin/discordgo"
@@ -15,8 +16,10 @@ import (
 
 // --- Constants (Consider moving to a shared package) ---
 const (
+	fieldNameTime             = "📅 Time"
 	placeholderNoParticipants = "*No participants*"
 	tagPrefix                 = "Tag:"
+	waitlistFieldName         = "⏳ Waitlist"
 )
 
 // UpdateRoundEventEmbed updates the round event embed with new participant information.
@@ -71,9 +74,28 @@ func (rrm *roundRsvpManager) UpdateRoundEventEmbed(ctx context.Context, channelI
 			return RoundRsvpOperationResult{Error: err}, err
 		}
 
-		// Update the single Participants field at index 2
-		embed.Fields[2].Value = rrm.formatParticipants(ctx, guildID, participants)
-		participantLines := embedpagination.ParticipantLinesFromFieldValue(embed.Fields[2].Value)
+		// Once the round starts its embed is the scorecard, which RSVP changes
+		// must not redraw.
+		if embed.Fields[0].Name != fieldNameTime {
+			rrm.logger.InfoContext(ctx, "Round embed is no longer an RSVP embed, skipping update",
+				attr.String("channel_id", resolvedChannelID),
+				attr.String("discord_message_id", messageID))
+			return RoundRsvpOperationResult{}, nil
+		}
+
+		// Update the single Participants field at index 2. Accepted players
+		// beyond the round's cap go on the waitlist instead.
+		participantField := embed.Fields[2]
+		maxPlayers := roundcapacity.MaxFromFieldName(participantField.Name)
+		in, waitlist := roundcapacity.Split(participants, maxPlayers)
+		participantField.Name = roundcapacity.FieldName(participantField.Name, roundcapacity.Taken(in), maxPlayers)
+		participantField.Value = rrm.formatParticipants(ctx, guildID, in)
+		participantLines := embedpagination.ParticipantLinesFromFieldValue(participantField.Value)
+
+		waitlistLines := make([]string, 0, len(waitlist))
+		for _, participant := range waitlist {
+			waitlistLines = append(waitlistLines, rrm.participantLine(guildID, participant))
+		}
 
 		// PRESERVED: old 3-field update — may be reused in PWA
 		// embed.Fields[2].Value = rrm.formatParticipants(ctx, acceptedParticipants)
@@ -81,8 +103,10 @@ func (rrm *roundRsvpManager) UpdateRoundEventEmbed(ctx context.Context, channelI
 		// embed.Fields[4].Value = rrm.formatParticipants(ctx, tentativeParticipants)
 
 		targetPage := 0
+		var previousWaitlist []string
 		if existingSnapshot, found := embedpagination.Get(messageID); found {
 			targetPage = existingSnapshot.CurrentPage
+			previousWaitlist = existingSnapshot.WaitlistItems
 		}
 
 		staticFields := make([]*discordgo.MessageEmbedField, 0, len(embed.Fields))
@@ -90,6 +114,13 @@ func (rrm *roundRsvpManager) UpdateRoundEventEmbed(ctx context.Context, channelI
 			if i == 2 {
 				continue
 			}
+			if embedpagination.IsWaitlistFieldName(field.Name) {
+				// Without a snapshot, the rendered waitlist is the last one known.
+				if previousWaitlist == nil {
+					previousWaitlist = embedpagination.WaitlistLinesFromFieldValue(field.Value)
+				}
+				continue
+			}
 			staticFields = append(staticFields, field)
 		}
 
@@ -98,9 +129,11 @@ func (rrm *roundRsvpManager) UpdateRoundEventEmbed(ctx context.Context, channelI
 			embed,
 			msg.Components,
 			staticFields,
-			embed.Fields[2].Name,
+			participantField.Name,
 			participantLines,
 		)
+		snapshot.WaitlistFieldName = waitlistFieldName
+		snapshot.WaitlistItems = waitlistLines
 		embedpagination.Set(snapshot)
 
 		pagedEmbed, pagedComponents, _, totalPages, err := embedpagination.RenderPage(messageID, targetPage)
@@ -142,7 +175,12 @@ func (rrm *roundRsvpManager) UpdateRoundEventEmbed(ctx context.Context, channelI
 		rrm.logger.InfoContext(ctx, "Successfully updated round event embed",
 			attr.String("channel_id", resolvedChannelID),
 			attr.String("discord_message_id", messageID),
-			attr.Int("participant_count", len(participants)))
+			attr.Int("participant_count", len(participants)),
+			attr.Int("waitlist_count", len(waitlist)))
+
+		if len(previousWaitlist) > 0 {
+			rrm.notifyPromoted(ctx, guildID, resolvedChannelID, messageID, embed.Title, in, previousWaitlist)
+		}
 
 		return RoundRsvpOperationResult{Success: updatedMsg}, nil
 	})
@@ -177,19 +215,65 @@ func (rrm *roundRsvpManager) formatParticipants(_ context.Context, guildID strin
 
 	var lines []string
 	for _, participant := range sortedParticipants {
-		displayName := rrm.resolveParticipantDisplayName(guildID, participant)
-		var line string
-		if participant.TagNumber != nil && *participant.TagNumber > 0 {
-			line = fmt.Sprintf("%s %s %d", displayName, tagPrefix, *participant.TagNumber)
-		} else {
-			line = displayName
-		}
-		lines = append(lines, line)
+		lines = append(lines, rrm.participantLine(guildID, participant))
 	}
 
 	return strings.Join(lines, "\n")
 }
 
+// participantLine formats a participant as "DisplayName Tag: N", or just
+// "DisplayName" if no tag number. Tentative players are marked so reminders
+// can call them out.
+func (rrm *roundRsvpManager) participantLine(guildID string, participant roundtypes.Participant) string {
+	line := rrm.resolveParticipantDisplayName(guildID, participant)
+	if participant.TagNumber != nil && *participant.TagNumber > 0 {
+		line = fmt.Sprintf("%s %s %d", line, tagPrefix, *participant.TagNumber)
+	}
+	if participant.Response == roundtypes.ResponseTentative {
+		line += embedpagination.TentativeSuffix
+	}
+	return line
+}
+
+// notifyPromoted DMs the players in the round who were on its previous
+// waitlist. Players are matched by their embed line, so a waitlist that
+// survived a restart in the snapshot or embed still promotes correctly.
+func (rrm *roundRsvpManager) notifyPromoted(ctx context.Context, guildID, channelID, messageID, title string, in []roundtypes.Participant, previousWaitlist []string) {
+	waiting := make(map[string]bool, len(previousWaitlist))
+	for _, line := range previousWaitlist {
+		waiting[line] = true
+	}
+
+	for _, participant := range in {
+		if participant.Response == roundtypes.ResponseTentative || participant.Response == roundtypes.ResponseDecline {
+			continue
+		}
+		if !waiting[rrm.participantLine(guildID, participant)] {
+			continue
+		}
+
+		content := fmt.Sprintf("🎉 A spot opened up in %s — you're off the waitlist and in the round!", title)
+		if guildID != "" && channelID != "" && messageID != "" {
+			content += fmt.Sprintf("\nhttps://discord.com/channels/%s/%s/%s", guildID, channelID, messageID)
+		}
+
+		dmChannel, err := rrm.session.UserChannelCreate(string(participant.UserID))
+		if err == nil {
+			_, err = rrm.session.ChannelMessageSend(dmChannel.ID, content)
+		}
+		if err != nil {
+			rrm.logger.WarnContext(ctx, "Failed to DM promoted waitlist player",
+				attr.Error(err),
+				attr.String("user_id", string(participant.UserID)),
+				attr.String("discord_message_id", messageID))
+			continue
+		}
+		rrm.logger.InfoContext(ctx, "Promoted player off the waitlist",
+			attr.String("user_id", string(participant.UserID)),
+			attr.String("discord_message_id", messageID))
+	}
+}
+
 // resolveParticipantDisplayName returns the best available display name for a participant.
 // Priority: guild nick → global display name → username → RawName → UserID string.
 func (rrm *roundRsvpManager) resolveParticipantDisplayName(guildID string, participant roundtypes.Participant) string {
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
@@ -223,3 +224,125 @@ func Test_roundRsvpManager_UpdateRoundEventEmbed(t *testing.T) {
 		})
 	}
 }
+
+func Test_roundRsvpManager_UpdateRoundEventEmbed_Waitlist(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	current := &discordgo.MessageEmbed{
+		Title: "**League Night**",
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "📅 Time", Value: "Test Time"},
+			{Name: "📍 Location", Value: "Test Location"},
+			{Name: "👥 Participants (0/2)", Value: "-"},
+		},
+	}
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return &discordgo.Message{ID: messageID, Embeds: []*discordgo.MessageEmbed{current}}, nil
+	}
+	fakeSession.ChannelMessageEditEmbedFunc = func(channelID, messageID string, embed *discordgo.MessageEmbed, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		current = embed
+		return &discordgo.Message{ID: messageID, Embeds: []*discordgo.MessageEmbed{embed}}, nil
+	}
+	var dmUsers []string
+	var dmContent string
+	fakeSession.UserChannelCreateFunc = func(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		dmUsers = append(dmUsers, recipientID)
+		return &discordgo.Channel{ID: "dm-" + recipientID}, nil
+	}
+	fakeSession.ChannelMessageSendFunc = func(channelID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		dmContent = content
+		return &discordgo.Message{ID: "dm"}, nil
+	}
+
+	rrm := &roundRsvpManager{
+		session: fakeSession,
+		logger:  loggerfrolfbot.NoOpLogger,
+		operationWrapper: func(ctx context.Context, name string, fn func(context.Context) (RoundRsvpOperationResult, error)) (RoundRsvpOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+	messageID := "waitlist-" + uuid.NewString()
+
+	_, err := rrm.UpdateRoundEventEmbed(context.Background(), "channel-123", messageID, []roundtypes.Participant{
+		{UserID: "alice", Response: roundtypes.ResponseAccept},
+		{UserID: "bob", Response: roundtypes.ResponseAccept},
+		{UserID: "carol", Response: roundtypes.ResponseAccept},
+		{UserID: "dave", Response: roundtypes.ResponseAccept},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if current.Fields[2].Name != "👥 Participants (2/2)" || current.Fields[2].Value != "alice\nbob" {
+		t.Fatalf("unexpected participants field %+v", current.Fields[2])
+	}
+	if len(current.Fields) != 4 || current.Fields[3].Name != waitlistFieldName || current.Fields[3].Value != "1. carol\n2. dave" {
+		t.Fatalf("expected a waitlist field, got %+v", current.Fields)
+	}
+	if len(dmUsers) != 0 {
+		t.Fatalf("expected no DMs before anyone declines, got %v", dmUsers)
+	}
+
+	// Bob drops out, so Carol is promoted and Dave moves up the waitlist.
+	_, err = rrm.UpdateRoundEventEmbed(context.Background(), "channel-123", messageID, []roundtypes.Participant{
+		{UserID: "alice", Response: roundtypes.ResponseAccept},
+		{UserID: "carol", Response: roundtypes.ResponseAccept},
+		{UserID: "dave", Response: roundtypes.ResponseAccept},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if current.Fields[2].Name != "👥 Participants (2/2)" || current.Fields[2].Value != "alice\ncarol" {
+		t.Fatalf("unexpected participants field %+v", current.Fields[2])
+	}
+	if current.Fields[3].Value != "1. dave" {
+		t.Fatalf("unexpected waitlist %q", current.Fields[3].Value)
+	}
+	if len(dmUsers) != 1 || dmUsers[0] != "carol" || !strings.Contains(dmContent, "off the waitlist") {
+		t.Fatalf("expected Carol to be DMed, got %v %q", dmUsers, dmContent)
+	}
+
+	snapshot, found := embedpagination.Get(messageID)
+	if !found || len(snapshot.WaitlistItems) != 1 || snapshot.WaitlistItems[0] != "dave" || snapshot.ParticipantFieldName != "👥 Participants (2/2)" {
+		t.Fatalf("snapshot out of step with the embed: %+v", snapshot)
+	}
+}
+
+func Test_roundRsvpManager_UpdateRoundEventEmbed_StartedRound(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return &discordgo.Message{ID: messageID, Embeds: []*discordgo.MessageEmbed{{
+			Title: "**League Night** - Round Started",
+			Fields: []*discordgo.MessageEmbedField{
+				{Name: "📅 Started", Value: "<t:1:f>"},
+				{Name: "📍 Location", Value: "Test Location"},
+				{Name: "👥 Participants", Value: "<@alice> — Score: --"},
+			},
+		}}}, nil
+	}
+	edited := false
+	fakeSession.ChannelMessageEditEmbedFunc = func(channelID, messageID string, embed *discordgo.MessageEmbed, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		edited = true
+		return &discordgo.Message{ID: messageID}, nil
+	}
+	fakeSession.ChannelMessageEditComplexFunc = func(edit *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		edited = true
+		return &discordgo.Message{ID: edit.ID}, nil
+	}
+
+	rrm := &roundRsvpManager{
+		session: fakeSession,
+		logger:  loggerfrolfbot.NoOpLogger,
+		operationWrapper: func(ctx context.Context, name string, fn func(context.Context) (RoundRsvpOperationResult, error)) (RoundRsvpOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	_, err := rrm.UpdateRoundEventEmbed(context.Background(), "channel-123", "started-"+uuid.NewString(), []roundtypes.Participant{
+		{UserID: "alice", Response: roundtypes.ResponseAccept},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if edited {
+		t.Fatal("expected a started round's scorecard to be left as is")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strings"
 
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundcapacity "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_capacity"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/utils"
 	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
 	"github.com/google/uuid"
@@ -133,8 +135,13 @@ func (rrm *roundRsvpManager) HandleRoundResponse(ctx context.Context, i *discord
 			return RoundRsvpOperationResult{Error: err}, nil
 		}
 
+		content := fmt.Sprintf("You have chosen: %s", string(response))
+		if response == roundtypes.ResponseAccept && rrm.roundFullWithout(i, user) {
+			content = "This round is full, so you're on the waitlist. You'll get a DM if a spot opens up."
+		}
+
 		_, err = rrm.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-			Content: fmt.Sprintf("You have chosen: %s", string(response)),
+			Content: content,
 			Flags:   discordgo.MessageFlagsEphemeral,
 		})
 		if err != nil {
@@ -261,3 +268,30 @@ func (rrm *roundRsvpManager) InteractionJoinRoundLate(ctx context.Context, i *di
 		return RoundRsvpOperationResult{Success: "Late join successfully processed"}, nil
 	})
 }
+
+// roundFullWithout reports whether the round message the interaction came
+// from shows a full round that user does not already have a spot in.
+func (rrm *roundRsvpManager) roundFullWithout(i *discordgo.InteractionCreate, user *discordgo.User) bool {
+	if i.Message == nil || len(i.Message.Embeds) == 0 || len(i.Message.Embeds[0].Fields) < 3 {
+		return false
+	}
+	field := i.Message.Embeds[0].Fields[2]
+	if field == nil || !roundcapacity.Full(field.Name) {
+		return false
+	}
+
+	// The message only shows the current page; the snapshot has every line.
+	lines := embedpagination.ParticipantLinesFromFieldValue(field.Value)
+	if snapshot, found := embedpagination.Get(i.Message.ID); found {
+		lines = snapshot.LineItems
+	}
+
+	name := rrm.resolveParticipantDisplayName(i.GuildID, roundtypes.Participant{UserID: sharedtypes.DiscordID(user.ID)})
+	for _, line := range lines {
+		line = strings.TrimSuffix(line, embedpagination.TentativeSuffix)
+		if line == name || strings.HasPrefix(line, name+" "+tagPrefix) {
+			return false
+		}
+	}
+	return true
+}
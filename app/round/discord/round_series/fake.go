@@ -0,0 +1,38 @@
+package roundseries
+
+import (
+	"context"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// FakeStore is a Store over a fixed set of series, as the backend would
+// return them. Err, when set, is returned by every lookup.
+type FakeStore struct {
+	Series []Series
+	Err    error
+}
+
+func (f *FakeStore) Lookup(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (Series, bool, error) {
+	if f.Err != nil {
+		return Series{}, false, f.Err
+	}
+	for _, series := range f.Series {
+		if _, ok := series.Find(roundID); ok && series.GuildID == guildID {
+			return series, true, nil
+		}
+	}
+	return Series{}, false, nil
+}
+
+func (f *FakeStore) Get(ctx context.Context, guildID sharedtypes.GuildID, seriesID string) (Series, bool, error) {
+	if f.Err != nil {
+		return Series{}, false, f.Err
+	}
+	for _, series := range f.Series {
+		if series.ID == seriesID && series.GuildID == guildID {
+			return series, true, nil
+		}
+	}
+	return Series{}, false, nil
+}
@@ -0,0 +1,235 @@
+package roundseries
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// Recurrence is how often a round series repeats.
+type Recurrence string
+
+const (
+	RecurrenceWeekly   Recurrence = "weekly"
+	RecurrenceBiweekly Recurrence = "biweekly"
+)
+
+// MaxOccurrences caps how many rounds a single series may create.
+const MaxOccurrences = 26
+
+// StartTimeLayout is the start time format recurring rounds must use so each
+// occurrence can be computed before the backend sees it.
+const StartTimeLayout = "2006-01-02 15:04"
+
+// UntilLayout is the format of a series' last date.
+const UntilLayout = "2006-01-02"
+
+// ParseRecurrence validates a recurrence option value.
+func ParseRecurrence(value string) (Recurrence, error) {
+	switch r := Recurrence(strings.ToLower(strings.TrimSpace(value))); r {
+	case RecurrenceWeekly, RecurrenceBiweekly:
+		return r, nil
+	default:
+		return "", fmt.Errorf("unknown recurrence %q", value)
+	}
+}
+
+// Days returns the number of days between occurrences.
+func (r Recurrence) Days() int {
+	if r == RecurrenceBiweekly {
+		return 14
+	}
+	return 7
+}
+
+// Label returns the recurrence as shown to users, e.g. "Weekly".
+func (r Recurrence) Label() string {
+	if r == RecurrenceBiweekly {
+		return "Every two weeks"
+	}
+	return "Weekly"
+}
+
+// LaterRounds describes n later rounds of a series, e.g. "the 3 later rounds".
+func LaterRounds(n int) string {
+	if n == 1 {
+		return "the next round"
+	}
+	return fmt.Sprintf("the %d later rounds", n)
+}
+
+// Occurrences returns the start times from first through the end of the
+// until date, keeping the wall-clock time across DST changes. The result is
+// capped at MaxOccurrences.
+func Occurrences(first time.Time, recurrence Recurrence, until time.Time) []time.Time {
+	loc := first.Location()
+	last := time.Date(until.Year(), until.Month(), until.Day(), 23, 59, 59, 0, loc)
+
+	var starts []time.Time
+	for n := 0; len(starts) < MaxOccurrences; n++ {
+		start := time.Date(first.Year(), first.Month(), first.Day()+n*recurrence.Days(), first.Hour(), first.Minute(), 0, 0, loc)
+		if start.After(last) {
+			break
+		}
+		starts = append(starts, start)
+	}
+	return starts
+}
+
+// Shift repeats an edit that moved one occurrence from one start time to
+// another: start moves by the same number of calendar days and onto the new
+// wall-clock time.
+func Shift(start, from, to time.Time) time.Time {
+	loc := to.Location()
+	start, from = start.In(loc), from.In(loc)
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(toDate.Sub(fromDate).Hours() / 24)
+	return time.Date(start.Year(), start.Month(), start.Day()+days, to.Hour(), to.Minute(), 0, 0, loc)
+}
+
+// Occurrence is one round of a series. RoundID, ChannelID and MessageID are
+// filled in once the backend has created the round and its embed is posted.
+type Occurrence struct {
+	StartTime time.Time
+	RoundID   sharedtypes.RoundID
+	ChannelID string
+	MessageID string
+}
+
+// Series is a set of rounds created together from one /createround submission.
+type Series struct {
+	ID          string
+	GuildID     sharedtypes.GuildID
+	Title       string
+	Recurrence  Recurrence
+	Occurrences []Occurrence
+}
+
+// Find returns the occurrence for roundID.
+func (s Series) Find(roundID sharedtypes.RoundID) (Occurrence, bool) {
+	for _, occurrence := range s.Occurrences {
+		if occurrence.RoundID == roundID {
+			return occurrence, true
+		}
+	}
+	return Occurrence{}, false
+}
+
+// Later returns the created occurrences that start after after, in start
+// time order.
+func (s Series) Later(after time.Time) []Occurrence {
+	var later []Occurrence
+	for _, occurrence := range s.Occurrences {
+		if occurrence.RoundID != (sharedtypes.RoundID{}) && occurrence.StartTime.After(after) {
+			later = append(later, occurrence)
+		}
+	}
+	sort.Slice(later, func(a, b int) bool { return later[a].StartTime.Before(later[b].StartTime) })
+	return later
+}
+
+// SeriesRoundsRequestV1 is the request-reply subject of
+// config.BackendFeatureRoundSeries. The backend stores the "series_id"
+// metadata of each round creation request with the round it creates, and
+// answers with the rounds sharing it.
+const SeriesRoundsRequestV1 = "round.series.rounds.request.v1"
+
+const seriesRequestTimeout = 2 * time.Second
+
+// SeriesRoundsRequestPayloadV1 asks for the rounds of a series, named either
+// by its ID or by one of its rounds.
+type SeriesRoundsRequestPayloadV1 struct {
+	GuildID  string `json:"guild_id"`
+	SeriesID string `json:"series_id,omitempty"`
+	RoundID  string `json:"round_id,omitempty"`
+}
+
+// SeriesRoundsResponsePayloadV1 is the reply to SeriesRoundsRequestV1.
+// SeriesID is empty when the round is not part of a series.
+type SeriesRoundsResponsePayloadV1 struct {
+	SeriesID string          `json:"series_id,omitempty"`
+	Title    string          `json:"title,omitempty"`
+	Rounds   []SeriesRoundV1 `json:"rounds"`
+}
+
+// SeriesRoundV1 is one round of a series as the backend has it.
+type SeriesRoundV1 struct {
+	ID        sharedtypes.RoundID `json:"id"`
+	StartTime time.Time           `json:"start_time"`
+	ChannelID string              `json:"channel_id"`
+	MessageID string              `json:"message_id"`
+}
+
+// Store looks up round series so series-level edits and cancellations can
+// find the rest of a round's series.
+type Store interface {
+	// Lookup returns the series roundID belongs to. It reports false when
+	// the round is not part of a series.
+	Lookup(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (Series, bool, error)
+	// Get returns the series with seriesID. It reports false when the series
+	// has no rounds left.
+	Get(ctx context.Context, guildID sharedtypes.GuildID, seriesID string) (Series, bool, error)
+}
+
+// BackendStore is a Store that asks the backend, which links each round to
+// its series, so every process sees the same series across restarts.
+type BackendStore struct {
+	eventBus eventbus.EventBus
+}
+
+// NewStore creates a series Store backed by the backend.
+func NewStore(eventBus eventbus.EventBus) Store {
+	return &BackendStore{eventBus: eventBus}
+}
+
+// Lookup returns the series roundID belongs to.
+func (s *BackendStore) Lookup(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) (Series, bool, error) {
+	return s.request(ctx, SeriesRoundsRequestPayloadV1{GuildID: string(guildID), RoundID: roundID.String()})
+}
+
+// Get returns the series with seriesID.
+func (s *BackendStore) Get(ctx context.Context, guildID sharedtypes.GuildID, seriesID string) (Series, bool, error) {
+	return s.request(ctx, SeriesRoundsRequestPayloadV1{GuildID: string(guildID), SeriesID: seriesID})
+}
+
+func (s *BackendStore) request(ctx context.Context, request SeriesRoundsRequestPayloadV1) (Series, bool, error) {
+	if request.GuildID == "" {
+		return Series{}, false, fmt.Errorf("guild id is required")
+	}
+
+	response, err := messagecreator.NATSRequest[SeriesRoundsRequestPayloadV1, SeriesRoundsResponsePayloadV1](
+		ctx,
+		s.eventBus,
+		SeriesRoundsRequestV1+"."+request.GuildID,
+		request,
+		seriesRequestTimeout,
+	)
+	if err != nil {
+		return Series{}, false, fmt.Errorf("failed to look up round series: %w", err)
+	}
+	if response.SeriesID == "" || len(response.Rounds) == 0 {
+		return Series{}, false, nil
+	}
+
+	series := Series{
+		ID:      response.SeriesID,
+		GuildID: sharedtypes.GuildID(request.GuildID),
+		Title:   response.Title,
+	}
+	for _, round := range response.Rounds {
+		series.Occurrences = append(series.Occurrences, Occurrence{
+			StartTime: round.StartTime,
+			RoundID:   round.ID,
+			ChannelID: round.ChannelID,
+			MessageID: round.MessageID,
+		})
+	}
+	return series, true, nil
+}
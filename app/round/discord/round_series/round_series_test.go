@@ -0,0 +1,87 @@
+package roundseries
+
+import (
+	"testing"
+	"time"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
+)
+
+func TestOccurrences_KeepsWallClockAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	first := time.Date(2026, time.March, 3, 18, 30, 0, 0, loc)
+	until := time.Date(2026, time.March, 17, 0, 0, 0, 0, time.UTC)
+
+	starts := Occurrences(first, RecurrenceWeekly, until)
+	if len(starts) != 3 {
+		t.Fatalf("expected 3 occurrences, got %d", len(starts))
+	}
+	for _, start := range starts {
+		if start.Hour() != 18 || start.Minute() != 30 {
+			t.Errorf("occurrence %v drifted from 18:30", start)
+		}
+	}
+	if got := starts[2].Format("2006-01-02"); got != "2026-03-17" {
+		t.Errorf("expected the until date to be included, got %s", got)
+	}
+}
+
+func TestOccurrences_BiweeklyAndCapped(t *testing.T) {
+	first := time.Date(2026, time.January, 6, 18, 0, 0, 0, time.UTC)
+
+	biweekly := Occurrences(first, RecurrenceBiweekly, time.Date(2026, time.February, 2, 0, 0, 0, 0, time.UTC))
+	if len(biweekly) != 2 || biweekly[1].Day() != 20 {
+		t.Fatalf("unexpected biweekly occurrences %v", biweekly)
+	}
+
+	capped := Occurrences(first, RecurrenceWeekly, first.AddDate(5, 0, 0))
+	if len(capped) != MaxOccurrences {
+		t.Fatalf("expected %d occurrences, got %d", MaxOccurrences, len(capped))
+	}
+}
+
+func TestShift_MovesByCalendarDaysAndWallClock(t *testing.T) {
+	from := time.Date(2026, time.March, 3, 18, 30, 0, 0, time.UTC)
+	to := time.Date(2026, time.March, 4, 19, 0, 0, 0, time.UTC)
+	start := time.Date(2026, time.March, 17, 18, 30, 0, 0, time.UTC)
+
+	got := Shift(start, from, to)
+	want := time.Date(2026, time.March, 18, 19, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("Shift() = %v, want %v", got, want)
+	}
+}
+
+func TestSeries_FindAndLater(t *testing.T) {
+	first := time.Date(2026, time.March, 3, 18, 30, 0, 0, time.UTC)
+	starts := Occurrences(first, RecurrenceWeekly, first.AddDate(0, 0, 14))
+
+	roundIDs := []sharedtypes.RoundID{sharedtypes.RoundID(uuid.New()), sharedtypes.RoundID(uuid.New()), sharedtypes.RoundID(uuid.New())}
+	// The backend may return rounds in any order.
+	series := Series{ID: "series-1", GuildID: "guild-1", Occurrences: []Occurrence{
+		{StartTime: starts[2], RoundID: roundIDs[2]},
+		{StartTime: starts[0], RoundID: roundIDs[0]},
+		{StartTime: starts[1], RoundID: roundIDs[1]},
+	}}
+
+	current, ok := series.Find(roundIDs[0])
+	if !ok || !current.StartTime.Equal(starts[0]) {
+		t.Fatalf("Find() = %+v, %v", current, ok)
+	}
+	if _, ok := series.Find(sharedtypes.RoundID(uuid.New())); ok {
+		t.Fatal("expected a round outside the series not to be found")
+	}
+
+	later := series.Later(current.StartTime)
+	if len(later) != 2 || later[0].RoundID != roundIDs[1] || later[1].RoundID != roundIDs[2] {
+		t.Fatalf("unexpected later occurrences %+v", later)
+	}
+	if len(series.Later(starts[2])) != 0 {
+		t.Fatal("expected no rounds after the last occurrence")
+	}
+}
@@ -0,0 +1,86 @@
+package roundtemplate
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /roundtemplate command (Admin only).
+func CommandSpec() interactions.CommandSpec {
+	adminPermission := int64(discordgo.PermissionAdministrator)
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "roundtemplate",
+			Description: "Manage round templates for /createround (Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "save",
+					Description: "Save a template, replacing any with the same name",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "name",
+							Description: "Name of the template, e.g. League Night",
+							Required:    true,
+							MaxLength:   maxNameLength,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "location",
+							Description: "Default course or location",
+							Required:    false,
+							MaxLength:   100,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "description",
+							Description: "Default round description",
+							Required:    false,
+							MaxLength:   500,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "time",
+							Description: "Default start time of day (HH:MM), e.g. 18:30",
+							Required:    false,
+							MaxLength:   5,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "timezone",
+							Description: "Timezone for the start time (defaults to the server timezone)",
+							Required:    false,
+							MaxLength:   50,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "list",
+					Description: "List this server's round templates",
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "delete",
+					Description: "Delete a round template",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "name",
+							Description:  "The template to delete",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+			DefaultMemberPermissions: &adminPermission,
+		},
+		RequiredPermission: interactions.AdminRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+		BackendFeature:     config.BackendFeatureRoundTemplates,
+	}
+}
@@ -0,0 +1,191 @@
+package roundtemplate
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RoundTemplateManager handles /roundtemplate.
+type RoundTemplateManager interface {
+	HandleRoundTemplateCommand(ctx context.Context, i *discordgo.InteractionCreate)
+	AutocompleteTemplateName(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error)
+}
+
+type roundTemplateManager struct {
+	session        discord.Session
+	logger         *slog.Logger
+	listTemplates  func(ctx context.Context, guildID string) (*TemplateListResponsePayloadV1, error)
+	saveTemplate   func(ctx context.Context, request TemplateSaveRequestPayloadV1) (*TemplateSaveResponsePayloadV1, error)
+	deleteTemplate func(ctx context.Context, request TemplateDeleteRequestPayloadV1) (*TemplateDeleteResponsePayloadV1, error)
+}
+
+// NewRoundTemplateManager creates a RoundTemplateManager backed by the
+// backend's round template requests.
+func NewRoundTemplateManager(session discord.Session, eventBus eventbus.EventBus, logger *slog.Logger) RoundTemplateManager {
+	return &roundTemplateManager{
+		session: session,
+		logger:  logger,
+		listTemplates: func(ctx context.Context, guildID string) (*TemplateListResponsePayloadV1, error) {
+			return ListTemplates(ctx, eventBus, guildID)
+		},
+		saveTemplate: func(ctx context.Context, request TemplateSaveRequestPayloadV1) (*TemplateSaveResponsePayloadV1, error) {
+			return messagecreator.NATSRequest[TemplateSaveRequestPayloadV1, TemplateSaveResponsePayloadV1](
+				ctx, eventBus, TemplateSaveRequestV1+"."+request.GuildID, request, templateRequestTimeout)
+		},
+		deleteTemplate: func(ctx context.Context, request TemplateDeleteRequestPayloadV1) (*TemplateDeleteResponsePayloadV1, error) {
+			return messagecreator.NATSRequest[TemplateDeleteRequestPayloadV1, TemplateDeleteResponsePayloadV1](
+				ctx, eventBus, TemplateDeleteRequestV1+"."+request.GuildID, request, templateRequestTimeout)
+		},
+	}
+}
+
+// HandleRoundTemplateCommand dispatches the /roundtemplate subcommands.
+func (m *roundTemplateManager) HandleRoundTemplateCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "roundtemplate")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "application_command")
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		m.logger.WarnContext(ctx, "No options provided for roundtemplate command")
+		return
+	}
+
+	// Requests go to the backend, so defer before making them.
+	if err := m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to defer roundtemplate interaction", attr.Error(err))
+		return
+	}
+
+	var content string
+	switch subcommand := options[0]; subcommand.Name {
+	case "save":
+		content = m.save(ctx, i, subcommand.Options)
+	case "list":
+		content = m.list(ctx, i)
+	case "delete":
+		content = m.delete(ctx, i, subcommand.Options)
+	default:
+		m.logger.WarnContext(ctx, "Unknown subcommand", attr.String("subcommand", subcommand.Name))
+		content = "Unknown subcommand."
+	}
+
+	if _, err := m.session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: &content}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to edit roundtemplate response", attr.Error(err))
+	}
+}
+
+// AutocompleteTemplateName offers the guild's templates for /roundtemplate
+// delete.
+func (m *roundTemplateManager) AutocompleteTemplateName(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	response, err := m.listTemplates(ctx, i.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	return TemplateNameChoices(response.Templates, focused.StringValue()), nil
+}
+
+func (m *roundTemplateManager) save(ctx context.Context, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) string {
+	var template Template
+	for _, opt := range options {
+		value := strings.TrimSpace(opt.StringValue())
+		switch opt.Name {
+		case "name":
+			template.Name = value
+		case "location":
+			template.Location = value
+		case "description":
+			template.Description = value
+		case "time":
+			template.StartTime = value
+		case "timezone":
+			template.Timezone = value
+		}
+	}
+	if err := template.Validate(); err != nil {
+		return "❌ " + err.Error()
+	}
+
+	response, err := m.saveTemplate(ctx, TemplateSaveRequestPayloadV1{
+		GuildID:  i.GuildID,
+		Template: template,
+		SavedBy:  userID(i),
+	})
+	if err != nil {
+		m.logger.ErrorContext(ctx, "Failed to save round template", attr.Error(err), attr.String("guild_id", i.GuildID))
+		return "❌ Couldn't save the template right now. Please try again."
+	}
+	if response.Error != "" {
+		return "❌ " + response.Error
+	}
+
+	verb := "Saved"
+	if response.Replaced {
+		verb = "Updated"
+	}
+	return fmt.Sprintf("%s round template %s. Use `/createround template:%s` to start from it.", verb, template.Summary(), template.Name)
+}
+
+func (m *roundTemplateManager) list(ctx context.Context, i *discordgo.InteractionCreate) string {
+	response, err := m.listTemplates(ctx, i.GuildID)
+	if err != nil {
+		m.logger.ErrorContext(ctx, "Failed to list round templates", attr.Error(err), attr.String("guild_id", i.GuildID))
+		return "❌ Couldn't load round templates right now. Please try again."
+	}
+	if len(response.Templates) == 0 {
+		return "No round templates yet. Save one with `/roundtemplate save`."
+	}
+
+	lines := make([]string, 0, len(response.Templates)+1)
+	lines = append(lines, "Round templates:")
+	for _, template := range response.Templates {
+		lines = append(lines, "- "+template.Summary())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m *roundTemplateManager) delete(ctx context.Context, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) string {
+	var name string
+	for _, opt := range options {
+		if opt.Name == "name" {
+			name = strings.TrimSpace(opt.StringValue())
+		}
+	}
+	if name == "" {
+		return "❌ Template name is required."
+	}
+
+	response, err := m.deleteTemplate(ctx, TemplateDeleteRequestPayloadV1{GuildID: i.GuildID, Name: name})
+	if err != nil {
+		m.logger.ErrorContext(ctx, "Failed to delete round template", attr.Error(err), attr.String("guild_id", i.GuildID))
+		return "❌ Couldn't delete the template right now. Please try again."
+	}
+	if response.Error != "" {
+		return "❌ " + response.Error
+	}
+	if !response.Deleted {
+		return fmt.Sprintf("No round template named **%s**.", name)
+	}
+	return fmt.Sprintf("Deleted round template **%s**.", name)
+}
+
+func userID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
@@ -0,0 +1,191 @@
+package roundtemplate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/bwmarrin/discordgo"
+)
+
+func newTemplateCommand(subcommand string, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "interaction-1",
+		GuildID: "guild-1",
+		Type:    discordgo.InteractionApplicationCommand,
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "admin-1"}},
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name: "roundtemplate",
+			Options: []*discordgo.ApplicationCommandInteractionDataOption{{
+				Name:    subcommand,
+				Type:    discordgo.ApplicationCommandOptionSubCommand,
+				Options: options,
+			}},
+		},
+	}}
+}
+
+func stringOption(name, value string) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{Name: name, Type: discordgo.ApplicationCommandOptionString, Value: value}
+}
+
+// newTestManager returns a manager whose backend requests fail unless a test
+// overrides them, and a pointer to the content of its last response edit.
+func newTestManager(t *testing.T) (*roundTemplateManager, *string) {
+	t.Helper()
+	fakeSession := discord.NewFakeSession()
+	var content string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		if r.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource || r.Data.Flags != discordgo.MessageFlagsEphemeral {
+			t.Errorf("expected an ephemeral deferred response, got %+v", r)
+		}
+		return nil
+	}
+	fakeSession.InteractionResponseEditFunc = func(i *discordgo.Interaction, edit *discordgo.WebhookEdit, opts ...discordgo.RequestOption) (*discordgo.Message, error) {
+		content = *edit.Content
+		return &discordgo.Message{}, nil
+	}
+
+	unavailable := errors.New("backend unavailable")
+	return &roundTemplateManager{
+		session: fakeSession,
+		logger:  testutils.NoOpLogger(),
+		listTemplates: func(ctx context.Context, guildID string) (*TemplateListResponsePayloadV1, error) {
+			return nil, unavailable
+		},
+		saveTemplate: func(ctx context.Context, request TemplateSaveRequestPayloadV1) (*TemplateSaveResponsePayloadV1, error) {
+			return nil, unavailable
+		},
+		deleteTemplate: func(ctx context.Context, request TemplateDeleteRequestPayloadV1) (*TemplateDeleteResponsePayloadV1, error) {
+			return nil, unavailable
+		},
+	}, &content
+}
+
+func TestRoundTemplateManager_Save(t *testing.T) {
+	m, content := newTestManager(t)
+	var saved TemplateSaveRequestPayloadV1
+	m.saveTemplate = func(ctx context.Context, request TemplateSaveRequestPayloadV1) (*TemplateSaveResponsePayloadV1, error) {
+		saved = request
+		return &TemplateSaveResponsePayloadV1{Template: request.Template, Replaced: true}, nil
+	}
+
+	m.HandleRoundTemplateCommand(context.Background(), newTemplateCommand("save",
+		stringOption("name", " League Night "),
+		stringOption("location", "Pier Park"),
+		stringOption("time", "18:30"),
+	))
+
+	want := Template{Name: "League Night", Location: "Pier Park", StartTime: "18:30"}
+	if saved.GuildID != "guild-1" || saved.SavedBy != "admin-1" || saved.Template != want {
+		t.Fatalf("unexpected save request %+v", saved)
+	}
+	if got := "Updated round template **League Night** · Pier Park · 18:30 server time. Use `/createround template:League Night` to start from it."; *content != got {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
+
+func TestRoundTemplateManager_SaveRejectsInvalidTemplate(t *testing.T) {
+	m, content := newTestManager(t)
+	m.saveTemplate = func(ctx context.Context, request TemplateSaveRequestPayloadV1) (*TemplateSaveResponsePayloadV1, error) {
+		t.Fatal("invalid template should not be sent to the backend")
+		return nil, nil
+	}
+
+	m.HandleRoundTemplateCommand(context.Background(), newTemplateCommand("save",
+		stringOption("name", "League Night"),
+		stringOption("time", "half six"),
+	))
+
+	if *content != "❌ Start time must be a time of day as HH:MM, e.g. 18:30." {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
+
+func TestRoundTemplateManager_List(t *testing.T) {
+	tests := []struct {
+		name      string
+		templates []Template
+		err       error
+		want      string
+	}{
+		{
+			name:      "templates",
+			templates: []Template{{Name: "League Night", Location: "Pier Park"}, {Name: "Doubles"}},
+			want:      "Round templates:\n- **League Night** · Pier Park\n- **Doubles**",
+		},
+		{
+			name: "none saved",
+			want: "No round templates yet. Save one with `/roundtemplate save`.",
+		},
+		{
+			name: "backend error",
+			err:  errors.New("timeout"),
+			want: "❌ Couldn't load round templates right now. Please try again.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, content := newTestManager(t)
+			m.listTemplates = func(ctx context.Context, guildID string) (*TemplateListResponsePayloadV1, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				return &TemplateListResponsePayloadV1{Templates: tt.templates}, nil
+			}
+
+			m.HandleRoundTemplateCommand(context.Background(), newTemplateCommand("list"))
+
+			if *content != tt.want {
+				t.Fatalf("response = %q, want %q", *content, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundTemplateManager_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		deleted bool
+		want    string
+	}{
+		{name: "deleted", deleted: true, want: "Deleted round template **Doubles**."},
+		{name: "not found", want: "No round template named **Doubles**."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, content := newTestManager(t)
+			m.deleteTemplate = func(ctx context.Context, request TemplateDeleteRequestPayloadV1) (*TemplateDeleteResponsePayloadV1, error) {
+				if request.GuildID != "guild-1" || request.Name != "Doubles" {
+					t.Fatalf("unexpected delete request %+v", request)
+				}
+				return &TemplateDeleteResponsePayloadV1{Deleted: tt.deleted}, nil
+			}
+
+			m.HandleRoundTemplateCommand(context.Background(), newTemplateCommand("delete", stringOption("name", "Doubles")))
+
+			if *content != tt.want {
+				t.Fatalf("response = %q, want %q", *content, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundTemplateManager_AutocompleteTemplateName(t *testing.T) {
+	m, _ := newTestManager(t)
+	m.listTemplates = func(ctx context.Context, guildID string) (*TemplateListResponsePayloadV1, error) {
+		return &TemplateListResponsePayloadV1{Templates: []Template{{Name: "League Night"}, {Name: "Doubles"}}}, nil
+	}
+
+	choices, err := m.AutocompleteTemplateName(context.Background(), newTemplateCommand("delete"), stringOption("name", "dou"))
+	if err != nil {
+		t.Fatalf("AutocompleteTemplateName() error = %v", err)
+	}
+	if len(choices) != 1 || choices[0].Value != "Doubles" {
+		t.Fatalf("unexpected choices %+v", choices)
+	}
+}
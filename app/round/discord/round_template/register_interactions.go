@@ -0,0 +1,19 @@
+package roundtemplate
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RegisterHandlers registers the roundtemplate command and template name autocomplete handlers.
+func RegisterHandlers(registry *interactions.Registry, manager RoundTemplateManager) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling roundtemplate command", attr.String("interaction_id", i.ID))
+		manager.HandleRoundTemplateCommand(ctx, i)
+	})
+	registry.RegisterAutocompleteHandler(CommandSpec().Name(), "name", manager.AutocompleteTemplateName)
+}
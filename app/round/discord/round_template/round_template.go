@@ -0,0 +1,228 @@
+package roundtemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/bwmarrin/discordgo"
+)
+
+// Request-reply subjects of config.BackendFeatureRoundTemplates.
+const (
+	TemplateListRequestV1   = "round.template.list.request.v1"
+	TemplateSaveRequestV1   = "round.template.save.request.v1"
+	TemplateDeleteRequestV1 = "round.template.delete.request.v1"
+)
+
+const templateRequestTimeout = 2 * time.Second
+
+// TimeOfDayLayout is the format of a template's default start time.
+const TimeOfDayLayout = "15:04"
+
+const (
+	maxNameLength  = 50
+	startDayLayout = "2006-01-02 "
+)
+
+// Template is a guild's saved defaults for the create round modal.
+type Template struct {
+	Name        string `json:"name"`
+	Location    string `json:"location,omitempty"`
+	Description string `json:"description,omitempty"`
+	StartTime   string `json:"start_time,omitempty"`
+	Timezone    string `json:"timezone,omitempty"`
+}
+
+// TemplateListRequestPayloadV1 asks for a guild's round templates.
+type TemplateListRequestPayloadV1 struct {
+	GuildID string `json:"guild_id"`
+}
+
+// TemplateListResponsePayloadV1 is the reply to TemplateListRequestV1.
+type TemplateListResponsePayloadV1 struct {
+	Templates []Template `json:"templates"`
+}
+
+// TemplateSaveRequestPayloadV1 creates or replaces the guild's template with
+// the same name.
+type TemplateSaveRequestPayloadV1 struct {
+	GuildID  string   `json:"guild_id"`
+	Template Template `json:"template"`
+	SavedBy  string   `json:"saved_by"`
+}
+
+// TemplateSaveResponsePayloadV1 is the reply to TemplateSaveRequestV1.
+type TemplateSaveResponsePayloadV1 struct {
+	Template Template `json:"template"`
+	Replaced bool     `json:"replaced"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// TemplateDeleteRequestPayloadV1 deletes a guild's template by name.
+type TemplateDeleteRequestPayloadV1 struct {
+	GuildID string `json:"guild_id"`
+	Name    string `json:"name"`
+}
+
+// TemplateDeleteResponsePayloadV1 is the reply to TemplateDeleteRequestV1.
+type TemplateDeleteResponsePayloadV1 struct {
+	Deleted bool   `json:"deleted"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Validate checks a template before it is saved.
+func (t Template) Validate() error {
+	if t.Name == "" {
+		return errors.New("Template name is required.")
+	}
+	if len(t.Name) > maxNameLength {
+		return fmt.Errorf("Template name must be %d characters or fewer.", maxNameLength)
+	}
+	if t.StartTime != "" {
+		if _, err := time.Parse(TimeOfDayLayout, t.StartTime); err != nil {
+			return errors.New("Start time must be a time of day as HH:MM, e.g. 18:30.")
+		}
+	}
+	if t.Timezone != "" {
+		if _, err := time.LoadLocation(t.Timezone); err != nil {
+			return fmt.Errorf("Timezone %q is not recognised. Use a name like America/Chicago.", t.Timezone)
+		}
+	}
+	return nil
+}
+
+// NextStart returns the next time after now that matches the template's
+// default time of day, formatted for the create round modal's start time. The
+// time is read in guildTimezone unless the template names its own timezone.
+// It reports false when the template has no default time.
+func (t Template) NextStart(now time.Time, guildTimezone string) (string, bool) {
+	if t.StartTime == "" {
+		return "", false
+	}
+	timeOfDay, err := time.Parse(TimeOfDayLayout, t.StartTime)
+	if err != nil {
+		return "", false
+	}
+	timezone := t.Timezone
+	if timezone == "" {
+		timezone = guildTimezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", false
+	}
+
+	now = now.In(loc)
+	start := time.Date(now.Year(), now.Month(), now.Day(), timeOfDay.Hour(), timeOfDay.Minute(), 0, 0, loc)
+	if !start.After(now) {
+		start = start.AddDate(0, 0, 1)
+	}
+	return start.Format(startDayLayout + TimeOfDayLayout), true
+}
+
+// Summary describes the template in one line, e.g. for /roundtemplate list.
+func (t Template) Summary() string {
+	parts := []string{"**" + t.Name + "**"}
+	if t.Location != "" {
+		parts = append(parts, t.Location)
+	}
+	if t.StartTime != "" {
+		timezone := t.Timezone
+		if timezone == "" {
+			timezone = "server time"
+		}
+		parts = append(parts, t.StartTime+" "+timezone)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// ListTemplates requests a guild's round templates from the backend.
+func ListTemplates(ctx context.Context, eventBus eventbus.EventBus, guildID string) (*TemplateListResponsePayloadV1, error) {
+	if guildID == "" {
+		return nil, errors.New("guild id is required")
+	}
+
+	return messagecreator.NATSRequest[TemplateListRequestPayloadV1, TemplateListResponsePayloadV1](
+		ctx,
+		eventBus,
+		TemplateListRequestV1+"."+guildID,
+		TemplateListRequestPayloadV1{GuildID: guildID},
+		templateRequestTimeout,
+	)
+}
+
+// FindTemplate looks up a guild's template by name, ignoring case. It returns
+// nil when the guild has no such template.
+func FindTemplate(ctx context.Context, eventBus eventbus.EventBus, guildID, name string) (*Template, error) {
+	response, err := ListTemplates(ctx, eventBus, guildID)
+	if err != nil {
+		return nil, err
+	}
+	for _, template := range response.Templates {
+		if strings.EqualFold(template.Name, strings.TrimSpace(name)) {
+			return &template, nil
+		}
+	}
+	return nil, nil
+}
+
+// NewTemplateFinder returns FindTemplate backed by eventBus, or nil while cfg
+// holds round templates back.
+func NewTemplateFinder(cfg *config.Config, eventBus eventbus.EventBus) func(ctx context.Context, guildID, name string) (*Template, error) {
+	if !cfg.BackendFeatureEnabled(config.BackendFeatureRoundTemplates) {
+		return nil
+	}
+	return func(ctx context.Context, guildID, name string) (*Template, error) {
+		return FindTemplate(ctx, eventBus, guildID, name)
+	}
+}
+
+// TemplateNameChoices builds autocomplete choices for the templates whose
+// names contain query.
+func TemplateNameChoices(templates []Template, query string) []*discordgo.ApplicationCommandOptionChoice {
+	query = strings.ToLower(strings.TrimSpace(query))
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(templates))
+	for _, template := range templates {
+		if template.Name == "" {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(template.Name), query) {
+			continue
+		}
+		label := template.Name
+		if template.Location != "" {
+			label += " · " + template.Location
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  interactions.TruncateChoiceName(label),
+			Value: template.Name,
+		})
+		if len(choices) == interactions.MaxAutocompleteChoices {
+			break
+		}
+	}
+	return choices
+}
+
+// NewTemplateNameHandler returns an autocomplete provider for template name
+// options that offers the guild's saved templates.
+func NewTemplateNameHandler(cfg *config.Config, eventBus eventbus.EventBus) interactions.AutocompleteHandler {
+	released := cfg.BackendFeatureEnabled(config.BackendFeatureRoundTemplates)
+	return func(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+		if !released {
+			return nil, nil
+		}
+		response, err := ListTemplates(ctx, eventBus, i.GuildID)
+		if err != nil {
+			return nil, err
+		}
+		return TemplateNameChoices(response.Templates, focused.StringValue()), nil
+	}
+}
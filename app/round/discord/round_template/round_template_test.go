@@ -0,0 +1,119 @@
+package roundtemplate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTemplate_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template Template
+		wantErr  string
+	}{
+		{name: "full template", template: Template{Name: "League Night", Location: "Pier Park", StartTime: "18:30", Timezone: "America/Los_Angeles"}},
+		{name: "name only", template: Template{Name: "Doubles"}},
+		{name: "missing name", template: Template{Location: "Pier Park"}, wantErr: "Template name is required."},
+		{name: "long name", template: Template{Name: strings.Repeat("x", maxNameLength+1)}, wantErr: "Template name must be 50 characters or fewer."},
+		{name: "bad time", template: Template{Name: "Doubles", StartTime: "6:30pm"}, wantErr: "Start time must be a time of day as HH:MM, e.g. 18:30."},
+		{name: "bad timezone", template: Template{Name: "Doubles", Timezone: "Mars/Olympus"}, wantErr: `Timezone "Mars/Olympus" is not recognised. Use a name like America/Chicago.`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTemplate_NextStart(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		template Template
+		now      time.Time
+		want     string
+		wantOK   bool
+	}{
+		{
+			name:     "later today",
+			template: Template{Name: "League Night", StartTime: "18:30"},
+			now:      time.Date(2026, time.March, 3, 12, 0, 0, 0, chicago),
+			want:     "2026-03-03 18:30",
+			wantOK:   true,
+		},
+		{
+			name:     "already passed today",
+			template: Template{Name: "League Night", StartTime: "18:30"},
+			now:      time.Date(2026, time.March, 3, 18, 30, 0, 0, chicago),
+			want:     "2026-03-04 18:30",
+			wantOK:   true,
+		},
+		{
+			name:     "read in the template's timezone",
+			template: Template{Name: "Dawn Patrol", StartTime: "07:00", Timezone: "America/New_York"},
+			now:      time.Date(2026, time.March, 3, 6, 30, 0, 0, chicago),
+			want:     "2026-03-04 07:00",
+			wantOK:   true,
+		},
+		{
+			name:     "no default time",
+			template: Template{Name: "Doubles"},
+			now:      time.Date(2026, time.March, 3, 12, 0, 0, 0, chicago),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.template.NextStart(tt.now, "America/Chicago")
+			if got != tt.want || ok != tt.wantOK {
+				t.Fatalf("NextStart() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTemplate_Summary(t *testing.T) {
+	template := Template{Name: "League Night", Location: "Pier Park", StartTime: "18:30"}
+	if got, want := template.Summary(), "**League Night** · Pier Park · 18:30 server time"; got != want {
+		t.Fatalf("Summary() = %q, want %q", got, want)
+	}
+	if got, want := (Template{Name: "Doubles"}).Summary(), "**Doubles**"; got != want {
+		t.Fatalf("Summary() = %q, want %q", got, want)
+	}
+}
+
+func TestTemplateNameChoices(t *testing.T) {
+	templates := []Template{
+		{Name: "League Night", Location: "Pier Park"},
+		{Name: "Doubles"},
+		{Name: ""},
+	}
+
+	choices := TemplateNameChoices(templates, "")
+	if len(choices) != 2 {
+		t.Fatalf("expected 2 choices, got %d", len(choices))
+	}
+	if choices[0].Name != "League Night · Pier Park" || choices[0].Value != "League Night" {
+		t.Fatalf("unexpected choice %+v", choices[0])
+	}
+
+	choices = TemplateNameChoices(templates, "  NIGHT ")
+	if len(choices) != 1 || choices[0].Value != "League Night" {
+		t.Fatalf("expected only League Night to match, got %+v", choices)
+	}
+}
@@ -0,0 +1,273 @@
+// Package roundtime reads the start times typed into the round modals. Besides
+// the exact YYYY-MM-DD HH:MM layout it understands short phrases such as
+// "tomorrow 6pm", "next tue 5:30" or "sat 10am", and builds the ephemeral
+// preview that lets the user confirm or adjust what was understood.
+package roundtime
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Layout is the exact start time format the round modals accept.
+const Layout = "2006-01-02 15:04"
+
+// ErrUnrecognised is returned when a start time can't be read.
+var ErrUnrecognised = errors.New(`Start Time wasn't understood. Use YYYY-MM-DD HH:MM, or a phrase like "tomorrow 6pm", "next tue 5:30" or "sat 10am".`)
+
+// ErrPassed is returned when "today" or "tonight" names a time that has
+// already passed.
+var ErrPassed = errors.New(`Start Time has already passed today. Pick a later time, or a day such as "tomorrow 6pm".`)
+
+var (
+	clockPattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm|a|p)?$`)
+	dayOfMonth   = regexp.MustCompile(`^(\d{1,2})(?:st|nd|rd|th)?$`)
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "weds": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var months = map[string]time.Month{
+	"jan": time.January, "january": time.January,
+	"feb": time.February, "february": time.February,
+	"mar": time.March, "march": time.March,
+	"apr": time.April, "april": time.April,
+	"may": time.May,
+	"jun": time.June, "june": time.June,
+	"jul": time.July, "july": time.July,
+	"aug": time.August, "august": time.August,
+	"sep": time.September, "sept": time.September, "september": time.September,
+	"oct": time.October, "october": time.October,
+	"nov": time.November, "november": time.November,
+	"dec": time.December, "december": time.December,
+}
+
+// Exact reports whether input is already in Layout, which needs no preview.
+func Exact(input string) bool {
+	_, err := time.Parse(Layout, strings.TrimSpace(input))
+	return err == nil
+}
+
+// Parse resolves input to a start time in loc, relative to now.
+//
+// A time of day is always required. It may be "6pm", "6:30pm", "noon" or a
+// 24-hour "18:30". A bare hour from 1 to 11, as in "5:30", could be am or
+// pm, so the sooner of the two after now is used, and the preview shows which
+// was picked. It can be preceded by "today", "tonight", "tomorrow", a weekday (optionally "next"),
+// a month and day such as "mar 14", or a YYYY-MM-DD date. Without a day, or
+// with a plain weekday, the soonest matching time after now is used; "next"
+// skips today. A month and day already past this year means next year.
+// "today" and "tonight" with a time already past return ErrPassed.
+func Parse(input string, now time.Time, loc *time.Location) (time.Time, error) {
+	input = strings.TrimSpace(input)
+	if start, err := time.ParseInLocation(Layout, input, loc); err == nil {
+		return start, nil
+	}
+
+	tokens := strings.FieldsFunc(strings.ToLower(input), func(r rune) bool {
+		return r == ' ' || r == ',' || r == '@'
+	})
+	filtered := tokens[:0]
+	for _, token := range tokens {
+		if token != "at" && token != "on" {
+			filtered = append(filtered, token)
+		}
+	}
+	tokens = filtered
+	if len(tokens) == 0 {
+		return time.Time{}, ErrUnrecognised
+	}
+
+	now = now.In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	// The time of day comes last, possibly split as "6 pm".
+	clock := tokens[len(tokens)-1]
+	tokens = tokens[:len(tokens)-1]
+	if (clock == "am" || clock == "pm") && len(tokens) > 0 {
+		clock = tokens[len(tokens)-1] + clock
+		tokens = tokens[:len(tokens)-1]
+	}
+	hours, minute, ok := parseClock(clock)
+	if !ok {
+		return time.Time{}, ErrUnrecognised
+	}
+	// at returns the first of the clock's hours on day that is after now, or
+	// the last of them when none is.
+	at := func(day time.Time) time.Time {
+		var start time.Time
+		for _, hour := range hours {
+			start = time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+			if start.After(now) {
+				break
+			}
+		}
+		return start
+	}
+
+	switch {
+	case len(tokens) == 0:
+		start := at(today)
+		if !start.After(now) {
+			start = at(today.AddDate(0, 0, 1))
+		}
+		return start, nil
+
+	case len(tokens) == 1 && (tokens[0] == "today" || tokens[0] == "tonight"):
+		start := at(today)
+		if !start.After(now) {
+			return time.Time{}, ErrPassed
+		}
+		return start, nil
+
+	case len(tokens) == 1 && (tokens[0] == "tomorrow" || tokens[0] == "tmrw" || tokens[0] == "tmr"):
+		return at(today.AddDate(0, 0, 1)), nil
+
+	case len(tokens) == 1 && isWeekday(tokens[0]):
+		days := daysUntil(now.Weekday(), weekdays[tokens[0]])
+		start := at(today.AddDate(0, 0, days))
+		if !start.After(now) {
+			start = at(today.AddDate(0, 0, days+7))
+		}
+		return start, nil
+
+	case len(tokens) == 2 && tokens[0] == "next" && isWeekday(tokens[1]):
+		days := daysUntil(now.Weekday(), weekdays[tokens[1]])
+		if days == 0 {
+			days = 7
+		}
+		return at(today.AddDate(0, 0, days)), nil
+
+	case len(tokens) == 1:
+		day, err := time.ParseInLocation("2006-01-02", tokens[0], loc)
+		if err != nil {
+			return time.Time{}, ErrUnrecognised
+		}
+		return at(day), nil
+
+	case len(tokens) == 2:
+		month, ok := months[tokens[0]]
+		if !ok {
+			return time.Time{}, ErrUnrecognised
+		}
+		match := dayOfMonth.FindStringSubmatch(tokens[1])
+		if match == nil {
+			return time.Time{}, ErrUnrecognised
+		}
+		dayNumber, _ := strconv.Atoi(match[1])
+		year := now.Year()
+		day := time.Date(year, month, dayNumber, 0, 0, 0, 0, loc)
+		if day.Month() != month {
+			return time.Time{}, ErrUnrecognised
+		}
+		if !at(day).After(now) {
+			day = time.Date(year+1, month, dayNumber, 0, 0, 0, 0, loc)
+		}
+		return at(day), nil
+	}
+
+	return time.Time{}, ErrUnrecognised
+}
+
+// parseClock reads a time of day. It returns the hours on the 24-hour clock
+// the time could mean, earliest first: both the am and the pm hour for a bare
+// hour from 1 to 11, and a single hour otherwise.
+func parseClock(clock string) (hours []int, minute int, ok bool) {
+	switch clock {
+	case "noon":
+		return []int{12}, 0, true
+	case "midnight":
+		return []int{0}, 0, true
+	}
+
+	match := clockPattern.FindStringSubmatch(clock)
+	if match == nil {
+		return nil, 0, false
+	}
+	hour, _ := strconv.Atoi(match[1])
+	if match[2] != "" {
+		minute, _ = strconv.Atoi(match[2])
+	}
+	if minute > 59 {
+		return nil, 0, false
+	}
+
+	switch match[3] {
+	case "":
+		// 0 and 12 to 23 are read on the 24-hour clock.
+		if hour > 23 {
+			return nil, 0, false
+		}
+		if hour >= 1 && hour <= 11 {
+			return []int{hour, hour + 12}, minute, true
+		}
+	case "am", "a":
+		if hour < 1 || hour > 12 {
+			return nil, 0, false
+		}
+		if hour == 12 {
+			hour = 0
+		}
+	default:
+		if hour < 1 || hour > 12 {
+			return nil, 0, false
+		}
+		if hour != 12 {
+			hour += 12
+		}
+	}
+	return []int{hour}, minute, true
+}
+
+func isWeekday(token string) bool {
+	_, ok := weekdays[token]
+	return ok
+}
+
+func daysUntil(from, to time.Weekday) int {
+	return (int(to) - int(from) + 7) % 7
+}
+
+// Timestamp formats t as a Discord timestamp, which each reader sees in their
+// own timezone. Style is a Discord timestamp style such as "F" or "R".
+func Timestamp(t time.Time, style string) string {
+	return fmt.Sprintf("<t:%d:%s>", t.Unix(), style)
+}
+
+// PreviewContent describes a resolved start time for the confirm/adjust step.
+func PreviewContent(input string, start time.Time) string {
+	return fmt.Sprintf("🕒 Start time: %s (%s)\nRead from %q in %s. Confirm to continue, or adjust it.",
+		Timestamp(start, "F"), Timestamp(start, "R"), input, start.Location())
+}
+
+// PreviewComponents returns the Confirm and Adjust buttons for a start time
+// preview.
+func PreviewComponents(confirmID, adjustID string) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Confirm",
+				Style:    discordgo.SuccessButton,
+				CustomID: confirmID,
+			},
+			discordgo.Button{
+				Label:    "Adjust",
+				Style:    discordgo.SecondaryButton,
+				CustomID: adjustID,
+			},
+		}},
+	}
+}
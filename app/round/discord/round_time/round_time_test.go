@@ -0,0 +1,102 @@
+package roundtime
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	// Tuesday afternoon.
+	now := time.Date(2026, time.March, 3, 14, 0, 0, 0, chicago)
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "2026-03-10 18:30", want: "2026-03-10 18:30"},
+		{input: "tomorrow 6pm", want: "2026-03-04 18:00"},
+		{input: "Tomorrow at 6 PM", want: "2026-03-04 18:00"},
+		{input: "tonight 9", want: "2026-03-03 21:00"},
+		{input: "today 6:30pm", want: "2026-03-03 18:30"},
+		{input: "6pm", want: "2026-03-03 18:00"},
+		{input: "5:30", want: "2026-03-03 17:30"},
+		{input: "1", want: "2026-03-04 01:00"},
+		{input: "10am", want: "2026-03-04 10:00"},
+		{input: "noon", want: "2026-03-04 12:00"},
+		{input: "sat 10am", want: "2026-03-07 10:00"},
+		{input: "Saturday, 10:15am", want: "2026-03-07 10:15"},
+		{input: "tue 6pm", want: "2026-03-03 18:00"},
+		{input: "tue 1pm", want: "2026-03-10 13:00"},
+		{input: "tue 6", want: "2026-03-03 18:00"},
+		{input: "tue 1", want: "2026-03-10 01:00"},
+		{input: "tomorrow 9", want: "2026-03-04 09:00"},
+		{input: "next tue 5:30", want: "2026-03-10 05:30"},
+		{input: "sat 10", want: "2026-03-07 10:00"},
+		{input: "sat 10:00am", want: "2026-03-07 10:00"},
+		{input: "fri 0:30", want: "2026-03-06 00:30"},
+		{input: "today 14:30", want: "2026-03-03 14:30"},
+		{input: "next tue 5:30pm", want: "2026-03-10 17:30"},
+		{input: "next fri 12am", want: "2026-03-06 00:00"},
+		{input: "mar 14 6pm", want: "2026-03-14 18:00"},
+		{input: "march 1st 6pm", want: "2027-03-01 18:00"},
+		{input: "2026-04-01 @ 7pm", want: "2026-04-01 19:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input, now, chicago)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if got.Location() != chicago || got.Format(Layout) != tt.want {
+				t.Fatalf("Parse(%q) = %v, want %s in %s", tt.input, got, tt.want, chicago)
+			}
+		})
+	}
+}
+
+func TestParse_Unrecognised(t *testing.T) {
+	now := time.Date(2026, time.March, 3, 14, 0, 0, 0, time.UTC)
+	for _, input := range []string{"", "soon", "tomorrow", "13pm", "sat 25:00", "6:75pm", "feb 30 6pm", "next week 6pm", "2026-03-10 6pm extra"} {
+		if _, err := Parse(input, now, time.UTC); !errors.Is(err, ErrUnrecognised) {
+			t.Errorf("Parse(%q) error = %v, want ErrUnrecognised", input, err)
+		}
+	}
+}
+
+func TestParse_TodayAlreadyPassed(t *testing.T) {
+	now := time.Date(2026, time.March, 3, 19, 0, 0, 0, time.UTC)
+	for _, input := range []string{"today 6pm", "tonight 6:30", "today 19:00"} {
+		if _, err := Parse(input, now, time.UTC); !errors.Is(err, ErrPassed) {
+			t.Errorf("Parse(%q) error = %v, want ErrPassed", input, err)
+		}
+	}
+	if got, err := Parse("tonight 9", now, time.UTC); err != nil || got.Format(Layout) != "2026-03-03 21:00" {
+		t.Errorf("Parse(tonight 9) = %v, %v", got, err)
+	}
+}
+
+func TestExact(t *testing.T) {
+	if !Exact(" 2026-03-10 18:30 ") {
+		t.Error("expected the exact layout to be exact")
+	}
+	if Exact("tomorrow 6pm") {
+		t.Error("expected a phrase not to be exact")
+	}
+}
+
+func TestPreviewContent(t *testing.T) {
+	start := time.Date(2026, time.March, 4, 18, 0, 0, 0, time.UTC)
+	content := PreviewContent("tomorrow 6pm", start)
+	for _, want := range []string{"<t:1772647200:F>", "<t:1772647200:R>", `"tomorrow 6pm"`, "UTC"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("expected %q in %q", want, content)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package scoreaudit
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /round command (Editor role or higher).
+func CommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "round",
+			Description: "Look into a round (Editor/Admin only)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "audit",
+					Description: "Show every change made to a round's scores",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "round_id",
+							Description:  "The round to show score changes for",
+							Required:     true,
+							Autocomplete: true,
+						},
+					},
+				},
+			},
+		},
+		RequiredPermission: interactions.EditorRequired,
+		RequiresSetup:      true,
+		BackendFeature:     config.BackendFeatureScoreAudit,
+	}
+}
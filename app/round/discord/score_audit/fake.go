@@ -0,0 +1,41 @@
+package scoreaudit
+
+import (
+	"context"
+	"sync"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// FakeLog is a Log kept in memory, as the backend would keep it. Err, when
+// set, is returned by every call.
+type FakeLog struct {
+	mu      sync.Mutex
+	Entries []Entry
+	Err     error
+}
+
+func (f *FakeLog) Record(ctx context.Context, guildID sharedtypes.GuildID, entries []Entry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return f.Err
+	}
+	f.Entries = append(f.Entries, entries...)
+	return nil
+}
+
+func (f *FakeLog) History(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]Entry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	var entries []Entry
+	for _, entry := range f.Entries {
+		if entry.RoundID == roundID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,172 @@
+package scoreaudit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+// historyKeyPrefix keys the pagination snapshot of a history view. Ephemeral
+// replies have no message ID to key it by, so the interaction's ID is used.
+const historyKeyPrefix = "score_audit-"
+
+// AuditManager handles /round.
+type AuditManager interface {
+	HandleRoundCommand(ctx context.Context, i *discordgo.InteractionCreate)
+	AutocompleteRoundID(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error)
+}
+
+type auditManager struct {
+	session             discord.Session
+	eventBus            eventbus.EventBus
+	logger              *slog.Logger
+	log                 Log
+	guildConfigResolver guildconfig.GuildConfigResolver
+	listRounds          func(ctx context.Context, guildID string, states []string) (*roundautocomplete.RoundListResponsePayloadV1, error)
+}
+
+// NewAuditManager creates an AuditManager showing the score changes in log.
+func NewAuditManager(session discord.Session, eventBus eventbus.EventBus, logger *slog.Logger, log Log, guildConfigResolver guildconfig.GuildConfigResolver) AuditManager {
+	return &auditManager{
+		session:             session,
+		eventBus:            eventBus,
+		logger:              logger,
+		log:                 log,
+		guildConfigResolver: guildConfigResolver,
+		listRounds: func(ctx context.Context, guildID string, states []string) (*roundautocomplete.RoundListResponsePayloadV1, error) {
+			return roundautocomplete.ListRounds(ctx, eventBus, guildID, states)
+		},
+	}
+}
+
+// HandleRoundCommand dispatches the /round subcommands.
+func (m *auditManager) HandleRoundCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "round")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "application_command")
+
+	options := i.ApplicationCommandData().Options
+	if len(options) == 0 {
+		m.logger.WarnContext(ctx, "No options provided for round command")
+		return
+	}
+
+	// History is read from the backend, so defer before reading it.
+	if err := m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to defer round command", attr.Error(err))
+		return
+	}
+
+	var response *discordgo.WebhookEdit
+	switch subcommand := options[0]; subcommand.Name {
+	case "audit":
+		response = m.audit(ctx, i, subcommand.Options)
+	default:
+		m.logger.WarnContext(ctx, "Unknown subcommand", attr.String("subcommand", subcommand.Name))
+		response = contentEdit("Unknown subcommand.")
+	}
+
+	if _, err := m.session.InteractionResponseEdit(i.Interaction, response); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to edit round command response", attr.Error(err))
+	}
+}
+
+func contentEdit(content string) *discordgo.WebhookEdit {
+	return &discordgo.WebhookEdit{Content: &content}
+}
+
+// AutocompleteRoundID offers the guild's in-progress and finalized rounds for
+// /round audit.
+func (m *auditManager) AutocompleteRoundID(ctx context.Context, i *discordgo.InteractionCreate, focused *discordgo.ApplicationCommandInteractionDataOption) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+	response, err := m.listRounds(ctx, i.GuildID, []string{
+		roundautocomplete.RoundStateInProgress,
+		roundautocomplete.RoundStateFinalized,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		return nil, nil
+	}
+	loc := guildconfig.GuildLocation(ctx, m.logger, m.guildConfigResolver, i.GuildID)
+	return roundautocomplete.RoundIDChoices(response.Rounds, focused.StringValue(), loc), nil
+}
+
+// audit shows a round's score changes, newest first, paginated when they
+// don't fit on one page.
+func (m *auditManager) audit(ctx context.Context, i *discordgo.InteractionCreate, options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.WebhookEdit {
+	var rawID string
+	for _, opt := range options {
+		if opt.Name == "round_id" {
+			rawID = strings.TrimSpace(opt.StringValue())
+		}
+	}
+	parsed, err := uuid.Parse(rawID)
+	if err != nil {
+		return contentEdit("❌ That isn't a round ID. Pick a round from the list.")
+	}
+	roundID := sharedtypes.RoundID(parsed)
+
+	entries, err := m.log.History(ctx, sharedtypes.GuildID(i.GuildID), roundID)
+	if err != nil {
+		m.logger.ErrorContext(ctx, "Failed to load score history", attr.Error(err), attr.String("round_id", roundID.String()))
+		return contentEdit("❌ Couldn't load the score history right now. Please try again.")
+	}
+	if len(entries) == 0 {
+		return contentEdit("No score changes have been recorded for this round.")
+	}
+
+	lines := make([]string, 0, len(entries))
+	for idx := len(entries) - 1; idx >= 0; idx-- {
+		lines = append(lines, describeEntry(entries[idx]))
+	}
+
+	changes := "changes"
+	if len(entries) == 1 {
+		changes = "change"
+	}
+	key := historyKeyPrefix + i.ID
+	embedpagination.Set(embedpagination.NewLineSnapshot(key, &discordgo.MessageEmbed{
+		Title:       "📜 Score history",
+		Description: fmt.Sprintf("Round `%s` · %d %s, newest first", roundID, len(entries), changes),
+		Color:       0x5865F2,
+	}, nil, nil, "Changes", lines))
+
+	embed, components, _, _, err := embedpagination.RenderPage(key, 0)
+	if err != nil {
+		m.logger.ErrorContext(ctx, "Failed to render score history", attr.Error(err))
+		return contentEdit("Failed to show the score history. Please try again.")
+	}
+	return &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	}
+}
+
+// describeEntry renders a change, e.g.
+// "<t:…:f> <@1> -- → +2 · single by <@2>".
+func describeEntry(entry Entry) string {
+	old := "--"
+	if entry.Old != nil {
+		old = fmt.Sprintf("%+d", *entry.Old)
+	}
+	by := string(entry.Source)
+	if entry.Actor != "" {
+		by += fmt.Sprintf(" by <@%s>", entry.Actor)
+	}
+	return fmt.Sprintf("<t:%d:f> <@%s> %s → %+d · %s", entry.At.Unix(), entry.UserID, old, entry.New, by)
+}
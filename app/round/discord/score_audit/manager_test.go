@@ -0,0 +1,125 @@
+package scoreaudit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+func newAuditCommand(interactionID, roundID string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      interactionID,
+		GuildID: "guild-1",
+		Type:    discordgo.InteractionApplicationCommand,
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "editor-1"}},
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name: "round",
+			Options: []*discordgo.ApplicationCommandInteractionDataOption{{
+				Name: "audit",
+				Type: discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandInteractionDataOption{{
+					Name:  "round_id",
+					Type:  discordgo.ApplicationCommandOptionString,
+					Value: roundID,
+				}},
+			}},
+		},
+	}}
+}
+
+func newTestAuditManager(log Log) (*auditManager, *discordgo.WebhookEdit) {
+	fakeSession := discord.NewFakeSession()
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		if resp.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource || resp.Data.Flags != discordgo.MessageFlagsEphemeral {
+			return fmt.Errorf("expected an ephemeral deferral, got %+v", resp)
+		}
+		return nil
+	}
+	response := &discordgo.WebhookEdit{}
+	fakeSession.InteractionResponseEditFunc = func(_ *discordgo.Interaction, edit *discordgo.WebhookEdit, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		*response = *edit
+		return &discordgo.Message{}, nil
+	}
+	return &auditManager{session: fakeSession, logger: testutils.NoOpLogger(), log: log}, response
+}
+
+func TestAuditManager_ShowsHistoryNewestFirst(t *testing.T) {
+	at := time.Date(2099, time.June, 1, 18, 0, 0, 0, time.UTC)
+	roundID := sharedtypes.RoundID(uuid.New())
+	log := &FakeLog{Entries: []Entry{
+		{RoundID: roundID, UserID: "1", Actor: "1", Source: SourceSingle, New: -2, At: at},
+		{RoundID: sharedtypes.RoundID(uuid.New()), UserID: "1", Source: SourceOverride, New: 5, At: at},
+		{RoundID: roundID, UserID: "1", Source: SourceOverride, Old: scorePointer(-2), New: 1, At: at.Add(time.Minute)},
+	}}
+
+	m, response := newTestAuditManager(log)
+	m.HandleRoundCommand(context.Background(), newAuditCommand("audit-1", roundID.String()))
+
+	embed := (*response.Embeds)[0]
+	if embed.Description != fmt.Sprintf("Round `%s` · 2 changes, newest first", roundID) {
+		t.Errorf("unexpected description %q", embed.Description)
+	}
+	want := fmt.Sprintf("<t:%d:f> <@1> -2 → +1 · override\n<t:%d:f> <@1> -- → -2 · single by <@1>", at.Add(time.Minute).Unix(), at.Unix())
+	if got := embed.Fields[0].Value; got != want {
+		t.Errorf("changes = %q, want %q", got, want)
+	}
+	if len(*response.Components) != 0 {
+		t.Errorf("expected no pager for one page, got %+v", *response.Components)
+	}
+}
+
+func TestAuditManager_PaginatesLongHistory(t *testing.T) {
+	at := time.Date(2099, time.June, 1, 18, 0, 0, 0, time.UTC)
+	roundID := sharedtypes.RoundID(uuid.New())
+	log := &FakeLog{}
+	for score := sharedtypes.Score(0); score < 40; score++ {
+		log.Entries = append(log.Entries, Entry{RoundID: roundID, UserID: "123456789012345678", Actor: "876543210987654321", Source: SourceBulk, New: score, At: at})
+	}
+
+	m, response := newTestAuditManager(log)
+	m.HandleRoundCommand(context.Background(), newAuditCommand("audit-2", roundID.String()))
+
+	if response.Components == nil || len(*response.Components) == 0 {
+		t.Fatalf("expected a pager for a long history")
+	}
+	customID := (*response.Components)[0].(discordgo.ActionsRow).Components[1].(discordgo.Button).CustomID
+	key, page, ok := embedpagination.ParsePagerCustomID(customID)
+	if !ok || key != historyKeyPrefix+"audit-2" || page != 1 {
+		t.Fatalf("unexpected pager button %q", customID)
+	}
+	embed, _, _, total, err := embedpagination.RenderPage(key, 1)
+	if err != nil || total < 2 || !strings.Contains(embed.Fields[0].Value, "bulk by <@876543210987654321>") {
+		t.Fatalf("expected the next page of changes, got %d pages, %v", total, err)
+	}
+}
+
+func TestAuditManager_NoHistoryOrBadRoundID(t *testing.T) {
+	log := &FakeLog{}
+	m, response := newTestAuditManager(log)
+
+	m.HandleRoundCommand(context.Background(), newAuditCommand("audit-3", uuid.New().String()))
+	if *response.Content != "No score changes have been recorded for this round." {
+		t.Errorf("unexpected response %q", *response.Content)
+	}
+
+	m.HandleRoundCommand(context.Background(), newAuditCommand("audit-4", "league night"))
+	if *response.Content != "❌ That isn't a round ID. Pick a round from the list." {
+		t.Errorf("unexpected response %q", *response.Content)
+	}
+
+	log.Err = errors.New("timeout")
+	m.HandleRoundCommand(context.Background(), newAuditCommand("audit-5", uuid.New().String()))
+	if *response.Content != "❌ Couldn't load the score history right now. Please try again." {
+		t.Errorf("unexpected response %q", *response.Content)
+	}
+}
@@ -0,0 +1,19 @@
+package scoreaudit
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RegisterHandlers registers the /round command and its round ID autocomplete.
+func RegisterHandlers(registry *interactions.Registry, manager AuditManager) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling round command", attr.String("interaction_id", i.ID))
+		manager.HandleRoundCommand(ctx, i)
+	})
+	registry.RegisterAutocompleteHandler(CommandSpec().Name(), "round_id", manager.AutocompleteRoundID)
+}
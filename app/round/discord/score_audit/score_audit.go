@@ -0,0 +1,172 @@
+package scoreaudit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// Request-reply subjects of config.BackendFeatureScoreAudit, which keeps
+// each guild's round score history.
+const (
+	RecordRequestV1  = "round.score.audit.record.request.v1"
+	HistoryRequestV1 = "round.score.audit.history.request.v1"
+)
+
+const auditRequestTimeout = 2 * time.Second
+
+// Source is how a score change was made.
+type Source string
+
+const (
+	// SourceSingle is a score entered for one player, from the score modal,
+	// the live scorekeeper or a confirmed attestation.
+	SourceSingle Source = "single"
+	// SourceBulk is a score from the bulk override modal.
+	SourceBulk Source = "bulk"
+	// SourceImport is a score from an imported scorecard file, for the
+	// players its preview matched.
+	SourceImport Source = "import"
+	// SourceOverride is a score corrected outside the bot, which the backend
+	// records itself.
+	SourceOverride Source = "override"
+)
+
+// Change is a score submission: the round it was made to, who made it and
+// how.
+type Change struct {
+	RoundID sharedtypes.RoundID
+	Actor   sharedtypes.DiscordID
+	Source  Source
+}
+
+// Entries returns the entries recording the scores submitted by c at the
+// given time. old holds the scores shown when they were submitted; scores it
+// already shows aren't changes.
+func (c Change) Entries(old map[sharedtypes.DiscordID]*sharedtypes.Score, scores []roundtypes.Participant, at time.Time) []Entry {
+	var entries []Entry
+	for _, participant := range scores {
+		previous := old[participant.UserID]
+		if participant.Score == nil || (previous != nil && *previous == *participant.Score) {
+			continue
+		}
+		entries = append(entries, Entry{
+			RoundID: c.RoundID,
+			UserID:  participant.UserID,
+			Actor:   c.Actor,
+			Source:  c.Source,
+			Old:     previous,
+			New:     *participant.Score,
+			At:      at,
+		})
+	}
+	return entries
+}
+
+// Entry is one recorded score change.
+type Entry struct {
+	RoundID sharedtypes.RoundID   `json:"round_id"`
+	UserID  sharedtypes.DiscordID `json:"user_id"`
+	// Actor made the change. It is empty for overrides made outside the bot.
+	Actor  sharedtypes.DiscordID `json:"actor,omitempty"`
+	Source Source                `json:"source"`
+	// Old is nil when the player had no score, or it isn't known where the
+	// change was made, as for imports.
+	Old *sharedtypes.Score `json:"old,omitempty"`
+	New sharedtypes.Score  `json:"new"`
+	At  time.Time          `json:"at"`
+}
+
+// RecordRequestPayloadV1 adds changes to a guild's round score history.
+type RecordRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	Entries []Entry             `json:"entries"`
+}
+
+// RecordResponsePayloadV1 is the reply to RecordRequestV1.
+type RecordResponsePayloadV1 struct {
+	Error string `json:"error,omitempty"`
+}
+
+// HistoryRequestPayloadV1 asks for a round's score history.
+type HistoryRequestPayloadV1 struct {
+	GuildID sharedtypes.GuildID `json:"guild_id"`
+	RoundID sharedtypes.RoundID `json:"round_id"`
+}
+
+// HistoryResponsePayloadV1 is the reply to HistoryRequestV1, oldest change
+// first.
+type HistoryResponsePayloadV1 struct {
+	Entries []Entry `json:"entries"`
+	Error   string  `json:"error,omitempty"`
+}
+
+// Log records the history of round score changes.
+type Log interface {
+	// Record records changes made to a round's scorecard.
+	Record(ctx context.Context, guildID sharedtypes.GuildID, entries []Entry) error
+	// History returns the round's changes, oldest first.
+	History(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]Entry, error)
+}
+
+// backendLog is a Log kept by the backend, so history survives restarts.
+type backendLog struct {
+	eventBus eventbus.EventBus
+}
+
+// NewLog creates a Log kept by the backend.
+func NewLog(eventBus eventbus.EventBus) Log {
+	return &backendLog{eventBus: eventBus}
+}
+
+// Record records changes made to a round's scorecard.
+func (l *backendLog) Record(ctx context.Context, guildID sharedtypes.GuildID, entries []Entry) error {
+	if guildID == "" {
+		return errors.New("guild id is required")
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	response, err := messagecreator.NATSRequest[RecordRequestPayloadV1, RecordResponsePayloadV1](
+		ctx,
+		l.eventBus,
+		RecordRequestV1+"."+string(guildID),
+		RecordRequestPayloadV1{GuildID: guildID, Entries: entries},
+		auditRequestTimeout,
+	)
+	if err != nil {
+		return err
+	}
+	if response.Error != "" {
+		return errors.New(response.Error)
+	}
+	return nil
+}
+
+// History returns the round's changes, oldest first.
+func (l *backendLog) History(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) ([]Entry, error) {
+	if guildID == "" {
+		return nil, errors.New("guild id is required")
+	}
+
+	response, err := messagecreator.NATSRequest[HistoryRequestPayloadV1, HistoryResponsePayloadV1](
+		ctx,
+		l.eventBus,
+		HistoryRequestV1+"."+string(guildID),
+		HistoryRequestPayloadV1{GuildID: guildID, RoundID: roundID},
+		auditRequestTimeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+	return response.Entries, nil
+}
@@ -0,0 +1,43 @@
+package scoreaudit
+
+import (
+	"testing"
+	"time"
+
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/google/uuid"
+)
+
+func scorePointer(s sharedtypes.Score) *sharedtypes.Score {
+	return &s
+}
+
+func TestChange_Entries(t *testing.T) {
+	roundID := sharedtypes.RoundID(uuid.New())
+	at := time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC)
+	change := Change{RoundID: roundID, Actor: "9", Source: SourceBulk}
+
+	old := map[sharedtypes.DiscordID]*sharedtypes.Score{
+		"1": scorePointer(-2),
+		"2": scorePointer(3),
+		"3": nil,
+	}
+	entries := change.Entries(old, []roundtypes.Participant{
+		{UserID: "1", Score: scorePointer(-4)},
+		{UserID: "2", Score: scorePointer(3)},
+		{UserID: "3", Score: scorePointer(0)},
+		{UserID: "4", Score: nil},
+	}, at)
+
+	if len(entries) != 2 {
+		t.Fatalf("expected unchanged and missing scores to be skipped, got %+v", entries)
+	}
+	if got := entries[0]; got.RoundID != roundID || got.UserID != "1" || got.Actor != "9" || got.Source != SourceBulk ||
+		got.Old == nil || *got.Old != -2 || got.New != -4 || !got.At.Equal(at) {
+		t.Errorf("unexpected first entry %+v", got)
+	}
+	if got := entries[1]; got.UserID != "3" || got.Old != nil || got.New != 0 {
+		t.Errorf("expected a first score to have no old value, got %+v", got)
+	}
+}
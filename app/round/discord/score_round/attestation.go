@@ -0,0 +1,449 @@
+package scoreround
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+// In guilds with attested scoring, a score entered with the score modal is
+// held on the scorecard instead of being sent to the backend, and a message
+// replying to the scorecard asks the rest of the player's card to confirm or
+// dispute it. Confirmed scores are sent on; disputed ones, and those nobody
+// else on the card can confirm, wait for a score editor to accept or reject
+// them. Held scores never reach the backend, and a round's finalization isn't
+// requested while any are left (see finalize_hold.go).
+// Every way scores reach the backend goes through attestation: the score
+// modal and the scorekeeper hold them, and the bulk override and scorecard
+// imports are left to score editors. Score editors' own entries count right
+// away, and they can confirm any held score.
+
+// heldState is how far a held score is from counting.
+type heldState string
+
+const (
+	heldPending  heldState = "pending"
+	heldDisputed heldState = "disputed"
+)
+
+// heldNote matches the note a held score adds to its player's scorecard line,
+// e.g. "<@1> — Score: -- · ⏳ -2 pending".
+var heldNote = regexp.MustCompile(` · (?:⏳|⚠️) ([+-]?\d+) (pending|disputed)$`)
+
+// withHeldScore notes score on a participant line as held in state, replacing
+// any score already held there.
+func withHeldScore(line string, state heldState, score int) string {
+	mark := "⏳"
+	if state == heldDisputed {
+		mark = "⚠️"
+	}
+	return fmt.Sprintf("%s · %s %+d %s", withoutHeldScore(line), mark, score, state)
+}
+
+// withoutHeldScore removes a held score's note from a participant line.
+func withoutHeldScore(line string) string {
+	return heldNote.ReplaceAllString(line, "")
+}
+
+// heldScoreOf returns the score held for userID on the scorecard lines.
+func heldScoreOf(lines []string, userID sharedtypes.DiscordID) (heldState, int, bool) {
+	for _, line := range lines {
+		uid, _, _, ok := parseParticipantLine(line)
+		if !ok || uid != userID {
+			continue
+		}
+		match := heldNote.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			return "", 0, false
+		}
+		score, err := strconv.Atoi(match[1])
+		if err != nil {
+			return "", 0, false
+		}
+		return heldState(match[2]), score, true
+	}
+	return "", 0, false
+}
+
+// attesters returns who can confirm playerID's score: the rest of their card,
+// or every other player when the round has no cards. Whoever entered the
+// score can't confirm it.
+func attesters(lines []string, playerID, submitterID sharedtypes.DiscordID) []sharedtypes.DiscordID {
+	players := []sharedtypes.DiscordID{}
+	if cards := roundcards.FromLines(lines); len(cards) > 0 {
+		if card := cardOf(cards, playerID); card > 0 {
+			players = cards[card-1]
+		}
+	} else {
+		for _, line := range lines {
+			if uid, _, _, ok := parseParticipantLine(line); ok {
+				players = append(players, uid)
+			}
+		}
+	}
+
+	var out []sharedtypes.DiscordID
+	for _, userID := range players {
+		if userID != playerID && userID != submitterID {
+			out = append(out, userID)
+		}
+	}
+	return out
+}
+
+// heldScore is what an attestation button is about.
+type heldScore struct {
+	roundID   string
+	player    sharedtypes.DiscordID
+	submitter sharedtypes.DiscordID
+	score     int
+}
+
+func attestationCustomID(prefix string, held heldScore) string {
+	return fmt.Sprintf("%s%s|%s|%s|%d", prefix, held.roundID, held.player, held.submitter, held.score)
+}
+
+func parseAttestationCustomID(customID string) (string, heldScore, bool) {
+	for _, prefix := range []string{attestConfirmPrefix, attestDisputePrefix, attestAcceptPrefix, attestRejectPrefix} {
+		rest, found := strings.CutPrefix(customID, prefix)
+		if !found {
+			continue
+		}
+		parts := strings.Split(rest, "|")
+		if len(parts) != 4 {
+			return "", heldScore{}, false
+		}
+		if _, err := uuid.Parse(parts[0]); err != nil {
+			return "", heldScore{}, false
+		}
+		score, err := strconv.Atoi(parts[3])
+		if err != nil {
+			return "", heldScore{}, false
+		}
+		return prefix, heldScore{
+			roundID:   parts[0],
+			player:    sharedtypes.DiscordID(parts[1]),
+			submitter: sharedtypes.DiscordID(parts[2]),
+			score:     score,
+		}, true
+	}
+	return "", heldScore{}, false
+}
+
+// requiresAttestation reports whether a score entered by the member who sent
+// i has to be confirmed before it counts.
+func (srm *scoreRoundManager) requiresAttestation(ctx context.Context, i *discordgo.InteractionCreate) bool {
+	if srm.attestedScoring == nil || !srm.attestedScoring(ctx, i.GuildID) {
+		return false
+	}
+	return !srm.isScoreEditor(ctx, i)
+}
+
+// isScoreEditor reports whether the member who sent i can settle disputed
+// scores: admins, and members with the guild's editor or admin role.
+func (srm *scoreRoundManager) isScoreEditor(ctx context.Context, i *discordgo.InteractionCreate) bool {
+	if canOverrideFinalized(i.Member, srm.config) {
+		return true
+	}
+	if i.Member == nil || srm.guildConfigResolver == nil {
+		return false
+	}
+	cfg, err := srm.guildConfigResolver.GetGuildConfigWithContext(ctx, i.GuildID)
+	if err != nil || cfg == nil {
+		return false
+	}
+	for _, role := range i.Member.Roles {
+		if role != "" && (role == cfg.EditorRoleID || role == cfg.AdminRoleID) {
+			return true
+		}
+	}
+	return false
+}
+
+// holdScore holds a score entered with the score modal on the scorecard and
+// asks the player's card to confirm it. The interaction has been deferred.
+func (srm *scoreRoundManager) holdScore(ctx context.Context, i *discordgo.InteractionCreate, held heldScore) (ScoreRoundOperationResult, error) {
+	if i.Message == nil {
+		_, _ = srm.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{Content: "Enter your score from the round's scorecard so it can be confirmed.", Flags: discordgo.MessageFlagsEphemeral})
+		return ScoreRoundOperationResult{Error: errors.New("score modal has no scorecard")}, nil
+	}
+
+	lines := srm.scorecardLines(i.ChannelID, i.Message.ID, i.Message)
+	if !linesInclude(lines, held.player) {
+		_, _ = srm.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{Content: fmt.Sprintf("<@%s> isn't on this round's scorecard.", held.player), Flags: discordgo.MessageFlagsEphemeral})
+		return ScoreRoundOperationResult{Failure: "player not on scorecard"}, nil
+	}
+
+	toEditors, err := srm.askAttestation(ctx, i.GuildID, i.ChannelID, i.Message.ID, lines, held)
+	if err != nil {
+		_, _ = srm.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{Content: "Something went wrong while submitting your score. Please try again later.", Flags: discordgo.MessageFlagsEphemeral})
+		return ScoreRoundOperationResult{Error: err}, nil
+	}
+
+	content := fmt.Sprintf("Your score of %d is pending until another player on the card confirms it.", held.score)
+	if toEditors {
+		content = fmt.Sprintf("Nobody else on the card can confirm your score of %d, so it's pending until a score editor accepts it.", held.score)
+	}
+	_, _ = srm.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{Content: content, Flags: discordgo.MessageFlagsEphemeral})
+	return ScoreRoundOperationResult{Success: "Score held for attestation"}, nil
+}
+
+// askAttestation holds a score on the scorecard and asks the player's card,
+// in a message replying to it, to confirm the score. When nobody else on the
+// card can, the score editors are asked instead and toEditors is true. lines
+// are the scorecard's participant lines.
+func (srm *scoreRoundManager) askAttestation(ctx context.Context, guildID, channelID, scorecardID string, lines []string, held heldScore) (toEditors bool, err error) {
+	confirmers := attesters(lines, held.player, held.submitter)
+	if len(confirmers) == 0 {
+		return true, srm.askEditors(ctx, guildID, channelID, scorecardID, held,
+			fmt.Sprintf("⚠️ %s. Nobody else on the card can confirm it.", describeEntry(held)))
+	}
+
+	if err := srm.rewriteParticipantLine(channelID, scorecardID, held.player, func(line string) string {
+		return withHeldScore(line, heldPending, held.score)
+	}); err != nil {
+		return false, err
+	}
+
+	content := fmt.Sprintf("⏳ %s. It counts once another player on the card confirms it.\n%s", describeEntry(held), mentionUsers(confirmers))
+	allowed := make([]string, len(confirmers))
+	for idx, userID := range confirmers {
+		allowed[idx] = string(userID)
+	}
+	if _, err := srm.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content:         content,
+		Components:      attestationButtons(held, attestConfirmPrefix, "Confirm", attestDisputePrefix, "Dispute"),
+		Reference:       &discordgo.MessageReference{MessageID: scorecardID, ChannelID: channelID, GuildID: guildID},
+		AllowedMentions: &discordgo.MessageAllowedMentions{Users: allowed},
+	}); err != nil {
+		srm.logger.ErrorContext(ctx, "Failed to ask for score attestation",
+			attr.Error(err), attr.String("round_id", held.roundID), attr.String("user_id", string(held.player)))
+	}
+	return false, nil
+}
+
+// HandleAttestation handles the Confirm and Dispute buttons asking a card to
+// attest a held score, and the Accept and Reject buttons score editors settle
+// disputes with.
+func (srm *scoreRoundManager) HandleAttestation(ctx context.Context, i *discordgo.InteractionCreate) (ScoreRoundOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_score_attestation")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+	return srm.operationWrapper(ctx, "handle_score_attestation", func(ctx context.Context) (ScoreRoundOperationResult, error) {
+		action, held, ok := parseAttestationCustomID(i.MessageComponentData().CustomID)
+		if !ok || i.Message == nil || i.Message.MessageReference == nil {
+			return srm.settleAttestation(i, "This score can no longer be attested.")
+		}
+		scorecardID := i.Message.MessageReference.MessageID
+		lines := srm.scorecardLines(i.ChannelID, scorecardID, nil)
+
+		want := heldPending
+		if action == attestAcceptPrefix || action == attestRejectPrefix {
+			want = heldDisputed
+		}
+		if state, score, found := heldScoreOf(lines, held.player); !found || state != want || score != held.score {
+			return srm.settleAttestation(i, fmt.Sprintf("%s. This score has since been settled or replaced.", describeEntry(held)))
+		}
+
+		presser := interactionUserID(i)
+		editor := srm.isScoreEditor(ctx, i)
+		switch action {
+		case attestConfirmPrefix, attestDisputePrefix:
+			if !editor && !containsUser(attesters(lines, held.player, held.submitter), presser) {
+				return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource,
+					fmt.Sprintf("Only another player on <@%s>'s card can confirm or dispute this score.", held.player))
+			}
+		default:
+			if !editor {
+				return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource, "Only score editors can settle a disputed score.")
+			}
+		}
+
+		switch action {
+		case attestConfirmPrefix, attestAcceptPrefix:
+			verb := "confirmed"
+			if action == attestAcceptPrefix {
+				verb = "accepted"
+			}
+			if err := srm.publishScore(ctx, i.GuildID, held.roundID, held.player, held.score, scorecardScores(lines, nil)[held.player], held.submitter, i.ChannelID, scorecardID); err != nil {
+				srm.logger.ErrorContext(ctx, "Failed to publish attested score",
+					attr.Error(err), attr.String("round_id", held.roundID), attr.String("user_id", string(held.player)))
+				return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource, "Failed to submit the score. Please try again later.")
+			}
+			return srm.settleAttestation(i, fmt.Sprintf("✅ %s. <@%s> %s it.", describeEntry(held), presser, verb))
+
+		case attestRejectPrefix:
+			if err := srm.rewriteParticipantLine(i.ChannelID, scorecardID, held.player, withoutHeldScore); err != nil {
+				return ScoreRoundOperationResult{Error: err}, nil
+			}
+			if roundUUID, err := uuid.Parse(held.roundID); err == nil {
+				if err := srm.ResumeFinalization(ctx, sharedtypes.GuildID(i.GuildID), sharedtypes.RoundID(roundUUID), i.ChannelID, scorecardID); err != nil {
+					srm.logger.ErrorContext(ctx, "Failed to resume finalization",
+						attr.Error(err), attr.String("round_id", held.roundID))
+				}
+			}
+			return srm.settleAttestation(i, fmt.Sprintf("❌ %s. <@%s> rejected it; enter the correct score from the scorecard.", describeEntry(held), presser))
+
+		default:
+			return srm.disputeScore(ctx, i, held, scorecardID, presser)
+		}
+	})
+}
+
+// disputeScore marks a held score as disputed and asks the score editors to
+// settle it.
+func (srm *scoreRoundManager) disputeScore(ctx context.Context, i *discordgo.InteractionCreate, held heldScore, scorecardID string, disputedBy sharedtypes.DiscordID) (ScoreRoundOperationResult, error) {
+	if err := srm.askEditors(ctx, i.GuildID, i.ChannelID, scorecardID, held,
+		fmt.Sprintf("⚠️ %s. <@%s> disputed it.", describeEntry(held), disputedBy)); err != nil {
+		return ScoreRoundOperationResult{Error: err}, nil
+	}
+	return srm.settleAttestation(i, fmt.Sprintf("⚠️ %s. <@%s> disputed it, so it's gone to the score editors.", describeEntry(held), disputedBy))
+}
+
+// askEditors holds a score on the scorecard as disputed and asks the score
+// editors, in a message replying to it that starts with reason, to accept or
+// reject it.
+func (srm *scoreRoundManager) askEditors(ctx context.Context, guildID, channelID, scorecardID string, held heldScore, reason string) error {
+	if err := srm.rewriteParticipantLine(channelID, scorecardID, held.player, func(line string) string {
+		return withHeldScore(line, heldDisputed, held.score)
+	}); err != nil {
+		return err
+	}
+
+	var editorRole string
+	if srm.guildConfigResolver != nil {
+		if cfg, err := srm.guildConfigResolver.GetGuildConfigWithContext(ctx, guildID); err == nil && cfg != nil {
+			editorRole = cfg.EditorRoleID
+			if editorRole == "" {
+				editorRole = cfg.AdminRoleID
+			}
+		}
+	}
+	content := reason + "\n"
+	allowed := &discordgo.MessageAllowedMentions{}
+	if editorRole != "" {
+		content += fmt.Sprintf("<@&%s> please accept or reject it.", editorRole)
+		allowed.Roles = []string{editorRole}
+	} else {
+		content += "A score editor needs to accept or reject it."
+	}
+	if _, err := srm.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content:         content,
+		Components:      attestationButtons(held, attestAcceptPrefix, "Accept", attestRejectPrefix, "Reject"),
+		Reference:       &discordgo.MessageReference{MessageID: scorecardID, ChannelID: channelID, GuildID: guildID},
+		AllowedMentions: allowed,
+	}); err != nil {
+		srm.logger.ErrorContext(ctx, "Failed to send held score to editors",
+			attr.Error(err), attr.String("round_id", held.roundID), attr.String("user_id", string(held.player)))
+	}
+	return nil
+}
+
+// settleAttestation replaces an attestation message's buttons with content.
+func (srm *scoreRoundManager) settleAttestation(i *discordgo.InteractionCreate, content string) (ScoreRoundOperationResult, error) {
+	if err := srm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:         content,
+			Components:      []discordgo.MessageComponent{},
+			AllowedMentions: &discordgo.MessageAllowedMentions{},
+		},
+	}); err != nil {
+		return ScoreRoundOperationResult{Error: err}, nil
+	}
+	return ScoreRoundOperationResult{Success: content}, nil
+}
+
+// rewriteParticipantLine rewrites userID's line on a scorecard and in its
+// pagination snapshot.
+func (srm *scoreRoundManager) rewriteParticipantLine(channelID, messageID string, userID sharedtypes.DiscordID, rewrite func(line string) string) error {
+	rewriteLines := func(lines []string) bool {
+		found := false
+		for idx, line := range lines {
+			if uid, _, _, ok := parseParticipantLine(line); ok && uid == userID {
+				lines[idx] = rewrite(line)
+				found = true
+			}
+		}
+		return found
+	}
+
+	message, err := srm.session.ChannelMessage(channelID, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch scorecard: %w", err)
+	}
+	if len(message.Embeds) == 0 {
+		return errors.New("scorecard has no embed")
+	}
+
+	// The snapshot is kept in step even when the scorecard fits on one page,
+	// since scorecardLines reads it first.
+	snapshot, inSnapshot := embedpagination.Update(messageID, func(snapshot *embedpagination.Snapshot) bool {
+		return snapshot != nil && snapshot.Kind == embedpagination.SnapshotKindLines && rewriteLines(snapshot.LineItems)
+	})
+	if inSnapshot && messageHasPager(message.Components) {
+		embed, components, _, _, err := embedpagination.RenderPage(messageID, snapshot.CurrentPage)
+		if err != nil {
+			return err
+		}
+		_, err = srm.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			Channel:    channelID,
+			ID:         messageID,
+			Embeds:     &[]*discordgo.MessageEmbed{embed},
+			Components: &components,
+		})
+		return err
+	}
+
+	embed := message.Embeds[0]
+	found := false
+	for _, field := range embed.Fields {
+		lines := strings.Split(field.Value, "\n")
+		if rewriteLines(lines) {
+			field.Value = strings.Join(lines, "\n")
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("user %s not found on scorecard", userID)
+	}
+
+	edit := &discordgo.MessageEdit{Channel: channelID, ID: messageID}
+	edit.SetEmbeds([]*discordgo.MessageEmbed{embed})
+	_, err = srm.session.ChannelMessageEditComplex(edit)
+	return err
+}
+
+// describeEntry says who entered a held score, and for whom.
+func describeEntry(held heldScore) string {
+	if held.submitter == held.player || held.submitter == "" {
+		return fmt.Sprintf("<@%s> entered **%+d**", held.player, held.score)
+	}
+	return fmt.Sprintf("<@%s> entered **%+d** for <@%s>", held.submitter, held.score, held.player)
+}
+
+func mentionUsers(userIDs []sharedtypes.DiscordID) string {
+	mentions := make([]string, len(userIDs))
+	for idx, userID := range userIDs {
+		mentions[idx] = fmt.Sprintf("<@%s>", userID)
+	}
+	return strings.Join(mentions, " ")
+}
+
+func attestationButtons(held heldScore, yesPrefix, yesLabel, noPrefix, noLabel string) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+		discordgo.Button{Label: yesLabel, Style: discordgo.SuccessButton, CustomID: attestationCustomID(yesPrefix, held)},
+		discordgo.Button{Label: noLabel, Style: discordgo.DangerButton, CustomID: attestationCustomID(noPrefix, held)},
+	}}}
+}
@@ -0,0 +1,244 @@
+package scoreround
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+)
+
+func Test_withHeldScore(t *testing.T) {
+	line := withHeldScore("<@1> Tag: 4 — Score: +1 (thru 9)", heldPending, -2)
+	if line != "<@1> Tag: 4 — Score: +1 (thru 9) · ⏳ -2 pending" {
+		t.Fatalf("withHeldScore() = %q", line)
+	}
+	line = withHeldScore(line, heldDisputed, -2)
+	if line != "<@1> Tag: 4 — Score: +1 (thru 9) · ⚠️ -2 disputed" {
+		t.Fatalf("withHeldScore() = %q, want the held score replaced", line)
+	}
+
+	uid, score, tag, ok := parseParticipantLine(line)
+	if !ok || uid != "1" || score == nil || *score != 1 || tag == nil || *tag != 4 {
+		t.Fatalf("expected the held score not to change the line's score, got %v %v %v", uid, score, tag)
+	}
+	if state, held, found := heldScoreOf([]string{line}, "1"); !found || state != heldDisputed || held != -2 {
+		t.Fatalf("heldScoreOf() = %v, %d, %v", state, held, found)
+	}
+	if got := withoutHeldScore(line); got != "<@1> Tag: 4 — Score: +1 (thru 9)" {
+		t.Fatalf("withoutHeldScore() = %q", got)
+	}
+}
+
+func Test_attesters(t *testing.T) {
+	cards := []string{"🃏 **Card 1**", "<@1> — Score: --", "<@2> — Score: --", "<@3> — Score: --", "🃏 **Card 2**", "<@4> — Score: --"}
+	if got := attesters(cards, "2", "2"); len(got) != 2 || got[0] != "1" || got[1] != "3" {
+		t.Fatalf("attesters() = %v, want the rest of the card", got)
+	}
+	if got := attesters(cards, "2", "1"); len(got) != 1 || got[0] != "3" {
+		t.Fatalf("attesters() = %v, want whoever entered the score left out", got)
+	}
+	if got := attesters([]string{"<@1> — Score: --", "<@2> — Score: --"}, "1", "1"); len(got) != 1 || got[0] != "2" {
+		t.Fatalf("attesters() without cards = %v, want every other player", got)
+	}
+}
+
+func Test_scoreRoundManager_AttestedScoring(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	var published []discordroundevents.RoundScoreUpdateRequestDiscordPayloadV1
+	direct := 0
+	srm := &scoreRoundManager{
+		session: fakeSession,
+		logger:  slog.New(loggerfrolfbot.NewTestHandler()),
+		helper: &testutils.FakeHelpers{
+			CreateResultMessageFunc: func(_ *message.Message, payload any, _ string) (*message.Message, error) {
+				if p, ok := payload.(discordroundevents.RoundScoreUpdateRequestDiscordPayloadV1); ok {
+					published = append(published, p)
+				} else {
+					direct++
+				}
+				return message.NewMessage("id", nil), nil
+			},
+		},
+		publisher: &testutils.FakeEventBus{},
+		guildConfigResolver: &testutils.FakeGuildConfigResolver{
+			GetGuildConfigFunc: func(ctx context.Context, guildID string) (*storage.GuildConfig, error) {
+				return &storage.GuildConfig{GuildID: guildID, EditorRoleID: "editors"}, nil
+			},
+		},
+		attestedScoring: func(ctx context.Context, guildID string) bool { return true },
+		operationWrapper: func(ctx context.Context, opName string, fn func(ctx context.Context) (ScoreRoundOperationResult, error)) (ScoreRoundOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	scorecard := &discordgo.Message{
+		ID: "scorecard",
+		Embeds: []*discordgo.MessageEmbed{{
+			Fields: []*discordgo.MessageEmbedField{{
+				Name: "👥 Participants",
+				Value: strings.Join([]string{
+					"🃏 **Card 1**", "<@1> — Score: --", "<@2> — Score: --", "<@3> — Score: --",
+					"🃏 **Card 2**", "<@4> — Score: --", "<@5> — Score: --", "<@6> — Score: --",
+					"🃏 **Card 3**", "<@7> — Score: --",
+				}, "\n"),
+			}},
+		}},
+	}
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return scorecard, nil
+	}
+	var sent []*discordgo.MessageSend
+	fakeSession.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		sent = append(sent, data)
+		return &discordgo.Message{ID: "attestation"}, nil
+	}
+	var response *discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		response = resp
+		return nil
+	}
+	var followup string
+	fakeSession.FollowupMessageCreateFunc = func(_ *discordgo.Interaction, _ bool, data *discordgo.WebhookParams, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		followup = data.Content
+		return &discordgo.Message{}, nil
+	}
+
+	submit := func(userID string, roles []string, score string) {
+		t.Helper()
+		_, err := srm.HandleScoreSubmission(context.Background(), &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			Type:      discordgo.InteractionModalSubmit,
+			GuildID:   "guild",
+			ChannelID: "channel",
+			Member:    &discordgo.Member{User: &discordgo.User{ID: userID}, Roles: roles},
+			Message:   scorecard,
+			Data: discordgo.ModalSubmitInteractionData{
+				CustomID: singleScoreModalCustomID(liveTestRoundID, userID),
+				Components: []discordgo.MessageComponent{&discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+					&discordgo.TextInput{CustomID: "score_input", Value: score},
+				}}},
+			},
+		}})
+		if err != nil {
+			t.Fatalf("HandleScoreSubmission() error = %v", err)
+		}
+	}
+	press := func(userID string, roles []string, customID string) {
+		t.Helper()
+		_, err := srm.HandleAttestation(context.Background(), &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			Type:      discordgo.InteractionMessageComponent,
+			GuildID:   "guild",
+			ChannelID: "channel",
+			Member:    &discordgo.Member{User: &discordgo.User{ID: userID}, Roles: roles},
+			Message:   &discordgo.Message{ID: "attestation", MessageReference: &discordgo.MessageReference{MessageID: "scorecard"}},
+			Data:      discordgo.MessageComponentInteractionData{CustomID: customID},
+		}})
+		if err != nil {
+			t.Fatalf("HandleAttestation() error = %v", err)
+		}
+	}
+	scorecardValue := func() string { return scorecard.Embeds[0].Fields[0].Value }
+
+	submit("2", nil, "-2")
+	if len(published) != 0 {
+		t.Fatalf("expected the score to be held, got %+v", published)
+	}
+	if !strings.Contains(scorecardValue(), "<@2> — Score: -- · ⏳ -2 pending") {
+		t.Fatalf("expected the scorecard to show the pending score, got %q", scorecardValue())
+	}
+	if followup != "Your score of -2 is pending until another player on the card confirms it." {
+		t.Fatalf("unexpected followup %q", followup)
+	}
+	if len(sent) != 1 {
+		t.Fatalf("expected the card to be asked to confirm, got %d messages", len(sent))
+	}
+	ask := sent[0]
+	if ask.Content != "⏳ <@2> entered **-2**. It counts once another player on the card confirms it.\n<@1> <@3>" {
+		t.Fatalf("unexpected attestation request %q", ask.Content)
+	}
+	if ask.Reference == nil || ask.Reference.MessageID != "scorecard" || strings.Join(ask.AllowedMentions.Users, ",") != "1,3" {
+		t.Fatalf("expected a reply to the scorecard pinging the card, got %+v %+v", ask.Reference, ask.AllowedMentions)
+	}
+	buttons := ask.Components[0].(discordgo.ActionsRow).Components
+	confirmID := buttons[0].(discordgo.Button).CustomID
+
+	// Neither the player nor someone on another card can confirm it.
+	for _, userID := range []string{"2", "4"} {
+		press(userID, nil, confirmID)
+		if response.Type != discordgo.InteractionResponseChannelMessageWithSource || response.Data.Flags != discordgo.MessageFlagsEphemeral {
+			t.Fatalf("expected <@%s> to be turned away, got %+v", userID, response)
+		}
+	}
+	if len(published) != 0 {
+		t.Fatalf("expected nothing published yet, got %+v", published)
+	}
+
+	press("1", nil, confirmID)
+	if len(published) != 1 || published[0].UserID != "2" || published[0].Score != -2 || published[0].MessageID != "scorecard" {
+		t.Fatalf("expected the confirmed score to be published, got %+v", published)
+	}
+	if response.Type != discordgo.InteractionResponseUpdateMessage || response.Data.Content != "✅ <@2> entered **-2**. <@1> confirmed it." || len(response.Data.Components) != 0 {
+		t.Fatalf("unexpected confirmation %+v", response.Data)
+	}
+
+	// A disputed score goes to the editors, who settle it.
+	submit("3", nil, "+4")
+	disputeID := sent[len(sent)-1].Components[0].(discordgo.ActionsRow).Components[1].(discordgo.Button).CustomID
+	press("1", nil, disputeID)
+	if !strings.Contains(scorecardValue(), "<@3> — Score: -- · ⚠️ +4 disputed") {
+		t.Fatalf("expected the scorecard to show the dispute, got %q", scorecardValue())
+	}
+	toEditors := sent[len(sent)-1]
+	if toEditors.Content != "⚠️ <@3> entered **+4**. <@1> disputed it.\n<@&editors> please accept or reject it." || strings.Join(toEditors.AllowedMentions.Roles, ",") != "editors" {
+		t.Fatalf("unexpected dispute message %q %+v", toEditors.Content, toEditors.AllowedMentions)
+	}
+	editorButtons := toEditors.Components[0].(discordgo.ActionsRow).Components
+	acceptID := editorButtons[0].(discordgo.Button).CustomID
+	rejectID := editorButtons[1].(discordgo.Button).CustomID
+
+	press("2", nil, acceptID)
+	if response.Data.Content != "Only score editors can settle a disputed score." {
+		t.Fatalf("expected a player to be turned away, got %q", response.Data.Content)
+	}
+	press("9", []string{"editors"}, rejectID)
+	if !strings.Contains(scorecardValue(), "<@3> — Score: --\n") || len(published) != 1 {
+		t.Fatalf("expected the rejected score to be cleared, got %q and %+v", scorecardValue(), published)
+	}
+
+	// Buttons for a score that has been settled do nothing.
+	press("9", []string{"editors"}, acceptID)
+	if response.Data.Content != "<@3> entered **+4**. This score has since been settled or replaced." || len(published) != 1 {
+		t.Fatalf("expected a stale button to be retired, got %q", response.Data.Content)
+	}
+
+	// A score nobody else on the card can confirm goes straight to the
+	// editors.
+	submit("7", nil, "+1")
+	if followup != "Nobody else on the card can confirm your score of 1, so it's pending until a score editor accepts it." {
+		t.Fatalf("unexpected followup %q", followup)
+	}
+	if !strings.Contains(scorecardValue(), "<@7> — Score: -- · ⚠️ +1 disputed") || len(published) != 1 {
+		t.Fatalf("expected the score to be held for the editors, got %q and %+v", scorecardValue(), published)
+	}
+	solo := sent[len(sent)-1]
+	if solo.Content != "⚠️ <@7> entered **+1**. Nobody else on the card can confirm it.\n<@&editors> please accept or reject it." {
+		t.Fatalf("unexpected message to the editors %q", solo.Content)
+	}
+	press("9", []string{"editors"}, solo.Components[0].(discordgo.ActionsRow).Components[0].(discordgo.Button).CustomID)
+	if len(published) != 2 || published[1].UserID != "7" || published[1].Score != 1 {
+		t.Fatalf("expected the accepted score to be published, got %+v", published)
+	}
+
+	// Editors' own entries count straight away.
+	submit("9", []string{"editors"}, "0")
+	if direct != 1 || len(published) != 2 {
+		t.Fatalf("expected the editor's score to be published directly, got %d direct and %+v", direct, published)
+	}
+}
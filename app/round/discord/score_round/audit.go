@@ -0,0 +1,80 @@
+package scoreround
+
+import (
+	"context"
+	"time"
+
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+)
+
+// SetScoreAuditLog sets the log submitted scores are recorded in.
+func (srm *scoreRoundManager) SetScoreAuditLog(log scoreaudit.Log) {
+	srm.audit = log
+}
+
+// scorecardScores reads each player's score off a scorecard's participant
+// lines and finalized participant fields.
+func scorecardScores(lines []string, fields []*discordgo.MessageEmbedField) map[sharedtypes.DiscordID]*sharedtypes.Score {
+	scores := make(map[sharedtypes.DiscordID]*sharedtypes.Score)
+	for _, line := range lines {
+		if uid, score, _, ok := parseParticipantLine(line); ok {
+			scores[uid] = score
+		}
+	}
+	for id, value := range parseFinalizedEmbedParticipants(&discordgo.MessageEmbed{Fields: fields}) {
+		var score *sharedtypes.Score
+		if value != nil {
+			v := sharedtypes.Score(*value)
+			score = &v
+		}
+		scores[sharedtypes.DiscordID(id)] = score
+	}
+	return scores
+}
+
+// snapshotScores reads each player's score off a paginated scorecard.
+func snapshotScores(snapshot *embedpagination.Snapshot) map[sharedtypes.DiscordID]*sharedtypes.Score {
+	return scorecardScores(snapshot.LineItems, snapshot.FieldItems)
+}
+
+// embedScores reads each player's score off a scorecard embed.
+func embedScores(embed *discordgo.MessageEmbed) map[sharedtypes.DiscordID]*sharedtypes.Score {
+	var lines []string
+	for _, field := range embed.Fields {
+		if field != nil && isParticipantField(field.Name, field.Value) {
+			lines = append(lines, embedpagination.ParticipantLinesFromFieldValue(field.Value)...)
+		}
+	}
+	return scorecardScores(lines, embed.Fields)
+}
+
+// shownScores reads each player's score off a scorecard as it is shown,
+// from its snapshot when it is paginated. msg is the scorecard message, or nil
+// when it wasn't fetched.
+func shownScores(messageID string, msg *discordgo.Message) map[sharedtypes.DiscordID]*sharedtypes.Score {
+	if snapshot, found := embedpagination.Get(messageID); found {
+		return snapshotScores(snapshot)
+	}
+	if msg == nil || len(msg.Embeds) == 0 {
+		return nil
+	}
+	return embedScores(msg.Embeds[0])
+}
+
+// recordScores records the scores submitted by change, as they are
+// submitted. old holds the scores the scorecard showed.
+func (srm *scoreRoundManager) recordScores(ctx context.Context, guildID string, change scoreaudit.Change, old map[sharedtypes.DiscordID]*sharedtypes.Score, scores []roundtypes.Participant) {
+	if srm.audit == nil {
+		return
+	}
+	entries := change.Entries(old, scores, time.Now().UTC())
+	if err := srm.audit.Record(ctx, sharedtypes.GuildID(guildID), entries); err != nil {
+		srm.logger.ErrorContext(ctx, "Failed to record score changes",
+			attr.Error(err), attr.String("round_id", change.RoundID.String()))
+	}
+}
@@ -18,8 +18,10 @@ type bulkUpdate struct {
 }
 
 type bulkDiagnostics struct {
-	line   string
-	reason string
+	row     int
+	line    string
+	reason  string
+	skipped bool
 }
 
 type nameIndex struct {
@@ -75,25 +77,49 @@ func resolveToken(tok string, idx nameIndex) (string, bool) {
 	return "", false
 }
 
-func parseBulkOverrides(raw string, originalScores map[string]int, idx nameIndex) ([]bulkUpdate, int, int, []string, []bulkDiagnostics) {
+// parseBulkOverrides reads one override per line: "name=score" lines, or
+// spreadsheet rows of tab- or comma-separated hole strokes that are summed and
+// scored against par. Par comes from a "Par" row, else coursePar.
+func parseBulkOverrides(raw string, originalScores map[string]int, idx nameIndex, coursePar int) ([]bulkUpdate, int, int, []string, []bulkDiagnostics) {
 	lines := strings.Split(raw, "\n")
+	table := scanBulkTable(lines, coursePar)
 	var updates []bulkUpdate
 	var diagnostics []bulkDiagnostics
 	var unresolved []string
 	var skipped, unchanged int
-	for _, rawLine := range lines {
+	updateIndex := map[string]int{}
+	for n, rawLine := range lines {
+		row := n + 1
 		line := strings.TrimSpace(rawLine)
 		if line == "" || strings.HasPrefix(line, "#") {
 			continue
 		}
-		norm := bulkReplacer.Replace(line)
-		parts := strings.Fields(norm)
-		if len(parts) < 2 {
+		skip := func(reason string) {
 			skipped++
-			diagnostics = append(diagnostics, bulkDiagnostics{line: rawLine, reason: "invalid-format"})
-			continue
+			diagnostics = append(diagnostics, bulkDiagnostics{row: row, line: rawLine, reason: reason, skipped: true})
 		}
-		token := parts[0]
+
+		var token, scoreStr string
+		cells, tabular := splitBulkRow(line)
+		if tabular {
+			if table.isLayoutRow(cells) {
+				continue
+			}
+			token = strings.TrimSpace(bulkReplacer.Replace(cells[0]))
+			if token == "" {
+				skip("invalid-format")
+				continue
+			}
+		} else {
+			norm := bulkReplacer.Replace(line)
+			parts := strings.Fields(norm)
+			if len(parts) < 2 {
+				skip("invalid-format")
+				continue
+			}
+			token, scoreStr = parts[0], parts[1]
+		}
+
 		participant := token
 		resolvedNow := false
 		if _, ok := originalScores[participant]; !ok {
@@ -102,35 +128,48 @@ func parseBulkOverrides(raw string, originalScores map[string]int, idx nameIndex
 				resolvedNow = true
 			} else {
 				unresolved = append(unresolved, token)
-				skipped++
-				diagnostics = append(diagnostics, bulkDiagnostics{line: rawLine, reason: "unresolved"})
+				skip("unresolved")
 				continue
 			}
 		}
-		scoreStr := parts[1]
+
+		var scoreVal int
 		forced := false
-		if strings.HasSuffix(scoreStr, "!") {
-			forced = true
-			scoreStr = strings.TrimSuffix(scoreStr, "!")
-		}
-		if scoreStr == scoreNoData {
-			unchanged++
-			diagnostics = append(diagnostics, bulkDiagnostics{line: rawLine, reason: "placeholder"})
-			continue
+		if tabular {
+			score, problem := table.score(cells)
+			if problem != "" {
+				skip(problem)
+				continue
+			}
+			scoreVal = score
+		} else {
+			if strings.HasSuffix(scoreStr, "!") {
+				forced = true
+				scoreStr = strings.TrimSuffix(scoreStr, "!")
+			}
+			if scoreStr == scoreNoData {
+				unchanged++
+				diagnostics = append(diagnostics, bulkDiagnostics{row: row, line: rawLine, reason: "placeholder"})
+				continue
+			}
+			scoreStr = strings.TrimPrefix(scoreStr, "+")
+			var err error
+			if scoreVal, err = strconv.Atoi(scoreStr); err != nil {
+				skip("invalid-score")
+				continue
+			}
 		}
-		scoreStr = strings.TrimPrefix(scoreStr, "+")
-		scoreVal, err := strconv.Atoi(scoreStr)
-		if err != nil || scoreVal < scoreMin || scoreVal > scoreMax {
-			skipped++
-			diagnostics = append(diagnostics, bulkDiagnostics{line: rawLine, reason: "invalid-score"})
+		if scoreVal < scoreMin || scoreVal > scoreMax {
+			skip("invalid-score")
 			continue
 		}
+
 		if orig, ok := originalScores[participant]; ok && orig == scoreVal && !forced {
 			unchanged++
 			if resolvedNow {
-				diagnostics = append(diagnostics, bulkDiagnostics{line: rawLine, reason: "unchanged(resolved)"})
+				diagnostics = append(diagnostics, bulkDiagnostics{row: row, line: rawLine, reason: "unchanged(resolved)"})
 			} else {
-				diagnostics = append(diagnostics, bulkDiagnostics{line: rawLine, reason: "unchanged"})
+				diagnostics = append(diagnostics, bulkDiagnostics{row: row, line: rawLine, reason: "unchanged"})
 			}
 			continue
 		}
@@ -141,12 +180,248 @@ func parseBulkOverrides(raw string, originalScores map[string]int, idx nameIndex
 		if resolvedNow {
 			reason += "(resolved)"
 		}
-		diagnostics = append(diagnostics, bulkDiagnostics{line: rawLine, reason: reason})
-		updates = append(updates, bulkUpdate{UserID: participant, Score: scoreVal, Forced: forced, Raw: rawLine})
+		diagnostics = append(diagnostics, bulkDiagnostics{row: row, line: rawLine, reason: reason})
+		update := bulkUpdate{UserID: participant, Score: scoreVal, Forced: forced, Raw: rawLine}
+		// A later row for the same player, e.g. a pasted row below the
+		// prefilled line, replaces the earlier one.
+		if at, ok := updateIndex[participant]; ok {
+			updates[at] = update
+			continue
+		}
+		updateIndex[participant] = len(updates)
+		updates = append(updates, update)
 	}
 	return updates, unchanged, skipped, unresolved, diagnostics
 }
 
+// bulkTable is the layout of the spreadsheet rows in a bulk paste.
+type bulkTable struct {
+	// holes are the cells holding strokes, from a header row. Without one,
+	// every cell after the player's name is a hole.
+	holes []int
+	// pars are the per-hole pars from a Par row, and par the course par.
+	pars []int
+	par  int
+	// holeCount is how many holes each player's row should have.
+	holeCount int
+}
+
+// splitBulkRow splits a spreadsheet row on tabs, or on commas when it has no
+// tabs. Lines with neither aren't spreadsheet rows.
+func splitBulkRow(line string) ([]string, bool) {
+	sep := "\t"
+	if !strings.Contains(line, sep) {
+		sep = ","
+		if !strings.Contains(line, sep) {
+			return nil, false
+		}
+	}
+	cells := strings.Split(line, sep)
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	return cells, true
+}
+
+// scanBulkTable finds the header and Par rows of the spreadsheet rows in a
+// bulk paste, and how many holes were played. A Par row with a single value
+// is the course par.
+func scanBulkTable(lines []string, coursePar int) bulkTable {
+	table := bulkTable{par: coursePar}
+	var parRow []string
+	var playerRows [][]string
+	for _, line := range lines {
+		cells, ok := splitBulkRow(strings.TrimSpace(line))
+		if !ok {
+			continue
+		}
+		switch {
+		case isBulkHeader(cells):
+			if table.holes != nil {
+				continue
+			}
+			table.holes = []int{}
+			for i, cell := range cells[1:] {
+				if isHoleLabel(cell) {
+					table.holes = append(table.holes, i+1)
+				}
+			}
+		case strings.EqualFold(cells[0], "par"):
+			if parRow == nil {
+				parRow = cells
+			}
+		default:
+			playerRows = append(playerRows, cells)
+		}
+	}
+
+	if parRow != nil && table.holes == nil && hasTotalColumn(parRow[1:]) {
+		table.holes = []int{}
+		for i := 1; i < len(parRow)-1; i++ {
+			table.holes = append(table.holes, i)
+		}
+	}
+	if parRow == nil && table.holes == nil {
+		if width := totalColumnWidth(playerRows); width > 0 {
+			table.holes = []int{}
+			for i := 1; i < width-1; i++ {
+				table.holes = append(table.holes, i)
+			}
+		}
+	}
+	if parRow != nil {
+		var pars []int
+		for _, cell := range table.holeCells(parRow) {
+			if par, err := strconv.Atoi(cell); err == nil && par > 0 {
+				pars = append(pars, par)
+			}
+		}
+		switch {
+		case len(pars) == 1 && table.holes == nil:
+			table.par = pars[0]
+		case len(pars) > 0:
+			table.pars = pars
+			table.par = 0
+			for _, par := range pars {
+				table.par += par
+			}
+		}
+	}
+
+	if len(table.pars) > 1 {
+		table.holeCount = len(table.pars)
+		return table
+	}
+	// Without per-hole pars, the row with the most holes sets how many were
+	// played, so a row missing a hole isn't scored short.
+	for _, cells := range playerRows {
+		played := 0
+		for _, cell := range table.holeCells(cells) {
+			if cell != "" {
+				played++
+			}
+		}
+		if played > table.holeCount {
+			table.holeCount = played
+		}
+	}
+	return table
+}
+
+func (t bulkTable) isLayoutRow(cells []string) bool {
+	return isBulkHeader(cells) || strings.EqualFold(cells[0], "par")
+}
+
+func (t bulkTable) holeCells(cells []string) []string {
+	if t.holes == nil {
+		return cells[1:]
+	}
+	out := make([]string, len(t.holes))
+	for i, index := range t.holes {
+		if index < len(cells) {
+			out[i] = cells[index]
+		}
+	}
+	return out
+}
+
+// score sums a row's hole strokes and returns them relative to par, or the
+// reason the row can't be scored.
+func (t bulkTable) score(cells []string) (int, string) {
+	strokes, played := 0, 0
+	for i, cell := range t.holeCells(cells) {
+		if cell == "" {
+			continue
+		}
+		value, err := strconv.Atoi(cell)
+		if err != nil || value < 1 {
+			return 0, fmt.Sprintf("invalid-strokes(hole %d)", i+1)
+		}
+		strokes += value
+		played++
+	}
+	switch {
+	case played == 0:
+		return 0, "no-strokes"
+	case played != t.holeCount:
+		return 0, fmt.Sprintf("incomplete(%d/%d holes)", played, t.holeCount)
+	case t.par == 0:
+		return 0, "missing-par"
+	}
+	return strokes - t.par, ""
+}
+
+// hasTotalColumn reports whether the last of a headerless Par row's values is
+// the total of the others, as when a spreadsheet's Total column is copied.
+func hasTotalColumn(pars []string) bool {
+	if len(pars) < 3 {
+		return false
+	}
+	sum := 0
+	for _, cell := range pars[:len(pars)-1] {
+		par, err := strconv.Atoi(cell)
+		if err != nil {
+			return false
+		}
+		sum += par
+	}
+	total, err := strconv.Atoi(pars[len(pars)-1])
+	return err == nil && total == sum
+}
+
+// totalColumnWidth returns the width of headerless player rows whose last
+// value is the total of their holes, as when a spreadsheet's Total column is
+// copied without a Par row, or 0 unless every row has one. Rows with cells
+// that aren't strokes are left to be reported when they are scored.
+func totalColumnWidth(rows [][]string) int {
+	width := 0
+	for _, cells := range rows {
+		if len(cells) < 4 {
+			return 0
+		}
+		total, err := strconv.Atoi(cells[len(cells)-1])
+		if err != nil {
+			continue
+		}
+		sum, strokes := 0, true
+		for _, cell := range cells[1 : len(cells)-1] {
+			if cell == "" {
+				continue
+			}
+			value, err := strconv.Atoi(cell)
+			if err != nil {
+				strokes = false
+				break
+			}
+			sum += value
+		}
+		if !strokes {
+			continue
+		}
+		if total != sum || (width != 0 && width != len(cells)) {
+			return 0
+		}
+		width = len(cells)
+	}
+	return width
+}
+
+func isBulkHeader(cells []string) bool {
+	switch strings.ToLower(cells[0]) {
+	case "", "name", "player", "players", "playername":
+		return true
+	}
+	return false
+}
+
+// isHoleLabel matches hole column headers such as "7", "H7" or "Hole 7".
+func isHoleLabel(cell string) bool {
+	label := strings.ToLower(strings.TrimSpace(cell))
+	label = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(label, "hole"), "h"))
+	hole, err := strconv.Atoi(label)
+	return err == nil && hole > 0
+}
+
 func summarizeBulk(updates []bulkUpdate, unchanged, skipped int, unresolved []string, diagnostics []bulkDiagnostics, resolvedMappings []string) string {
 	var summary string
 	if len(updates) == 0 {
@@ -160,17 +435,31 @@ func summarizeBulk(updates []bulkUpdate, unchanged, skipped int, unresolved []st
 	if len(unresolved) > 0 {
 		summary += "\nUnresolved: " + strings.Join(unresolved, ", ")
 	}
-	if len(updates) == 0 && len(diagnostics) > 0 {
-		limit := len(diagnostics)
+	details := diagnostics
+	if len(updates) > 0 {
+		// Once something is submitted, only the rows that were skipped matter.
+		details = nil
+		for _, d := range diagnostics {
+			if d.skipped {
+				details = append(details, d)
+			}
+		}
+	}
+	if len(details) > 0 {
+		limit := len(details)
 		if limit > 25 {
 			limit = 25
 		}
-		summary += "\nDetails:\n"
+		if len(updates) > 0 {
+			summary += "\nProblems:\n"
+		} else {
+			summary += "\nDetails:\n"
+		}
 		for i := 0; i < limit; i++ {
-			d := diagnostics[i]
-			summary += d.reason + " => " + d.line + "\n"
+			d := details[i]
+			summary += fmt.Sprintf("row %d: %s => %s\n", d.row, d.reason, strings.TrimSpace(d.line))
 		}
-		if limit < len(diagnostics) {
+		if limit < len(details) {
 			summary += "... (truncated)\n"
 		}
 	}
@@ -0,0 +1,117 @@
+package scoreround
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_parseBulkOverrides_Tabular(t *testing.T) {
+	idx := buildNameIndex(map[string]string{"alice": "111", "bob": "222", "carol smith": "333"})
+	original := map[string]int{"123": 0, "222": 1}
+
+	tests := []struct {
+		name          string
+		raw           string
+		coursePar     int
+		wantScores    map[string]int
+		wantUnchanged int
+		wantProblems  []bulkDiagnostics
+	}{
+		{
+			name: "tab separated with header and par row",
+			raw: "Player\t1\t2\t3\tTotal\n" +
+				"Par\t3\t3\t4\t10\n" +
+				"Alice\t3\t4\t4\t11\n" +
+				"<@123>\t2\t3\t4\t9\n" +
+				"Carol Smith\t3\t\t4\t7\n" +
+				"Dave\t3\t3\t3\t9\n" +
+				"bob\t3\tx\t3\t",
+			wantScores: map[string]int{"111": 1, "123": -1},
+			wantProblems: []bulkDiagnostics{
+				{row: 5, reason: "incomplete(2/3 holes)"},
+				{row: 6, reason: "unresolved"},
+				{row: 7, reason: "invalid-strokes(hole 2)"},
+			},
+		},
+		{
+			name:          "comma separated below prefilled lines with a course par",
+			raw:           "<@123>=0\n<@222>=+1\nAlice,3,4,4\nbob,3,3\n<@123>,2,3,3",
+			coursePar:     10,
+			wantScores:    map[string]int{"111": 1, "123": -2},
+			wantUnchanged: 2,
+			wantProblems:  []bulkDiagnostics{{row: 4, reason: "incomplete(2/3 holes)"}},
+		},
+		{
+			name:       "headerless total column is not counted",
+			raw:        "Par,3,3,4,10\nAlice,3,4,4,11",
+			wantScores: map[string]int{"111": 1},
+		},
+		{
+			name:         "headerless total column without a par row",
+			raw:          "Alice,3,4,4,11\n<@123>,2,3,3,8\nCarol Smith,3,,4,7",
+			coursePar:    10,
+			wantScores:   map[string]int{"111": 1, "123": -2},
+			wantProblems: []bulkDiagnostics{{row: 3, reason: "incomplete(2/3 holes)"}},
+		},
+		{
+			name:       "single value par row and total strokes",
+			raw:        "Par\t54\nAlice\t58",
+			coursePar:  48,
+			wantScores: map[string]int{"111": 4},
+		},
+		{
+			name:         "no par",
+			raw:          "Alice\t3\t4\t4",
+			wantScores:   map[string]int{},
+			wantProblems: []bulkDiagnostics{{row: 1, reason: "missing-par"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates, unchanged, skipped, _, diagnostics := parseBulkOverrides(tt.raw, original, idx, tt.coursePar)
+
+			scores := map[string]int{}
+			for _, u := range updates {
+				scores[u.UserID] = u.Score
+			}
+			if !reflect.DeepEqual(scores, tt.wantScores) {
+				t.Errorf("scores = %v, want %v", scores, tt.wantScores)
+			}
+			if unchanged != tt.wantUnchanged {
+				t.Errorf("unchanged = %d, want %d", unchanged, tt.wantUnchanged)
+			}
+
+			var problems []bulkDiagnostics
+			for _, d := range diagnostics {
+				if d.skipped {
+					problems = append(problems, bulkDiagnostics{row: d.row, reason: d.reason})
+				}
+			}
+			if !reflect.DeepEqual(problems, tt.wantProblems) {
+				t.Errorf("problems = %+v, want %+v", problems, tt.wantProblems)
+			}
+			if skipped != len(tt.wantProblems) {
+				t.Errorf("skipped = %d, want %d", skipped, len(tt.wantProblems))
+			}
+		})
+	}
+}
+
+func Test_summarizeBulk_ListsProblemRowsAlongsideUpdates(t *testing.T) {
+	idx := buildNameIndex(map[string]string{"alice": "111"})
+	updates, unchanged, skipped, unresolved, diagnostics := parseBulkOverrides("Par,3,3\nAlice,3,4\nDave,3,3", nil, idx, 0)
+
+	summary := summarizeBulk(updates, unchanged, skipped, unresolved, diagnostics, nil)
+	want := "Bulk override submitted: 1 updates, 0 unchanged, 1 skipped.\n" +
+		"Unresolved: Dave\n" +
+		"Problems:\n" +
+		"row 3: unresolved => Dave,3,3\n"
+	if summary != want {
+		t.Fatalf("summary = %q, want %q", summary, want)
+	}
+	if strings.Contains(summary, "Alice") {
+		t.Fatalf("expected rows that were submitted not to be listed")
+	}
+}
@@ -8,6 +8,14 @@ const (
 	bulkOverrideButtonPrefix = "round_bulk_score_override|"
 	submitSingleModalPrefix  = "submit_score_modal|"
 	submitBulkOverridePrefix = "submit_score_bulk_override|"
+	keepScoreButtonPrefix    = "round_keep_score|"
+	liveScoreHolePrefix      = "live_score_hole|"
+	liveScoreSetPrefix       = "live_score_set|"
+	attestConfirmPrefix      = "attest_confirm|"
+	attestDisputePrefix      = "attest_dispute|"
+	attestAcceptPrefix       = "attest_accept|"
+	attestRejectPrefix       = "attest_reject|"
+	finalizeOverridePrefix   = "round_finalize_override|"
 
 	// Score bounds (disc golf reasonable range)
 	scoreMin = -36
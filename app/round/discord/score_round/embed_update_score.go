@@ -74,15 +74,14 @@ func (srm *scoreRoundManager) UpdateScoreEmbed(ctx context.Context, channelID, m
 						return false
 					}
 					snapshot.FieldItems = updatedFields
-					return true
 				default:
 					updatedLines, updated := updateLineItemsScore(snapshot.LineItems, userID, score)
 					if !updated {
 						return false
 					}
 					snapshot.LineItems = updatedLines
-					return true
 				}
+				return true
 			}); found {
 				embed, components, _, _, renderErr := embedpagination.RenderPage(messageID, snapshot.CurrentPage)
 				if renderErr != nil {
@@ -116,7 +115,7 @@ func (srm *scoreRoundManager) UpdateScoreEmbed(ctx context.Context, channelID, m
 					continue
 				}
 				if uid == userID {
-					lines[j] = formatParticipantLine(uid, score, tag)
+					lines[j] = withLiveHoles(formatParticipantLine(uid, score, tag), liveHoles(line))
 					userFound = true
 				}
 			}
@@ -127,6 +126,17 @@ func (srm *scoreRoundManager) UpdateScoreEmbed(ctx context.Context, channelID, m
 			return ScoreRoundOperationResult{Success: fmt.Sprintf("User %s not found in embed fields to update score", userID)}, nil
 		}
 
+		// The snapshot is kept in step even when the scorecard fits on one
+		// page, since scorecardLines reads it first.
+		embedpagination.Update(messageID, func(snapshot *embedpagination.Snapshot) bool {
+			if snapshot == nil || snapshot.Kind != embedpagination.SnapshotKindLines {
+				return false
+			}
+			updatedLines, updated := updateLineItemsScore(snapshot.LineItems, userID, score)
+			snapshot.LineItems = updatedLines
+			return updated
+		})
+
 		edit := &discordgo.MessageEdit{
 			Channel: channelID,
 			ID:      messageID,
@@ -163,7 +173,7 @@ func (srm *scoreRoundManager) UpdateScoreEmbedBulk(ctx context.Context, channelI
 				case embedpagination.SnapshotKindFields:
 					snapshot.FieldItems = updateFieldItemsFromParticipants(snapshot.FieldItems, participants)
 				default:
-					snapshot.LineItems = participantsToEmbedLines(participants)
+					snapshot.LineItems = participantsToScorecardLines(snapshot.LineItems, participants)
 				}
 				return true
 			}); found {
@@ -193,9 +203,11 @@ func (srm *scoreRoundManager) UpdateScoreEmbedBulk(ctx context.Context, channelI
 		// Filter out all existing participant fields to "clean" the embed and avoid duplicates.
 		var newFields []*discordgo.MessageEmbedField
 		participantFieldIndex := -1
+		var existingLines []string
 
 		for _, f := range embed.Fields {
 			if isParticipantField(f.Name, f.Value) {
+				existingLines = append(existingLines, embedpagination.ParticipantLinesFromFieldValue(f.Value)...)
 				// Stick to the first location we find for participants.
 				if participantFieldIndex == -1 {
 					participantFieldIndex = len(newFields)
@@ -209,11 +221,18 @@ func (srm *scoreRoundManager) UpdateScoreEmbedBulk(ctx context.Context, channelI
 		}
 		embed.Fields = newFields
 
-		lines := participantsToEmbedLines(participants)
+		lines := participantsToScorecardLines(existingLines, participants)
 		newValue := strings.Join(lines, "\n")
 		if len(lines) == 0 {
 			newValue = placeholderNoParticipants
 		}
+		embedpagination.Update(messageID, func(snapshot *embedpagination.Snapshot) bool {
+			if snapshot == nil || snapshot.Kind != embedpagination.SnapshotKindLines {
+				return false
+			}
+			snapshot.LineItems = participantsToScorecardLines(snapshot.LineItems, participants)
+			return true
+		})
 
 		fieldName := "👥 Participants"
 		if participantFieldIndex != -1 {
@@ -0,0 +1,197 @@
+package scoreround
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+// Held scores never reach the backend, so it can't finalize a round on its
+// own while a player's only score is held. When the round's scheduled event
+// ends with scores still held, the finalization isn't requested: the
+// scorecard gets a Finalize Anyway button for admins instead, and the
+// finalization is requested once the last held score is settled.
+
+// heldPlayers returns the players with a score held on the scorecard lines.
+func heldPlayers(lines []string) []sharedtypes.DiscordID {
+	var players []sharedtypes.DiscordID
+	for _, line := range lines {
+		if uid, _, _, ok := parseParticipantLine(line); ok && heldNote.MatchString(strings.TrimSpace(line)) {
+			players = append(players, uid)
+		}
+	}
+	return players
+}
+
+// HoldFinalization holds back a round's finalization request while scores
+// are held on its scorecard, and reports whether it did.
+func (srm *scoreRoundManager) HoldFinalization(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, channelID, messageID string) (bool, error) {
+	pending := heldPlayers(srm.scorecardLines(channelID, messageID, nil))
+	if len(pending) == 0 {
+		return false, nil
+	}
+
+	if err := srm.editScorecardComponents(channelID, messageID, func(components []discordgo.MessageComponent) []discordgo.MessageComponent {
+		return append(withoutFinalizeOverride(components), discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Finalize Anyway",
+				Style:    discordgo.DangerButton,
+				CustomID: finalizeOverridePrefix + roundID.String(),
+				Emoji:    &discordgo.ComponentEmoji{Name: "🏁"},
+			},
+		}})
+	}); err != nil {
+		return true, fmt.Errorf("failed to add the finalize override: %w", err)
+	}
+
+	if _, err := srm.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content: fmt.Sprintf("⏳ The round has ended, but it isn't finalized while scores are waiting to be confirmed: %s. "+
+			"It's finalized once they're settled, or an admin can press **Finalize Anyway** to finalize it without them.",
+			mentionUsers(pending)),
+		Reference:       &discordgo.MessageReference{MessageID: messageID, ChannelID: channelID, GuildID: string(guildID)},
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	}); err != nil {
+		srm.logger.ErrorContext(ctx, "Failed to announce held finalization",
+			attr.Error(err), attr.String("round_id", roundID.String()), attr.String("message_id", messageID))
+	}
+	return true, nil
+}
+
+// ResumeFinalization requests a held finalization once no scores are held on
+// the scorecard. Scorecards without a held finalization are left alone.
+func (srm *scoreRoundManager) ResumeFinalization(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, channelID, messageID string) error {
+	scorecard, err := srm.session.ChannelMessage(channelID, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch scorecard: %w", err)
+	}
+	if !hasFinalizeOverride(scorecard.Components) || len(heldPlayers(srm.scorecardLines(channelID, messageID, scorecard))) > 0 {
+		return nil
+	}
+	return srm.publishFinalizeRequest(guildID, roundID)
+}
+
+// HandleFinalizeOverride handles the Finalize Anyway button: the scores still
+// held are dropped from the scorecard and the finalization is requested.
+func (srm *scoreRoundManager) HandleFinalizeOverride(ctx context.Context, i *discordgo.InteractionCreate) (ScoreRoundOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_finalize_override")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+	return srm.operationWrapper(ctx, "handle_finalize_override", func(ctx context.Context) (ScoreRoundOperationResult, error) {
+		roundID, err := uuid.Parse(strings.TrimPrefix(i.MessageComponentData().CustomID, finalizeOverridePrefix))
+		if err != nil || i.Message == nil {
+			return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource, "Invalid round information. Please try again.")
+		}
+		if !canOverrideFinalized(i.Member, srm.config) {
+			return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource, "Only admins can finalize a round with scores still waiting to be confirmed.")
+		}
+
+		pending := heldPlayers(srm.scorecardLines(i.ChannelID, i.Message.ID, i.Message))
+		for _, userID := range pending {
+			if err := srm.rewriteParticipantLine(i.ChannelID, i.Message.ID, userID, withoutHeldScore); err != nil {
+				srm.logger.ErrorContext(ctx, "Failed to drop held score",
+					attr.Error(err), attr.String("round_id", roundID.String()), attr.String("user_id", string(userID)))
+			}
+		}
+
+		if err := srm.publishFinalizeRequest(sharedtypes.GuildID(i.GuildID), sharedtypes.RoundID(roundID)); err != nil {
+			srm.logger.ErrorContext(ctx, "Failed to request finalization",
+				attr.Error(err), attr.String("round_id", roundID.String()))
+			return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource, "Failed to finalize the round. Please try again later.")
+		}
+
+		content := "Finalizing the round."
+		if len(pending) > 0 {
+			content = fmt.Sprintf("Finalizing the round without the scores still waiting to be confirmed: %s.", mentionUsers(pending))
+		}
+		if err := srm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content:         content,
+				Flags:           discordgo.MessageFlagsEphemeral,
+				AllowedMentions: &discordgo.MessageAllowedMentions{},
+			},
+		}); err != nil {
+			return ScoreRoundOperationResult{Error: err}, nil
+		}
+		return ScoreRoundOperationResult{Success: content}, nil
+	})
+}
+
+// publishFinalizeRequest asks the backend to finalize a round, the same
+// request a completed scheduled event sends.
+func (srm *scoreRoundManager) publishFinalizeRequest(guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) error {
+	msg, err := srm.helper.CreateNewMessage(roundevents.RoundFinalizeRequestedPayloadV1{
+		GuildID: guildID,
+		RoundID: roundID,
+	}, roundevents.RoundFinalizeRequestedV1)
+	if err != nil {
+		return fmt.Errorf("failed to create finalize request: %w", err)
+	}
+	return srm.publisher.Publish(roundevents.RoundFinalizeRequestedV1, msg)
+}
+
+// editScorecardComponents rewrites a scorecard's buttons, keeping its
+// pagination snapshot in step.
+func (srm *scoreRoundManager) editScorecardComponents(channelID, messageID string, rewrite func([]discordgo.MessageComponent) []discordgo.MessageComponent) error {
+	scorecard, err := srm.session.ChannelMessage(channelID, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch scorecard: %w", err)
+	}
+
+	snapshot, inSnapshot := embedpagination.Update(messageID, func(snapshot *embedpagination.Snapshot) bool {
+		if snapshot == nil {
+			return false
+		}
+		snapshot.BaseComponents = rewrite(snapshot.BaseComponents)
+		return true
+	})
+	if inSnapshot && messageHasPager(scorecard.Components) {
+		embed, components, _, _, err := embedpagination.RenderPage(messageID, snapshot.CurrentPage)
+		if err != nil {
+			return err
+		}
+		_, err = srm.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+			Channel:    channelID,
+			ID:         messageID,
+			Embeds:     &[]*discordgo.MessageEmbed{embed},
+			Components: &components,
+		})
+		return err
+	}
+
+	components := rewrite(scorecard.Components)
+	_, err = srm.session.ChannelMessageEditComplex(&discordgo.MessageEdit{
+		Channel:    channelID,
+		ID:         messageID,
+		Components: &components,
+	})
+	return err
+}
+
+func hasFinalizeOverride(components []discordgo.MessageComponent) bool {
+	for _, customID := range buttonCustomIDs(components) {
+		if strings.HasPrefix(customID, finalizeOverridePrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutFinalizeOverride drops the row holding the Finalize Anyway button.
+func withoutFinalizeOverride(components []discordgo.MessageComponent) []discordgo.MessageComponent {
+	kept := make([]discordgo.MessageComponent, 0, len(components))
+	for _, component := range components {
+		if !hasFinalizeOverride([]discordgo.MessageComponent{component}) {
+			kept = append(kept, component)
+		}
+	}
+	return kept
+}
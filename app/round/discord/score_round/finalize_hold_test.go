@@ -0,0 +1,131 @@
+package scoreround
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+func Test_scoreRoundManager_HoldFinalization(t *testing.T) {
+	roundID := sharedtypes.RoundID(uuid.MustParse(liveTestRoundID))
+	fakeSession := discord.NewFakeSession()
+	var requests []roundevents.RoundFinalizeRequestedPayloadV1
+	var topics []string
+	srm := &scoreRoundManager{
+		session: fakeSession,
+		logger:  slog.New(loggerfrolfbot.NewTestHandler()),
+		helper: &testutils.FakeHelpers{
+			CreateNewMessageFunc: func(payload any, topic string) (*message.Message, error) {
+				requests = append(requests, payload.(roundevents.RoundFinalizeRequestedPayloadV1))
+				return message.NewMessage("id", nil), nil
+			},
+		},
+		publisher: &testutils.FakeEventBus{
+			PublishFunc: func(topic string, messages ...*message.Message) error {
+				topics = append(topics, topic)
+				return nil
+			},
+		},
+		operationWrapper: func(ctx context.Context, opName string, fn func(ctx context.Context) (ScoreRoundOperationResult, error)) (ScoreRoundOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	scorecard := &discordgo.Message{
+		ID: "held-finalization-scorecard",
+		Embeds: []*discordgo.MessageEmbed{{
+			Fields: []*discordgo.MessageEmbedField{{Name: "👥 Participants", Value: "<@1> — Score: -2\n<@2> — Score: +1 · ⏳ +3 pending"}},
+		}},
+		Components: []discordgo.MessageComponent{discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{Label: "Keep Score", CustomID: keepScoreButtonPrefix + liveTestRoundID},
+		}}},
+	}
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return scorecard, nil
+	}
+	fakeSession.ChannelMessageEditComplexFunc = func(edit *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		if edit.Embeds != nil {
+			scorecard.Embeds = *edit.Embeds
+		}
+		if edit.Components != nil {
+			scorecard.Components = *edit.Components
+		}
+		return scorecard, nil
+	}
+	var sent []*discordgo.MessageSend
+	fakeSession.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		sent = append(sent, data)
+		return &discordgo.Message{ID: "notice"}, nil
+	}
+	var response *discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		response = resp
+		return nil
+	}
+
+	held, err := srm.HoldFinalization(context.Background(), "guild", roundID, "channel", scorecard.ID)
+	if err != nil || !held {
+		t.Fatalf("HoldFinalization() = %v, %v, want the finalization held", held, err)
+	}
+	if !hasFinalizeOverride(scorecard.Components) || len(buttonCustomIDs(scorecard.Components)) != 2 {
+		t.Fatalf("expected Finalize Anyway beside Keep Score, got %+v", scorecard.Components)
+	}
+	if len(sent) != 1 || !strings.Contains(sent[0].Content, "waiting to be confirmed: <@2>.") || sent[0].Reference.MessageID != scorecard.ID {
+		t.Fatalf("expected a notice replying to the scorecard, got %+v", sent)
+	}
+
+	// Nothing is requested while the score is still held.
+	if err := srm.ResumeFinalization(context.Background(), "guild", roundID, "channel", scorecard.ID); err != nil || len(requests) != 0 {
+		t.Fatalf("ResumeFinalization() = %v with %d requests, want none", err, len(requests))
+	}
+
+	override := func(member *discordgo.Member) {
+		t.Helper()
+		_, err := srm.HandleFinalizeOverride(context.Background(), &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			Type:      discordgo.InteractionMessageComponent,
+			GuildID:   "guild",
+			ChannelID: "channel",
+			Member:    member,
+			Message:   scorecard,
+			Data:      discordgo.MessageComponentInteractionData{CustomID: finalizeOverridePrefix + liveTestRoundID},
+		}})
+		if err != nil {
+			t.Fatalf("HandleFinalizeOverride() error = %v", err)
+		}
+	}
+	override(&discordgo.Member{User: &discordgo.User{ID: "1"}})
+	if response.Data.Content != "Only admins can finalize a round with scores still waiting to be confirmed." || len(requests) != 0 {
+		t.Fatalf("expected a player to be turned away, got %q", response.Data.Content)
+	}
+
+	override(&discordgo.Member{User: &discordgo.User{ID: "9"}, Permissions: discordgo.PermissionAdministrator})
+	if value := scorecard.Embeds[0].Fields[0].Value; value != "<@1> — Score: -2\n<@2> — Score: +1" {
+		t.Fatalf("expected the held score to be dropped, got %q", value)
+	}
+	if len(requests) != 1 || topics[0] != roundevents.RoundFinalizeRequestedV1 || requests[0].RoundID != roundID || requests[0].GuildID != "guild" {
+		t.Fatalf("expected the finalization to be requested, got %+v on %v", requests, topics)
+	}
+
+	// Once nothing is held, a held finalization is requested; a scorecard
+	// without one is left alone.
+	if held, err := srm.HoldFinalization(context.Background(), "guild", roundID, "channel", scorecard.ID); err != nil || held {
+		t.Fatalf("HoldFinalization() = %v, %v, want nothing to hold", held, err)
+	}
+	if err := srm.ResumeFinalization(context.Background(), "guild", roundID, "channel", scorecard.ID); err != nil || len(requests) != 2 {
+		t.Fatalf("ResumeFinalization() = %v with %+v, want a second request", err, requests)
+	}
+	scorecard.Components = withoutFinalizeOverride(scorecard.Components)
+	if err := srm.ResumeFinalization(context.Background(), "guild", roundID, "channel", scorecard.ID); err != nil || len(requests) != 2 {
+		t.Fatalf("ResumeFinalization() = %v with %d requests, want none without a held finalization", err, len(requests))
+	}
+}
@@ -77,7 +77,7 @@ func (srm *scoreRoundManager) HandleScoreButton(ctx context.Context, i *discordg
 }
 
 func buildBulkOverrideModal(roundID, userID, prefill string) *discordgo.InteractionResponse {
-	return &discordgo.InteractionResponse{Type: discordgo.InteractionResponseModal, Data: &discordgo.InteractionResponseData{Title: "Score Override(s)", CustomID: bulkOverrideModalCustomID(roundID, userID), Components: []discordgo.MessageComponent{discordgo.ActionsRow{Components: []discordgo.MessageComponent{discordgo.TextInput{CustomID: "bulk_scores_input", Label: "Score Overrides", Placeholder: "<@user|id|name|prefix>=score (0 allowed, -- keep, suffix ! force, # comment)", Style: discordgo.TextInputParagraph, Required: false, Value: prefill}}}}}}
+	return &discordgo.InteractionResponse{Type: discordgo.InteractionResponseModal, Data: &discordgo.InteractionResponseData{Title: "Score Override(s)", CustomID: bulkOverrideModalCustomID(roundID, userID), Components: []discordgo.MessageComponent{discordgo.ActionsRow{Components: []discordgo.MessageComponent{discordgo.TextInput{CustomID: "bulk_scores_input", Label: "Score Overrides", Placeholder: "<@user|id|name|prefix>=score (0 allowed, -- keep, suffix ! force, # comment)", Style: discordgo.TextInputParagraph, Required: false, Value: prefill}}}, discordgo.ActionsRow{Components: []discordgo.MessageComponent{discordgo.TextInput{CustomID: "bulk_course_par_input", Label: "Course Par (for pasted hole-by-hole rows)", Placeholder: "e.g. 54, or paste a Par row instead", Style: discordgo.TextInputShort, Required: false, MaxLength: 3}}}}}}
 }
 
 func buildSingleScoreModal(roundID, userID string) *discordgo.InteractionResponse {
@@ -3,11 +3,14 @@ package scoreround
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
 	sharedevents "github.com/Black-And-White-Club/frolf-bot-shared/events/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -22,25 +25,38 @@ func (srm *scoreRoundManager) handleBulkScoreSubmission(ctx context.Context, i *
 	if err != nil {
 		return ScoreRoundOperationResult{Error: fmt.Errorf("invalid round ID in bulk override")}, nil
 	}
+	if srm.requiresAttestation(ctx, i) {
+		return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource, "Scores in this server have to be confirmed, so only score editors can override them in bulk.")
+	}
 
 	data := i.ModalSubmitData()
-	var bulkValue string
+	var bulkValue, courseParValue string
+	readInput := func(customID, value string) {
+		switch customID {
+		case "bulk_scores_input":
+			bulkValue = value
+		case "bulk_course_par_input":
+			courseParValue = value
+		}
+	}
 	for _, comp := range data.Components {
 		switch rowTyped := comp.(type) {
 		case *discordgo.ActionsRow:
 			for _, inner := range rowTyped.Components {
-				if ti, ok := inner.(*discordgo.TextInput); ok && ti.CustomID == "bulk_scores_input" {
-					bulkValue = ti.Value
+				if ti, ok := inner.(*discordgo.TextInput); ok {
+					readInput(ti.CustomID, ti.Value)
 				}
 			}
 		case discordgo.ActionsRow:
 			for _, inner := range rowTyped.Components {
-				if ti, ok := inner.(discordgo.TextInput); ok && ti.CustomID == "bulk_scores_input" {
-					bulkValue = ti.Value
+				if ti, ok := inner.(discordgo.TextInput); ok {
+					readInput(ti.CustomID, ti.Value)
 				}
 			}
 		}
 	}
+	// An unreadable course par is left at 0 so pasted rows report missing-par.
+	coursePar, _ := strconv.Atoi(strings.TrimSpace(courseParValue))
 
 	var activeEmbed *discordgo.MessageEmbed
 	if i.Message != nil && len(i.Message.Embeds) > 0 {
@@ -102,10 +118,10 @@ func (srm *scoreRoundManager) handleBulkScoreSubmission(ctx context.Context, i *
 	}
 	idx := buildNameIndex(nameToID)
 
-	updates, unchanged, skipped, unresolved, diagnostics := parseBulkOverrides(bulkValue, originalScores, idx)
+	updates, unchanged, skipped, unresolved, diagnostics := parseBulkOverrides(bulkValue, originalScores, idx, coursePar)
 	var resolvedMappings []string
 	for _, d := range diagnostics {
-		if strings.Contains(d.reason, "resolved") {
+		if !d.skipped && strings.Contains(d.reason, "resolved") {
 			resolvedMappings = append(resolvedMappings, d.line)
 		}
 	}
@@ -148,6 +164,8 @@ func (srm *scoreRoundManager) handleBulkScoreSubmission(ctx context.Context, i *
 		if errCreate == nil {
 			if errPub := srm.publisher.Publish(sharedevents.ScoreBulkUpdateRequestedV1, resultMsg); errPub != nil {
 				summary = "Bulk override failed to publish: " + errPub.Error()
+			} else {
+				srm.recordBulkScores(ctx, i, bulkPayload)
 			}
 		} else {
 			summary = "Bulk override failed to create message: " + errCreate.Error()
@@ -161,3 +179,20 @@ func (srm *scoreRoundManager) handleBulkScoreSubmission(ctx context.Context, i *
 	}
 	return ScoreRoundOperationResult{Success: fmt.Sprintf("bulk override processed: %d", len(updates))}, nil
 }
+
+// recordBulkScores records the scores of a published bulk override as made by
+// the member who submitted it.
+func (srm *scoreRoundManager) recordBulkScores(ctx context.Context, i *discordgo.InteractionCreate, payload sharedevents.ScoreBulkUpdateRequestedPayloadV1) {
+	scores := make([]roundtypes.Participant, 0, len(payload.Updates))
+	for _, update := range payload.Updates {
+		score := update.Score
+		scores = append(scores, roundtypes.Participant{UserID: update.UserID, Score: &score})
+	}
+	messageID := ""
+	if i.Message != nil {
+		messageID = i.Message.ID
+	}
+	srm.recordScores(ctx, i.GuildID,
+		scoreaudit.Change{RoundID: payload.RoundID, Actor: interactionUserID(i), Source: scoreaudit.SourceBulk},
+		shownScores(messageID, i.Message), scores)
+}
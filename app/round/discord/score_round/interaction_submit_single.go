@@ -6,9 +6,11 @@ import (
 	"strconv"
 	"strings"
 
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -143,6 +145,15 @@ func (srm *scoreRoundManager) HandleScoreSubmission(ctx context.Context, i *disc
 			return ScoreRoundOperationResult{Error: fmt.Errorf("score out of range")}, nil
 		}
 
+		if srm.requiresAttestation(ctx, i) {
+			return srm.holdScore(ctx, i, heldScore{
+				roundID:   roundID.String(),
+				player:    sharedtypes.DiscordID(participantID),
+				submitter: sharedtypes.DiscordID(userID),
+				score:     scoreVal,
+			})
+		}
+
 		// Build and publish the canonical round domain score update request.
 		// The Discord code acts as a transport adapter: validate/auth has already
 		// happened, so we publish the domain event directly and preserve transport
@@ -186,6 +197,10 @@ func (srm *scoreRoundManager) HandleScoreSubmission(ctx context.Context, i *disc
 			_, _ = srm.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{Content: "Failed to submit your score. Please try again later.", Flags: discordgo.MessageFlagsEphemeral})
 			return ScoreRoundOperationResult{Error: fmt.Errorf("failed to publish message")}, nil
 		}
+		srm.recordScores(ctx, i.GuildID,
+			scoreaudit.Change{RoundID: scorePayload.RoundID, Actor: sharedtypes.DiscordID(userID), Source: scoreaudit.SourceSingle},
+			shownScores(scorePayload.MessageID, i.Message),
+			[]roundtypes.Participant{{UserID: scorePayload.UserID, Score: &scoreValue}})
 
 		_, _ = srm.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{Content: fmt.Sprintf("Your score of %d has been submitted!", scoreVal), Flags: discordgo.MessageFlagsEphemeral})
 		return ScoreRoundOperationResult{Success: "Score submission processed successfully"}, nil
@@ -0,0 +1,520 @@
+package scoreround
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
+	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+// livePlayersPerPage leaves one action row of the scorekeeper for the hole
+// buttons.
+const livePlayersPerPage = 4
+
+const liveScoreExpired = "This scorekeeper has expired. Press **Keep Score** on the scorecard to open a new one."
+
+// holeScoreOptions are the scores offered for a hole, relative to par.
+var holeScoreOptions = []struct {
+	score int
+	label string
+}{
+	{-3, "Albatross (-3)"},
+	{-2, "Eagle (-2)"},
+	{-1, "Birdie (-1)"},
+	{0, "Par (0)"},
+	{1, "Bogey (+1)"},
+	{2, "Double bogey (+2)"},
+	{3, "Triple bogey (+3)"},
+	{4, "+4"},
+}
+
+// livePlayer is a player as the scorekeeper shows them on one hole.
+type livePlayer struct {
+	userID sharedtypes.DiscordID
+	name   string
+	score  *int
+	total  int
+	thru   int
+}
+
+// liveScorekeeper is what a scorekeeper message is showing. The scorecard
+// message keeps the scores, so the scorekeeper only needs to find it.
+type liveScorekeeper struct {
+	messageID string
+	card      int
+	hole      int
+	page      int
+	holes     int
+}
+
+// HandleKeepScoreButton opens a scorekeeper for the card of the player who
+// pressed Keep Score, or for them alone when the round has no cards.
+func (srm *scoreRoundManager) HandleKeepScoreButton(ctx context.Context, i *discordgo.InteractionCreate) (ScoreRoundOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_keep_score_button")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+
+	return srm.operationWrapper(ctx, "handle_keep_score_button", func(ctx context.Context) (ScoreRoundOperationResult, error) {
+		userID := interactionUserID(i)
+		roundID := strings.TrimPrefix(i.MessageComponentData().CustomID, keepScoreButtonPrefix)
+		if _, err := uuid.Parse(roundID); err != nil || userID == "" || i.Message == nil {
+			return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource, "Invalid round information. Please try again.")
+		}
+
+		lines := srm.scorecardLines(i.ChannelID, i.Message.ID, i.Message)
+		card, players := 0, []sharedtypes.DiscordID{userID}
+		if cards := roundcards.FromLines(lines); len(cards) > 0 {
+			card = cardOf(cards, userID)
+			if card == 0 {
+				return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource, "You're not on a card in this round.")
+			}
+			players = cards[card-1]
+		} else if !linesInclude(lines, userID) {
+			return srm.respondLive(i, discordgo.InteractionResponseChannelMessageWithSource, "Join the round before keeping score.")
+		}
+
+		holes := liveHolesByUser(lines)
+		holeCount := srm.config.LiveScoreHoleCount()
+		view := liveScorekeeper{messageID: i.Message.ID, card: card, hole: firstOpenHole(holes, players, holeCount), holes: holeCount}
+		data := buildScorekeeper(view, srm.livePlayers(ctx, i.GuildID, view, players, holes))
+		if err := srm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: data,
+		}); err != nil {
+			return ScoreRoundOperationResult{Error: err}, nil
+		}
+		return ScoreRoundOperationResult{Success: "Scorekeeper opened"}, nil
+	})
+}
+
+// HandleLiveScoreInteraction handles the scorekeeper's hole buttons and score
+// menus. A score is noted for the hole on the player's scorecard line and their
+// running total is sent to the backend as their score.
+func (srm *scoreRoundManager) HandleLiveScoreInteraction(ctx context.Context, i *discordgo.InteractionCreate) (ScoreRoundOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_live_score")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "component")
+
+	return srm.operationWrapper(ctx, "handle_live_score", func(ctx context.Context) (ScoreRoundOperationResult, error) {
+		data := i.MessageComponentData()
+		view, target, ok := parseLiveScoreCustomID(data.CustomID, srm.config.LiveScoreHoleCount())
+		if !ok {
+			return srm.respondLive(i, discordgo.InteractionResponseUpdateMessage, liveScoreExpired)
+		}
+
+		lines := srm.scorecardLines(i.ChannelID, view.messageID, nil)
+		players := []sharedtypes.DiscordID{interactionUserID(i)}
+		if view.card > 0 {
+			cards := roundcards.FromLines(lines)
+			if view.card > len(cards) {
+				return srm.respondLive(i, discordgo.InteractionResponseUpdateMessage, liveScoreExpired)
+			}
+			players = cards[view.card-1]
+		}
+		if !linesInclude(lines, players[0]) {
+			return srm.respondLive(i, discordgo.InteractionResponseUpdateMessage, liveScoreExpired)
+		}
+		holes := liveHolesByUser(lines)
+
+		if target != "" {
+			if len(data.Values) == 0 || !containsUser(players, target) {
+				return srm.respondLive(i, discordgo.InteractionResponseUpdateMessage, liveScoreExpired)
+			}
+			score, err := strconv.Atoi(data.Values[0])
+			if err != nil {
+				return srm.respondLive(i, discordgo.InteractionResponseUpdateMessage, liveScoreExpired)
+			}
+
+			holes[target][view.hole] = score
+			if err := srm.rewriteParticipantLine(i.ChannelID, view.messageID, target, func(line string) string {
+				return withLiveHoles(line, holes[target])
+			}); err != nil {
+				srm.logger.ErrorContext(ctx, "Failed to note live score",
+					attr.Error(err), attr.String("message_id", view.messageID), attr.String("user_id", string(target)))
+				return srm.respondLive(i, discordgo.InteractionResponseUpdateMessage, "Failed to save the score. Press **Keep Score** on the scorecard to try again.")
+			}
+			srm.publishLiveScore(ctx, i, view, lines, holes, players, target)
+			if view.hole < view.holes && holeComplete(holes, players, view.hole) {
+				view.hole++
+			}
+		}
+
+		if err := srm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: buildScorekeeper(view, srm.livePlayers(ctx, i.GuildID, view, players, holes)),
+		}); err != nil {
+			return ScoreRoundOperationResult{Error: err}, nil
+		}
+		return ScoreRoundOperationResult{Success: "Scorekeeper updated"}, nil
+	})
+}
+
+// publishLiveScore sends the running total of the player just scored to the
+// backend, skipping a total the scorecard already shows. When the
+// scorekeeper's scores have to be attested, running totals aren't sent;
+// instead the card's totals are held for it to confirm once it has played
+// every hole.
+func (srm *scoreRoundManager) publishLiveScore(ctx context.Context, i *discordgo.InteractionCreate, view liveScorekeeper, lines []string, holes map[sharedtypes.DiscordID]map[int]int, players []sharedtypes.DiscordID, target sharedtypes.DiscordID) {
+	attested := srm.requiresAttestation(ctx, i)
+	if attested && !cardComplete(holes, players, view.holes) {
+		return
+	}
+
+	scorecard, err := srm.session.ChannelMessage(i.ChannelID, view.messageID)
+	roundID := ""
+	if err == nil {
+		roundID = scorecardRoundID(scorecard.Components)
+	}
+	if roundID == "" {
+		srm.logger.ErrorContext(ctx, "Failed to find the round of a live score",
+			attr.Error(err), attr.String("message_id", view.messageID))
+		return
+	}
+
+	current := make(map[sharedtypes.DiscordID]*sharedtypes.Score, len(lines))
+	for _, line := range lines {
+		if uid, score, _, ok := parseParticipantLine(line); ok {
+			current[uid] = score
+		}
+	}
+
+	if !attested {
+		total, _ := sumHoles(holes[target])
+		if score := current[target]; score != nil && int(*score) == total {
+			return
+		}
+		if err := srm.publishScore(ctx, i.GuildID, roundID, target, total, current[target], interactionUserID(i), i.ChannelID, view.messageID); err != nil {
+			srm.logger.ErrorContext(ctx, "Failed to publish live score",
+				attr.Error(err), attr.String("round_id", roundID), attr.String("user_id", string(target)))
+		}
+		return
+	}
+
+	for _, userID := range players {
+		total, _ := sumHoles(holes[userID])
+		if score := current[userID]; score != nil && int(*score) == total {
+			continue
+		}
+		if _, score, found := heldScoreOf(lines, userID); found && score == total {
+			continue
+		}
+		held := heldScore{roundID: roundID, player: userID, submitter: interactionUserID(i), score: total}
+		if _, err := srm.askAttestation(ctx, i.GuildID, i.ChannelID, view.messageID, lines, held); err != nil {
+			srm.logger.ErrorContext(ctx, "Failed to hold live score",
+				attr.Error(err), attr.String("round_id", roundID), attr.String("user_id", string(userID)))
+		}
+	}
+}
+
+// publishScore sends a player's running, final or attested score, entered by actor,
+// through the Discord score update request, the same path as other score
+// entry, and records it replacing old.
+func (srm *scoreRoundManager) publishScore(ctx context.Context, guildID, roundID string, userID sharedtypes.DiscordID, total int, old *sharedtypes.Score, actor sharedtypes.DiscordID, channelID, messageID string) error {
+	roundUUID, err := uuid.Parse(roundID)
+	if err != nil {
+		return err
+	}
+	payload := discordroundevents.RoundScoreUpdateRequestDiscordPayloadV1{
+		GuildID:   guildID,
+		RoundID:   sharedtypes.RoundID(roundUUID),
+		UserID:    userID,
+		Score:     sharedtypes.Score(total),
+		ChannelID: channelID,
+		MessageID: messageID,
+	}
+	msg := message.NewMessage(watermill.NewUUID(), nil)
+	msg.Metadata.Set("topic", discordroundevents.RoundScoreUpdateRequestDiscordV1)
+	msg.Metadata.Set("guild_id", guildID)
+	msg.Metadata.Set("channel_id", channelID)
+	msg.Metadata.Set("discord_message_id", messageID)
+	resultMsg, err := srm.helper.CreateResultMessage(msg, payload, discordroundevents.RoundScoreUpdateRequestDiscordV1)
+	if err != nil {
+		return err
+	}
+	if err := srm.publisher.Publish(discordroundevents.RoundScoreUpdateRequestDiscordV1, resultMsg); err != nil {
+		return err
+	}
+
+	score := payload.Score
+	srm.recordScores(ctx, guildID, scoreaudit.Change{RoundID: payload.RoundID, Actor: actor, Source: scoreaudit.SourceSingle},
+		map[sharedtypes.DiscordID]*sharedtypes.Score{userID: old}, []roundtypes.Participant{{UserID: userID, Score: &score}})
+	return nil
+}
+
+// scorecardRoundID returns the round a scorecard belongs to, from its Keep
+// Score button.
+func scorecardRoundID(components []discordgo.MessageComponent) string {
+	for _, customID := range buttonCustomIDs(components) {
+		if roundID, found := strings.CutPrefix(customID, keepScoreButtonPrefix); found {
+			return roundID
+		}
+	}
+	return ""
+}
+
+// scorecardLines returns the participant lines of a scorecard, reading the
+// whole list from its snapshot when it is paginated. message is fetched when
+// nil and there is no snapshot.
+func (srm *scoreRoundManager) scorecardLines(channelID, messageID string, msg *discordgo.Message) []string {
+	if snapshot, found := embedpagination.Get(messageID); found && snapshot.Kind == embedpagination.SnapshotKindLines {
+		return snapshot.LineItems
+	}
+	if msg == nil {
+		fetched, err := srm.session.ChannelMessage(channelID, messageID)
+		if err != nil {
+			return nil
+		}
+		msg = fetched
+	}
+	if len(msg.Embeds) == 0 {
+		return nil
+	}
+	var lines []string
+	for _, field := range msg.Embeds[0].Fields {
+		if field != nil && isParticipantField(field.Name, field.Value) {
+			lines = append(lines, embedpagination.ParticipantLinesFromFieldValue(field.Value)...)
+		}
+	}
+	return lines
+}
+
+// livePlayers gathers what the scorekeeper shows for each player on the view's
+// hole.
+func (srm *scoreRoundManager) livePlayers(ctx context.Context, guildID string, view liveScorekeeper, userIDs []sharedtypes.DiscordID, holes map[sharedtypes.DiscordID]map[int]int) []livePlayer {
+	players := make([]livePlayer, len(userIDs))
+	for idx, userID := range userIDs {
+		player := livePlayer{userID: userID, name: srm.displayName(ctx, guildID, userID)}
+		if score, ok := holes[userID][view.hole]; ok {
+			player.score = &score
+		}
+		player.total, player.thru = sumHoles(holes[userID])
+		players[idx] = player
+	}
+	return players
+}
+
+// firstOpenHole returns the first of count holes not every player has a
+// score on.
+func firstOpenHole(holes map[sharedtypes.DiscordID]map[int]int, players []sharedtypes.DiscordID, count int) int {
+	for hole := 1; hole < count; hole++ {
+		if !holeComplete(holes, players, hole) {
+			return hole
+		}
+	}
+	return count
+}
+
+func holeComplete(holes map[sharedtypes.DiscordID]map[int]int, players []sharedtypes.DiscordID, hole int) bool {
+	for _, userID := range players {
+		if _, ok := holes[userID][hole]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// cardComplete reports whether every player has a score on each of count
+// holes.
+func cardComplete(holes map[sharedtypes.DiscordID]map[int]int, players []sharedtypes.DiscordID, count int) bool {
+	for _, userID := range players {
+		if len(holes[userID]) < count {
+			return false
+		}
+	}
+	return true
+}
+
+func (srm *scoreRoundManager) displayName(ctx context.Context, guildID string, userID sharedtypes.DiscordID) string {
+	if guildID != "" {
+		if member, err := srm.session.GuildMember(guildID, string(userID)); err == nil && member != nil {
+			if member.Nick != "" {
+				return member.Nick
+			}
+			if member.User != nil && member.User.GlobalName != "" {
+				return member.User.GlobalName
+			}
+			if member.User != nil && member.User.Username != "" {
+				return member.User.Username
+			}
+		} else if err != nil {
+			srm.logger.DebugContext(ctx, "Failed to look up scorekeeper player name", attr.Error(err))
+		}
+	}
+	return string(userID)
+}
+
+func (srm *scoreRoundManager) respondLive(i *discordgo.InteractionCreate, responseType discordgo.InteractionResponseType, content string) (ScoreRoundOperationResult, error) {
+	data := &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral}
+	if responseType == discordgo.InteractionResponseUpdateMessage {
+		data.Components = []discordgo.MessageComponent{}
+	}
+	if err := srm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{Type: responseType, Data: data}); err != nil {
+		return ScoreRoundOperationResult{Error: err}, nil
+	}
+	return ScoreRoundOperationResult{Failure: content}, nil
+}
+
+// buildScorekeeper renders a scorekeeper: the card's running totals, buttons
+// to move between holes and a score menu per player for the current hole.
+func buildScorekeeper(view liveScorekeeper, players []livePlayer) *discordgo.InteractionResponseData {
+	title := "📝 **Scorekeeper**"
+	if view.card > 0 {
+		title = fmt.Sprintf("📝 **Scorekeeper · Card %d**", view.card)
+	}
+	lines := []string{fmt.Sprintf("%s — Hole %d of %d", title, view.hole, view.holes)}
+	for _, player := range players {
+		standing := "no holes yet"
+		if player.thru > 0 {
+			standing = fmt.Sprintf("%+d (thru %d)", player.total, player.thru)
+		}
+		lines = append(lines, fmt.Sprintf("**%s**: %s", player.name, standing))
+	}
+
+	pages := (len(players) + livePlayersPerPage - 1) / livePlayersPerPage
+	if view.page >= pages {
+		view.page = 0
+	}
+
+	buttons := []discordgo.MessageComponent{
+		discordgo.Button{
+			Label:    fmt.Sprintf("◀ Hole %d", view.hole-1),
+			Style:    discordgo.SecondaryButton,
+			CustomID: liveScoreHoleCustomID(view, view.hole-1, view.page),
+			Disabled: view.hole <= 1,
+		},
+		discordgo.Button{
+			Label:    fmt.Sprintf("Hole %d ▶", view.hole+1),
+			Style:    discordgo.SecondaryButton,
+			CustomID: liveScoreHoleCustomID(view, view.hole+1, view.page),
+			Disabled: view.hole >= view.holes,
+		},
+	}
+	if pages > 1 {
+		next := (view.page + 1) % pages
+		last := min((next+1)*livePlayersPerPage, len(players))
+		buttons = append(buttons, discordgo.Button{
+			Label:    fmt.Sprintf("Players %d–%d", next*livePlayersPerPage+1, last),
+			Style:    discordgo.PrimaryButton,
+			CustomID: liveScoreHoleCustomID(view, view.hole, next),
+		})
+	}
+
+	components := []discordgo.MessageComponent{discordgo.ActionsRow{Components: buttons}}
+	start := view.page * livePlayersPerPage
+	for _, player := range players[start:min(start+livePlayersPerPage, len(players))] {
+		options := make([]discordgo.SelectMenuOption, len(holeScoreOptions))
+		for idx, option := range holeScoreOptions {
+			options[idx] = discordgo.SelectMenuOption{
+				Label:   option.label,
+				Value:   strconv.Itoa(option.score),
+				Default: player.score != nil && *player.score == option.score,
+			}
+		}
+		components = append(components, discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.SelectMenu{
+				MenuType:    discordgo.StringSelectMenu,
+				CustomID:    liveScoreSetCustomID(view, player.userID),
+				Placeholder: fmt.Sprintf("%s · hole %d", player.name, view.hole),
+				Options:     options,
+			},
+		}})
+	}
+
+	return &discordgo.InteractionResponseData{
+		Content:         strings.Join(lines, "\n"),
+		Flags:           discordgo.MessageFlagsEphemeral,
+		Components:      components,
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	}
+}
+
+func liveScoreHoleCustomID(view liveScorekeeper, hole, page int) string {
+	return fmt.Sprintf("%s%s|%d|%d|%d", liveScoreHolePrefix, view.messageID, view.card, hole, page)
+}
+
+func liveScoreSetCustomID(view liveScorekeeper, userID sharedtypes.DiscordID) string {
+	return fmt.Sprintf("%s%s|%d|%d|%d|%s", liveScoreSetPrefix, view.messageID, view.card, view.hole, view.page, userID)
+}
+
+// parseLiveScoreCustomID reads a scorekeeper custom ID back into the view it
+// belongs to, on a course of holes, and, for a score menu, the player it
+// scores.
+func parseLiveScoreCustomID(customID string, holes int) (liveScorekeeper, sharedtypes.DiscordID, bool) {
+	var rest string
+	wantParts := 4
+	switch {
+	case strings.HasPrefix(customID, liveScoreHolePrefix):
+		rest = strings.TrimPrefix(customID, liveScoreHolePrefix)
+	case strings.HasPrefix(customID, liveScoreSetPrefix):
+		rest = strings.TrimPrefix(customID, liveScoreSetPrefix)
+		wantParts = 5
+	default:
+		return liveScorekeeper{}, "", false
+	}
+
+	parts := strings.Split(rest, "|")
+	if len(parts) != wantParts {
+		return liveScorekeeper{}, "", false
+	}
+	card, cardErr := strconv.Atoi(parts[1])
+	hole, holeErr := strconv.Atoi(parts[2])
+	page, pageErr := strconv.Atoi(parts[3])
+	if parts[0] == "" || cardErr != nil || holeErr != nil || pageErr != nil || hole < 1 || hole > holes || card < 0 || page < 0 {
+		return liveScorekeeper{}, "", false
+	}
+
+	view := liveScorekeeper{messageID: parts[0], card: card, hole: hole, page: page, holes: holes}
+	if wantParts == 5 {
+		return view, sharedtypes.DiscordID(parts[4]), parts[4] != ""
+	}
+	return view, "", true
+}
+
+// cardOf returns the number of the card userID is on, or 0.
+func cardOf(cards [][]sharedtypes.DiscordID, userID sharedtypes.DiscordID) int {
+	for idx, card := range cards {
+		if containsUser(card, userID) {
+			return idx + 1
+		}
+	}
+	return 0
+}
+
+func containsUser(userIDs []sharedtypes.DiscordID, userID sharedtypes.DiscordID) bool {
+	for _, id := range userIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func linesInclude(lines []string, userID sharedtypes.DiscordID) bool {
+	for _, line := range lines {
+		if uid, _, _, ok := parseParticipantLine(line); ok && uid == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func interactionUserID(i *discordgo.InteractionCreate) sharedtypes.DiscordID {
+	if i.Member != nil && i.Member.User != nil {
+		return sharedtypes.DiscordID(i.Member.User.ID)
+	}
+	if i.User != nil {
+		return sharedtypes.DiscordID(i.User.ID)
+	}
+	return ""
+}
@@ -0,0 +1,299 @@
+package scoreround
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+)
+
+const liveTestRoundID = "6f1c2b9e-3d4a-4c5b-8e7f-9a0b1c2d3e4f"
+
+func Test_scoreRoundManager_LiveScorekeeper(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	var published []discordroundevents.RoundScoreUpdateRequestDiscordPayloadV1
+	newManager := func() *scoreRoundManager {
+		return &scoreRoundManager{
+			session: fakeSession,
+			logger:  slog.New(loggerfrolfbot.NewTestHandler()),
+			helper: &testutils.FakeHelpers{
+				CreateResultMessageFunc: func(_ *message.Message, payload any, _ string) (*message.Message, error) {
+					published = append(published, payload.(discordroundevents.RoundScoreUpdateRequestDiscordPayloadV1))
+					return message.NewMessage("id", nil), nil
+				},
+			},
+			publisher: &testutils.FakeEventBus{},
+			operationWrapper: func(ctx context.Context, opName string, fn func(ctx context.Context) (ScoreRoundOperationResult, error)) (ScoreRoundOperationResult, error) {
+				return fn(ctx)
+			},
+		}
+	}
+	srm := newManager()
+
+	scorecard := &discordgo.Message{
+		ID: "live-scorecard",
+		Embeds: []*discordgo.MessageEmbed{{
+			Fields: []*discordgo.MessageEmbedField{{
+				Name: "👥 Participants",
+				Value: strings.Join([]string{
+					"🃏 **Card 1**", "<@1> — Score: --", "<@2> — Score: --", "<@3> — Score: --",
+					"🃏 **Card 2**", "<@4> — Score: --", "<@5> — Score: --", "<@6> — Score: --",
+				}, "\n"),
+			}},
+		}},
+		Components: []discordgo.MessageComponent{discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{Label: "Keep Score", CustomID: keepScoreButtonPrefix + liveTestRoundID},
+		}}},
+	}
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		if messageID != scorecard.ID {
+			return nil, errors.New("unknown message")
+		}
+		return scorecard, nil
+	}
+	fakeSession.ChannelMessageEditComplexFunc = func(edit *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		scorecard.Embeds = *edit.Embeds
+		return scorecard, nil
+	}
+	fakeSession.GuildMemberFunc = func(guildID, userID string, options ...discordgo.RequestOption) (*discordgo.Member, error) {
+		return &discordgo.Member{Nick: "P" + userID}, nil
+	}
+	var response *discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		response = resp
+		return nil
+	}
+
+	press := func(userID, customID string, values ...string) {
+		t.Helper()
+		i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			Type:      discordgo.InteractionMessageComponent,
+			GuildID:   "guild",
+			ChannelID: "channel",
+			Member:    &discordgo.Member{User: &discordgo.User{ID: userID}},
+			Message:   scorecard,
+			Data:      discordgo.MessageComponentInteractionData{CustomID: customID, Values: values},
+		}}
+		var err error
+		if strings.HasPrefix(customID, keepScoreButtonPrefix) {
+			_, err = srm.HandleKeepScoreButton(context.Background(), i)
+		} else {
+			_, err = srm.HandleLiveScoreInteraction(context.Background(), i)
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	setHole := func(hole int, userID, score string) {
+		t.Helper()
+		press("2", liveScoreSetCustomID(liveScorekeeper{messageID: scorecard.ID, card: 1, hole: hole}, sharedtypes.DiscordID(userID)), score)
+	}
+
+	press("2", keepScoreButtonPrefix+liveTestRoundID)
+	if !strings.HasPrefix(response.Data.Content, "📝 **Scorekeeper · Card 1** — Hole 1 of 18") {
+		t.Fatalf("unexpected scorekeeper: %q", response.Data.Content)
+	}
+	if got := len(response.Data.Components); got != 4 {
+		t.Fatalf("expected hole buttons and 3 score menus, got %d rows", got)
+	}
+
+	for idx, userID := range []string{"1", "2", "3"} {
+		setHole(1, userID, []string{"-1", "0", "2"}[idx])
+	}
+	if !strings.Contains(response.Data.Content, "Hole 2 of 18") {
+		t.Fatalf("expected the scorekeeper to move on once the hole was scored, got %q", response.Data.Content)
+	}
+	setHole(2, "1", "-1")
+	wantTotals := []struct {
+		userID sharedtypes.DiscordID
+		score  sharedtypes.Score
+	}{{"1", -1}, {"2", 0}, {"3", 2}, {"1", -2}}
+	if len(published) != len(wantTotals) {
+		t.Fatalf("expected a running total per hole score, got %+v", published)
+	}
+	for idx, want := range wantTotals {
+		if got := published[idx]; got.UserID != want.userID || got.Score != want.score || got.MessageID != scorecard.ID || got.ChannelID != "channel" || got.RoundID.String() != liveTestRoundID {
+			t.Fatalf("unexpected running total %d: %+v", idx, got)
+		}
+	}
+	if lines := scorecard.Embeds[0].Fields[0].Value; !strings.Contains(lines, "<@1> — Score: -- (📝 -2 thru 2: -1 -1)") || !strings.Contains(lines, "<@3> — Score: -- (📝 +2 thru 1: +2)") {
+		t.Fatalf("expected the hole scores on the scorecard, got %q", lines)
+	}
+
+	// A new process picks the card up from the scorecard.
+	srm = newManager()
+	press("3", keepScoreButtonPrefix+liveTestRoundID)
+	if content := response.Data.Content; !strings.Contains(content, "Hole 2 of 18") || !strings.Contains(content, "**P1**: -2 (thru 2)") {
+		t.Fatalf("expected the scorekeeper to resume from the scorecard, got %q", content)
+	}
+
+	// The hole count comes from the config.
+	srm.config = &config.Config{Discord: config.DiscordConfig{LiveScoreHoles: 9}}
+	press("3", keepScoreButtonPrefix+liveTestRoundID)
+	if content := response.Data.Content; !strings.Contains(content, "Hole 2 of 9") {
+		t.Fatalf("expected a nine-hole scorekeeper, got %q", content)
+	}
+
+	press("9", keepScoreButtonPrefix+liveTestRoundID)
+	if response.Data.Content != "You're not on a card in this round." {
+		t.Fatalf("unexpected response for a player on no card: %q", response.Data.Content)
+	}
+
+	press("4", liveScoreSetCustomID(liveScorekeeper{messageID: "deleted-scorecard", card: 2, hole: 1}, "4"), "0")
+	if response.Data.Content != liveScoreExpired {
+		t.Fatalf("expected an expired scorekeeper, got %q", response.Data.Content)
+	}
+}
+
+func Test_scoreRoundManager_LiveScorekeeper_HoldsAttestedTotals(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	published := 0
+	srm := &scoreRoundManager{
+		session: fakeSession,
+		logger:  slog.New(loggerfrolfbot.NewTestHandler()),
+		config:  &config.Config{Discord: config.DiscordConfig{LiveScoreHoles: 3}},
+		helper: &testutils.FakeHelpers{
+			CreateResultMessageFunc: func(_ *message.Message, _ any, _ string) (*message.Message, error) {
+				published++
+				return message.NewMessage("id", nil), nil
+			},
+		},
+		publisher:       &testutils.FakeEventBus{},
+		attestedScoring: func(ctx context.Context, guildID string) bool { return true },
+		operationWrapper: func(ctx context.Context, opName string, fn func(ctx context.Context) (ScoreRoundOperationResult, error)) (ScoreRoundOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	scorecard := &discordgo.Message{
+		ID: "attested-live-scorecard",
+		Embeds: []*discordgo.MessageEmbed{{
+			Fields: []*discordgo.MessageEmbedField{{Name: "👥 Participants", Value: "<@1> — Score: --\n<@2> — Score: --"}},
+		}},
+		Components: []discordgo.MessageComponent{discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{Label: "Keep Score", CustomID: keepScoreButtonPrefix + liveTestRoundID},
+		}}},
+	}
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return scorecard, nil
+	}
+	fakeSession.ChannelMessageEditComplexFunc = func(edit *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		scorecard.Embeds = *edit.Embeds
+		return scorecard, nil
+	}
+	var sent []*discordgo.MessageSend
+	fakeSession.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		sent = append(sent, data)
+		return &discordgo.Message{ID: "attestation"}, nil
+	}
+
+	setHole := func(hole int, score string) {
+		t.Helper()
+		_, err := srm.HandleLiveScoreInteraction(context.Background(), &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			Type:      discordgo.InteractionMessageComponent,
+			GuildID:   "guild",
+			ChannelID: "channel",
+			Member:    &discordgo.Member{User: &discordgo.User{ID: "1"}},
+			Data: discordgo.MessageComponentInteractionData{
+				CustomID: liveScoreSetCustomID(liveScorekeeper{messageID: scorecard.ID, hole: hole}, "1"),
+				Values:   []string{score},
+			},
+		}})
+		if err != nil {
+			t.Fatalf("HandleLiveScoreInteraction() error = %v", err)
+		}
+	}
+
+	setHole(1, "-1")
+	for hole := 2; hole <= 3; hole++ {
+		setHole(hole, "0")
+	}
+	if published != 0 {
+		t.Fatalf("expected the total to be held for attestation, got %d published", published)
+	}
+	if value := scorecard.Embeds[0].Fields[0].Value; !strings.HasSuffix(strings.Split(value, "\n")[0], "· ⏳ -1 pending") {
+		t.Fatalf("expected the scorecard to show the pending total, got %q", value)
+	}
+	if len(sent) != 1 || sent[0].Content != "⏳ <@1> entered **-1**. It counts once another player on the card confirms it.\n<@2>" {
+		t.Fatalf("expected the round to be asked to confirm the total, got %+v", sent)
+	}
+
+	// Re-entering a hole without changing the total doesn't ask again.
+	setHole(3, "0")
+	if len(sent) != 1 {
+		t.Fatalf("expected the held total not to be asked about twice, got %d messages", len(sent))
+	}
+}
+
+func Test_withLiveHoles(t *testing.T) {
+	line := withLiveHoles("<@1> Tag: 4 — Score: -- · ⏳ -2 pending", map[int]int{1: -1, 3: 0})
+	if line != "<@1> Tag: 4 — Score: -- (📝 -1 thru 2: -1 _ +0) · ⏳ -2 pending" {
+		t.Fatalf("withLiveHoles() = %q", line)
+	}
+	if holes := liveHoles(line); len(holes) != 2 || holes[1] != -1 || holes[3] != 0 {
+		t.Fatalf("liveHoles() = %v", holes)
+	}
+	if state, score, ok := heldScoreOf([]string{line}, "1"); !ok || state != heldPending || score != -2 {
+		t.Fatalf("expected the held score to survive, got %v %d %v", state, score, ok)
+	}
+	if _, score, _, _ := parseParticipantLine(line); score != nil {
+		t.Fatalf("expected live holes not to count as a score, got %d", *score)
+	}
+}
+
+func Test_parseLiveScoreCustomID(t *testing.T) {
+	view := liveScorekeeper{messageID: "1234567890123456789", card: 2, hole: 7, page: 1, holes: 18}
+
+	got, target, ok := parseLiveScoreCustomID(liveScoreSetCustomID(view, "42"), 18)
+	if !ok || got != view || target != "42" {
+		t.Fatalf("parseLiveScoreCustomID() = %+v, %q, %v", got, target, ok)
+	}
+	got, target, ok = parseLiveScoreCustomID(liveScoreHoleCustomID(view, 8, 0), 18)
+	if !ok || got.hole != 8 || got.page != 0 || target != "" {
+		t.Fatalf("parseLiveScoreCustomID() for a hole button = %+v, %q, %v", got, target, ok)
+	}
+	if len(liveScoreSetCustomID(view, "1234567890123456789")) > 100 {
+		t.Fatal("score menu custom ID is over Discord's 100 characters")
+	}
+	if _, _, ok := parseLiveScoreCustomID(liveScoreHolePrefix+"1234567890123456789|1|19|0", 18); ok {
+		t.Fatal("expected a hole past 18 to be rejected")
+	}
+	if _, _, ok := parseLiveScoreCustomID(liveScoreHolePrefix+"1234567890123456789|1|10|0", 9); ok {
+		t.Fatal("expected a hole past 9 to be rejected on a nine-hole course")
+	}
+}
+
+func Test_buildScorekeeper_PagesPlayers(t *testing.T) {
+	var players []livePlayer
+	for _, id := range []string{"1", "2", "3", "4", "5"} {
+		players = append(players, livePlayer{userID: sharedtypes.DiscordID(id), name: "P" + id})
+	}
+	par := 0
+	players[4].score, players[4].total, players[4].thru = &par, 0, 1
+
+	data := buildScorekeeper(liveScorekeeper{messageID: "scorecard", hole: 1, page: 1, holes: 18}, players)
+	if len(data.Components) != 2 {
+		t.Fatalf("expected hole buttons and one score menu on the second page, got %d rows", len(data.Components))
+	}
+	buttons := data.Components[0].(discordgo.ActionsRow).Components
+	if len(buttons) != 3 || !buttons[0].(discordgo.Button).Disabled || buttons[2].(discordgo.Button).Label != "Players 1–4" {
+		t.Fatalf("unexpected buttons: %+v", buttons)
+	}
+	menu := data.Components[1].(discordgo.ActionsRow).Components[0].(discordgo.SelectMenu)
+	if menu.Placeholder != "P5 · hole 1" || !menu.Options[3].Default {
+		t.Fatalf("unexpected score menu: %+v", menu)
+	}
+	if !strings.Contains(data.Content, "**P5**: +0 (thru 1)") {
+		t.Fatalf("unexpected content: %q", data.Content)
+	}
+}
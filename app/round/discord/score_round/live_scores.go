@@ -0,0 +1,80 @@
+package scoreround
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// The hole-by-hole scores entered with the scorekeeper are noted on their
+// player's scorecard line, e.g. "<@1> — Score: -- (📝 -2 thru 3: -1 +0 -1)",
+// so the scorecard is all the state a scorekeeper has and survives restarts.
+// Holes skipped before the last one played show as "_". Each running total is
+// also sent to the backend as the player's score, unless scores are attested,
+// when a card's totals wait until every player on it has played every hole.
+
+// liveNote matches the note live hole scores add to a participant line.
+var liveNote = regexp.MustCompile(` \(📝 [+-]?\d+ thru \d+: ([^)]*)\)`)
+
+const liveNoHole = "_"
+
+// liveHoles returns the hole scores noted on a participant line, relative to
+// par, by hole.
+func liveHoles(line string) map[int]int {
+	holes := make(map[int]int)
+	match := liveNote.FindStringSubmatch(line)
+	if match == nil {
+		return holes
+	}
+	for idx, token := range strings.Fields(match[1]) {
+		if score, err := strconv.Atoi(token); err == nil {
+			holes[idx+1] = score
+		}
+	}
+	return holes
+}
+
+// withLiveHoles notes holes on a participant line, replacing any noted there.
+// A held score's note stays last.
+func withLiveHoles(line string, holes map[int]int) string {
+	held := heldNote.FindString(line)
+	line = liveNote.ReplaceAllString(withoutHeldScore(line), "")
+	if len(holes) == 0 {
+		return line + held
+	}
+
+	last := 0
+	for hole := range holes {
+		last = max(last, hole)
+	}
+	tokens := make([]string, last)
+	for hole := 1; hole <= last; hole++ {
+		tokens[hole-1] = liveNoHole
+		if score, ok := holes[hole]; ok {
+			tokens[hole-1] = fmt.Sprintf("%+d", score)
+		}
+	}
+	total, thru := sumHoles(holes)
+	return fmt.Sprintf("%s (📝 %+d thru %d: %s)%s", line, total, thru, strings.Join(tokens, " "), held)
+}
+
+// liveHolesByUser returns the hole scores noted on scorecard lines, by player.
+func liveHolesByUser(lines []string) map[sharedtypes.DiscordID]map[int]int {
+	byUser := make(map[sharedtypes.DiscordID]map[int]int)
+	for _, line := range lines {
+		if uid, _, _, ok := parseParticipantLine(line); ok {
+			byUser[uid] = liveHoles(line)
+		}
+	}
+	return byUser
+}
+
+func sumHoles(holes map[int]int) (total, thru int) {
+	for _, score := range holes {
+		total += score
+	}
+	return total, len(holes)
+}
@@ -5,12 +5,24 @@ import (
 	"strings"
 
 	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/bwmarrin/discordgo"
 )
 
 func messageHasPager(components []discordgo.MessageComponent) bool {
+	for _, customID := range buttonCustomIDs(components) {
+		if embedpagination.IsPagerCustomID(customID) {
+			return true
+		}
+	}
+	return false
+}
+
+// buttonCustomIDs returns the custom IDs of the buttons in a message's rows.
+func buttonCustomIDs(components []discordgo.MessageComponent) []string {
+	var customIDs []string
 	for _, component := range components {
 		row, ok := component.(discordgo.ActionsRow)
 		if !ok {
@@ -29,12 +41,10 @@ func messageHasPager(components []discordgo.MessageComponent) bool {
 					continue
 				}
 			}
-			if embedpagination.IsPagerCustomID(button.CustomID) {
-				return true
-			}
+			customIDs = append(customIDs, button.CustomID)
 		}
 	}
-	return false
+	return customIDs
 }
 
 func storeLineSnapshotFromEmbed(messageID string, embed *discordgo.MessageEmbed, components []discordgo.MessageComponent) {
@@ -71,6 +81,8 @@ func storeLineSnapshotFromEmbed(messageID string, embed *discordgo.MessageEmbed,
 	embedpagination.Set(snapshot)
 }
 
+// updateLineItemsScore sets userID's score on their line, noting how many holes
+// they are through when the score is a live running total.
 func updateLineItemsScore(lines []string, userID sharedtypes.DiscordID, score *sharedtypes.Score) ([]string, bool) {
 	if len(lines) == 0 {
 		return lines, false
@@ -85,7 +97,7 @@ func updateLineItemsScore(lines []string, userID sharedtypes.DiscordID, score *s
 		if !ok || uid != userID {
 			continue
 		}
-		updated[i] = formatParticipantLine(uid, score, tag)
+		updated[i] = withLiveHoles(formatParticipantLine(uid, score, tag), liveHoles(line))
 		found = true
 	}
 
@@ -146,6 +158,51 @@ func updateFieldItemsFromParticipants(fields []*discordgo.MessageEmbedField, par
 	return updated
 }
 
+// participantsToScorecardLines rebuilds the participant lines from
+// participants. Lines grouped into cards keep their cards: each player's line
+// is updated in place, players no longer in the round are dropped and new
+// players follow the last card. Live hole scores noted on a line are kept.
+func participantsToScorecardLines(existing []string, participants []roundtypes.Participant) []string {
+	live := liveHolesByUser(existing)
+	if !roundcards.HasCards(existing) {
+		lines := participantsToEmbedLines(participants)
+		for idx, participant := range participants {
+			lines[idx] = withLiveHoles(lines[idx], live[participant.UserID])
+		}
+		return lines
+	}
+
+	participantByUser := make(map[sharedtypes.DiscordID]roundtypes.Participant, len(participants))
+	for _, participant := range participants {
+		participantByUser[participant.UserID] = participant
+	}
+
+	lines := make([]string, 0, len(existing)+len(participants))
+	placed := make(map[sharedtypes.DiscordID]bool, len(participants))
+	for _, line := range existing {
+		if roundcards.IsHeaderLine(line) {
+			lines = append(lines, line)
+			continue
+		}
+		uid, _, _, ok := parseParticipantLine(line)
+		if !ok {
+			continue
+		}
+		participant, found := participantByUser[uid]
+		if !found {
+			continue
+		}
+		lines = append(lines, withLiveHoles(formatParticipantLine(participant.UserID, participant.Score, participant.TagNumber), live[uid]))
+		placed[uid] = true
+	}
+	for _, participant := range participants {
+		if !placed[participant.UserID] {
+			lines = append(lines, formatParticipantLine(participant.UserID, participant.Score, participant.TagNumber))
+		}
+	}
+	return lines
+}
+
 func appendMissingParticipantLines(existing []string, participants []roundtypes.Participant) []string {
 	if len(participants) == 0 {
 		return existing
@@ -53,4 +53,39 @@ func RegisterHandlers(registry *interactions.Registry, manager ScoreRoundManager
 		)
 		manager.HandleScoreSubmission(ctx, i)
 	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	// Live scorekeeper opened from the scorecard
+	registry.RegisterMutatingHandler("round_keep_score|", func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.InfoContext(ctx, "Handling round_keep_score button press",
+			attr.String("custom_id", i.MessageComponentData().CustomID),
+			attr.String("interaction_id", i.ID),
+			attr.String("user_id", i.Member.User.ID),
+		)
+		manager.HandleKeepScoreButton(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	// Live scorekeeper hole buttons and score menus
+	for _, prefix := range []string{"live_score_hole|", "live_score_set|"} {
+		registry.RegisterMutatingHandler(prefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+			manager.HandleLiveScoreInteraction(ctx, i)
+		}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+	}
+
+	// Attested scoring: the card confirms or disputes a held score, editors
+	// settle disputes
+	for _, prefix := range []string{"attest_confirm|", "attest_dispute|", "attest_accept|", "attest_reject|"} {
+		registry.RegisterMutatingHandler(prefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+			manager.HandleAttestation(ctx, i)
+		}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+	}
+
+	// Finalize Anyway on a scorecard whose finalization waits on held scores
+	registry.RegisterMutatingHandler("round_finalize_override|", func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.InfoContext(ctx, "Handling round_finalize_override button press",
+			attr.String("custom_id", i.MessageComponentData().CustomID),
+			attr.String("interaction_id", i.ID),
+			attr.String("user_id", i.Member.User.ID),
+		)
+		manager.HandleFinalizeOverride(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
 }
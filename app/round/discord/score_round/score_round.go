@@ -9,6 +9,7 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
@@ -32,6 +33,12 @@ type ScoreRoundManager interface {
 	UpdateScoreEmbed(ctx context.Context, channelID, messageID string, userID sharedtypes.DiscordID, score *sharedtypes.Score) (ScoreRoundOperationResult, error)
 	UpdateScoreEmbedBulk(ctx context.Context, channelID, messageID string, participants []roundtypes.Participant) (ScoreRoundOperationResult, error)
 	AddLateParticipantToScorecard(ctx context.Context, channelID, messageID string, participants []roundtypes.Participant) (ScoreRoundOperationResult, error)
+	HandleKeepScoreButton(ctx context.Context, i *discordgo.InteractionCreate) (ScoreRoundOperationResult, error)
+	HandleLiveScoreInteraction(ctx context.Context, i *discordgo.InteractionCreate) (ScoreRoundOperationResult, error)
+	HandleAttestation(ctx context.Context, i *discordgo.InteractionCreate) (ScoreRoundOperationResult, error)
+	HoldFinalization(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, channelID, messageID string) (bool, error)
+	ResumeFinalization(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, channelID, messageID string) error
+	HandleFinalizeOverride(ctx context.Context, i *discordgo.InteractionCreate) (ScoreRoundOperationResult, error)
 }
 
 // scoreRoundManager implements the ScoreRoundManager interface.
@@ -47,6 +54,10 @@ type scoreRoundManager struct {
 	metrics             discordmetrics.DiscordMetrics
 	operationWrapper    func(ctx context.Context, opName string, fn func(ctx context.Context) (ScoreRoundOperationResult, error)) (ScoreRoundOperationResult, error)
 	guildConfigResolver guildconfig.GuildConfigResolver
+	// attestedScoring is nil while attested scoring is held back.
+	attestedScoring func(ctx context.Context, guildID string) bool
+	// audit records the changes made to scorecards. It is nil until set.
+	audit scoreaudit.Log
 }
 
 // NewScoreRoundManager creates a new ScoreRoundManager instance.
@@ -83,6 +94,7 @@ func NewScoreRoundManager(
 			return wrapScoreRoundOperation(ctx, opName, fn, logger, tracer, metrics)
 		},
 		guildConfigResolver: guildConfigResolver,
+		attestedScoring:     guildconfig.NewAttestedScoring(config, guildConfigResolver, publisher),
 	}
 }
 
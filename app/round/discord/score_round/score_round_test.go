@@ -10,6 +10,7 @@ import (
 	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
@@ -429,10 +430,20 @@ func Test_HandleScoreSubmission_Single(t *testing.T) {
 		fakeSession.FollowupMessageCreateFunc = func(i *discordgo.Interaction, wait bool, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error) {
 			return &discordgo.Message{}, nil
 		}
+		log := &scoreaudit.FakeLog{}
+		srm.SetScoreAuditLog(log)
+		defer srm.SetScoreAuditLog(nil)
+
 		res, err := srm.HandleScoreSubmission(context.Background(), i)
 		if err != nil || res.Error != nil {
 			t.Fatalf("unexpected error: %v %v", err, res.Error)
 		}
+		if len(log.Entries) != 1 {
+			t.Fatalf("expected the submitted score to be recorded, got %+v", log.Entries)
+		}
+		if got := log.Entries[0]; got.UserID != "u1" || got.Actor != "u1" || got.Source != scoreaudit.SourceSingle || got.Old != nil || got.New != 5 {
+			t.Errorf("unexpected entry %+v", got)
+		}
 	})
 }
 
@@ -496,9 +507,19 @@ func Test_HandleScoreSubmission_Bulk(t *testing.T) {
 			return nil
 		}
 
+		log := &scoreaudit.FakeLog{}
+		srm.SetScoreAuditLog(log)
+		defer srm.SetScoreAuditLog(nil)
+
 		res, err := srm.HandleScoreSubmission(context.Background(), i)
 		if err != nil || res.Error != nil {
 			t.Fatalf("unexpected error: %v %v", err, res.Error)
 		}
+		if len(log.Entries) != 1 {
+			t.Fatalf("expected the override to be recorded, got %+v", log.Entries)
+		}
+		if got := log.Entries[0]; got.UserID != "123" || got.Actor != "u1" || got.Source != scoreaudit.SourceBulk || got.Old == nil || *got.Old != 1 || got.New != 2 {
+			t.Errorf("unexpected entry %+v", got)
+		}
 	})
 }
@@ -8,6 +8,7 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/bwmarrin/discordgo"
 )
@@ -276,3 +277,68 @@ func Test_scoreRoundManager_UpdateScoreEmbed(t *testing.T) {
 		})
 	}
 }
+
+func Test_participantsToScorecardLines_KeepsCards(t *testing.T) {
+	existing := []string{
+		"🃏 **Card 1**",
+		"<@111> — Score: --",
+		"<@222> — Score: --",
+		"🃏 **Card 2**",
+		"<@333> — Score: --",
+	}
+	participants := []roundtypes.Participant{
+		{UserID: "333", Score: intPointer(-2)},
+		{UserID: "111", Score: intPointer(1)},
+		{UserID: "444"},
+	}
+
+	got := participantsToScorecardLines(existing, participants)
+	want := "🃏 **Card 1**\n<@111> — Score: +1\n🃏 **Card 2**\n<@333> — Score: -2\n<@444> — Score: --"
+	if strings.Join(got, "\n") != want {
+		t.Fatalf("participantsToScorecardLines() = %q", got)
+	}
+
+	if got := participantsToScorecardLines(existing[1:3], participants); len(got) != len(participants) || got[0] != "<@333> — Score: -2" {
+		t.Fatalf("lines without cards should follow the participants, got %q", got)
+	}
+}
+
+func Test_scoreRoundManager_UpdateScoreEmbed_KeepsLiveHoles(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	line := "<@111> Tag: 4 — Score: -- (📝 -2 thru 3: -1 +0 -1)"
+	fakeSession.ChannelMessageFunc = func(cID, mID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return &discordgo.Message{
+			ID: mID,
+			Embeds: []*discordgo.MessageEmbed{{
+				Fields: []*discordgo.MessageEmbedField{{Name: "👥 Participants", Value: line}},
+			}},
+		}, nil
+	}
+	fakeSession.ChannelMessageEditComplexFunc = func(edit *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		line = (*edit.Embeds)[0].Fields[0].Value
+		return &discordgo.Message{ID: edit.ID}, nil
+	}
+
+	srm := &scoreRoundManager{
+		session: fakeSession,
+		logger:  loggerfrolfbot.NoOpLogger,
+		operationWrapper: func(ctx context.Context, opName string, fn func(ctx context.Context) (ScoreRoundOperationResult, error)) (ScoreRoundOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	if _, err := srm.UpdateScoreEmbed(context.Background(), "channel", "scorecard", "111", intPointer(-2)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if line != "<@111> Tag: 4 — Score: -2 (📝 -2 thru 3: -1 +0 -1)" {
+		t.Fatalf("unexpected live line: %q", line)
+	}
+
+	// A total entered by hand leaves the hole scores for the scorekeeper.
+	if _, err := srm.UpdateScoreEmbed(context.Background(), "channel", "scorecard", "111", intPointer(1)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if line != "<@111> Tag: 4 — Score: +1 (📝 -2 thru 3: -1 +0 -1)" {
+		t.Fatalf("unexpected line after a hand-entered total: %q", line)
+	}
+}
@@ -0,0 +1,58 @@
+package scorecardupload
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// SetScoreAuditLog sets the log imported scores are recorded in.
+func (m *scorecardUploadManager) SetScoreAuditLog(log scoreaudit.Log) {
+	m.audit = log
+}
+
+// recordImportScores records the scores an imported scorecard file gives the
+// players its UDisc names match, as it is imported. Files that can't be read
+// here, and players it can't match, are left to the backend's matching and
+// aren't recorded.
+func (m *scorecardUploadManager) recordImportScores(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, userID sharedtypes.DiscordID, fileName string, fileData []byte) {
+	if m.audit == nil || m.listUDiscIdentities == nil || len(fileData) == 0 || !strings.HasSuffix(strings.ToLower(fileName), ".csv") {
+		return
+	}
+	card, err := parseUDiscCSV(fileData)
+	if err != nil {
+		return
+	}
+
+	response, err := m.listUDiscIdentities(ctx, string(guildID))
+	if err == nil && response.Error != "" {
+		err = errors.New(response.Error)
+	}
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to load UDisc identities to record imported scores",
+			attr.Error(err), attr.String("round_id", roundID.String()))
+		return
+	}
+
+	matched, _ := matchPlayers(card.Players, response.Identities)
+	scores := make([]roundtypes.Participant, 0, len(matched))
+	for _, match := range matched {
+		if !match.Player.HasRelative {
+			continue
+		}
+		score := sharedtypes.Score(match.Player.Relative)
+		scores = append(scores, roundtypes.Participant{UserID: sharedtypes.DiscordID(match.UserID), Score: &score})
+	}
+
+	change := scoreaudit.Change{RoundID: roundID, Actor: userID, Source: scoreaudit.SourceImport}
+	if err := m.audit.Record(ctx, guildID, change.Entries(nil, scores, time.Now().UTC())); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to record imported scores",
+			attr.Error(err), attr.String("round_id", roundID.String()))
+	}
+}
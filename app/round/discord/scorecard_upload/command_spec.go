@@ -0,0 +1,22 @@
+package scorecardupload
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/bwmarrin/discordgo"
+)
+
+// MessageCommandSpec returns the "Import as scorecard for round…" message
+// context-menu command.
+func MessageCommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name: "Import as scorecard for round…",
+			Type: discordgo.MessageApplicationCommand,
+		},
+		RequiredPermission: interactions.PlayerRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+		BackendFeature:     config.BackendFeatureRoundLists,
+	}
+}
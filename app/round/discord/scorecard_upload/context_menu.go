@@ -0,0 +1,221 @@
+package scorecardupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+// importRoundSelectPrefix prefixes the round picker shown by the "Import as
+// scorecard for round…" message command: scorecard_import_round|<channelID>|<messageID>.
+const importRoundSelectPrefix = "scorecard_import_round|"
+
+// HandleImportScorecardMessageCommand handles the "Import as scorecard for
+// round…" message context-menu command by asking which in-progress round the
+// message's scorecard belongs to.
+func (m *scorecardUploadManager) HandleImportScorecardMessageCommand(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	userID := interactionUserIDFromCreate(i)
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "scorecard_import")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "message_command")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, userID)
+
+	return m.operationWrapper(ctx, "HandleImportScorecardMessageCommand", func(ctx context.Context) (ScorecardUploadOperationResult, error) {
+		data := i.ApplicationCommandData()
+		var target *discordgo.Message
+		if data.Resolved != nil {
+			target = data.Resolved.Messages[data.TargetID]
+		}
+		if target == nil || (firstScorecardAttachment(target.Attachments) == nil && extractFirstUDiscURL(target.Content) == "") {
+			err := m.respondEphemeral(i.Interaction, "That message has no CSV/XLSX scorecard or UDisc link to import.")
+			return ScorecardUploadOperationResult{Failure: "no_scorecard"}, err
+		}
+
+		if m.listRounds == nil {
+			err := m.respondEphemeral(i.Interaction, "Importing from a message isn't available yet.")
+			return ScorecardUploadOperationResult{Failure: "round_lists_held"}, err
+		}
+		response, err := m.listRounds(ctx, i.GuildID, []string{roundautocomplete.RoundStateInProgress})
+		if err != nil {
+			m.logger.ErrorContext(ctx, "Failed to list rounds for scorecard import", attr.Error(err))
+			_ = m.sendUploadError(ctx, m.session, i.Interaction, "Couldn't load rounds right now. Please try again.")
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+
+		var options []discordgo.SelectMenuOption
+		if response != nil {
+			loc := guildconfig.GuildLocation(ctx, m.logger, m.guildConfig, i.GuildID)
+			for _, choice := range roundautocomplete.RoundIDChoices(response.Rounds, "", loc) {
+				roundID, _ := choice.Value.(string)
+				options = append(options, discordgo.SelectMenuOption{Label: choice.Name, Value: roundID})
+			}
+		}
+		if len(options) == 0 {
+			err := m.respondEphemeral(i.Interaction, "There are no rounds in progress to import this scorecard into.")
+			return ScorecardUploadOperationResult{Failure: "no_rounds"}, err
+		}
+
+		channelID := target.ChannelID
+		if channelID == "" {
+			channelID = i.ChannelID
+		}
+
+		err = m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: "Which round is this scorecard for?",
+				Flags:   discordgo.MessageFlagsEphemeral,
+				Components: []discordgo.MessageComponent{
+					discordgo.ActionsRow{
+						Components: []discordgo.MessageComponent{
+							discordgo.SelectMenu{
+								MenuType:    discordgo.StringSelectMenu,
+								CustomID:    importRoundSelectPrefix + channelID + "|" + target.ID,
+								Placeholder: "Choose a round",
+								Options:     options,
+							},
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			m.logger.ErrorContext(ctx, "Failed to send scorecard import round picker", attr.Error(err))
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+
+		return ScorecardUploadOperationResult{Success: "round_picker_sent"}, nil
+	})
+}
+
+// HandleImportScorecardRoundSelect imports the chosen message's UDisc link
+// into the round picked from the "Import as scorecard for round…" menu, or
+// previews its scorecard file before it is imported.
+func (m *scorecardUploadManager) HandleImportScorecardRoundSelect(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	userID := interactionUserIDFromCreate(i)
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "scorecard_import")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "component")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, userID)
+
+	return m.operationWrapper(ctx, "HandleImportScorecardRoundSelect", func(ctx context.Context) (ScorecardUploadOperationResult, error) {
+		data := i.MessageComponentData()
+		parts := strings.Split(strings.TrimPrefix(data.CustomID, importRoundSelectPrefix), "|")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" || len(data.Values) == 0 {
+			err := fmt.Errorf("malformed scorecard import selection %q", data.CustomID)
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+		channelID, messageID := parts[0], parts[1]
+
+		parsedRoundID, err := uuid.Parse(data.Values[0])
+		if err != nil {
+			return ScorecardUploadOperationResult{Error: err}, fmt.Errorf("invalid round ID format: %w", err)
+		}
+		roundID := sharedtypes.RoundID(parsedRoundID)
+		guildID := sharedtypes.GuildID(i.GuildID)
+
+		if !m.allowUploadIngress(i.GuildID, userID) {
+			err := m.updateImportPicker(i, "Too many upload attempts. Please wait a minute and try again.")
+			return ScorecardUploadOperationResult{Failure: "rate_limited"}, err
+		}
+
+		msg, err := m.session.ChannelMessage(channelID, messageID)
+		if err != nil {
+			m.logger.ErrorContext(ctx, "Failed to fetch message for scorecard import",
+				attr.Error(err),
+				attr.String("channel_id", channelID),
+				attr.String("message_id", messageID),
+			)
+			_ = m.updateImportPicker(i, "Couldn't load that message anymore. Was it deleted?")
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+
+		if scorecardFile := firstScorecardAttachment(msg.Attachments); scorecardFile != nil {
+			return m.previewMessageFile(ctx, i, stagedImport{
+				GuildID:   guildID,
+				RoundID:   roundID,
+				UserID:    userID,
+				ChannelID: channelID,
+				FileURL:   scorecardFile.URL,
+				FileName:  scorecardFile.Filename,
+			}, scorecardFile)
+		}
+
+		udiscURL := extractFirstUDiscURL(msg.Content)
+		if udiscURL == "" {
+			err := m.updateImportPicker(i, "That message no longer has a scorecard to import.")
+			return ScorecardUploadOperationResult{Failure: "no_scorecard"}, err
+		}
+		importID, err := m.publishScorecardURLEvent(ctx, guildID, roundID, sharedtypes.DiscordID(userID), channelID, "", udiscURL, "")
+		if err != nil {
+			_ = m.updateImportPicker(i, importFailureMessage(err, "Failed to process scorecard upload. Please try again."))
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+
+		err = m.updateImportPicker(i, fmt.Sprintf("✅ Scorecard import started! Import ID: `%s`\n\nI'll match the players and notify you when ready.", importID))
+		if err != nil {
+			m.logger.ErrorContext(ctx, "Failed to confirm scorecard import", attr.Error(err))
+			return ScorecardUploadOperationResult{Success: importID}, err
+		}
+
+		return ScorecardUploadOperationResult{Success: importID}, nil
+	})
+}
+
+// previewMessageFile downloads the chosen message's scorecard file and
+// replaces the round picker with its import preview.
+func (m *scorecardUploadManager) previewMessageFile(ctx context.Context, i *discordgo.InteractionCreate, staged stagedImport, scorecardFile *discordgo.MessageAttachment) (ScorecardUploadOperationResult, error) {
+	fileData, err := m.loadAttachmentData(ctx, scorecardFile)
+	if err != nil {
+		reply := "Failed to download file. Please try again."
+		if errors.Is(err, errAttachmentTooLarge) {
+			reply = "File too large. Maximum size is 10MB."
+		}
+		_ = m.updateImportPicker(i, reply)
+		return ScorecardUploadOperationResult{Error: err}, err
+	}
+
+	content, components, err := m.stageImport(ctx, staged, fileData)
+	if err != nil {
+		_ = m.updateImportPicker(i, "Failed to process scorecard upload. Please try again.")
+		return ScorecardUploadOperationResult{Error: err}, err
+	}
+	if err := m.updatePreview(i, content, components); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to send scorecard import preview", attr.Error(err))
+		return ScorecardUploadOperationResult{Error: err}, err
+	}
+	return ScorecardUploadOperationResult{Success: "preview_sent"}, nil
+}
+
+// updateImportPicker replaces the ephemeral round picker with content.
+func (m *scorecardUploadManager) updateImportPicker(i *discordgo.InteractionCreate, content string) error {
+	return m.updatePreview(i, content, []discordgo.MessageComponent{})
+}
+
+// updatePreview replaces the message the component was used on.
+func (m *scorecardUploadManager) updatePreview(i *discordgo.InteractionCreate, content string, components []discordgo.MessageComponent) error {
+	return m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: components,
+		},
+	})
+}
+
+func (m *scorecardUploadManager) respondEphemeral(i *discordgo.Interaction, content string) error {
+	return m.session.InteractionRespond(i, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package scorecardupload
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+func newContextMenuTestManager(session discord.Session, publisher *testutils.FakeEventBus) *scorecardUploadManager {
+	return &scorecardUploadManager{
+		session:   session,
+		publisher: publisher,
+		logger:    discardLogger(),
+		operationWrapper: func(ctx context.Context, _ string, fn func(context.Context) (ScorecardUploadOperationResult, error)) (ScorecardUploadOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+}
+
+func messageCommandInteraction(target *discordgo.Message) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:        "interaction-id",
+		Type:      discordgo.InteractionApplicationCommand,
+		GuildID:   "guild-id",
+		ChannelID: "channel-id",
+		Member:    &discordgo.Member{User: &discordgo.User{ID: "user-id"}},
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name:        MessageCommandSpec().Name(),
+			CommandType: discordgo.MessageApplicationCommand,
+			TargetID:    target.ID,
+			Resolved: &discordgo.ApplicationCommandInteractionDataResolved{
+				Messages: map[string]*discordgo.Message{target.ID: target},
+			},
+		},
+	}}
+}
+
+func Test_scorecardUploadManager_HandleImportScorecardMessageCommand_OffersInProgressRounds(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	m := newContextMenuTestManager(fakeSession, &testutils.FakeEventBus{})
+
+	var gotStates []string
+	m.listRounds = func(ctx context.Context, guildID string, states []string) (*roundautocomplete.RoundListResponsePayloadV1, error) {
+		gotStates = states
+		return &roundautocomplete.RoundListResponsePayloadV1{
+			Rounds: []roundautocomplete.RoundSummaryV1{{ID: "round-1", Title: "League Night", State: roundautocomplete.RoundStateInProgress}},
+		}, nil
+	}
+
+	var response *discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		response = resp
+		return nil
+	}
+
+	target := &discordgo.Message{
+		ID:          "message-id",
+		ChannelID:   "channel-id",
+		Attachments: []*discordgo.MessageAttachment{{Filename: "scorecard.csv"}},
+	}
+	if _, err := m.HandleImportScorecardMessageCommand(context.Background(), messageCommandInteraction(target)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(gotStates) != 1 || gotStates[0] != roundautocomplete.RoundStateInProgress {
+		t.Fatalf("expected in-progress rounds to be listed, got %v", gotStates)
+	}
+	if response == nil || response.Data.Flags != discordgo.MessageFlagsEphemeral {
+		t.Fatalf("expected an ephemeral round picker, got %+v", response)
+	}
+	row := response.Data.Components[0].(discordgo.ActionsRow)
+	menu := row.Components[0].(discordgo.SelectMenu)
+	if menu.CustomID != importRoundSelectPrefix+"channel-id|message-id" {
+		t.Fatalf("unexpected custom ID %q", menu.CustomID)
+	}
+	if len(menu.Options) != 1 || menu.Options[0].Value != "round-1" || !strings.Contains(menu.Options[0].Label, "League Night") {
+		t.Fatalf("unexpected options %+v", menu.Options)
+	}
+}
+
+func Test_scorecardUploadManager_HandleImportScorecardMessageCommand_RejectsMessagesWithoutScorecard(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	m := newContextMenuTestManager(fakeSession, &testutils.FakeEventBus{})
+	m.listRounds = func(ctx context.Context, guildID string, states []string) (*roundautocomplete.RoundListResponsePayloadV1, error) {
+		t.Fatal("rounds should not be listed for a message without a scorecard")
+		return nil, nil
+	}
+
+	var content string
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		content = resp.Data.Content
+		return nil
+	}
+
+	target := &discordgo.Message{ID: "message-id", Content: "nice round everyone"}
+	if _, err := m.HandleImportScorecardMessageCommand(context.Background(), messageCommandInteraction(target)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(content, "no CSV/XLSX scorecard or UDisc link") {
+		t.Fatalf("unexpected reply %q", content)
+	}
+}
+
+func Test_scorecardUploadManager_HandleImportScorecardRoundSelect_PublishesURLImport(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	fakePublisher := &testutils.FakeEventBus{}
+	m := newContextMenuTestManager(fakeSession, fakePublisher)
+
+	roundID := uuid.New()
+	const udiscURL = "https://udisc.com/scorecards/abc123"
+
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		if channelID != "channel-id" || messageID != "message-id" {
+			t.Fatalf("unexpected message lookup %s/%s", channelID, messageID)
+		}
+		return &discordgo.Message{ID: messageID, ChannelID: channelID, Content: "scores: " + udiscURL}, nil
+	}
+
+	var payload roundevents.ScorecardURLRequestedPayloadV1
+	fakePublisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		if topic != roundevents.ScorecardURLRequestedV1 {
+			t.Fatalf("unexpected topic %q", topic)
+		}
+		return json.Unmarshal(messages[0].Payload, &payload)
+	}
+
+	var response *discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		response = resp
+		return nil
+	}
+
+	i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "interaction-id",
+		Type:    discordgo.InteractionMessageComponent,
+		GuildID: "guild-id",
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "user-id"}},
+		Data: discordgo.MessageComponentInteractionData{
+			CustomID: importRoundSelectPrefix + "channel-id|message-id",
+			Values:   []string{roundID.String()},
+		},
+	}}
+
+	res, err := m.HandleImportScorecardRoundSelect(context.Background(), i)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.UDiscURL != udiscURL || payload.RoundID.String() != roundID.String() || string(payload.UserID) != "user-id" {
+		t.Fatalf("unexpected payload %+v", payload)
+	}
+	if res.Success != payload.ImportID {
+		t.Fatalf("expected import ID %q as result, got %v", payload.ImportID, res.Success)
+	}
+	if response == nil || response.Type != discordgo.InteractionResponseUpdateMessage || !strings.Contains(response.Data.Content, payload.ImportID) {
+		t.Fatalf("expected picker to be replaced with confirmation, got %+v", response)
+	}
+}
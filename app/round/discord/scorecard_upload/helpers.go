@@ -16,6 +16,10 @@ import (
 
 var errAttachmentTooLarge = errors.New("attachment exceeds maximum size")
 
+// errStagedFileChanged is returned when a previewed file no longer matches
+// the preview when it's downloaded again.
+var errStagedFileChanged = errors.New("scorecard file changed since it was previewed")
+
 // sendUploadConfirmation sends an ephemeral response confirming the upload started.
 func (m *scorecardUploadManager) sendUploadConfirmation(ctx context.Context, s discord.Session, i *discordgo.Interaction, importID string) error {
 	response := &discordgo.InteractionResponse{
@@ -144,7 +144,7 @@ func (m *scorecardUploadManager) HandleScorecardUploadModalSubmit(ctx context.Co
 			importID, err := m.publishScorecardURLEvent(ctx, guildID, sharedtypes.RoundID(parsedRoundID), userID, channelID, messageID, uDiscURL, notes)
 			if err != nil {
 				m.logger.ErrorContext(ctx, "Failed to publish scorecard URL event", attr.Error(err))
-				_ = m.sendUploadError(ctx, m.session, i.Interaction, "Failed to upload scorecard from URL. Please try again later.")
+				_ = m.sendUploadError(ctx, m.session, i.Interaction, importFailureMessage(err, "Failed to upload scorecard from URL. Please try again later."))
 				return ScorecardUploadOperationResult{}, err
 			}
 
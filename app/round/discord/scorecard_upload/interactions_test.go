@@ -13,6 +13,7 @@ import (
 	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
@@ -277,7 +278,7 @@ func Test_scorecardUploadManager_HandleScorecardUploadButton_RespondError_Propag
 	}
 }
 
-func Test_scorecardUploadManager_HandleFileUploadMessage_PendingExists_PublishesAndConfirms(t *testing.T) {
+func Test_scorecardUploadManager_HandleFileUploadMessage_PendingExists_PreviewsThenPublishesOnConfirm(t *testing.T) {
 	fakeSession := discord.NewFakeSession()
 	fakePublisher := &testutils.FakeEventBus{}
 
@@ -307,9 +308,11 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_PendingExists_Publishes
 	}}
 
 	m := &scorecardUploadManager{
-		session:   fakeSession,
-		publisher: fakePublisher,
-		logger:    discardLogger(),
+		session:             fakeSession,
+		publisher:           fakePublisher,
+		logger:              discardLogger(),
+		interactionStore:    storage.NewFakeStorage[any](),
+		listUDiscIdentities: noUDiscIdentities,
 		operationWrapper: func(ctx context.Context, _ string, fn func(context.Context) (ScorecardUploadOperationResult, error)) (ScorecardUploadOperationResult, error) {
 			return fn(ctx)
 		},
@@ -323,7 +326,9 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_PendingExists_Publishes
 		},
 	}
 
+	published := 0
 	fakePublisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		published++
 		if topic != roundevents.ScorecardUploadedV1 {
 			t.Fatalf("unexpected topic: %q", topic)
 		}
@@ -364,17 +369,19 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_PendingExists_Publishes
 		return nil
 	}
 
-	fakeSession.ChannelMessageSendFunc = func(cID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
-		if cID != channelID {
-			t.Fatalf("channel_id mismatch: got %q want %q", cID, channelID)
-		}
-		if !strings.Contains(content, "Scorecard import started") {
-			t.Fatalf("unexpected confirmation content: %q", content)
+	fakeSession.UserChannelCreateFunc = func(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		if recipientID != userID {
+			t.Fatalf("expected a DM to the uploader, got %q", recipientID)
 		}
-		if !strings.Contains(content, "Import ID") {
-			t.Fatalf("expected import id in confirmation: %q", content)
+		return &discordgo.Channel{ID: "dm-channel-id"}, nil
+	}
+	var preview *discordgo.MessageSend
+	fakeSession.ChannelMessageSendComplexFunc = func(cID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		if cID != "dm-channel-id" {
+			t.Fatalf("expected the preview in the uploader's DMs, got channel %q", cID)
 		}
-		return &discordgo.Message{ID: "confirmation"}, nil
+		preview = data
+		return &discordgo.Message{ID: "preview"}, nil
 	}
 
 	m.HandleFileUploadMessage(fakeSession, msg)
@@ -387,6 +394,23 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_PendingExists_Publishes
 	if stillThere {
 		t.Fatalf("expected pending upload to be consumed")
 	}
+	if published != 0 {
+		t.Fatalf("expected nothing published before the preview is confirmed")
+	}
+	if preview == nil || !strings.Contains(preview.Content, "Scorecard preview") {
+		t.Fatalf("expected a preview of the upload, got %+v", preview)
+	}
+	if preview.AllowedMentions == nil || len(preview.AllowedMentions.Parse) != 0 {
+		t.Fatalf("expected the preview not to ping anyone, got %+v", preview.AllowedMentions)
+	}
+
+	response := pressPreviewButton(t, m, fakeSession, preview.Components, previewConfirmPrefix, userID)
+	if published != 1 {
+		t.Fatalf("expected the confirmed scorecard to be published once, got %d", published)
+	}
+	if !strings.Contains(response.Data.Content, "Scorecard import started") || !strings.Contains(response.Data.Content, "Import ID") {
+		t.Fatalf("unexpected confirmation content: %q", response.Data.Content)
+	}
 }
 
 func Test_scorecardUploadManager_HandleFileUploadMessage_ThreadURL_PublishesAndConfirms(t *testing.T) {
@@ -464,7 +488,7 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_ThreadURL_PublishesAndC
 	m.HandleFileUploadMessage(fakeSession, msg)
 }
 
-func Test_scorecardUploadManager_HandleFileUploadMessage_LargeAttachment_PublishesURLReferenceWithoutDownload(t *testing.T) {
+func Test_scorecardUploadManager_HandleFileUploadMessage_LargeAttachment_PublishesURLReferenceWithoutDownloadOnConfirm(t *testing.T) {
 	fakeSession := discord.NewFakeSession()
 	fakePublisher := &testutils.FakeEventBus{}
 
@@ -498,9 +522,11 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_LargeAttachment_Publish
 	}}
 
 	m := &scorecardUploadManager{
-		session:   fakeSession,
-		publisher: fakePublisher,
-		logger:    discardLogger(),
+		session:             fakeSession,
+		publisher:           fakePublisher,
+		logger:              discardLogger(),
+		interactionStore:    storage.NewFakeStorage[any](),
+		listUDiscIdentities: noUDiscIdentities,
 		operationWrapper: func(ctx context.Context, _ string, fn func(context.Context) (ScorecardUploadOperationResult, error)) (ScorecardUploadOperationResult, error) {
 			return fn(ctx)
 		},
@@ -534,11 +560,17 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_LargeAttachment_Publish
 		return nil
 	}
 
-	fakeSession.ChannelMessageSendFunc = func(cID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
-		return &discordgo.Message{ID: "confirmation"}, nil
+	var preview *discordgo.MessageSend
+	fakeSession.ChannelMessageSendComplexFunc = func(cID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		preview = data
+		return &discordgo.Message{ID: "preview"}, nil
 	}
 
 	m.HandleFileUploadMessage(fakeSession, msg)
+	if preview == nil || !strings.Contains(preview.Content, "too large to preview") {
+		t.Fatalf("expected a preview saying the file is too large to preview, got %+v", preview)
+	}
+	pressPreviewButton(t, m, fakeSession, preview.Components, previewConfirmPrefix, userID)
 
 	if downloadRequests != 0 {
 		t.Fatalf("expected attachment download to be skipped, got %d request(s)", downloadRequests)
@@ -589,6 +621,55 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_DownloadFailure_SendsEr
 	m.HandleFileUploadMessage(fakeSession, msg)
 }
 
+func Test_scorecardUploadManager_HandleFileUploadMessage_ClosedDMs_SendsError(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+
+	msg := &discordgo.MessageCreate{Message: &discordgo.Message{
+		ID:        "message-id",
+		GuildID:   "guild-id",
+		ChannelID: "channel-id",
+		Author:    &discordgo.User{ID: "user-id", Bot: false},
+		Attachments: []*discordgo.MessageAttachment{
+			{Filename: "scorecard.csv", URL: serveScorecard(t, testUDiscCSV)},
+		},
+	}}
+
+	fakeSession.UserChannelCreateFunc = func(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		return nil, fmt.Errorf("cannot send messages to this user")
+	}
+	fakeSession.ChannelMessageSendComplexFunc = func(cID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		t.Fatalf("expected no preview outside the uploader's DMs, got %+v in %q", data, cID)
+		return nil, nil
+	}
+	var errorContent string
+	fakeSession.ChannelMessageSendFunc = func(channelID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		errorContent = content
+		return &discordgo.Message{ID: "err"}, nil
+	}
+
+	m := &scorecardUploadManager{
+		session:             fakeSession,
+		logger:              discardLogger(),
+		interactionStore:    storage.NewFakeStorage[any](),
+		listUDiscIdentities: noUDiscIdentities,
+		operationWrapper: func(ctx context.Context, _ string, fn func(context.Context) (ScorecardUploadOperationResult, error)) (ScorecardUploadOperationResult, error) {
+			return fn(ctx)
+		},
+		pendingUploads: map[string]*pendingUpload{
+			"user-id:channel-id": {
+				RoundID:   sharedtypes.RoundID(uuid.New()),
+				GuildID:   sharedtypes.GuildID("guild-id"),
+				CreatedAt: time.Now(),
+			},
+		},
+	}
+
+	m.HandleFileUploadMessage(fakeSession, msg)
+	if !strings.Contains(errorContent, "couldn't DM you the import preview") {
+		t.Fatalf("unexpected error content: %q", errorContent)
+	}
+}
+
 func Test_scorecardUploadManager_HandleFileUploadMessage_FileTooLarge_SendsError(t *testing.T) {
 	fakeSession := discord.NewFakeSession()
 
@@ -634,7 +715,7 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_FileTooLarge_SendsError
 	m.HandleFileUploadMessage(fakeSession, msg)
 }
 
-func Test_scorecardUploadManager_HandleFileUploadMessage_PublishError_SendsErrorAndConsumesPending(t *testing.T) {
+func Test_scorecardUploadManager_HandleFileUploadMessage_ConfirmPublishError_ReportsErrorAndConsumesPending(t *testing.T) {
 	fakeSession := discord.NewFakeSession()
 	fakePublisher := &testutils.FakeEventBus{}
 
@@ -662,17 +743,18 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_PublishError_SendsError
 		return fmt.Errorf("publish failed")
 	}
 
-	fakeSession.ChannelMessageSendFunc = func(cID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
-		if !strings.Contains(content, "Failed to process scorecard upload") {
-			t.Fatalf("unexpected error content: %q", content)
-		}
-		return &discordgo.Message{ID: "err"}, nil
+	var preview *discordgo.MessageSend
+	fakeSession.ChannelMessageSendComplexFunc = func(cID string, data *discordgo.MessageSend, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		preview = data
+		return &discordgo.Message{ID: "preview"}, nil
 	}
 
 	m := &scorecardUploadManager{
-		session:   fakeSession,
-		publisher: fakePublisher,
-		logger:    discardLogger(),
+		session:             fakeSession,
+		publisher:           fakePublisher,
+		logger:              discardLogger(),
+		interactionStore:    storage.NewFakeStorage[any](),
+		listUDiscIdentities: noUDiscIdentities,
 		operationWrapper: func(ctx context.Context, _ string, fn func(context.Context) (ScorecardUploadOperationResult, error)) (ScorecardUploadOperationResult, error) {
 			return fn(ctx)
 		},
@@ -687,6 +769,13 @@ func Test_scorecardUploadManager_HandleFileUploadMessage_PublishError_SendsError
 	}
 
 	m.HandleFileUploadMessage(fakeSession, msg)
+	if preview == nil {
+		t.Fatalf("expected an import preview")
+	}
+	response := pressPreviewButton(t, m, fakeSession, preview.Components, previewConfirmPrefix, userID)
+	if !strings.Contains(response.Data.Content, "Failed to process scorecard upload") {
+		t.Fatalf("unexpected error content: %q", response.Data.Content)
+	}
 
 	m.pendingMutex.RLock()
 	_, stillThere := m.pendingUploads[key]
@@ -97,38 +97,19 @@ func (m *scorecardUploadManager) HandleFileUploadMessage(s discord.Session, msg
 	)
 
 	// Download the file after confirming there's a pending upload context.
-	if scorecardFile.Size > maxAttachmentBytes {
-		m.sendFileUploadErrorMessage(ctx, s, msg.ChannelID, "File too large. Maximum size is 10MB.")
-		return
-	}
-
-	shouldInlineFileData := scorecardFile.URL == "" || scorecardFile.Size <= 0 || scorecardFile.Size <= maxInlineFileDataBytes
-
-	var (
-		fileData []byte
-		err      error
-	)
-	if shouldInlineFileData {
-		fileData, err = m.downloadAttachment(ctx, scorecardFile.URL)
-		if err != nil {
-			if errors.Is(err, errAttachmentTooLarge) {
-				m.sendFileUploadErrorMessage(ctx, s, msg.ChannelID, "File too large. Maximum size is 10MB.")
-				return
-			}
-
-			m.logger.ErrorContext(ctx, "Failed to download attachment",
-				attr.Error(err),
-				attr.String("url", scorecardFile.URL),
-			)
-			m.sendFileUploadErrorMessage(ctx, s, msg.ChannelID, "Failed to download file. Please try again.")
+	fileData, err := m.loadAttachmentData(ctx, scorecardFile)
+	if err != nil {
+		if errors.Is(err, errAttachmentTooLarge) {
+			m.sendFileUploadErrorMessage(ctx, s, msg.ChannelID, "File too large. Maximum size is 10MB.")
 			return
 		}
-	} else {
-		m.logger.InfoContext(ctx, "Skipping attachment download for large scorecard; publishing URL reference",
-			attr.String("filename", scorecardFile.Filename),
-			attr.Int("attachment_size", scorecardFile.Size),
+
+		m.logger.ErrorContext(ctx, "Failed to download attachment",
+			attr.Error(err),
 			attr.String("url", scorecardFile.URL),
 		)
+		m.sendFileUploadErrorMessage(ctx, s, msg.ChannelID, "Failed to download file. Please try again.")
+		return
 	}
 
 	uploadCtx, ok := m.takeUploadContext(key, exists, threadCtx, threadCtxExists)
@@ -150,21 +131,21 @@ func (m *scorecardUploadManager) HandleFileUploadMessage(s discord.Session, msg
 		attr.Bool("thread_auto_ingress", threadCtxExists && !exists),
 	)
 
-	// Publish scorecard upload event
-	importID, err := m.publishScorecardUploadEvent(
-		ctx,
-		uploadCtx.GuildID,
-		uploadCtx.RoundID,
-		sharedtypes.DiscordID(msg.Author.ID),
-		msg.ChannelID,
-		uploadCtx.EventMessageID,
-		fileData,
-		scorecardFile.URL,
-		scorecardFile.Filename,
-		uploadCtx.Notes,
-	)
+	// Hold the file until the uploader checks the preview. Plain messages
+	// can't be answered ephemerally, so the preview is sent to the uploader
+	// by DM, where only they can see it.
+	content, components, err := m.stageImport(ctx, stagedImport{
+		GuildID:        uploadCtx.GuildID,
+		RoundID:        uploadCtx.RoundID,
+		UserID:         msg.Author.ID,
+		ChannelID:      msg.ChannelID,
+		EventMessageID: uploadCtx.EventMessageID,
+		FileURL:        scorecardFile.URL,
+		FileName:       scorecardFile.Filename,
+		Notes:          uploadCtx.Notes,
+	}, fileData)
 	if err != nil {
-		m.logger.ErrorContext(ctx, "Failed to publish scorecard upload event",
+		m.logger.ErrorContext(ctx, "Failed to stage scorecard upload",
 			attr.Error(err),
 		)
 		m.sendFileUploadErrorMessage(ctx, s, msg.ChannelID,
@@ -172,16 +153,30 @@ func (m *scorecardUploadManager) HandleFileUploadMessage(s discord.Session, msg
 		return
 	}
 
-	// Send confirmation
-	_, err = s.ChannelMessageSend(msg.ChannelID,
-		fmt.Sprintf("✅ Scorecard import started! Import ID: `%s`\n\nI'll match the players and notify you when ready.", importID))
-	if err != nil {
-		m.logger.ErrorContext(ctx, "Failed to send file upload confirmation",
+	if err := m.sendPreviewDM(s, msg.Author.ID, content, components); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to send scorecard import preview",
 			attr.Error(err),
+			attr.String("user_id", msg.Author.ID),
 		)
+		m.sendFileUploadErrorMessage(ctx, s, msg.ChannelID,
+			"I couldn't DM you the import preview. Please allow direct messages from this server and upload the scorecard again.")
 	}
 }
 
+// sendPreviewDM sends a scorecard import preview to its uploader's DMs.
+func (m *scorecardUploadManager) sendPreviewDM(s discord.Session, userID, content string, components []discordgo.MessageComponent) error {
+	channel, err := s.UserChannelCreate(userID)
+	if err != nil {
+		return fmt.Errorf("failed to open DM channel: %w", err)
+	}
+	_, err = s.ChannelMessageSendComplex(channel.ID, &discordgo.MessageSend{
+		Content:         content,
+		Components:      components,
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	})
+	return err
+}
+
 func (m *scorecardUploadManager) handleRoundThreadURLUpload(
 	ctx context.Context,
 	s discord.Session,
@@ -214,7 +209,7 @@ func (m *scorecardUploadManager) handleRoundThreadURLUpload(
 			attr.String("channel_id", msg.ChannelID),
 			attr.String("round_id", uploadCtx.RoundID.String()),
 		)
-		m.sendFileUploadErrorMessage(ctx, s, msg.ChannelID, "Failed to process scorecard URL. Please try again.")
+		m.sendFileUploadErrorMessage(ctx, s, msg.ChannelID, importFailureMessage(err, "Failed to process scorecard URL. Please try again."))
 		return
 	}
 
@@ -228,6 +223,25 @@ func (m *scorecardUploadManager) handleRoundThreadURLUpload(
 	}
 }
 
+// loadAttachmentData downloads small scorecards so they can be published
+// inline. Larger ones return nil data and are published by URL reference.
+func (m *scorecardUploadManager) loadAttachmentData(ctx context.Context, attachment *discordgo.MessageAttachment) ([]byte, error) {
+	if attachment.Size > maxAttachmentBytes {
+		return nil, errAttachmentTooLarge
+	}
+
+	if attachment.URL != "" && attachment.Size > maxInlineFileDataBytes {
+		m.logger.InfoContext(ctx, "Skipping attachment download for large scorecard; publishing URL reference",
+			attr.String("filename", attachment.Filename),
+			attr.Int("attachment_size", attachment.Size),
+			attr.String("url", attachment.URL),
+		)
+		return nil, nil
+	}
+
+	return m.downloadAttachment(ctx, attachment.URL)
+}
+
 type uploadContext struct {
 	GuildID        sharedtypes.GuildID
 	RoundID        sharedtypes.RoundID
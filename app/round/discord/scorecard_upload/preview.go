@@ -0,0 +1,324 @@
+package scorecardupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+const (
+	previewConfirmPrefix = "scorecard_preview_confirm|"
+	previewCancelPrefix  = "scorecard_preview_cancel|"
+	previewKeyPrefix     = "scorecard_preview:"
+
+	// maxPreviewPlayers caps each player list so the preview fits in a message.
+	maxPreviewPlayers = 25
+)
+
+// stagedImport is a scorecard file held until its uploader confirms the
+// import preview. Only the file's URL is kept; it's downloaded again on import.
+type stagedImport struct {
+	GuildID        sharedtypes.GuildID
+	RoundID        sharedtypes.RoundID
+	UserID         string
+	ChannelID      string
+	EventMessageID string
+	FileURL        string
+	FileName       string
+	Notes          string
+	// Hash is the SHA-256 of the previewed file, empty when the file was too
+	// large to download and is published by URL.
+	Hash string
+}
+
+// playerMatch is a scorecard player and the Discord user whose UDisc
+// identity they match, if any.
+type playerMatch struct {
+	Player udiscPlayer
+	UserID string
+}
+
+// stageImport holds a scorecard file until its uploader confirms it, and
+// returns the preview and its Confirm/Cancel buttons. fileData is nil for
+// files too large to download.
+func (m *scorecardUploadManager) stageImport(ctx context.Context, staged stagedImport, fileData []byte) (string, []discordgo.MessageComponent, error) {
+	staged.Hash = fileHash(fileData)
+
+	token := uuid.NewString()
+	if err := m.interactionStore.Set(ctx, previewKeyPrefix+token, staged); err != nil {
+		return "", nil, fmt.Errorf("failed to store scorecard preview: %w", err)
+	}
+	return m.previewContent(ctx, staged, fileData), previewComponents(token), nil
+}
+
+// stagedFileData downloads a staged file again and checks it's the file that
+// was previewed. It returns nil for files published by URL.
+func (m *scorecardUploadManager) stagedFileData(ctx context.Context, staged stagedImport) ([]byte, error) {
+	if staged.Hash == "" {
+		return nil, nil
+	}
+	fileData, err := m.downloadAttachment(ctx, staged.FileURL)
+	if err != nil {
+		return nil, err
+	}
+	if fileHash(fileData) != staged.Hash {
+		return nil, errStagedFileChanged
+	}
+	return fileData, nil
+}
+
+// previewContent lists who the scorecard's players were matched to, with
+// their totals, and warns when the same file was imported before.
+func (m *scorecardUploadManager) previewContent(ctx context.Context, staged stagedImport, fileData []byte) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "📋 **Scorecard preview** · `%s`\n", staged.FileName)
+
+	if previous := m.previousImport(ctx, staged); previous != nil {
+		into := "another round"
+		if previous.RoundID == staged.RoundID {
+			into = "this round"
+		}
+		fmt.Fprintf(&sb, "⚠️ This exact file was already imported into %s by <@%s> <t:%d:R>.\n", into, previous.UserID, previous.ImportedAt.Unix())
+	}
+
+	switch {
+	case len(fileData) == 0:
+		sb.WriteString("\nThis file is too large to preview. Players will be matched when it's imported.\n")
+	case !strings.HasSuffix(strings.ToLower(staged.FileName), ".csv"):
+		sb.WriteString("\nOnly CSV files can be previewed. Players will be matched when it's imported.\n")
+	default:
+		card, err := parseUDiscCSV(fileData)
+		if err != nil {
+			fmt.Fprintf(&sb, "\n⚠️ Couldn't read the players from this file: %s. You can still import it.\n", err)
+		} else {
+			sb.WriteString(m.describeScorecard(ctx, staged.GuildID, card))
+		}
+	}
+
+	sb.WriteString("\nImport this scorecard?")
+	return sb.String()
+}
+
+// describeScorecard lists the scorecard's course and players, split into
+// those matched to a player by UDisc identity and those not.
+func (m *scorecardUploadManager) describeScorecard(ctx context.Context, guildID sharedtypes.GuildID, card udiscScorecard) string {
+	var sb strings.Builder
+	if card.Course != "" {
+		sb.WriteString(card.Course)
+		if card.Layout != "" {
+			sb.WriteString(" · " + card.Layout)
+		}
+		if card.Par > 0 {
+			fmt.Fprintf(&sb, " (par %d)", card.Par)
+		}
+		sb.WriteString("\n")
+	}
+
+	players := make([]playerMatch, 0, len(card.Players))
+	for _, player := range card.Players {
+		players = append(players, playerMatch{Player: player})
+	}
+	if m.listUDiscIdentities == nil {
+		writePlayerList(&sb, "Players", "•", players)
+		sb.WriteString("_Players will be matched when it's imported._\n")
+		return sb.String()
+	}
+
+	response, err := m.listUDiscIdentities(ctx, string(guildID))
+	if err == nil && response.Error != "" {
+		err = errors.New(response.Error)
+	}
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to load UDisc identities for scorecard preview",
+			attr.Error(err),
+			attr.String("guild_id", string(guildID)),
+		)
+		writePlayerList(&sb, "Players", "•", players)
+		sb.WriteString("_Couldn't check who these players are right now. They'll be matched when it's imported._\n")
+		return sb.String()
+	}
+
+	matched, unmatched := matchPlayers(card.Players, response.Identities)
+	if len(matched) > 0 {
+		writePlayerList(&sb, "Matched", "✅", matched)
+	}
+	if len(unmatched) > 0 {
+		writePlayerList(&sb, "Unmatched", "❓", unmatched)
+		sb.WriteString("_Unmatched players won't get a score unless they set their name with `/set-udisc-name` before you import._\n")
+	}
+	return sb.String()
+}
+
+func writePlayerList(sb *strings.Builder, title, marker string, players []playerMatch) {
+	fmt.Fprintf(sb, "\n**%s (%d)**\n", title, len(players))
+	for index, match := range players {
+		if index == maxPreviewPlayers {
+			fmt.Fprintf(sb, "…and %d more\n", len(players)-maxPreviewPlayers)
+			break
+		}
+		if match.UserID != "" {
+			fmt.Fprintf(sb, "%s %s → <@%s> · %s\n", marker, match.Player.Name, match.UserID, match.Player.score())
+		} else {
+			fmt.Fprintf(sb, "%s %s · %s\n", marker, match.Player.Name, match.Player.score())
+		}
+	}
+}
+
+// matchPlayers pairs scorecard names with the players whose UDisc username
+// or name is the same, ignoring case, spacing and a leading "@". Names that
+// fit more than one player are left unmatched.
+func matchPlayers(players []udiscPlayer, identities []UDiscIdentityV1) (matched, unmatched []playerMatch) {
+	owners := make(map[string]string, len(identities)*2)
+	for _, identity := range identities {
+		for _, name := range []string{identity.Username, identity.Name} {
+			key := normalizeUDiscName(name)
+			if key == "" || identity.UserID == "" {
+				continue
+			}
+			if owner, seen := owners[key]; seen && owner != identity.UserID {
+				owners[key] = ""
+				continue
+			}
+			owners[key] = identity.UserID
+		}
+	}
+
+	for _, player := range players {
+		if userID := owners[normalizeUDiscName(player.Name)]; userID != "" {
+			matched = append(matched, playerMatch{Player: player, UserID: userID})
+		} else {
+			unmatched = append(unmatched, playerMatch{Player: player})
+		}
+	}
+	return matched, unmatched
+}
+
+func normalizeUDiscName(name string) string {
+	name = strings.TrimPrefix(strings.TrimSpace(name), "@")
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func previewComponents(token string) []discordgo.MessageComponent {
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Import",
+				Style:    discordgo.SuccessButton,
+				CustomID: previewConfirmPrefix + token,
+			},
+			discordgo.Button{
+				Label:    "Cancel",
+				Style:    discordgo.SecondaryButton,
+				CustomID: previewCancelPrefix + token,
+			},
+		}},
+	}
+}
+
+// HandleScorecardPreviewConfirm imports the scorecard held by a preview.
+func (m *scorecardUploadManager) HandleScorecardPreviewConfirm(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "scorecard_preview_confirm")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, interactionUserIDFromCreate(i))
+
+	return m.operationWrapper(ctx, "HandleScorecardPreviewConfirm", func(ctx context.Context) (ScorecardUploadOperationResult, error) {
+		key := previewKeyPrefix + strings.TrimPrefix(i.MessageComponentData().CustomID, previewConfirmPrefix)
+		staged, ok := m.heldImport(ctx, i, key)
+		if !ok {
+			return ScorecardUploadOperationResult{Failure: "preview_unavailable"}, nil
+		}
+		// Delete before publishing so a double click can't import twice.
+		m.interactionStore.Delete(ctx, key)
+
+		fileData, err := m.stagedFileData(ctx, staged)
+		if err != nil {
+			m.logger.WarnContext(ctx, "Failed to download staged scorecard", attr.Error(err))
+			_ = m.updateImportPicker(i, "Couldn't download the scorecard again. Please upload it again.")
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+
+		importID, err := m.publishScorecardUploadEvent(ctx, staged.GuildID, staged.RoundID, sharedtypes.DiscordID(staged.UserID),
+			staged.ChannelID, staged.EventMessageID, fileData, staged.FileURL, staged.FileName, staged.Notes)
+		if err != nil {
+			_ = m.updateImportPicker(i, importFailureMessage(err, "Failed to process scorecard upload. Please try again."))
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+		m.trackImport(ctx, importID, staged)
+
+		err = m.updateImportPicker(i, fmt.Sprintf("✅ Scorecard import started! Import ID: `%s`\n\nI'll match the players and notify you when ready.", importID))
+		if err != nil {
+			m.logger.ErrorContext(ctx, "Failed to confirm scorecard import", attr.Error(err))
+			return ScorecardUploadOperationResult{Success: importID}, err
+		}
+		return ScorecardUploadOperationResult{Success: importID}, nil
+	})
+}
+
+// HandleScorecardPreviewCancel drops the scorecard held by a preview.
+func (m *scorecardUploadManager) HandleScorecardPreviewCancel(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "scorecard_preview_cancel")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, interactionUserIDFromCreate(i))
+
+	return m.operationWrapper(ctx, "HandleScorecardPreviewCancel", func(ctx context.Context) (ScorecardUploadOperationResult, error) {
+		key := previewKeyPrefix + strings.TrimPrefix(i.MessageComponentData().CustomID, previewCancelPrefix)
+		if _, ok := m.heldImport(ctx, i, key); !ok {
+			return ScorecardUploadOperationResult{Failure: "preview_unavailable"}, nil
+		}
+		m.interactionStore.Delete(ctx, key)
+
+		if err := m.updateImportPicker(i, "Scorecard import cancelled."); err != nil {
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+		return ScorecardUploadOperationResult{Success: "import_cancelled"}, nil
+	})
+}
+
+// heldImport returns the scorecard held by a preview, telling the user when
+// it has expired or belongs to someone else.
+func (m *scorecardUploadManager) heldImport(ctx context.Context, i *discordgo.InteractionCreate, key string) (stagedImport, bool) {
+	stored, err := m.interactionStore.Get(ctx, key)
+	staged, ok := stored.(stagedImport)
+	if err != nil || !ok {
+		if err == nil {
+			m.logger.WarnContext(ctx, "Unexpected scorecard preview type", attr.String("type", fmt.Sprintf("%T", stored)))
+		}
+		_ = m.updateImportPicker(i, "This scorecard preview has expired. Please upload the scorecard again.")
+		return stagedImport{}, false
+	}
+
+	if staged.UserID != interactionUserIDFromCreate(i) {
+		_ = m.respondEphemeral(i.Interaction, "Only the player who uploaded this scorecard can import or cancel it.")
+		return stagedImport{}, false
+	}
+	return staged, true
+}
+
+// previousImport returns the backend's record of an earlier import of the
+// staged file into the guild, or nil when there is none or it couldn't be
+// checked.
+func (m *scorecardUploadManager) previousImport(ctx context.Context, staged stagedImport) *ScorecardImportV1 {
+	if staged.Hash == "" || m.lookupImport == nil {
+		return nil
+	}
+
+	response, err := m.lookupImport(ctx, string(staged.GuildID), staged.Hash)
+	if err == nil && response.Error != "" {
+		err = errors.New(response.Error)
+	}
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to check for an earlier import of the scorecard",
+			attr.Error(err),
+			attr.String("guild_id", string(staged.GuildID)),
+		)
+		return nil
+	}
+	return response.Import
+}
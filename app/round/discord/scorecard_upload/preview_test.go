@@ -0,0 +1,431 @@
+package scorecardupload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+const testUDiscCSV = "\xef\xbb\xbfPlayerName,CourseName,LayoutName,StartDate,EndDate,Total,+/-,RoundRating,Hole1,Hole2,Hole3\n" +
+	"Par,Pier Park,Main,2099-06-01 1800,2099-06-01 2000,9,,,3,3,3\n" +
+	"Alice Smith,Pier Park,Main,2099-06-01 1800,2099-06-01 2000,10,+1,,3,4,3\n" +
+	"bob,Pier Park,Main,2099-06-01 1800,2099-06-01 2000,9,0,,3,3,3\n" +
+	"Carol,Pier Park,Main,2099-06-01 1800,2099-06-01 2000,8,-1,,2,3,3\n"
+
+// serveScorecard serves data as the scorecard attachment at the returned URL.
+func serveScorecard(t *testing.T, data string) string {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(data))
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func noUDiscIdentities(ctx context.Context, guildID string) (*UDiscIdentitiesResponsePayloadV1, error) {
+	return &UDiscIdentitiesResponsePayloadV1{}, nil
+}
+
+// pressPreviewButton presses the preview button with the given prefix as
+// userID and returns the response to it.
+func pressPreviewButton(t *testing.T, m *scorecardUploadManager, fakeSession *discord.FakeSession, components []discordgo.MessageComponent, prefix, userID string) *discordgo.InteractionResponse {
+	t.Helper()
+
+	customID := ""
+	for _, component := range components {
+		row, ok := component.(discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, child := range row.Components {
+			if button, ok := child.(discordgo.Button); ok && strings.HasPrefix(button.CustomID, prefix) {
+				customID = button.CustomID
+			}
+		}
+	}
+	if customID == "" {
+		t.Fatalf("no %q button in %+v", prefix, components)
+	}
+
+	var response *discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		response = resp
+		return nil
+	}
+	i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "preview-interaction",
+		Type:    discordgo.InteractionMessageComponent,
+		GuildID: "guild-id",
+		Member:  &discordgo.Member{User: &discordgo.User{ID: userID}},
+		Data:    discordgo.MessageComponentInteractionData{CustomID: customID},
+	}}
+	if prefix == previewConfirmPrefix {
+		_, _ = m.HandleScorecardPreviewConfirm(context.Background(), i)
+	} else {
+		_, _ = m.HandleScorecardPreviewCancel(context.Background(), i)
+	}
+	if response == nil {
+		t.Fatalf("expected a response to the %q button", prefix)
+	}
+	return response
+}
+
+func newPreviewTestManager(fakeSession *discord.FakeSession, publisher *testutils.FakeEventBus) *scorecardUploadManager {
+	return &scorecardUploadManager{
+		session:          fakeSession,
+		publisher:        publisher,
+		logger:           discardLogger(),
+		interactionStore: storage.NewFakeStorage[any](),
+		operationWrapper: func(ctx context.Context, _ string, fn func(context.Context) (ScorecardUploadOperationResult, error)) (ScorecardUploadOperationResult, error) {
+			return fn(ctx)
+		},
+		listUDiscIdentities: func(ctx context.Context, guildID string) (*UDiscIdentitiesResponsePayloadV1, error) {
+			return &UDiscIdentitiesResponsePayloadV1{Identities: []UDiscIdentityV1{
+				{UserID: "111", Name: "alice  smith"},
+				{UserID: "222", Username: "@Bob"},
+			}}, nil
+		},
+	}
+}
+
+func Test_parseUDiscCSV(t *testing.T) {
+	card, err := parseUDiscCSV([]byte(testUDiscCSV))
+	if err != nil {
+		t.Fatalf("parseUDiscCSV() error = %v", err)
+	}
+	want := udiscScorecard{
+		Course: "Pier Park",
+		Layout: "Main",
+		Par:    9,
+		Players: []udiscPlayer{
+			{Name: "Alice Smith", Total: 10, HasTotal: true, Relative: 1, HasRelative: true},
+			{Name: "bob", Total: 9, HasTotal: true, Relative: 0, HasRelative: true},
+			{Name: "Carol", Total: 8, HasTotal: true, Relative: -1, HasRelative: true},
+		},
+	}
+	if !reflect.DeepEqual(card, want) {
+		t.Fatalf("parseUDiscCSV() = %+v, want %+v", card, want)
+	}
+
+	// Without Total and +/- columns, totals are summed and compared to par.
+	card, err = parseUDiscCSV([]byte("PlayerName,Hole1,Hole2\nPar,3,3\nDana,4,4\nEli,,\n"))
+	if err != nil {
+		t.Fatalf("parseUDiscCSV() error = %v", err)
+	}
+	if got := []string{card.Players[0].score(), card.Players[1].score()}; !reflect.DeepEqual(got, []string{"8 (+2)", "no score"}) {
+		t.Fatalf("scores = %v, want [8 (+2) no score]", got)
+	}
+
+	for _, tt := range []struct{ name, data, want string }{
+		{name: "not csv", data: "PlayerName\n\"unterminated", want: "it isn't a valid CSV file"},
+		{name: "no player column", data: "Course,Total\nPier Park,54\n", want: "it has no PlayerName column"},
+		{name: "only par", data: "PlayerName,Total\nPar,54\n", want: "it has no player rows"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseUDiscCSV([]byte(tt.data)); err == nil || err.Error() != tt.want {
+				t.Fatalf("parseUDiscCSV() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func Test_matchPlayers(t *testing.T) {
+	players := []udiscPlayer{{Name: "@JDoe"}, {Name: " Sam  Lee "}, {Name: "Alex"}, {Name: "Nobody"}}
+	identities := []UDiscIdentityV1{
+		{UserID: "1", Username: "jdoe"},
+		{UserID: "2", Name: "sam lee"},
+		{UserID: "3", Name: "Alex"},
+		{UserID: "4", Username: "alex"},
+	}
+
+	matched, unmatched := matchPlayers(players, identities)
+	if want := []playerMatch{{Player: players[0], UserID: "1"}, {Player: players[1], UserID: "2"}}; !reflect.DeepEqual(matched, want) {
+		t.Fatalf("matched = %+v, want %+v", matched, want)
+	}
+	// "Alex" could be either of two players, so it isn't guessed.
+	if want := []playerMatch{{Player: players[2]}, {Player: players[3]}}; !reflect.DeepEqual(unmatched, want) {
+		t.Fatalf("unmatched = %+v, want %+v", unmatched, want)
+	}
+}
+
+func Test_scorecardUploadManager_stageImport_Preview(t *testing.T) {
+	m := newPreviewTestManager(discord.NewFakeSession(), &testutils.FakeEventBus{})
+
+	content, components, err := m.stageImport(context.Background(), stagedImport{
+		GuildID:  "guild-id",
+		RoundID:  sharedtypes.RoundID(uuid.New()),
+		UserID:   "user-id",
+		FileName: "round.csv",
+	}, []byte(testUDiscCSV))
+	if err != nil {
+		t.Fatalf("stageImport() error = %v", err)
+	}
+
+	want := "📋 **Scorecard preview** · `round.csv`\n" +
+		"Pier Park · Main (par 9)\n" +
+		"\n**Matched (2)**\n" +
+		"✅ Alice Smith → <@111> · 10 (+1)\n" +
+		"✅ bob → <@222> · 9 (E)\n" +
+		"\n**Unmatched (1)**\n" +
+		"❓ Carol · 8 (-1)\n" +
+		"_Unmatched players won't get a score unless they set their name with `/set-udisc-name` before you import._\n" +
+		"\nImport this scorecard?"
+	if content != want {
+		t.Fatalf("preview = %q, want %q", content, want)
+	}
+	if len(components) != 1 {
+		t.Fatalf("expected one row of buttons, got %+v", components)
+	}
+}
+
+func Test_scorecardUploadManager_stageImport_PreviewWithoutPlayers(t *testing.T) {
+	tests := []struct {
+		name       string
+		staged     stagedImport
+		fileData   string
+		held       bool
+		identities func(ctx context.Context, guildID string) (*UDiscIdentitiesResponsePayloadV1, error)
+		want       string
+	}{
+		{
+			name:     "identities unavailable",
+			staged:   stagedImport{FileName: "round.csv"},
+			fileData: testUDiscCSV,
+			identities: func(ctx context.Context, guildID string) (*UDiscIdentitiesResponsePayloadV1, error) {
+				return nil, errors.New("timeout")
+			},
+			want: "\n**Players (3)**\n• Alice Smith · 10 (+1)\n• bob · 9 (E)\n• Carol · 8 (-1)\n_Couldn't check who these players are right now. They'll be matched when it's imported._\n",
+		},
+		{
+			name:     "identities held back",
+			staged:   stagedImport{FileName: "round.csv"},
+			fileData: testUDiscCSV,
+			held:     true,
+			want:     "\n**Players (3)**\n• Alice Smith · 10 (+1)\n• bob · 9 (E)\n• Carol · 8 (-1)\n_Players will be matched when it's imported._\n",
+		},
+		{
+			name:     "unreadable csv",
+			staged:   stagedImport{FileName: "round.csv"},
+			fileData: "Course,Total\n",
+			want:     "⚠️ Couldn't read the players from this file: it has no PlayerName column. You can still import it.",
+		},
+		{
+			name:     "xlsx",
+			staged:   stagedImport{FileName: "round.xlsx"},
+			fileData: "PK",
+			want:     "Only CSV files can be previewed.",
+		},
+		{
+			name:   "too large to download",
+			staged: stagedImport{FileURL: "https://cdn.example/round.csv", FileName: "round.csv"},
+			want:   "This file is too large to preview.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newPreviewTestManager(discord.NewFakeSession(), &testutils.FakeEventBus{})
+			if tt.identities != nil {
+				m.listUDiscIdentities = tt.identities
+			}
+			if tt.held {
+				m.listUDiscIdentities = nil
+			}
+			var fileData []byte
+			if tt.fileData != "" {
+				fileData = []byte(tt.fileData)
+			}
+			content, _, err := m.stageImport(context.Background(), tt.staged, fileData)
+			if err != nil {
+				t.Fatalf("stageImport() error = %v", err)
+			}
+			if !strings.Contains(content, tt.want) || !strings.HasSuffix(content, "\nImport this scorecard?") {
+				t.Fatalf("preview = %q, want it to contain %q", content, tt.want)
+			}
+		})
+	}
+}
+
+func Test_scorecardUploadManager_Preview_FlagsDuplicateFiles(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	// The backend stores the file hash sent with each import and looks
+	// earlier imports up by it.
+	imports := map[string]ScorecardImportV1{}
+	publisher := &testutils.FakeEventBus{}
+	publisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		if topic != roundevents.ScorecardUploadedV1 {
+			return nil
+		}
+		var payload roundevents.ScorecardUploadedPayloadV1
+		if err := json.Unmarshal(messages[0].Payload, &payload); err != nil {
+			return err
+		}
+		if hash := messages[0].Metadata.Get(fileHashMetadataKey); hash != "" {
+			imports[string(payload.GuildID)+":"+hash] = ScorecardImportV1{ImportID: payload.ImportID, RoundID: payload.RoundID, UserID: string(payload.UserID), ImportedAt: payload.Timestamp}
+		}
+		return nil
+	}
+	m := newPreviewTestManager(fakeSession, publisher)
+	m.lookupImport = func(ctx context.Context, guildID, fileHash string) (*ScorecardImportLookupResponsePayloadV1, error) {
+		if previous, ok := imports[guildID+":"+fileHash]; ok {
+			return &ScorecardImportLookupResponsePayloadV1{Import: &previous}, nil
+		}
+		return &ScorecardImportLookupResponsePayloadV1{}, nil
+	}
+	roundID := sharedtypes.RoundID(uuid.New())
+	staged := stagedImport{GuildID: "guild-id", RoundID: roundID, UserID: "user-id", FileURL: serveScorecard(t, testUDiscCSV), FileName: "round.csv"}
+	fileData := []byte(testUDiscCSV)
+
+	content, components, err := m.stageImport(context.Background(), staged, fileData)
+	if err != nil || strings.Contains(content, "already imported") {
+		t.Fatalf("first upload: preview = %q, err = %v", content, err)
+	}
+	pressPreviewButton(t, m, fakeSession, components, previewConfirmPrefix, "user-id")
+	if len(imports) != 1 {
+		t.Fatalf("expected the import to carry the file hash, got %v", imports)
+	}
+
+	content, _, _ = m.stageImport(context.Background(), staged, fileData)
+	if !strings.Contains(content, "⚠️ This exact file was already imported into this round by <@user-id> <t:") {
+		t.Fatalf("expected a duplicate warning, got %q", content)
+	}
+
+	staged.RoundID = sharedtypes.RoundID(uuid.New())
+	content, _, _ = m.stageImport(context.Background(), staged, fileData)
+	if !strings.Contains(content, "already imported into another round") {
+		t.Fatalf("expected a duplicate warning for another round, got %q", content)
+	}
+
+	staged.GuildID = "other-guild"
+	content, _, _ = m.stageImport(context.Background(), staged, fileData)
+	if strings.Contains(content, "already imported") {
+		t.Fatalf("expected other guilds not to be warned, got %q", content)
+	}
+
+	// A failed lookup doesn't hold up the preview.
+	m.lookupImport = func(ctx context.Context, guildID, fileHash string) (*ScorecardImportLookupResponsePayloadV1, error) {
+		return nil, errors.New("timeout")
+	}
+	staged.GuildID = "guild-id"
+	content, _, err = m.stageImport(context.Background(), staged, fileData)
+	if err != nil || strings.Contains(content, "already imported") || !strings.HasSuffix(content, "\nImport this scorecard?") {
+		t.Fatalf("expected a preview without a warning, got %q, %v", content, err)
+	}
+}
+
+func Test_scorecardUploadManager_PreviewButtons(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	publisher := &testutils.FakeEventBus{}
+	published := 0
+	publisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		published++
+		return nil
+	}
+	m := newPreviewTestManager(fakeSession, publisher)
+	staged := stagedImport{GuildID: "guild-id", RoundID: sharedtypes.RoundID(uuid.New()), UserID: "user-id", FileName: "round.csv"}
+
+	_, components, err := m.stageImport(context.Background(), staged, []byte(testUDiscCSV))
+	if err != nil {
+		t.Fatalf("stageImport() error = %v", err)
+	}
+
+	response := pressPreviewButton(t, m, fakeSession, components, previewConfirmPrefix, "someone-else")
+	if response.Type != discordgo.InteractionResponseChannelMessageWithSource || response.Data.Flags != discordgo.MessageFlagsEphemeral ||
+		response.Data.Content != "Only the player who uploaded this scorecard can import or cancel it." {
+		t.Fatalf("unexpected response to another player: %+v", response.Data)
+	}
+
+	response = pressPreviewButton(t, m, fakeSession, components, previewCancelPrefix, "user-id")
+	if response.Type != discordgo.InteractionResponseUpdateMessage || response.Data.Content != "Scorecard import cancelled." || len(response.Data.Components) != 0 {
+		t.Fatalf("unexpected cancel response: %+v", response.Data)
+	}
+
+	response = pressPreviewButton(t, m, fakeSession, components, previewConfirmPrefix, "user-id")
+	if response.Data.Content != "This scorecard preview has expired. Please upload the scorecard again." {
+		t.Fatalf("unexpected response to a cancelled preview: %q", response.Data.Content)
+	}
+	if published != 0 {
+		t.Fatalf("expected nothing published, got %d", published)
+	}
+}
+
+func Test_scorecardUploadManager_PreviewConfirm_RejectsChangedFile(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	publisher := &testutils.FakeEventBus{}
+	published := 0
+	publisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		published++
+		return nil
+	}
+	m := newPreviewTestManager(fakeSession, publisher)
+	// The attachment no longer matches the file that was previewed.
+	staged := stagedImport{GuildID: "guild-id", RoundID: sharedtypes.RoundID(uuid.New()), UserID: "user-id", FileURL: serveScorecard(t, "PlayerName,Total\n"), FileName: "round.csv"}
+
+	_, components, err := m.stageImport(context.Background(), staged, []byte(testUDiscCSV))
+	if err != nil {
+		t.Fatalf("stageImport() error = %v", err)
+	}
+	stored, err := m.interactionStore.Get(context.Background(), previewKeyPrefix+strings.TrimPrefix(unmatchedCustomID(t, components, previewConfirmPrefix), previewConfirmPrefix))
+	if held, ok := stored.(stagedImport); err != nil || !ok || held.FileURL != staged.FileURL || held.Hash != fileHash([]byte(testUDiscCSV)) {
+		t.Fatalf("expected only the file reference and hash to be staged, got %+v, %v", stored, err)
+	}
+
+	response := pressPreviewButton(t, m, fakeSession, components, previewConfirmPrefix, "user-id")
+	if response.Data.Content != "Couldn't download the scorecard again. Please upload it again." {
+		t.Fatalf("unexpected response to a changed file: %q", response.Data.Content)
+	}
+	if published != 0 {
+		t.Fatalf("expected nothing published, got %d", published)
+	}
+}
+
+func Test_scorecardUploadManager_PreviewConfirm_RecordsImportedScores(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	publisher := &testutils.FakeEventBus{}
+	publisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		return nil
+	}
+	m := newPreviewTestManager(fakeSession, publisher)
+	log := &scoreaudit.FakeLog{}
+	m.SetScoreAuditLog(log)
+	roundID := sharedtypes.RoundID(uuid.New())
+	staged := stagedImport{GuildID: "guild-id", RoundID: roundID, UserID: "user-id", FileURL: serveScorecard(t, testUDiscCSV), FileName: "round.csv"}
+
+	_, components, err := m.stageImport(context.Background(), staged, []byte(testUDiscCSV))
+	if err != nil {
+		t.Fatalf("stageImport() error = %v", err)
+	}
+	response := pressPreviewButton(t, m, fakeSession, components, previewConfirmPrefix, "user-id")
+	if !strings.HasPrefix(response.Data.Content, "✅ Scorecard import started!") {
+		t.Fatalf("unexpected confirm response: %q", response.Data.Content)
+	}
+
+	// Carol has no UDisc identity, so her score is left to the backend.
+	history, _ := log.History(context.Background(), "guild-id", roundID)
+	if len(history) != 2 {
+		t.Fatalf("expected the matched players' scores to be recorded, got %+v", history)
+	}
+	for idx, want := range []struct {
+		userID sharedtypes.DiscordID
+		score  sharedtypes.Score
+	}{{"111", 1}, {"222", 0}} {
+		if got := history[idx]; got.UserID != want.userID || got.New != want.score || got.Actor != "user-id" || got.Source != scoreaudit.SourceImport || got.Old != nil {
+			t.Errorf("unexpected entry %d: %+v", idx, got)
+		}
+	}
+}
@@ -3,21 +3,78 @@ package scorecardupload
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	userevents "github.com/Black-And-White-Club/frolf-bot-shared/events/user"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
 	"github.com/google/uuid"
 )
 
 const maxInlineFileDataBytes = 256 * 1024
 
+// errImportNeedsEditor is returned for imports by members whose scores have
+// to be attested: an import sends scores straight to the backend, so in
+// guilds with attested scoring only score editors can import.
+var errImportNeedsEditor = errors.New("only score editors can import scorecards in guilds with attested scoring")
+
+const importNeedsEditorMessage = "Scores in this server have to be confirmed, so only score editors can import scorecards. Ask one to import it, or enter your score from the scorecard."
+
+// importFailureMessage is what the user is told when an import couldn't be
+// published.
+func importFailureMessage(err error, fallback string) string {
+	if errors.Is(err, errImportNeedsEditor) {
+		return importNeedsEditorMessage
+	}
+	return fallback
+}
+
+// requiresAttestation reports whether scores imported by userID have to be
+// confirmed before they count, which imports can't do.
+func (m *scorecardUploadManager) requiresAttestation(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) bool {
+	if m.attestedScoring == nil || !m.attestedScoring(ctx, string(guildID)) {
+		return false
+	}
+	return !m.isScoreEditor(ctx, guildID, userID)
+}
+
+// isScoreEditor reports whether userID is an admin or has the guild's editor
+// or admin role.
+func (m *scorecardUploadManager) isScoreEditor(ctx context.Context, guildID sharedtypes.GuildID, userID sharedtypes.DiscordID) bool {
+	if m.guildConfig == nil || m.session == nil {
+		return false
+	}
+	cfg, err := m.guildConfig.GetGuildConfigWithContext(ctx, string(guildID))
+	if err != nil || cfg == nil {
+		return false
+	}
+	member, err := m.session.GuildMember(string(guildID), string(userID))
+	if err != nil || member == nil {
+		return false
+	}
+	if member.Permissions&discordgo.PermissionAdministrator != 0 {
+		return true
+	}
+	for _, role := range member.Roles {
+		if role != "" && (role == cfg.EditorRoleID || role == cfg.AdminRoleID) {
+			return true
+		}
+	}
+	return false
+}
+
 // publishScorecardURLEvent publishes a scorecard URL requested event to the message bus.
 func (m *scorecardUploadManager) publishScorecardURLEvent(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, userID sharedtypes.DiscordID, channelID, messageID, uDiscURL, notes string) (string, error) {
+	if m.requiresAttestation(ctx, guildID, userID) {
+		return "", errImportNeedsEditor
+	}
+
 	importID := uuid.New().String()
 
 	payload := roundevents.ScorecardURLRequestedPayloadV1{
@@ -62,6 +119,10 @@ func (m *scorecardUploadManager) publishScorecardURLEvent(ctx context.Context, g
 // publishScorecardUploadEvent publishes a scorecard uploaded event (for file uploads) to the message bus.
 // This will be used when file upload is fully implemented.
 func (m *scorecardUploadManager) publishScorecardUploadEvent(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, userID sharedtypes.DiscordID, channelID, messageID string, fileData []byte, fileURL, fileName, notes string) (string, error) {
+	if m.requiresAttestation(ctx, guildID, userID) {
+		return "", errImportNeedsEditor
+	}
+
 	importID := uuid.New().String()
 
 	inlineData := fileData
@@ -98,12 +159,16 @@ func (m *scorecardUploadManager) publishScorecardUploadEvent(ctx context.Context
 	msg.Metadata.Set("domain", "scorecard")
 	msg.Metadata.Set("guild_id", string(guildID))
 	msg.Metadata.Set("import_id", importID)
+	if hash := fileHash(fileData); hash != "" {
+		msg.Metadata.Set(fileHashMetadataKey, hash)
+	}
 
 	err = m.publisher.Publish(roundevents.ScorecardUploadedV1, msg)
 	if err != nil {
 		m.logger.ErrorContext(ctx, "Failed to publish scorecard uploaded event", attr.Error(err))
 		return "", fmt.Errorf("failed to publish event: %w", err)
 	}
+	m.recordImportScores(ctx, guildID, roundID, userID, fileName, fileData)
 
 	m.logger.InfoContext(ctx, "Published scorecard upload event",
 		attr.String("import_id", importID),
@@ -113,3 +178,28 @@ func (m *scorecardUploadManager) publishScorecardUploadEvent(ctx context.Context
 
 	return importID, nil
 }
+
+// publishUDiscName saves name as the player's UDisc name, as /set-udisc-name does.
+func (m *scorecardUploadManager) publishUDiscName(ctx context.Context, guildID sharedtypes.GuildID, userID, name string) error {
+	payload := userevents.UpdateUDiscIdentityRequestedPayloadV1{
+		GuildID: guildID,
+		UserID:  sharedtypes.DiscordID(userID),
+		Name:    &name,
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	msg := message.NewMessage(watermill.NewUUID(), payloadBytes)
+	msg.Metadata.Set("correlation_id", uuid.New().String())
+	msg.Metadata.Set("user_id", userID)
+	msg.Metadata.Set("guild_id", string(guildID))
+
+	if err := m.publisher.Publish(userevents.UpdateUDiscIdentityRequestedV1, msg); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to publish UDisc update event", attr.Error(err))
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	return nil
+}
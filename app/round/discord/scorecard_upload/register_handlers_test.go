@@ -23,6 +23,15 @@ type fakeScorecardUploadManager struct {
 	modalCalls  int
 	fileCalls   int
 
+	importCalls       int
+	importSelectCalls int
+
+	previewConfirmCalls int
+	previewCancelCalls  int
+
+	unmatchedSelectCalls  int
+	unmatchedConfirmCalls int
+
 	lastSession discord.Session
 	lastMsg     *discordgo.MessageCreate
 }
@@ -57,6 +66,52 @@ func (m *fakeScorecardUploadManager) SendUploadError(ctx context.Context, channe
 	return nil
 }
 
+func (m *fakeScorecardUploadManager) HandleImportScorecardMessageCommand(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	m.mu.Lock()
+	m.importCalls++
+	m.mu.Unlock()
+	return ScorecardUploadOperationResult{Success: "ok"}, nil
+}
+
+func (m *fakeScorecardUploadManager) HandleImportScorecardRoundSelect(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	m.mu.Lock()
+	m.importSelectCalls++
+	m.mu.Unlock()
+	return ScorecardUploadOperationResult{Success: "ok"}, nil
+}
+
+func (m *fakeScorecardUploadManager) HandleScorecardPreviewConfirm(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	m.mu.Lock()
+	m.previewConfirmCalls++
+	m.mu.Unlock()
+	return ScorecardUploadOperationResult{Success: "ok"}, nil
+}
+
+func (m *fakeScorecardUploadManager) HandleScorecardPreviewCancel(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	m.mu.Lock()
+	m.previewCancelCalls++
+	m.mu.Unlock()
+	return ScorecardUploadOperationResult{Success: "ok"}, nil
+}
+
+func (m *fakeScorecardUploadManager) PromptUnmatchedPlayers(ctx context.Context, importID string) error {
+	return nil
+}
+
+func (m *fakeScorecardUploadManager) HandleUnmatchedPlayerSelect(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	m.mu.Lock()
+	m.unmatchedSelectCalls++
+	m.mu.Unlock()
+	return ScorecardUploadOperationResult{Success: "ok"}, nil
+}
+
+func (m *fakeScorecardUploadManager) HandleUnmatchedPlayersConfirm(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	m.mu.Lock()
+	m.unmatchedConfirmCalls++
+	m.mu.Unlock()
+	return ScorecardUploadOperationResult{Success: "ok"}, nil
+}
+
 type testDiscordgoAdder struct {
 	handler func(s *discordgo.Session, e *discordgo.MessageCreate)
 }
@@ -139,6 +194,79 @@ func TestRegisterHandlers_wiresButtonModalAndMessageHandlers(t *testing.T) {
 	}
 	mgr.mu.Unlock()
 
+	// Message context-menu command routes by type and name.
+	importInteraction := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type:    discordgo.InteractionApplicationCommand,
+		ID:      "i3",
+		GuildID: "g1",
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name:        MessageCommandSpec().Name(),
+			CommandType: discordgo.MessageApplicationCommand,
+			TargetID:    "m1",
+		},
+	}}
+	importInteraction.Member = &discordgo.Member{User: &discordgo.User{ID: "u1"}, Roles: []string{"player"}}
+	registry.HandleInteraction(&discordgo.Session{}, importInteraction)
+
+	// Round picker selection routes via prefix matching.
+	selectInteraction := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type:    discordgo.InteractionMessageComponent,
+		ID:      "i4",
+		GuildID: "g1",
+		Data: discordgo.MessageComponentInteractionData{
+			CustomID: importRoundSelectPrefix + "c1|m1",
+			Values:   []string{"round-123"},
+		},
+	}}
+	selectInteraction.Member = &discordgo.Member{User: &discordgo.User{ID: "u1"}, Roles: []string{"player"}}
+	registry.HandleInteraction(&discordgo.Session{}, selectInteraction)
+	mgr.mu.Lock()
+	if mgr.importCalls != 1 || mgr.importSelectCalls != 1 {
+		mgr.mu.Unlock()
+		t.Fatalf("expected import command and selection handled once, got %d and %d", mgr.importCalls, mgr.importSelectCalls)
+	}
+	mgr.mu.Unlock()
+
+	// Preview buttons route in the server and in DMs, where files uploaded
+	// through the DM prompt are previewed.
+	confirmInteraction := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type:    discordgo.InteractionMessageComponent,
+		ID:      "i5",
+		GuildID: "g1",
+		Data:    discordgo.MessageComponentInteractionData{CustomID: previewConfirmPrefix + "token"},
+	}}
+	confirmInteraction.Member = &discordgo.Member{User: &discordgo.User{ID: "u1"}, Roles: []string{"player"}}
+	registry.HandleInteraction(&discordgo.Session{}, confirmInteraction)
+	registry.HandleInteraction(&discordgo.Session{}, &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		Type: discordgo.InteractionMessageComponent,
+		ID:   "i6",
+		User: &discordgo.User{ID: "u1"},
+		Data: discordgo.MessageComponentInteractionData{CustomID: previewCancelPrefix + "token"},
+	}})
+	mgr.mu.Lock()
+	if mgr.previewConfirmCalls != 1 || mgr.previewCancelCalls != 1 {
+		mgr.mu.Unlock()
+		t.Fatalf("expected preview confirm and DM cancel handled once, got %d and %d", mgr.previewConfirmCalls, mgr.previewCancelCalls)
+	}
+	mgr.mu.Unlock()
+
+	for _, customID := range []string{unmatchedSelectPrefix + "token|0", unmatchedConfirmPrefix + "token"} {
+		interaction := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			Type:    discordgo.InteractionMessageComponent,
+			ID:      customID,
+			GuildID: "g1",
+			Data:    discordgo.MessageComponentInteractionData{CustomID: customID, Values: []string{"u2"}},
+		}}
+		interaction.Member = &discordgo.Member{User: &discordgo.User{ID: "u1"}, Roles: []string{"player"}}
+		registry.HandleInteraction(&discordgo.Session{}, interaction)
+	}
+	mgr.mu.Lock()
+	if mgr.unmatchedSelectCalls != 1 || mgr.unmatchedConfirmCalls != 1 {
+		mgr.mu.Unlock()
+		t.Fatalf("expected unmatched name select and confirm handled once, got %d and %d", mgr.unmatchedSelectCalls, mgr.unmatchedConfirmCalls)
+	}
+	mgr.mu.Unlock()
+
 	// MessageCreate handler is wired through MessageRegistry.
 	fakeSession := discord.NewFakeSession()
 	wrapper := discord.Session(fakeSession)
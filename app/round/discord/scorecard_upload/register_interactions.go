@@ -142,6 +142,99 @@ func RegisterHandlers(registry *interactions.Registry, messageRegistry *interact
 		manager.HandleScorecardUploadModalSubmit(ctx, i)
 	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
 
+	// "Import as scorecard for round…" message context menu and its round picker
+	registry.RegisterCommandHandler(MessageCommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
+		if i == nil || i.Interaction == nil {
+			slog.WarnContext(ctx, "Ignoring scorecard import command with nil interaction payload")
+			return
+		}
+
+		slog.InfoContext(ctx, "Handling scorecard import message command",
+			attr.String("interaction_id", i.ID),
+			attr.String("user_id", interactionUserIDFromCreate(i)),
+		)
+		manager.HandleImportScorecardMessageCommand(ctx, i)
+	})
+
+	registry.RegisterMutatingHandler(importRoundSelectPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		if i == nil || i.Interaction == nil {
+			slog.WarnContext(ctx, "Ignoring scorecard import selection with nil interaction payload")
+			return
+		}
+
+		userID := interactionUserIDFromCreate(i)
+		if userID == "" {
+			slog.WarnContext(ctx, "Ignoring scorecard import selection with missing user",
+				attr.String("interaction_id", i.ID))
+			return
+		}
+
+		slog.InfoContext(ctx, "Handling scorecard import round selection",
+			attr.String("custom_id", i.MessageComponentData().CustomID),
+			attr.String("interaction_id", i.ID),
+			attr.String("user_id", userID),
+		)
+		manager.HandleImportScorecardRoundSelect(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	// Import preview buttons, shown before a scorecard file is imported
+	registry.RegisterMutatingHandler(previewConfirmPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		if i == nil || i.Interaction == nil {
+			slog.WarnContext(ctx, "Ignoring scorecard preview confirm with nil interaction payload")
+			return
+		}
+
+		slog.InfoContext(ctx, "Handling scorecard preview confirm",
+			attr.String("custom_id", i.MessageComponentData().CustomID),
+			attr.String("interaction_id", i.ID),
+			attr.String("user_id", interactionUserIDFromCreate(i)),
+		)
+		manager.HandleScorecardPreviewConfirm(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	registry.RegisterMutatingHandler(previewCancelPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		if i == nil || i.Interaction == nil {
+			slog.WarnContext(ctx, "Ignoring scorecard preview cancel with nil interaction payload")
+			return
+		}
+
+		slog.InfoContext(ctx, "Handling scorecard preview cancel",
+			attr.String("custom_id", i.MessageComponentData().CustomID),
+			attr.String("interaction_id", i.ID),
+			attr.String("user_id", interactionUserIDFromCreate(i)),
+		)
+		manager.HandleScorecardPreviewCancel(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	// Unmatched name resolution, posted when an import fails to match players
+	registry.RegisterMutatingHandler(unmatchedSelectPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		if i == nil || i.Interaction == nil {
+			slog.WarnContext(ctx, "Ignoring unmatched scorecard name selection with nil interaction payload")
+			return
+		}
+
+		slog.InfoContext(ctx, "Handling unmatched scorecard name selection",
+			attr.String("custom_id", i.MessageComponentData().CustomID),
+			attr.String("interaction_id", i.ID),
+			attr.String("user_id", interactionUserIDFromCreate(i)),
+		)
+		manager.HandleUnmatchedPlayerSelect(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
+	registry.RegisterMutatingHandler(unmatchedConfirmPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		if i == nil || i.Interaction == nil {
+			slog.WarnContext(ctx, "Ignoring unmatched scorecard names confirm with nil interaction payload")
+			return
+		}
+
+		slog.InfoContext(ctx, "Handling unmatched scorecard names confirm",
+			attr.String("custom_id", i.MessageComponentData().CustomID),
+			attr.String("interaction_id", i.ID),
+			attr.String("user_id", interactionUserIDFromCreate(i)),
+		)
+		manager.HandleUnmatchedPlayersConfirm(ctx, i)
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+
 	// File upload message listener - adapter to provide context to legacy handler
 	messageRegistry.RegisterMessageCreateHandler(func(ctx context.Context, s discord.Session, m *discordgo.MessageCreate) {
 		if m == nil || m.Message == nil {
@@ -0,0 +1,73 @@
+package scorecardupload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+// ScorecardImportLookupRequestV1 is the request-reply subject of
+// config.BackendFeatureScorecardImports, which looks up a guild's earlier
+// import of a scorecard file by the file's hash.
+const ScorecardImportLookupRequestV1 = "round.scorecard.import.lookup.request.v1"
+
+// fileHashMetadataKey is the scorecard upload metadata carrying the SHA-256
+// of the file, which the backend stores with the import.
+const fileHashMetadataKey = "file_hash"
+
+const scorecardImportLookupTimeout = 2 * time.Second
+
+// ScorecardImportLookupRequestPayloadV1 asks for the last import of a file
+// into a guild.
+type ScorecardImportLookupRequestPayloadV1 struct {
+	GuildID  string `json:"guild_id"`
+	FileHash string `json:"file_hash"`
+}
+
+// ScorecardImportLookupResponsePayloadV1 is the reply to
+// ScorecardImportLookupRequestV1. Import is nil when the file wasn't imported
+// before.
+type ScorecardImportLookupResponsePayloadV1 struct {
+	Import *ScorecardImportV1 `json:"import,omitempty"`
+	Error  string             `json:"error,omitempty"`
+}
+
+// ScorecardImportV1 is who imported a file, into which round and when.
+type ScorecardImportV1 struct {
+	ImportID   string              `json:"import_id"`
+	RoundID    sharedtypes.RoundID `json:"round_id"`
+	UserID     string              `json:"user_id"`
+	ImportedAt time.Time           `json:"imported_at"`
+}
+
+// LookupScorecardImport requests the last import of the file with the given
+// hash into a guild from the backend.
+func LookupScorecardImport(ctx context.Context, eventBus eventbus.EventBus, guildID, fileHash string) (*ScorecardImportLookupResponsePayloadV1, error) {
+	if guildID == "" {
+		return nil, fmt.Errorf("guild id is required")
+	}
+
+	return messagecreator.NATSRequest[ScorecardImportLookupRequestPayloadV1, ScorecardImportLookupResponsePayloadV1](
+		ctx,
+		eventBus,
+		ScorecardImportLookupRequestV1+"."+guildID,
+		ScorecardImportLookupRequestPayloadV1{GuildID: guildID, FileHash: fileHash},
+		scorecardImportLookupTimeout,
+	)
+}
+
+// fileHash returns the SHA-256 of a scorecard file, or "" when it wasn't
+// downloaded.
+func fileHash(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -8,6 +8,9 @@ import (
 	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	roundautocomplete "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_autocomplete"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
@@ -49,6 +52,13 @@ type ScorecardUploadManager interface {
 	HandleFileUploadMessage(s discord.Session, m *discordgo.MessageCreate)
 	EnsureRoundThreadInstructions(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, parentChannelID, eventMessageID string) error
 	SendUploadError(ctx context.Context, channelID, userID, errorMsg string) error
+	HandleImportScorecardMessageCommand(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error)
+	HandleImportScorecardRoundSelect(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error)
+	HandleScorecardPreviewConfirm(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error)
+	HandleScorecardPreviewCancel(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error)
+	PromptUnmatchedPlayers(ctx context.Context, importID string) error
+	HandleUnmatchedPlayerSelect(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error)
+	HandleUnmatchedPlayersConfirm(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error)
 }
 
 // scorecardUploadManager implements the ScorecardUploadManager interface.
@@ -59,6 +69,7 @@ type scorecardUploadManager struct {
 	config           *config.Config
 	interactionStore storage.ISInterface[any]
 	guildConfigCache storage.ISInterface[storage.GuildConfig]
+	guildConfig      guildconfig.GuildConfigResolver
 	tracer           trace.Tracer
 	metrics          discordmetrics.DiscordMetrics
 	operationWrapper func(ctx context.Context, opName string, fn func(ctx context.Context) (ScorecardUploadOperationResult, error)) (ScorecardUploadOperationResult, error)
@@ -69,6 +80,19 @@ type scorecardUploadManager struct {
 	ingressWindows   map[string][]time.Time // key: "guildID:userID" (guildID can be empty for DM)
 	ingressMutex     sync.Mutex
 	httpClient       *http.Client
+	listRounds       roundautocomplete.RoundLister
+	// listUDiscIdentities loads the guild's UDisc identities to match
+	// scorecard players against in the import preview. It is nil while
+	// identity lookups are held back.
+	listUDiscIdentities func(ctx context.Context, guildID string) (*UDiscIdentitiesResponsePayloadV1, error)
+	// lookupImport finds an earlier import of a file by its hash, to flag
+	// the same file being uploaded again. It is nil while import lookups are
+	// held back.
+	lookupImport    func(ctx context.Context, guildID, fileHash string) (*ScorecardImportLookupResponsePayloadV1, error)
+	attestedScoring func(ctx context.Context, guildID string) bool
+	// audit records imported scores. It is nil while score audit is held
+	// back.
+	audit scoreaudit.Log
 }
 
 // NewScorecardUploadManager creates a new ScorecardUploadManager instance.
@@ -82,6 +106,7 @@ func NewScorecardUploadManager(
 	guildConfigCache storage.ISInterface[storage.GuildConfig],
 	tracer trace.Tracer,
 	metrics discordmetrics.DiscordMetrics,
+	guildConfigResolver guildconfig.GuildConfigResolver,
 ) ScorecardUploadManager {
 	if tracer == nil {
 		tracer = noop.NewTracerProvider().Tracer("")
@@ -94,6 +119,7 @@ func NewScorecardUploadManager(
 		config:           cfg,
 		interactionStore: interactionStore,
 		guildConfigCache: guildConfigCache,
+		guildConfig:      guildConfigResolver,
 		tracer:           tracer,
 		metrics:          metrics,
 		pendingUploads:   make(map[string]*pendingUpload),
@@ -105,6 +131,19 @@ func NewScorecardUploadManager(
 		operationWrapper: func(ctx context.Context, opName string, fn func(ctx context.Context) (ScorecardUploadOperationResult, error)) (ScorecardUploadOperationResult, error) {
 			return operationWrapper(ctx, opName, fn, logger, tracer)
 		},
+		listRounds:      roundautocomplete.NewRoundLister(cfg, publisher),
+		attestedScoring: guildconfig.NewAttestedScoring(cfg, guildConfigResolver, publisher),
+	}
+
+	if cfg.BackendFeatureEnabled(config.BackendFeatureUDiscIdentities) {
+		m.listUDiscIdentities = func(ctx context.Context, guildID string) (*UDiscIdentitiesResponsePayloadV1, error) {
+			return RequestUDiscIdentities(ctx, publisher, guildID)
+		}
+	}
+	if cfg.BackendFeatureEnabled(config.BackendFeatureScorecardImports) {
+		m.lookupImport = func(ctx context.Context, guildID, fileHash string) (*ScorecardImportLookupResponsePayloadV1, error) {
+			return LookupScorecardImport(ctx, publisher, guildID, fileHash)
+		}
 	}
 
 	// Start background cleanup of old pending uploads
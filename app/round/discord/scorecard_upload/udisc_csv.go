@@ -0,0 +1,158 @@
+package scorecardupload
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// udiscScorecard is the part of a UDisc CSV export the import preview shows.
+type udiscScorecard struct {
+	Course  string
+	Layout  string
+	Par     int
+	Players []udiscPlayer
+}
+
+// udiscPlayer is one player row of a UDisc CSV export.
+type udiscPlayer struct {
+	Name        string
+	Total       int
+	HasTotal    bool
+	Relative    int
+	HasRelative bool
+}
+
+// parseUDiscCSV reads the course, par and player totals from a UDisc CSV
+// export. The "Par" row holds the course par; totals come from the Total
+// column, or the sum of the hole columns when it is missing.
+func parseUDiscCSV(data []byte) (udiscScorecard, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return udiscScorecard{}, errors.New("it isn't a valid CSV file")
+	}
+	if len(records) == 0 {
+		return udiscScorecard{}, errors.New("the file is empty")
+	}
+
+	columns := map[string]int{}
+	var holes []int
+	for index, header := range records[0] {
+		key := strings.ToLower(strings.TrimSpace(header))
+		if strings.HasPrefix(key, "hole") {
+			holes = append(holes, index)
+			continue
+		}
+		if _, seen := columns[key]; !seen {
+			columns[key] = index
+		}
+	}
+	nameColumn := ""
+	for _, key := range []string{"playername", "player", "name"} {
+		if _, ok := columns[key]; ok {
+			nameColumn = key
+			break
+		}
+	}
+	if nameColumn == "" {
+		return udiscScorecard{}, errors.New("it has no PlayerName column")
+	}
+
+	var card udiscScorecard
+	for _, record := range records[1:] {
+		name := csvField(record, columns, nameColumn)
+		if name == "" {
+			continue
+		}
+		if card.Course == "" {
+			card.Course = csvField(record, columns, "coursename")
+			card.Layout = csvField(record, columns, "layoutname")
+		}
+
+		total, hasTotal := udiscTotal(record, columns, holes)
+		if strings.EqualFold(name, "par") {
+			if card.Par == 0 && hasTotal {
+				card.Par = total
+			}
+			continue
+		}
+
+		player := udiscPlayer{Name: name, Total: total, HasTotal: hasTotal}
+		player.Relative, player.HasRelative = parseRelativeScore(csvField(record, columns, "+/-"))
+		card.Players = append(card.Players, player)
+	}
+	if len(card.Players) == 0 {
+		return udiscScorecard{}, errors.New("it has no player rows")
+	}
+
+	if card.Par > 0 {
+		for index, player := range card.Players {
+			if player.HasTotal && !player.HasRelative {
+				card.Players[index].Relative = player.Total - card.Par
+				card.Players[index].HasRelative = true
+			}
+		}
+	}
+	return card, nil
+}
+
+// score is the player's total and how it compares to par, e.g. "56 (+2)".
+func (p udiscPlayer) score() string {
+	switch {
+	case !p.HasTotal:
+		return "no score"
+	case !p.HasRelative:
+		return strconv.Itoa(p.Total)
+	case p.Relative == 0:
+		return fmt.Sprintf("%d (E)", p.Total)
+	case p.Relative > 0:
+		return fmt.Sprintf("%d (+%d)", p.Total, p.Relative)
+	default:
+		return fmt.Sprintf("%d (%d)", p.Total, p.Relative)
+	}
+}
+
+func csvField(record []string, columns map[string]int, key string) string {
+	index, ok := columns[key]
+	if !ok || index >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[index])
+}
+
+// udiscTotal is the row's Total column, or the sum of its hole columns.
+func udiscTotal(record []string, columns map[string]int, holes []int) (int, bool) {
+	if total, err := strconv.Atoi(csvField(record, columns, "total")); err == nil {
+		return total, true
+	}
+
+	sum, counted := 0, false
+	for _, index := range holes {
+		if index >= len(record) {
+			continue
+		}
+		if strokes, err := strconv.Atoi(strings.TrimSpace(record[index])); err == nil {
+			sum += strokes
+			counted = true
+		}
+	}
+	return sum, counted
+}
+
+// parseRelativeScore reads a "+/-" column value such as "+2", "-3" or "E".
+func parseRelativeScore(value string) (int, bool) {
+	if strings.EqualFold(value, "e") {
+		return 0, true
+	}
+	relative, err := strconv.Atoi(strings.TrimPrefix(value, "+"))
+	if err != nil {
+		return 0, false
+	}
+	return relative, true
+}
@@ -0,0 +1,50 @@
+package scorecardupload
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	messagecreator "github.com/Black-And-White-Club/discord-frolf-bot/app/shared/utils"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+)
+
+// UDiscIdentitiesRequestV1 is the request-reply subject of
+// config.BackendFeatureUDiscIdentities, which lists a guild's UDisc
+// identities as set with /set-udisc-name.
+const UDiscIdentitiesRequestV1 = "user.udisc.identities.request.v1"
+
+const udiscIdentitiesTimeout = 2 * time.Second
+
+// UDiscIdentitiesRequestPayloadV1 asks for every UDisc identity in a guild.
+type UDiscIdentitiesRequestPayloadV1 struct {
+	GuildID string `json:"guild_id"`
+}
+
+// UDiscIdentitiesResponsePayloadV1 is the reply to UDiscIdentitiesRequestV1.
+type UDiscIdentitiesResponsePayloadV1 struct {
+	Identities []UDiscIdentityV1 `json:"identities"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// UDiscIdentityV1 is the UDisc username and name a player goes by.
+type UDiscIdentityV1 struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"udisc_username,omitempty"`
+	Name     string `json:"udisc_name,omitempty"`
+}
+
+// RequestUDiscIdentities requests a guild's UDisc identities from the backend.
+func RequestUDiscIdentities(ctx context.Context, eventBus eventbus.EventBus, guildID string) (*UDiscIdentitiesResponsePayloadV1, error) {
+	if guildID == "" {
+		return nil, fmt.Errorf("guild id is required")
+	}
+
+	return messagecreator.NATSRequest[UDiscIdentitiesRequestPayloadV1, UDiscIdentitiesResponsePayloadV1](
+		ctx,
+		eventBus,
+		UDiscIdentitiesRequestV1+"."+guildID,
+		UDiscIdentitiesRequestPayloadV1{GuildID: guildID},
+		udiscIdentitiesTimeout,
+	)
+}
@@ -0,0 +1,370 @@
+package scorecardupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+const (
+	unmatchedSelectPrefix  = "scorecard_unmatched_select|"
+	unmatchedConfirmPrefix = "scorecard_unmatched_confirm|"
+	unmatchedKeyPrefix     = "scorecard_unmatched:"
+	importKeyPrefix        = "scorecard_import:"
+
+	// maxUnmatchedSelects leaves a row for the confirm button within
+	// Discord's five rows of components.
+	maxUnmatchedSelects = 4
+	// identitySyncAttempts and identitySyncInterval bound how long a re-run
+	// import waits for the backend to report newly saved UDisc names.
+	identitySyncAttempts = 5
+	identitySyncInterval = 500 * time.Millisecond
+)
+
+// unmatchedResolution is a failed import whose unmatched scorecard names are
+// being mapped to members.
+type unmatchedResolution struct {
+	Import stagedImport
+	Names  []string
+	// Mappings is the member picked for each name, keyed by its index in Names.
+	Mappings map[int]string
+}
+
+// trackImport keeps an imported file so a failed import can be resolved.
+func (m *scorecardUploadManager) trackImport(ctx context.Context, importID string, staged stagedImport) {
+	if staged.Hash == "" {
+		return
+	}
+	if err := m.interactionStore.Set(ctx, importKeyPrefix+importID, staged); err != nil {
+		m.logger.WarnContext(ctx, "Failed to track scorecard import",
+			attr.Error(err),
+			attr.String("import_id", importID),
+		)
+	}
+}
+
+// PromptUnmatchedPlayers posts the names on a failed import's scorecard that
+// don't match a member to the round's thread, with a menu to map each one.
+// It does nothing for imports the bot didn't keep the file of, or while
+// UDisc identity lookups are held back.
+func (m *scorecardUploadManager) PromptUnmatchedPlayers(ctx context.Context, importID string) error {
+	if importID == "" || m.listUDiscIdentities == nil {
+		return nil
+	}
+	stored, err := m.interactionStore.Get(ctx, importKeyPrefix+importID)
+	staged, ok := stored.(stagedImport)
+	if err != nil || !ok || !strings.HasSuffix(strings.ToLower(staged.FileName), ".csv") {
+		return nil
+	}
+	// Failures can be reported more than once; only ask once.
+	m.interactionStore.Delete(ctx, importKeyPrefix+importID)
+
+	fileData, err := m.stagedFileData(ctx, staged)
+	if err != nil {
+		return fmt.Errorf("failed to download scorecard: %w", err)
+	}
+	card, err := parseUDiscCSV(fileData)
+	if err != nil {
+		return nil
+	}
+	response, err := m.listUDiscIdentities(ctx, string(staged.GuildID))
+	if err == nil && response.Error != "" {
+		err = errors.New(response.Error)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load UDisc identities: %w", err)
+	}
+	_, unmatched := matchPlayers(card.Players, response.Identities)
+	if len(unmatched) == 0 {
+		return nil
+	}
+
+	resolution := unmatchedResolution{Import: staged, Mappings: map[int]string{}}
+	for _, match := range unmatched {
+		resolution.Names = append(resolution.Names, match.Player.Name)
+	}
+	token := uuid.NewString()
+	if err := m.interactionStore.Set(ctx, unmatchedKeyPrefix+token, resolution); err != nil {
+		return fmt.Errorf("failed to store unmatched scorecard names: %w", err)
+	}
+
+	channelID := m.resolutionChannel(ctx, staged)
+	_, err = m.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content:         unmatchedContent(resolution),
+		Components:      unmatchedComponents(token, resolution),
+		AllowedMentions: &discordgo.MessageAllowedMentions{Users: []string{staged.UserID}},
+	})
+	if err != nil {
+		m.interactionStore.Delete(ctx, unmatchedKeyPrefix+token)
+		return fmt.Errorf("failed to send unmatched scorecard names: %w", err)
+	}
+
+	m.logger.InfoContext(ctx, "Posted unmatched scorecard names",
+		attr.String("import_id", importID),
+		attr.String("channel_id", channelID),
+		attr.Int("unmatched", len(resolution.Names)),
+	)
+	return nil
+}
+
+// resolutionChannel is the round's scorecard thread, or the channel the file
+// was uploaded in when there's no thread to post to.
+func (m *scorecardUploadManager) resolutionChannel(ctx context.Context, staged stagedImport) string {
+	if _, ok := m.threadUploadContext(staged.ChannelID); ok {
+		return staged.ChannelID
+	}
+	if staged.EventMessageID == "" || m.guildConfigCache == nil {
+		return staged.ChannelID
+	}
+	cfg, err := m.guildConfigCache.Get(ctx, string(staged.GuildID))
+	if err != nil || cfg.EventChannelID == "" {
+		return staged.ChannelID
+	}
+
+	threadID, err := m.resolveOrCreateRoundThread(ctx, cfg.EventChannelID, staged.EventMessageID, staged.RoundID)
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to resolve round thread for unmatched scorecard names",
+			attr.Error(err),
+			attr.String("round_id", staged.RoundID.String()),
+		)
+		return staged.ChannelID
+	}
+	return threadID
+}
+
+func unmatchedContent(resolution unmatchedResolution) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "<@%s> These names on `%s` didn't match anyone, so they got no score:\n", resolution.Import.UserID, resolution.Import.FileName)
+	for index, name := range resolution.Names {
+		if userID := resolution.Mappings[index]; userID != "" {
+			fmt.Fprintf(&sb, "• **%s** → <@%s>\n", name, userID)
+		} else {
+			fmt.Fprintf(&sb, "• **%s**\n", name)
+		}
+	}
+	sb.WriteString("\nPick who each name is, then press **Save & re-import**. Each name is saved as that player's UDisc name.")
+	if len(resolution.Names) > maxUnmatchedSelects {
+		fmt.Fprintf(&sb, "\n_Only the first %d names can be picked here. The rest can set theirs with `/set-udisc-name`._", maxUnmatchedSelects)
+	}
+	return sb.String()
+}
+
+func unmatchedComponents(token string, resolution unmatchedResolution) []discordgo.MessageComponent {
+	var rows []discordgo.MessageComponent
+	for index, name := range resolution.Names {
+		if index == maxUnmatchedSelects {
+			break
+		}
+		if runes := []rune(name); len(runes) > 100 {
+			name = string(runes[:100])
+		}
+		menu := discordgo.SelectMenu{
+			MenuType:    discordgo.UserSelectMenu,
+			CustomID:    fmt.Sprintf("%s%s|%d", unmatchedSelectPrefix, token, index),
+			Placeholder: "Who is " + name + "?",
+			MaxValues:   1,
+		}
+		if userID := resolution.Mappings[index]; userID != "" {
+			menu.DefaultValues = []discordgo.SelectMenuDefaultValue{{ID: userID, Type: discordgo.SelectMenuDefaultValueUser}}
+		}
+		rows = append(rows, discordgo.ActionsRow{Components: []discordgo.MessageComponent{menu}})
+	}
+
+	return append(rows, discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+		discordgo.Button{
+			Label:    "Save & re-import",
+			Style:    discordgo.PrimaryButton,
+			CustomID: unmatchedConfirmPrefix + token,
+			Disabled: len(resolution.Mappings) == 0,
+		},
+	}})
+}
+
+// HandleUnmatchedPlayerSelect records the member picked for an unmatched name.
+func (m *scorecardUploadManager) HandleUnmatchedPlayerSelect(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "scorecard_unmatched_select")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "select_menu")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, interactionUserIDFromCreate(i))
+
+	return m.operationWrapper(ctx, "HandleUnmatchedPlayerSelect", func(ctx context.Context) (ScorecardUploadOperationResult, error) {
+		data := i.MessageComponentData()
+		token, rawIndex, _ := strings.Cut(strings.TrimPrefix(data.CustomID, unmatchedSelectPrefix), "|")
+		resolution, ok := m.heldResolution(ctx, i, token)
+		if !ok {
+			return ScorecardUploadOperationResult{Failure: "resolution_unavailable"}, nil
+		}
+		index, err := strconv.Atoi(rawIndex)
+		if err != nil || index < 0 || index >= len(resolution.Names) {
+			return ScorecardUploadOperationResult{Failure: "invalid_selection"}, nil
+		}
+
+		if len(data.Values) == 0 {
+			delete(resolution.Mappings, index)
+		} else {
+			resolution.Mappings[index] = data.Values[0]
+		}
+		if err := m.interactionStore.Set(ctx, unmatchedKeyPrefix+token, resolution); err != nil {
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+
+		err = m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Content:         unmatchedContent(resolution),
+				Components:      unmatchedComponents(token, resolution),
+				AllowedMentions: &discordgo.MessageAllowedMentions{},
+			},
+		})
+		if err != nil {
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+		return ScorecardUploadOperationResult{Success: "name_mapped"}, nil
+	})
+}
+
+// HandleUnmatchedPlayersConfirm saves the picked names as the players' UDisc
+// names and re-runs the import.
+func (m *scorecardUploadManager) HandleUnmatchedPlayersConfirm(ctx context.Context, i *discordgo.InteractionCreate) (ScorecardUploadOperationResult, error) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "scorecard_unmatched_confirm")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, interactionUserIDFromCreate(i))
+
+	return m.operationWrapper(ctx, "HandleUnmatchedPlayersConfirm", func(ctx context.Context) (ScorecardUploadOperationResult, error) {
+		token := strings.TrimPrefix(i.MessageComponentData().CustomID, unmatchedConfirmPrefix)
+		resolution, ok := m.heldResolution(ctx, i, token)
+		if !ok {
+			return ScorecardUploadOperationResult{Failure: "resolution_unavailable"}, nil
+		}
+		if len(resolution.Mappings) == 0 {
+			_ = m.respondEphemeral(i.Interaction, "Pick who at least one name is first.")
+			return ScorecardUploadOperationResult{Failure: "no_mappings"}, nil
+		}
+		// Delete before saving so a double click can't import twice.
+		m.interactionStore.Delete(ctx, unmatchedKeyPrefix+token)
+
+		// Saving waits on the backend, so acknowledge the click first.
+		err := m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseDeferredMessageUpdate,
+		})
+		if err != nil {
+			_ = m.interactionStore.Set(ctx, unmatchedKeyPrefix+token, resolution)
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+
+		staged := resolution.Import
+		indexes := make([]int, 0, len(resolution.Mappings))
+		for index := range resolution.Mappings {
+			indexes = append(indexes, index)
+		}
+		sort.Ints(indexes)
+
+		var saved strings.Builder
+		for _, index := range indexes {
+			name, userID := resolution.Names[index], resolution.Mappings[index]
+			if err := m.publishUDiscName(ctx, staged.GuildID, userID, name); err != nil {
+				_ = m.interactionStore.Set(ctx, unmatchedKeyPrefix+token, resolution)
+				_ = m.editResolution(i, "Failed to save the UDisc names. Please try again.\n\n"+unmatchedContent(resolution), unmatchedComponents(token, resolution))
+				return ScorecardUploadOperationResult{Error: err}, err
+			}
+			fmt.Fprintf(&saved, "• **%s** → <@%s>\n", name, userID)
+		}
+		m.awaitUDiscNames(ctx, staged.GuildID, resolution)
+
+		fileData, err := m.stagedFileData(ctx, staged)
+		if err != nil {
+			_ = m.editResolution(i, "✅ Saved UDisc names:\n"+saved.String()+"\nCouldn't download the scorecard again. Please upload it again.", nil)
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+		importID, err := m.publishScorecardUploadEvent(ctx, staged.GuildID, staged.RoundID, sharedtypes.DiscordID(staged.UserID),
+			staged.ChannelID, staged.EventMessageID, fileData, staged.FileURL, staged.FileName, staged.Notes)
+		if err != nil {
+			_ = m.editResolution(i, "✅ Saved UDisc names:\n"+saved.String()+"\n"+importFailureMessage(err, "Couldn't re-run the import. Please upload the scorecard again."), nil)
+			return ScorecardUploadOperationResult{Error: err}, err
+		}
+		m.trackImport(ctx, importID, staged)
+
+		err = m.editResolution(i, fmt.Sprintf("✅ Saved UDisc names:\n%s\nRe-running the import. Import ID: `%s`", saved.String(), importID), nil)
+		if err != nil {
+			m.logger.ErrorContext(ctx, "Failed to confirm unmatched scorecard names", attr.Error(err))
+			return ScorecardUploadOperationResult{Success: importID}, err
+		}
+		return ScorecardUploadOperationResult{Success: importID}, nil
+	})
+}
+
+// heldResolution returns the unmatched names a message is mapping, telling
+// the user when they've expired or belong to someone else's upload.
+func (m *scorecardUploadManager) heldResolution(ctx context.Context, i *discordgo.InteractionCreate, token string) (unmatchedResolution, bool) {
+	stored, err := m.interactionStore.Get(ctx, unmatchedKeyPrefix+token)
+	resolution, ok := stored.(unmatchedResolution)
+	if err != nil || !ok {
+		_ = m.respondEphemeral(i.Interaction, "These names can no longer be picked here. Set them with `/set-udisc-name` and upload the scorecard again.")
+		return unmatchedResolution{}, false
+	}
+	if resolution.Import.UserID != interactionUserIDFromCreate(i) {
+		_ = m.respondEphemeral(i.Interaction, "Only the player who uploaded this scorecard can pick who these names are.")
+		return unmatchedResolution{}, false
+	}
+	return resolution, true
+}
+
+// awaitUDiscNames waits for the backend to report the newly saved UDisc names
+// so the re-run import can match them. It gives up after a few attempts and
+// lets the import run anyway.
+func (m *scorecardUploadManager) awaitUDiscNames(ctx context.Context, guildID sharedtypes.GuildID, resolution unmatchedResolution) {
+	if m.listUDiscIdentities == nil {
+		return
+	}
+	players := make([]udiscPlayer, 0, len(resolution.Mappings))
+	want := make(map[string]string, len(resolution.Mappings))
+	for index, userID := range resolution.Mappings {
+		players = append(players, udiscPlayer{Name: resolution.Names[index]})
+		want[resolution.Names[index]] = userID
+	}
+
+	for attempt := 0; attempt < identitySyncAttempts; attempt++ {
+		if response, err := m.listUDiscIdentities(ctx, string(guildID)); err == nil && response.Error == "" {
+			matched, _ := matchPlayers(players, response.Identities)
+			saved := 0
+			for _, match := range matched {
+				if want[match.Player.Name] == match.UserID {
+					saved++
+				}
+			}
+			if saved == len(players) {
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(identitySyncInterval):
+		}
+	}
+	m.logger.WarnContext(ctx, "Re-running scorecard import before UDisc names were saved",
+		attr.String("guild_id", string(guildID)),
+	)
+}
+
+func (m *scorecardUploadManager) editResolution(i *discordgo.InteractionCreate, content string, components []discordgo.MessageComponent) error {
+	if components == nil {
+		components = []discordgo.MessageComponent{}
+	}
+	_, err := m.session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content:         &content,
+		Components:      &components,
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	})
+	return err
+}
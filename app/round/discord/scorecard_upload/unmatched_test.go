@@ -0,0 +1,225 @@
+package scorecardupload
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	userevents "github.com/Black-And-White-Club/frolf-bot-shared/events/user"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+func unmatchedInteraction(customID, userID string, values ...string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "unmatched-interaction",
+		Type:    discordgo.InteractionMessageComponent,
+		GuildID: "guild-id",
+		Member:  &discordgo.Member{User: &discordgo.User{ID: userID}},
+		Data:    discordgo.MessageComponentInteractionData{CustomID: customID, Values: values},
+	}}
+}
+
+// promptUnmatched tracks a failed import of testUDiscCSV uploaded in a round
+// thread and returns the message prompting for its unmatched names.
+func promptUnmatched(t *testing.T, m *scorecardUploadManager, fakeSession *discord.FakeSession) *discordgo.MessageSend {
+	t.Helper()
+
+	m.threadContexts = map[string]*threadUploadContext{"thread-id": {}}
+	m.trackImport(context.Background(), "import-1", stagedImport{
+		GuildID:   "guild-id",
+		RoundID:   sharedtypes.RoundID(uuid.New()),
+		UserID:    "user-id",
+		ChannelID: "thread-id",
+		FileURL:   serveScorecard(t, testUDiscCSV),
+		FileName:  "round.csv",
+		Hash:      fileHash([]byte(testUDiscCSV)),
+	})
+
+	var prompt *discordgo.MessageSend
+	fakeSession.ChannelMessageSendComplexFunc = func(channelID string, data *discordgo.MessageSend, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		if channelID != "thread-id" {
+			t.Errorf("expected the prompt in the round thread, got %q", channelID)
+		}
+		prompt = data
+		return &discordgo.Message{ID: "prompt-id"}, nil
+	}
+	if err := m.PromptUnmatchedPlayers(context.Background(), "import-1"); err != nil {
+		t.Fatalf("PromptUnmatchedPlayers() error = %v", err)
+	}
+	if prompt == nil {
+		t.Fatalf("expected unmatched names to be posted")
+	}
+	return prompt
+}
+
+func unmatchedCustomID(t *testing.T, components []discordgo.MessageComponent, prefix string) string {
+	t.Helper()
+	for _, component := range components {
+		for _, child := range component.(discordgo.ActionsRow).Components {
+			switch c := child.(type) {
+			case discordgo.SelectMenu:
+				if strings.HasPrefix(c.CustomID, prefix) {
+					return c.CustomID
+				}
+			case discordgo.Button:
+				if strings.HasPrefix(c.CustomID, prefix) {
+					return c.CustomID
+				}
+			}
+		}
+	}
+	t.Fatalf("no %q component in %+v", prefix, components)
+	return ""
+}
+
+func Test_scorecardUploadManager_PromptUnmatchedPlayers(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	m := newPreviewTestManager(fakeSession, &testutils.FakeEventBus{})
+
+	prompt := promptUnmatched(t, m, fakeSession)
+
+	want := "<@user-id> These names on `round.csv` didn't match anyone, so they got no score:\n" +
+		"• **Carol**\n" +
+		"\nPick who each name is, then press **Save & re-import**. Each name is saved as that player's UDisc name."
+	if prompt.Content != want {
+		t.Fatalf("prompt = %q, want %q", prompt.Content, want)
+	}
+	if len(prompt.AllowedMentions.Users) != 1 || prompt.AllowedMentions.Users[0] != "user-id" {
+		t.Fatalf("expected only the uploader to be pinged, got %+v", prompt.AllowedMentions)
+	}
+	if len(prompt.Components) != 2 {
+		t.Fatalf("expected a member menu and a button row, got %+v", prompt.Components)
+	}
+	menu := prompt.Components[0].(discordgo.ActionsRow).Components[0].(discordgo.SelectMenu)
+	if menu.MenuType != discordgo.UserSelectMenu || menu.Placeholder != "Who is Carol?" {
+		t.Fatalf("unexpected menu: %+v", menu)
+	}
+	if button := prompt.Components[1].(discordgo.ActionsRow).Components[0].(discordgo.Button); !button.Disabled {
+		t.Fatalf("expected the button to be disabled until a member is picked")
+	}
+
+	// The same failure reported again doesn't post a second prompt.
+	fakeSession.ChannelMessageSendComplexFunc = func(string, *discordgo.MessageSend, ...discordgo.RequestOption) (*discordgo.Message, error) {
+		t.Fatalf("expected no second prompt")
+		return nil, nil
+	}
+	if err := m.PromptUnmatchedPlayers(context.Background(), "import-1"); err != nil {
+		t.Fatalf("PromptUnmatchedPlayers() error = %v", err)
+	}
+	if err := m.PromptUnmatchedPlayers(context.Background(), "untracked-import"); err != nil {
+		t.Fatalf("PromptUnmatchedPlayers() error = %v", err)
+	}
+}
+
+func Test_scorecardUploadManager_PromptUnmatchedPlayers_HeldWithoutIdentities(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	m := newPreviewTestManager(fakeSession, &testutils.FakeEventBus{})
+	m.listUDiscIdentities = nil
+	m.trackImport(context.Background(), "import-1", stagedImport{
+		GuildID:  "guild-id",
+		UserID:   "user-id",
+		FileURL:  serveScorecard(t, testUDiscCSV),
+		FileName: "round.csv",
+		Hash:     fileHash([]byte(testUDiscCSV)),
+	})
+
+	fakeSession.ChannelMessageSendComplexFunc = func(string, *discordgo.MessageSend, ...discordgo.RequestOption) (*discordgo.Message, error) {
+		t.Fatalf("expected no prompt while UDisc identities are held back")
+		return nil, nil
+	}
+	if err := m.PromptUnmatchedPlayers(context.Background(), "import-1"); err != nil {
+		t.Fatalf("PromptUnmatchedPlayers() error = %v", err)
+	}
+}
+
+func Test_scorecardUploadManager_UnmatchedPlayers_SavesNamesAndReimports(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	publisher := &testutils.FakeEventBus{}
+	m := newPreviewTestManager(fakeSession, publisher)
+	prompt := promptUnmatched(t, m, fakeSession)
+
+	var response *discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+		response = resp
+		return nil
+	}
+
+	// Someone else can't pick who the names are.
+	selectID := unmatchedCustomID(t, prompt.Components, unmatchedSelectPrefix)
+	_, _ = m.HandleUnmatchedPlayerSelect(context.Background(), unmatchedInteraction(selectID, "someone-else", "333"))
+	if response.Data.Flags != discordgo.MessageFlagsEphemeral || response.Data.Content != "Only the player who uploaded this scorecard can pick who these names are." {
+		t.Fatalf("unexpected response to another player: %+v", response.Data)
+	}
+
+	_, _ = m.HandleUnmatchedPlayerSelect(context.Background(), unmatchedInteraction(selectID, "user-id", "333"))
+	if response.Type != discordgo.InteractionResponseUpdateMessage || !strings.Contains(response.Data.Content, "• **Carol** → <@333>\n") {
+		t.Fatalf("unexpected select response: %+v", response.Data)
+	}
+	if button := response.Data.Components[1].(discordgo.ActionsRow).Components[0].(discordgo.Button); button.Disabled {
+		t.Fatalf("expected the button to be enabled once a member is picked")
+	}
+
+	var topics []string
+	saved := false
+	publisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		topics = append(topics, topic)
+		if topic == userevents.UpdateUDiscIdentityRequestedV1 {
+			var payload userevents.UpdateUDiscIdentityRequestedPayloadV1
+			if err := json.Unmarshal(messages[0].Payload, &payload); err != nil {
+				t.Fatalf("failed to unmarshal payload: %v", err)
+			}
+			if payload.GuildID != "guild-id" || payload.UserID != "333" || payload.Name == nil || *payload.Name != "Carol" || payload.Username != nil {
+				t.Fatalf("unexpected UDisc identity payload: %+v", payload)
+			}
+			saved = true
+		}
+		return nil
+	}
+	m.listUDiscIdentities = func(ctx context.Context, guildID string) (*UDiscIdentitiesResponsePayloadV1, error) {
+		if !saved {
+			return &UDiscIdentitiesResponsePayloadV1{}, nil
+		}
+		return &UDiscIdentitiesResponsePayloadV1{Identities: []UDiscIdentityV1{{UserID: "333", Name: "Carol"}}}, nil
+	}
+	var edited string
+	fakeSession.InteractionResponseEditFunc = func(_ *discordgo.Interaction, edit *discordgo.WebhookEdit, _ ...discordgo.RequestOption) (*discordgo.Message, error) {
+		edited = *edit.Content
+		if len(*edit.Components) != 0 {
+			t.Errorf("expected the menus to be removed, got %+v", *edit.Components)
+		}
+		return &discordgo.Message{}, nil
+	}
+
+	confirmID := unmatchedCustomID(t, response.Data.Components, unmatchedConfirmPrefix)
+	result, err := m.HandleUnmatchedPlayersConfirm(context.Background(), unmatchedInteraction(confirmID, "user-id"))
+	if err != nil {
+		t.Fatalf("HandleUnmatchedPlayersConfirm() error = %v", err)
+	}
+	if response.Type != discordgo.InteractionResponseDeferredMessageUpdate {
+		t.Fatalf("expected the click to be acknowledged, got %v", response.Type)
+	}
+	if len(topics) != 2 || topics[0] != userevents.UpdateUDiscIdentityRequestedV1 || topics[1] != roundevents.ScorecardUploadedV1 {
+		t.Fatalf("expected the name saved then the import re-run, got %v", topics)
+	}
+	importID, _ := result.Success.(string)
+	if importID == "" || edited != "✅ Saved UDisc names:\n• **Carol** → <@333>\n\nRe-running the import. Import ID: `"+importID+"`" {
+		t.Fatalf("unexpected confirmation %q for import %q", edited, importID)
+	}
+
+	// The re-run import is tracked in case it fails too.
+	if _, err := m.interactionStore.Get(context.Background(), importKeyPrefix+importID); err != nil {
+		t.Fatalf("expected the re-run import to be tracked: %v", err)
+	}
+
+	_, _ = m.HandleUnmatchedPlayersConfirm(context.Background(), unmatchedInteraction(confirmID, "user-id"))
+	if !strings.HasPrefix(response.Data.Content, "These names can no longer be picked here.") || len(topics) != 2 {
+		t.Fatalf("expected a second click not to import again, got %q and %v", response.Data.Content, topics)
+	}
+}
@@ -0,0 +1,168 @@
+package startround
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// cardThreadArchiveMinutes keeps card threads open for a day of play.
+	cardThreadArchiveMinutes = 1440
+	maxThreadNameLength      = 100
+	maxMessageLength         = 2000
+)
+
+// scorecardLines lays the sorted participants out as scorecard lines. Cards
+// already on the embed are kept; otherwise players are dealt onto cards when
+// the round asks for them. Players on no card follow the last card.
+func scorecardLines(list []*ParticipantData, existing [][]sharedtypes.DiscordID, mode roundcards.Mode) []string {
+	byUser := make(map[sharedtypes.DiscordID]*ParticipantData, len(list))
+	for _, p := range list {
+		byUser[p.UserID] = p
+	}
+
+	var cards [][]*ParticipantData
+	if len(existing) > 0 {
+		for _, card := range existing {
+			var players []*ParticipantData
+			for _, userID := range card {
+				if p := byUser[userID]; p != nil {
+					players = append(players, p)
+				}
+			}
+			cards = append(cards, players)
+		}
+	} else if mode != "" {
+		participants := make([]roundtypes.Participant, 0, len(list))
+		for _, p := range list {
+			participants = append(participants, roundtypes.Participant{UserID: p.UserID, Response: p.Response, Score: p.Score, TagNumber: p.TagNumber})
+		}
+		for _, card := range roundcards.Assign(participants, mode) {
+			players := make([]*ParticipantData, len(card))
+			for idx, participant := range card {
+				players[idx] = byUser[participant.UserID]
+			}
+			cards = append(cards, players)
+		}
+	}
+
+	lines := make([]string, 0, len(list)+len(cards)+1)
+	if len(cards) == 0 {
+		for _, p := range list {
+			lines = append(lines, formatParticipantLine(p))
+		}
+		return lines
+	}
+
+	placed := make(map[sharedtypes.DiscordID]bool, len(list))
+	for idx, card := range cards {
+		lines = append(lines, roundcards.HeaderLine(idx+1))
+		for _, p := range card {
+			lines = append(lines, formatParticipantLine(p))
+			placed[p.UserID] = true
+		}
+	}
+	var rest []string
+	for _, p := range list {
+		if !placed[p.UserID] {
+			rest = append(rest, formatParticipantLine(p))
+		}
+	}
+	if len(rest) > 0 {
+		lines = append(lines, roundcards.NoCardLine)
+		lines = append(lines, rest...)
+	}
+	return lines
+}
+
+// embedCards returns the cards a round embed already shows.
+func embedCards(embed *discordgo.MessageEmbed) [][]sharedtypes.DiscordID {
+	if embed == nil {
+		return nil
+	}
+	var lines []string
+	for _, field := range embed.Fields {
+		if field != nil && responseFromFieldName(field.Name) != "" {
+			lines = append(lines, embedpagination.ParticipantLinesFromFieldValue(field.Value)...)
+		}
+	}
+	return roundcards.FromLines(lines)
+}
+
+// openCards creates a private thread for each card, adds the card's players
+// to it and posts the assignments in the round's channel. Failures are logged
+// and skipped so the round still starts.
+func (m *startRoundManager) openCards(ctx context.Context, channelID, title string, cards [][]sharedtypes.DiscordID) {
+	lines := []string{fmt.Sprintf("🃏 **Card assignments for %s**", title)}
+	for idx, card := range cards {
+		mentions := make([]string, len(card))
+		for i, userID := range card {
+			mentions[i] = fmt.Sprintf("<@%s>", userID)
+		}
+		line := fmt.Sprintf("**Card %d**: %s", idx+1, strings.Join(mentions, ", "))
+
+		thread, err := m.session.ThreadStartComplex(channelID, &discordgo.ThreadStart{
+			Name:                cardThreadName(title, idx+1),
+			Type:                discordgo.ChannelTypeGuildPrivateThread,
+			AutoArchiveDuration: cardThreadArchiveMinutes,
+			Invitable:           false,
+		})
+		if err != nil || thread == nil {
+			m.logger.WarnContext(ctx, "Failed to create card thread",
+				attr.Error(err), attr.String("channel_id", channelID), attr.Int("card", idx+1))
+		} else {
+			for _, userID := range card {
+				if err := m.session.ThreadMemberAdd(thread.ID, string(userID)); err != nil {
+					m.logger.WarnContext(ctx, "Failed to add player to card thread",
+						attr.Error(err), attr.String("thread_id", thread.ID), attr.String("user_id", string(userID)))
+				}
+			}
+			line += fmt.Sprintf(" · <#%s>", thread.ID)
+		}
+		lines = append(lines, line)
+	}
+
+	for _, content := range splitMessage(lines) {
+		if _, err := m.session.ChannelMessageSend(channelID, content); err != nil {
+			m.logger.WarnContext(ctx, "Failed to post card assignments",
+				attr.Error(err), attr.String("channel_id", channelID))
+			return
+		}
+	}
+}
+
+func cardThreadName(title string, card int) string {
+	name := []rune(fmt.Sprintf("Card %d · %s", card, title))
+	if len(name) > maxThreadNameLength {
+		name = name[:maxThreadNameLength]
+	}
+	return string(name)
+}
+
+// splitMessage joins lines into as few messages as fit Discord's length limit.
+func splitMessage(lines []string) []string {
+	var messages []string
+	current := ""
+	for _, line := range lines {
+		if current != "" && len(current)+1+len(line) > maxMessageLength {
+			messages = append(messages, current)
+			current = ""
+		}
+		if current != "" {
+			current += "\n"
+		}
+		current += line
+	}
+	if current != "" {
+		messages = append(messages, current)
+	}
+	return messages
+}
@@ -0,0 +1,51 @@
+package startround
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+)
+
+func Test_scorecardLines(t *testing.T) {
+	var list []*ParticipantData
+	for _, id := range []string{"1", "2", "3", "4", "5", "6"} {
+		list = append(list, &ParticipantData{UserID: sharedtypes.DiscordID(id), Response: roundtypes.ResponseAccept})
+	}
+	list = append(list, &ParticipantData{UserID: "7", Response: roundtypes.ResponseTentative})
+
+	want := []string{
+		"🃏 **Card 1**", "<@1> — Score: --", "<@2> — Score: --", "<@3> — Score: --",
+		"🃏 **Card 2**", "<@4> — Score: --", "<@5> — Score: --", "<@6> — Score: --",
+		"🃏 **No card**", "<@7> — Score: --",
+	}
+	if got := scorecardLines(list, nil, roundcards.ModeTagOrder); !reflect.DeepEqual(got, want) {
+		t.Fatalf("scorecardLines() = %q", got)
+	}
+
+	// Cards already on the embed win over the mode.
+	existing := [][]sharedtypes.DiscordID{{"6", "5", "4", "3"}, {"2", "1", "7"}}
+	want = []string{
+		"🃏 **Card 1**", "<@6> — Score: --", "<@5> — Score: --", "<@4> — Score: --", "<@3> — Score: --",
+		"🃏 **Card 2**", "<@2> — Score: --", "<@1> — Score: --", "<@7> — Score: --",
+	}
+	if got := scorecardLines(list, existing, roundcards.ModeRandom); !reflect.DeepEqual(got, want) {
+		t.Fatalf("scorecardLines() with existing cards = %q", got)
+	}
+
+	if got := scorecardLines(list, nil, ""); len(got) != len(list) {
+		t.Fatalf("expected no cards without a mode, got %q", got)
+	}
+
+}
+
+func Test_splitMessage(t *testing.T) {
+	long := strings.Repeat("x", 1500)
+	got := splitMessage([]string{"header", long, long})
+	if len(got) != 2 || got[0] != "header\n"+long || got[1] != long {
+		t.Fatalf("splitMessage() gave %d messages", len(got))
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
@@ -34,9 +35,11 @@ const (
 
 	customIDEnterScore = "round_enter_score"
 	customIDJoinLate   = "round_join_late"
+	customIDKeepScore  = "round_keep_score"
 
 	emojiEnterScore = "💰"
 	emojiJoinLate   = "🏃"
+	emojiKeepScore  = "📝"
 
 	colorRoundStarted = 0x00AA00
 )
@@ -49,7 +52,7 @@ type ParticipantData struct {
 }
 
 var participantLineRegex = regexp.MustCompile(
-	`<@!?(\d+)>(?:\s+Tag:\s*(\d+))?(?:\s*—\s*Score:\s*([^—]+))?`,
+	`<@!?(\d+)>(?:\s+Tag:\s*(\d+))?(?:\s*—\s*Score:\s*([^—(]+))?`,
 )
 
 func parseParticipantLine(
@@ -178,8 +181,13 @@ func (m *startRoundManager) TransformRoundToScorecard(
 			participants[p.UserID] = existing
 		}
 
+		// Players still waiting for a spot don't play the round.
+		waitlisted := waitlistedPlayers(existingEmbed, payload.Participants)
 		list := make([]*ParticipantData, 0, len(participants))
 		for _, p := range participants {
+			if waitlisted[p.UserID] {
+				continue
+			}
 			list = append(list, p)
 		}
 
@@ -208,10 +216,11 @@ func (m *startRoundManager) TransformRoundToScorecard(
 		// 		tentative = append(tentative, line)
 		// 	}
 		// }
-		var participantLines []string
-		for _, p := range list {
-			participantLines = append(participantLines, formatParticipantLine(p))
+		mode := roundcards.Mode("")
+		if existingEmbed != nil && existingEmbed.Footer != nil {
+			mode = roundcards.ModeFromFooter(existingEmbed.Footer.Text)
 		}
+		participantLines := scorecardLines(list, embedCards(existingEmbed), mode)
 
 		location := placeholderUnknownLocation
 		if payload.Location != "" {
@@ -254,6 +263,12 @@ func (m *startRoundManager) TransformRoundToScorecard(
 						CustomID: fmt.Sprintf("%s|%s", customIDJoinLate, payload.RoundID),
 						Emoji:    &discordgo.ComponentEmoji{Name: emojiJoinLate},
 					},
+					discordgo.Button{
+						Label:    "Keep Score",
+						Style:    discordgo.SecondaryButton,
+						CustomID: fmt.Sprintf("%s|%s", customIDKeepScore, payload.RoundID),
+						Emoji:    &discordgo.ComponentEmoji{Name: emojiKeepScore},
+					},
 				},
 			},
 		}
@@ -37,6 +37,12 @@ func Test_startRoundManager_TransformRoundToScorecard(t *testing.T) {
 						CustomID: fmt.Sprintf("round_join_late|%s", roundID),
 						Emoji:    &discordgo.ComponentEmoji{Name: "🏃"},
 					},
+					discordgo.Button{
+						Label:    "Keep Score",
+						Style:    discordgo.SecondaryButton,
+						CustomID: fmt.Sprintf("round_keep_score|%s", roundID),
+						Emoji:    &discordgo.ComponentEmoji{Name: "📝"},
+					},
 				},
 			},
 		}
@@ -33,6 +33,7 @@ type StartRoundOperationResult struct {
 type StartRoundManager interface {
 	TransformRoundToScorecard(ctx context.Context, payload *roundevents.DiscordRoundStartPayloadV1, existingEmbed *discordgo.MessageEmbed) (StartRoundOperationResult, error)
 	UpdateRoundToScorecard(ctx context.Context, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1) (StartRoundOperationResult, error)
+	ReopenScorecard(ctx context.Context, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1) (StartRoundOperationResult, error)
 }
 
 // startRoundManager implements the StartRoundManager interface.
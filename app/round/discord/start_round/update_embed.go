@@ -6,13 +6,25 @@ import (
 	"fmt"
 
 	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
 	"github.com/bwmarrin/discordgo"
 )
 
 func (m *startRoundManager) UpdateRoundToScorecard(ctx context.Context, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1) (StartRoundOperationResult, error) {
-	return m.operationWrapper(ctx, "UpdateRoundToScorecard", func(ctx context.Context) (StartRoundOperationResult, error) {
+	return m.updateScorecard(ctx, "UpdateRoundToScorecard", channelID, messageID, payload, true)
+}
+
+// ReopenScorecard puts a finalized round's embed back to the in-progress
+// scorecard with the current scores. Its cards were opened when the round
+// started, so none are opened again, and its waitlist is already gone.
+func (m *startRoundManager) ReopenScorecard(ctx context.Context, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1) (StartRoundOperationResult, error) {
+	return m.updateScorecard(ctx, "ReopenScorecard", channelID, messageID, payload, false)
+}
+
+func (m *startRoundManager) updateScorecard(ctx context.Context, opName, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1, starting bool) (StartRoundOperationResult, error) {
+	return m.operationWrapper(ctx, opName, func(ctx context.Context) (StartRoundOperationResult, error) {
 		// Multi-tenant: resolve channel ID from payload/config before backend lookup
 		resolvedChannelID := channelID
 		if resolvedChannelID == "" && payload != nil {
@@ -54,6 +66,8 @@ func (m *startRoundManager) UpdateRoundToScorecard(ctx context.Context, channelI
 			existingEmbed = existingMsg.Embeds[0]
 		}
 
+		waitlisted := waitlistedPlayers(existingEmbed, payload.Participants)
+
 		transformResult, err := m.TransformRoundToScorecard(ctx, payload, existingEmbed)
 		if err != nil {
 			m.logger.ErrorContext(ctx, "Failed to run TransformRoundToScorecard wrapper", attr.Error(err))
@@ -75,8 +89,10 @@ func (m *startRoundManager) UpdateRoundToScorecard(ctx context.Context, channelI
 		}
 
 		targetPage := 0
+		hadCards := len(embedCards(existingEmbed)) > 0
 		if existingSnapshot, found := embedpagination.Get(messageID); found {
 			targetPage = existingSnapshot.CurrentPage
+			hadCards = hadCards || roundcards.HasCards(existingSnapshot.LineItems)
 		}
 
 		staticFields := make([]*discordgo.MessageEmbedField, 0, len(transformedData.Embed.Fields))
@@ -121,6 +137,15 @@ func (m *startRoundManager) UpdateRoundToScorecard(ctx context.Context, channelI
 			return StartRoundOperationResult{Error: fmt.Errorf("failed to update round embed: %w", err)}, nil
 		}
 
+		// Cards are opened once, when they are first dealt.
+		if starting && !hadCards && roundcards.HasCards(participantLines) {
+			m.openCards(ctx, resolvedChannelID, string(payload.Title), roundcards.FromLines(participantLines))
+		}
+		// Players still waiting for a spot are taken off the round as it starts.
+		if starting && len(waitlisted) > 0 {
+			m.releaseWaitlist(ctx, payload, resolvedChannelID, messageID, waitlisted)
+		}
+
 		m.logger.InfoContext(ctx, "Successfully updated round embed to scorecard",
 			attr.String("message_id", messageID),
 			attr.String("channel_id", resolvedChannelID))
@@ -3,15 +3,19 @@ package startround
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
 	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/bwmarrin/discordgo"
 	"github.com/google/uuid"
 )
@@ -144,3 +148,279 @@ func Test_startRoundManager_UpdateRoundToScorecard(t *testing.T) {
 		})
 	}
 }
+
+func Test_startRoundManager_UpdateRoundToScorecard_Cards(t *testing.T) {
+	start := sharedtypes.StartTime(time.Date(2099, 3, 15, 10, 0, 0, 0, time.UTC))
+	payload := &roundevents.DiscordRoundStartPayloadV1{
+		RoundID:   sharedtypes.RoundID(uuid.New()),
+		Title:     "League Night",
+		Location:  "Test Course",
+		StartTime: &start,
+	}
+	for idx := 1; idx <= 7; idx++ {
+		tag := sharedtypes.TagNumber(idx)
+		payload.Participants = append(payload.Participants, roundevents.RoundParticipantV1{
+			UserID:    sharedtypes.DiscordID(fmt.Sprintf("10%d", idx)),
+			TagNumber: &tag,
+			Response:  roundtypes.ResponseAccept,
+		})
+	}
+
+	fakeSession := discord.NewFakeSession()
+	message := &discordgo.Message{ID: "cards-message", Embeds: []*discordgo.MessageEmbed{{
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "📅 Time", Value: "<t:4077000000:f>"},
+			{Name: "📍 Location", Value: "Test Course"},
+			{Name: "👥 Participants", Value: "-"},
+		},
+		Footer: &discordgo.MessageEmbedFooter{Text: "Created by Jace • 🃏 Cards: Tag order"},
+	}}}
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return message, nil
+	}
+	fakeSession.ChannelMessageEditComplexFunc = func(edit *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		message = &discordgo.Message{ID: edit.ID, Embeds: *edit.Embeds}
+		return message, nil
+	}
+	var threads []*discordgo.ThreadStart
+	fakeSession.ThreadStartComplexFunc = func(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		threads = append(threads, data)
+		return &discordgo.Channel{ID: fmt.Sprintf("thread-%d", len(threads))}, nil
+	}
+	members := map[string][]string{}
+	fakeSession.ThreadMemberAddFunc = func(threadID, memberID string, options ...discordgo.RequestOption) error {
+		members[threadID] = append(members[threadID], memberID)
+		return nil
+	}
+	var posted []string
+	fakeSession.ChannelMessageSendFunc = func(channelID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		posted = append(posted, content)
+		return &discordgo.Message{}, nil
+	}
+
+	srm := &startRoundManager{
+		session: fakeSession,
+		logger:  loggerfrolfbot.NoOpLogger,
+		config:  &config.Config{},
+		operationWrapper: func(ctx context.Context, name string, fn func(ctx context.Context) (StartRoundOperationResult, error)) (StartRoundOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	if got, err := srm.UpdateRoundToScorecard(context.Background(), "test-channel", "cards-message", payload); err != nil || got.Error != nil {
+		t.Fatalf("UpdateRoundToScorecard() error = %v, result.Error = %v", err, got.Error)
+	}
+
+	if len(threads) != 2 || threads[0].Name != "Card 1 · League Night" || threads[0].Type != discordgo.ChannelTypeGuildPrivateThread {
+		t.Fatalf("unexpected card threads %+v", threads)
+	}
+	if got := fmt.Sprint(members["thread-1"], members["thread-2"]); got != "[101 102 103 104] [105 106 107]" {
+		t.Fatalf("thread members = %s", got)
+	}
+	want := "🃏 **Card assignments for League Night**\n" +
+		"**Card 1**: <@101>, <@102>, <@103>, <@104> · <#thread-1>\n" +
+		"**Card 2**: <@105>, <@106>, <@107> · <#thread-2>"
+	if len(posted) != 1 || posted[0] != want {
+		t.Fatalf("posted %q", posted)
+	}
+
+	snapshot, found := embedpagination.Get("cards-message")
+	if !found || snapshot.LineItems[0] != "🃏 **Card 1**" || snapshot.LineItems[5] != "🃏 **Card 2**" {
+		t.Fatalf("expected the scorecard to keep the cards, got %+v", snapshot)
+	}
+
+	// A redelivered start event keeps the cards without opening them again.
+	if _, err := srm.UpdateRoundToScorecard(context.Background(), "test-channel", "cards-message", payload); err != nil {
+		t.Fatalf("UpdateRoundToScorecard() error = %v", err)
+	}
+	if len(threads) != 2 || len(posted) != 1 {
+		t.Fatalf("cards were opened again: %d threads, %d posts", len(threads), len(posted))
+	}
+}
+
+func Test_startRoundManager_ReopenScorecard_DoesNotReopenCards(t *testing.T) {
+	start := sharedtypes.StartTime(time.Date(2099, 3, 15, 10, 0, 0, 0, time.UTC))
+	payload := &roundevents.DiscordRoundStartPayloadV1{
+		RoundID:   sharedtypes.RoundID(uuid.New()),
+		Title:     "League Night",
+		Location:  "Test Course",
+		StartTime: &start,
+	}
+	for idx := 1; idx <= 7; idx++ {
+		tag := sharedtypes.TagNumber(idx)
+		score := sharedtypes.Score(idx - 3)
+		payload.Participants = append(payload.Participants, roundevents.RoundParticipantV1{
+			UserID:    sharedtypes.DiscordID(fmt.Sprintf("20%d", idx)),
+			TagNumber: &tag,
+			Score:     &score,
+			Response:  roundtypes.ResponseAccept,
+		})
+	}
+
+	footer := &discordgo.MessageEmbedFooter{Text: "Created by Jace • 🃏 Cards: Tag order"}
+	message := &discordgo.Message{ID: "reopen-message", Embeds: []*discordgo.MessageEmbed{{
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "📅 Time", Value: "<t:4077000000:f>"},
+			{Name: "📍 Location", Value: "Test Course"},
+			{Name: "👥 Participants", Value: "-"},
+		},
+		Footer: footer,
+	}}}
+
+	fakeSession := discord.NewFakeSession()
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return message, nil
+	}
+	fakeSession.ChannelMessageEditComplexFunc = func(edit *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		message = &discordgo.Message{ID: edit.ID, Embeds: *edit.Embeds}
+		return message, nil
+	}
+	threads := 0
+	fakeSession.ThreadStartComplexFunc = func(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		threads++
+		return &discordgo.Channel{ID: fmt.Sprintf("thread-%d", threads)}, nil
+	}
+	posts := 0
+	fakeSession.ChannelMessageSendFunc = func(channelID, content string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		posts++
+		return &discordgo.Message{}, nil
+	}
+
+	srm := &startRoundManager{
+		session: fakeSession,
+		logger:  loggerfrolfbot.NoOpLogger,
+		config:  &config.Config{},
+		operationWrapper: func(ctx context.Context, name string, fn func(ctx context.Context) (StartRoundOperationResult, error)) (StartRoundOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	if _, err := srm.UpdateRoundToScorecard(context.Background(), "test-channel", "reopen-message", payload); err != nil {
+		t.Fatalf("UpdateRoundToScorecard() error = %v", err)
+	}
+	if threads != 2 || posts != 1 {
+		t.Fatalf("expected the round start to open 2 cards, got %d threads, %d posts", threads, posts)
+	}
+
+	// Finalizing drops the cards from the embed and replaces its snapshot.
+	message = &discordgo.Message{ID: "reopen-message", Embeds: []*discordgo.MessageEmbed{{
+		Title: "**League Night** - Round Finalized",
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "📅 Started", Value: "<t:4077000000:f>"},
+			{Name: "📍 Location", Value: "Test Course"},
+		},
+		Footer: footer,
+	}}}
+	embedpagination.Delete("reopen-message")
+
+	if got, err := srm.ReopenScorecard(context.Background(), "test-channel", "reopen-message", payload); err != nil || got.Error != nil {
+		t.Fatalf("ReopenScorecard() error = %v, result.Error = %v", err, got.Error)
+	}
+	if threads != 2 || posts != 1 {
+		t.Fatalf("reopening opened cards again: %d threads, %d posts", threads, posts)
+	}
+	if title := message.Embeds[0].Title; title != "**League Night** - Round Started" {
+		t.Errorf("expected the embed back in progress, got title %q", title)
+	}
+	snapshot, found := embedpagination.Get("reopen-message")
+	if !found || len(snapshot.LineItems) == 0 || snapshot.LineItems[1] != "<@201> Tag: 1 — Score: -2" {
+		t.Fatalf("expected the reopened scorecard to show the current scores, got %+v", snapshot)
+	}
+}
+
+func Test_startRoundManager_UpdateRoundToScorecard_Waitlist(t *testing.T) {
+	start := sharedtypes.StartTime(time.Date(2099, 3, 15, 10, 0, 0, 0, time.UTC))
+	payload := &roundevents.DiscordRoundStartPayloadV1{
+		GuildID:   "guild-1",
+		RoundID:   sharedtypes.RoundID(uuid.New()),
+		Title:     "League Night",
+		Location:  "Test Course",
+		StartTime: &start,
+		Participants: []roundevents.RoundParticipantV1{
+			{UserID: "101", Response: roundtypes.ResponseAccept},
+			{UserID: "102", Response: roundtypes.ResponseAccept},
+			{UserID: "103", Response: roundtypes.ResponseAccept},
+		},
+	}
+
+	fakeSession := discord.NewFakeSession()
+	roundMessage := &discordgo.Message{ID: "waitlist-message", Embeds: []*discordgo.MessageEmbed{{
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "📅 Time", Value: "<t:4077000000:f>"},
+			{Name: "📍 Location", Value: "Test Course"},
+			{Name: "👥 Participants (2/2)", Value: "Ace\nBirdie"},
+			{Name: "⏳ Waitlist", Value: "1. Bogey"},
+		},
+		Footer: &discordgo.MessageEmbedFooter{Text: "Created by Jace • 🃏 Cards: Tag order"},
+	}}}
+	fakeSession.ChannelMessageFunc = func(channelID, messageID string, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		return roundMessage, nil
+	}
+	fakeSession.ChannelMessageEditComplexFunc = func(edit *discordgo.MessageEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+		roundMessage = &discordgo.Message{ID: edit.ID, Embeds: *edit.Embeds}
+		return roundMessage, nil
+	}
+	fakeSession.ThreadStartComplexFunc = func(channelID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		return &discordgo.Channel{ID: "thread-1"}, nil
+	}
+	var threadMembers []string
+	fakeSession.ThreadMemberAddFunc = func(threadID, memberID string, options ...discordgo.RequestOption) error {
+		threadMembers = append(threadMembers, memberID)
+		return nil
+	}
+	var dmUsers []string
+	fakeSession.UserChannelCreateFunc = func(recipientID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+		dmUsers = append(dmUsers, recipientID)
+		return &discordgo.Channel{ID: "dm-" + recipientID}, nil
+	}
+
+	var removals []roundevents.ParticipantRemovalRequestPayloadV1
+	srm := &startRoundManager{
+		session: fakeSession,
+		logger:  loggerfrolfbot.NoOpLogger,
+		config:  &config.Config{},
+		helper: &testutils.FakeHelpers{
+			CreateNewMessageFunc: func(payload any, topic string) (*message.Message, error) {
+				if topic == roundevents.RoundParticipantRemovalRequestedV2 {
+					removals = append(removals, payload.(roundevents.ParticipantRemovalRequestPayloadV1))
+				}
+				return message.NewMessage("removal-msg", nil), nil
+			},
+		},
+		publisher: &testutils.FakeEventBus{},
+		operationWrapper: func(ctx context.Context, name string, fn func(ctx context.Context) (StartRoundOperationResult, error)) (StartRoundOperationResult, error) {
+			return fn(ctx)
+		},
+	}
+
+	if got, err := srm.UpdateRoundToScorecard(context.Background(), "test-channel", "waitlist-message", payload); err != nil || got.Error != nil {
+		t.Fatalf("UpdateRoundToScorecard() error = %v, result.Error = %v", err, got.Error)
+	}
+
+	snapshot, found := embedpagination.Get("waitlist-message")
+	if !found {
+		t.Fatal("expected a scorecard snapshot")
+	}
+	for _, line := range snapshot.LineItems {
+		if strings.Contains(line, "<@103>") {
+			t.Fatalf("waitlisted player is on the scorecard: %q", snapshot.LineItems)
+		}
+	}
+	if fmt.Sprint(threadMembers) != "[101 102]" {
+		t.Fatalf("card thread members = %v", threadMembers)
+	}
+	if len(removals) != 1 || removals[0].UserID != "103" || removals[0].RoundID != payload.RoundID {
+		t.Fatalf("expected player 103 to be taken off the round, got %+v", removals)
+	}
+	if fmt.Sprint(dmUsers) != "[103]" {
+		t.Fatalf("expected player 103 to be DMed, got %v", dmUsers)
+	}
+
+	// A redelivered start event finds the scorecard and has no waitlist left.
+	if _, err := srm.UpdateRoundToScorecard(context.Background(), "test-channel", "waitlist-message", payload); err != nil {
+		t.Fatalf("UpdateRoundToScorecard() error = %v", err)
+	}
+	if len(removals) != 1 || len(dmUsers) != 1 {
+		t.Fatalf("waitlist was released again: %d removals, %d DMs", len(removals), len(dmUsers))
+	}
+}
@@ -0,0 +1,100 @@
+package startround
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	roundcapacity "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_capacity"
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+)
+
+// waitlistedPlayers returns the accepted players beyond the cap the round
+// embed shows. Participants are taken in the backend's order, as the RSVP
+// embed takes them, so the same players are left waiting for a spot.
+func waitlistedPlayers(embed *discordgo.MessageEmbed, participants []roundevents.RoundParticipantV1) map[sharedtypes.DiscordID]bool {
+	if embed == nil {
+		return nil
+	}
+	maxPlayers := 0
+	for _, field := range embed.Fields {
+		if field != nil && responseFromFieldName(field.Name) != "" {
+			if limit := roundcapacity.MaxFromFieldName(field.Name); limit > 0 {
+				maxPlayers = limit
+			}
+		}
+	}
+	if maxPlayers == 0 {
+		return nil
+	}
+
+	players := make([]roundtypes.Participant, len(participants))
+	for idx, p := range participants {
+		players[idx] = roundtypes.Participant{UserID: p.UserID, Response: p.Response}
+	}
+	_, waitlist := roundcapacity.Split(players, maxPlayers)
+	waitlisted := make(map[sharedtypes.DiscordID]bool, len(waitlist))
+	for _, p := range waitlist {
+		waitlisted[p.UserID] = true
+	}
+	return waitlisted
+}
+
+// releaseWaitlist takes the players still waiting for a spot off the round
+// once it starts, so they are neither scored nor put on a card, and lets each
+// of them know. Failures are logged and skipped so the round still starts.
+func (m *startRoundManager) releaseWaitlist(ctx context.Context, payload *roundevents.DiscordRoundStartPayloadV1, channelID, messageID string, waitlisted map[sharedtypes.DiscordID]bool) {
+	userIDs := make([]string, 0, len(waitlisted))
+	for userID := range waitlisted {
+		userIDs = append(userIDs, string(userID))
+	}
+	sort.Strings(userIDs)
+
+	for _, userID := range userIDs {
+		if err := m.requestRemoval(payload, channelID, messageID, sharedtypes.DiscordID(userID)); err != nil {
+			m.logger.WarnContext(ctx, "Failed to take waitlisted player off the round",
+				attr.Error(err),
+				attr.String("user_id", userID),
+				attr.String("discord_message_id", messageID))
+		}
+
+		content := fmt.Sprintf("⏳ %s has started without a spot opening up, so you've been taken off its waitlist.", payload.Title)
+		if payload.GuildID != "" && channelID != "" && messageID != "" {
+			content += fmt.Sprintf("\nhttps://discord.com/channels/%s/%s/%s", payload.GuildID, channelID, messageID)
+		}
+
+		dmChannel, err := m.session.UserChannelCreate(userID)
+		if err == nil {
+			_, err = m.session.ChannelMessageSend(dmChannel.ID, content)
+		}
+		if err != nil {
+			m.logger.WarnContext(ctx, "Failed to DM waitlisted player",
+				attr.Error(err),
+				attr.String("user_id", userID),
+				attr.String("discord_message_id", messageID))
+		}
+	}
+}
+
+func (m *startRoundManager) requestRemoval(payload *roundevents.DiscordRoundStartPayloadV1, channelID, messageID string, userID sharedtypes.DiscordID) error {
+	if m.publisher == nil || m.helper == nil {
+		return fmt.Errorf("no publisher to request the removal")
+	}
+
+	msg, err := m.helper.CreateNewMessage(roundevents.ParticipantRemovalRequestPayloadV1{
+		GuildID: payload.GuildID,
+		RoundID: payload.RoundID,
+		UserID:  userID,
+	}, roundevents.RoundParticipantRemovalRequestedV2)
+	if err != nil {
+		return fmt.Errorf("failed to create removal request: %w", err)
+	}
+	msg.Metadata.Set("discord_message_id", messageID)
+	msg.Metadata.Set("channel_id", channelID)
+
+	return m.publisher.Publish(roundevents.RoundParticipantRemovalRequestedV2, msg)
+}
@@ -0,0 +1,33 @@
+package startround
+
+import (
+	"reflect"
+	"testing"
+
+	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
+	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+)
+
+func Test_waitlistedPlayers(t *testing.T) {
+	embed := &discordgo.MessageEmbed{Fields: []*discordgo.MessageEmbedField{
+		{Name: "📍 Location", Value: "Pier Park"},
+		{Name: "👥 Participants (2/2)", Value: "Ace\nBirdie"},
+	}}
+	participants := []roundevents.RoundParticipantV1{
+		{UserID: "1", Response: roundtypes.ResponseAccept},
+		{UserID: "2", Response: roundtypes.ResponseTentative},
+		{UserID: "3", Response: roundtypes.ResponseAccept},
+		{UserID: "4", Response: roundtypes.ResponseAccept},
+	}
+
+	if got := waitlistedPlayers(embed, participants); !reflect.DeepEqual(got, map[sharedtypes.DiscordID]bool{"4": true}) {
+		t.Fatalf("waitlistedPlayers() = %v, want player 4", got)
+	}
+
+	embed.Fields[1].Name = "👥 Participants"
+	if got := waitlistedPlayers(embed, participants); got != nil {
+		t.Fatalf("expected no waitlist without a cap, got %v", got)
+	}
+}
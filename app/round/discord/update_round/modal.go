@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	roundtime "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_time"
 	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
 	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
 	roundtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/round"
@@ -58,17 +60,7 @@ func (urm *updateRoundManager) SendUpdateRoundModal(
 
 		err := urm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseModal,
-			Data: &discordgo.InteractionResponseData{
-				Title:    "Update Round",
-				CustomID: customID,
-				Components: []discordgo.MessageComponent{
-					textRow("title", "Title", 100),
-					textAreaRow("description", "Description", 500),
-					textRow("start_time", "Start Time", 30),
-					textRow("timezone", "Timezone", 50),
-					textRow("location", "Location", 100),
-				},
-			},
+			Data: updateRoundModal(customID, nil),
 		})
 		if err != nil {
 			return UpdateRoundOperationResult{Error: err}, err
@@ -126,46 +118,98 @@ func (urm *updateRoundManager) HandleUpdateRoundModalSubmit(
 		}
 
 		if timezone == "" {
-			timezone = "America/Chicago"
+			timezone = guildconfig.GuildTimezone(ctx, urm.logger, urm.guildConfigResolver, i.GuildID)
 		}
 
-		payload := discordroundevents.RoundUpdateModalSubmittedPayloadV1{
-			GuildID:     sharedtypes.GuildID(i.GuildID),
+		submission := updateSubmission{
 			RoundID:     sharedtypes.RoundID(roundUUID),
-			UserID:      sharedtypes.DiscordID(userID),
 			ChannelID:   i.ChannelID,
 			MessageID:   parts[2],
-			Title:       optionalTitle(title),
-			Description: optionalDescription(description),
-			StartTime:   optionalString(startTime),
-			Location:    optionalLocation(location),
-			Timezone:    optionalTimezone(timezone),
+			Title:       title,
+			Description: description,
+			StartTime:   startTime,
+			Timezone:    timezone,
+			Location:    location,
 		}
 
-		_ = urm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-			Type: discordgo.InteractionResponseChannelMessageWithSource,
-			Data: &discordgo.InteractionResponseData{
-				Content: "Round update request received.",
-				Flags:   discordgo.MessageFlagsEphemeral,
-			},
-		})
-
-		msg, err := urm.createEvent(ctx, discordroundevents.RoundUpdateModalSubmittedV1, payload, i)
-		if err != nil {
-			return UpdateRoundOperationResult{Error: err}, err
+		// Phrases like "tomorrow 6pm" are resolved in the round's timezone and
+		// previewed for confirmation before anything is published.
+		if startTime != "" && !roundtime.Exact(startTime) {
+			loc, err := time.LoadLocation(timezone)
+			if err != nil {
+				respondError(urm.session, i.Interaction, "Timezone is not recognised.")
+				return UpdateRoundOperationResult{Error: err}, nil
+			}
+			start, err := roundtime.Parse(startTime, time.Now(), loc)
+			if err != nil {
+				respondError(urm.session, i.Interaction, err.Error())
+				return UpdateRoundOperationResult{Error: err}, nil
+			}
+			submission.StartTime = start.Format(roundtime.Layout)
+			submission.RawStartTime = startTime
+			return urm.sendStartTimePreview(ctx, i, submission, start)
 		}
 
-		msg.Metadata.Set("submitted_at", time.Now().UTC().Format(time.RFC3339))
-		msg.Metadata.Set("user_id", userID)
-		msg.Metadata.Set("user_timezone", timezone)
-		msg.Metadata.Set("raw_start_time", startTime)
+		return urm.submitUpdate(ctx, i, userID, submission, discordgo.InteractionResponseChannelMessageWithSource)
+	})
+}
 
-		if err := urm.publisher.Publish(discordroundevents.RoundUpdateModalSubmittedV1, msg); err != nil {
-			return UpdateRoundOperationResult{Error: err}, err
-		}
+// updateSubmission is a validated update round modal submission.
+type updateSubmission struct {
+	RoundID     sharedtypes.RoundID
+	ChannelID   string
+	MessageID   string
+	Title       string
+	Description string
+	// StartTime is as typed, or in roundtime.Layout once a phrase has been
+	// resolved; RawStartTime is then the phrase.
+	StartTime    string
+	RawStartTime string
+	Timezone     string
+	Location     string
+}
 
-		return UpdateRoundOperationResult{Success: "round update request published"}, nil
+// submitUpdate acknowledges a validated submission with a response of
+// responseType and publishes the round update request.
+func (urm *updateRoundManager) submitUpdate(ctx context.Context, i *discordgo.InteractionCreate, userID string, submission updateSubmission, responseType discordgo.InteractionResponseType) (UpdateRoundOperationResult, error) {
+	payload := discordroundevents.RoundUpdateModalSubmittedPayloadV1{
+		GuildID:     sharedtypes.GuildID(i.GuildID),
+		RoundID:     submission.RoundID,
+		UserID:      sharedtypes.DiscordID(userID),
+		ChannelID:   submission.ChannelID,
+		MessageID:   submission.MessageID,
+		Title:       optionalTitle(submission.Title),
+		Description: optionalDescription(submission.Description),
+		StartTime:   optionalString(submission.StartTime),
+		Location:    optionalLocation(submission.Location),
+		Timezone:    optionalTimezone(submission.Timezone),
+	}
+
+	seriesNote, seriesComponents := urm.prepareSeriesEdit(ctx, i.GuildID, payload.RoundID, submission.Title, submission.Description, submission.StartTime, submission.Timezone, submission.Location)
+	_ = urm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: responseType,
+		Data: &discordgo.InteractionResponseData{
+			Content:    "Round update request received." + seriesNote,
+			Flags:      discordgo.MessageFlagsEphemeral,
+			Components: seriesComponents,
+		},
 	})
+
+	msg, err := urm.createEvent(ctx, discordroundevents.RoundUpdateModalSubmittedV1, payload, i)
+	if err != nil {
+		return UpdateRoundOperationResult{Error: err}, err
+	}
+
+	msg.Metadata.Set("submitted_at", time.Now().UTC().Format(time.RFC3339))
+	msg.Metadata.Set("user_id", userID)
+	msg.Metadata.Set("user_timezone", submission.Timezone)
+	msg.Metadata.Set("raw_start_time", submission.StartTime)
+
+	if err := urm.publisher.Publish(discordroundevents.RoundUpdateModalSubmittedV1, msg); err != nil {
+		return UpdateRoundOperationResult{Error: err}, err
+	}
+
+	return UpdateRoundOperationResult{Success: "round update request published"}, nil
 }
 
 // HandleUpdateRoundModalCancel handles a user's cancellation of the update round modal.
@@ -270,7 +314,23 @@ func getUserIDFromInteraction(i *discordgo.InteractionCreate) string {
 	return ""
 }
 
-func textRow(id, label string, max int) discordgo.ActionsRow {
+// updateRoundModal builds the update round modal, with inputs pre-filled
+// from prefill by custom ID.
+func updateRoundModal(customID string, prefill map[string]string) *discordgo.InteractionResponseData {
+	return &discordgo.InteractionResponseData{
+		Title:    "Update Round",
+		CustomID: customID,
+		Components: []discordgo.MessageComponent{
+			textRow("title", "Title", 100, prefill["title"]),
+			textAreaRow("description", "Description", 500, prefill["description"]),
+			textRow("start_time", "Start Time", 30, prefill["start_time"]),
+			textRow("timezone", "Timezone", 50, prefill["timezone"]),
+			textRow("location", "Location", 100, prefill["location"]),
+		},
+	}
+}
+
+func textRow(id, label string, max int, value string) discordgo.ActionsRow {
 	return discordgo.ActionsRow{
 		Components: []discordgo.MessageComponent{
 			discordgo.TextInput{
@@ -278,12 +338,13 @@ func textRow(id, label string, max int) discordgo.ActionsRow {
 				Label:     label,
 				Style:     discordgo.TextInputShort,
 				MaxLength: max,
+				Value:     value,
 			},
 		},
 	}
 }
 
-func textAreaRow(id, label string, max int) discordgo.ActionsRow {
+func textAreaRow(id, label string, max int, value string) discordgo.ActionsRow {
 	return discordgo.ActionsRow{
 		Components: []discordgo.MessageComponent{
 			discordgo.TextInput{
@@ -291,6 +352,7 @@ func textAreaRow(id, label string, max int) discordgo.ActionsRow {
 				Label:     label,
 				Style:     discordgo.TextInputParagraph,
 				MaxLength: max,
+				Value:     value,
 			},
 		},
 	}
@@ -50,4 +50,36 @@ func RegisterHandlers(registry *interactions.Registry, manager UpdateRoundManage
 			attr.Any("result", result),
 			attr.Error(err))
 	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.EditorRequired, RequiresSetup: true})
+
+	// Register series edit button handler
+	registry.RegisterMutatingHandler(seriesEditPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		customID := i.MessageComponentData().CustomID
+		slog.Info("Series edit button interaction received", attr.String("custom_id", customID))
+
+		result, err := manager.HandleApplySeriesEditButton(ctx, i)
+		slog.Info("HandleApplySeriesEditButton completed",
+			attr.Any("result", result),
+			attr.Error(err))
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.EditorRequired, RequiresSetup: true})
+
+	// Register start time preview button handlers
+	registry.RegisterMutatingHandler(startTimeConfirmPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		customID := i.MessageComponentData().CustomID
+		slog.Info("Start time confirmation received", attr.String("custom_id", customID))
+
+		result, err := manager.HandleStartTimeConfirmButton(ctx, i)
+		slog.Info("HandleStartTimeConfirmButton completed",
+			attr.Any("result", result),
+			attr.Error(err))
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.EditorRequired, RequiresSetup: true})
+
+	registry.RegisterMutatingHandler(startTimeAdjustPrefix, func(ctx context.Context, i *discordgo.InteractionCreate) {
+		customID := i.MessageComponentData().CustomID
+		slog.Info("Start time adjustment received", attr.String("custom_id", customID))
+
+		result, err := manager.HandleStartTimeAdjustButton(ctx, i)
+		slog.Info("HandleStartTimeAdjustButton completed",
+			attr.Any("result", result),
+			attr.Error(err))
+	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.EditorRequired, RequiresSetup: true})
 }
@@ -0,0 +1,190 @@
+package updateround
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+const (
+	seriesEditPrefix    = "round_series_edit|"
+	seriesEditKeyPrefix = "round_series_edit:"
+)
+
+// seriesLookupFailedNote tells the user later rounds were left alone because
+// the series could not be looked up.
+const seriesLookupFailedNote = "\n⚠️ Couldn't check whether this round is part of a series, so any later rounds were left as they are."
+
+// seriesEdit is an edit to one round of a series, held until the editor
+// chooses whether to apply it to the later rounds too.
+type seriesEdit struct {
+	RoundID     sharedtypes.RoundID
+	Title       string
+	Description string
+	Location    string
+	Timezone    string
+	// From and To are the edited round's old and new start times. They are
+	// zero when the start time was not changed, or not given as
+	// roundseries.StartTimeLayout, in which case later rounds keep theirs.
+	From  time.Time
+	To    time.Time
+	Later []roundseries.Occurrence
+}
+
+// SetSeriesStore sets the store used to find the rest of a round's series.
+func (urm *updateRoundManager) SetSeriesStore(store roundseries.Store) {
+	if urm == nil {
+		return
+	}
+	urm.seriesStore = store
+}
+
+// prepareSeriesEdit records an edit to a round that has later rounds in its
+// series and returns the button offering to apply it to them. It returns nil
+// when the round is not part of a series, and a note saying so when the
+// series could not be looked up.
+func (urm *updateRoundManager) prepareSeriesEdit(ctx context.Context, guildID string, roundID sharedtypes.RoundID, title, description, startTime, timezone, location string) (string, []discordgo.MessageComponent) {
+	if urm.seriesStore == nil || urm.interactionStore == nil {
+		return "", nil
+	}
+	series, ok, err := urm.seriesStore.Lookup(ctx, sharedtypes.GuildID(guildID), roundID)
+	if err != nil {
+		urm.logger.WarnContext(ctx, "Failed to look up round series",
+			attr.String("round_id", roundID.String()),
+			attr.Error(err))
+		return seriesLookupFailedNote, nil
+	}
+	if !ok {
+		return "", nil
+	}
+	current, ok := series.Find(roundID)
+	if !ok {
+		return "", nil
+	}
+	later := series.Later(current.StartTime)
+	if len(later) == 0 {
+		return "", nil
+	}
+
+	edit := seriesEdit{
+		RoundID:     roundID,
+		Title:       title,
+		Description: description,
+		Location:    location,
+		Timezone:    timezone,
+		Later:       later,
+	}
+	if startTime != "" {
+		if loc, err := time.LoadLocation(timezone); err == nil {
+			if to, err := time.ParseInLocation(roundseries.StartTimeLayout, startTime, loc); err == nil {
+				edit.From, edit.To = current.StartTime, to
+			}
+		}
+	}
+
+	token := uuid.NewString()
+	if err := urm.interactionStore.Set(ctx, seriesEditKeyPrefix+token, edit); err != nil {
+		urm.logger.WarnContext(ctx, "Failed to store series edit", attr.Error(err))
+		return "", nil
+	}
+
+	return "", []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    fmt.Sprintf("Apply to %s in this series", roundseries.LaterRounds(len(later))),
+				Style:    discordgo.SecondaryButton,
+				CustomID: seriesEditPrefix + token,
+			},
+		}},
+	}
+}
+
+// HandleApplySeriesEditButton repeats an edit on the later rounds of the
+// edited round's series.
+func (urm *updateRoundManager) HandleApplySeriesEditButton(ctx context.Context, i *discordgo.InteractionCreate) (UpdateRoundOperationResult, error) {
+	if i == nil || i.Interaction == nil {
+		err := fmt.Errorf("interaction is nil or incomplete")
+		return UpdateRoundOperationResult{Error: err}, err
+	}
+
+	userID := getUserIDFromInteraction(i)
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, userID)
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_apply_series_edit")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.GuildIDKey, i.GuildID)
+
+	return urm.operationWrapper(ctx, "handle_apply_series_edit", func(ctx context.Context) (UpdateRoundOperationResult, error) {
+		key := seriesEditKeyPrefix + strings.TrimPrefix(i.MessageComponentData().CustomID, seriesEditPrefix)
+		stored, err := urm.interactionStore.Get(ctx, key)
+		if err != nil {
+			urm.respondSeriesEdit(i, "This series edit has expired. Edit the later rounds individually.")
+			return UpdateRoundOperationResult{Failure: "series edit expired"}, nil
+		}
+		edit, ok := stored.(seriesEdit)
+		if !ok {
+			err := fmt.Errorf("unexpected series edit type %T", stored)
+			return UpdateRoundOperationResult{Error: err}, err
+		}
+		urm.interactionStore.Delete(ctx, key)
+
+		for _, occurrence := range edit.Later {
+			payload := discordroundevents.RoundUpdateModalSubmittedPayloadV1{
+				GuildID:     sharedtypes.GuildID(i.GuildID),
+				RoundID:     occurrence.RoundID,
+				UserID:      sharedtypes.DiscordID(userID),
+				ChannelID:   occurrence.ChannelID,
+				MessageID:   occurrence.MessageID,
+				Title:       optionalTitle(edit.Title),
+				Description: optionalDescription(edit.Description),
+				Location:    optionalLocation(edit.Location),
+				Timezone:    optionalTimezone(edit.Timezone),
+			}
+
+			rawStartTime := ""
+			if !edit.To.IsZero() {
+				start := roundseries.Shift(occurrence.StartTime, edit.From, edit.To)
+				rawStartTime = start.Format(roundseries.StartTimeLayout)
+				payload.StartTime = &rawStartTime
+			}
+
+			msg, err := urm.createEvent(ctx, discordroundevents.RoundUpdateModalSubmittedV1, payload, i)
+			if err != nil {
+				return UpdateRoundOperationResult{Error: err}, err
+			}
+			msg.Metadata.Set("submitted_at", time.Now().UTC().Format(time.RFC3339))
+			msg.Metadata.Set("user_id", userID)
+			msg.Metadata.Set("user_timezone", edit.Timezone)
+			msg.Metadata.Set("raw_start_time", rawStartTime)
+
+			if err := urm.publisher.Publish(discordroundevents.RoundUpdateModalSubmittedV1, msg); err != nil {
+				return UpdateRoundOperationResult{Error: err}, err
+			}
+		}
+
+		urm.logger.InfoContext(ctx, "Series edit applied",
+			attr.RoundID("round_id", edit.RoundID),
+			attr.Int("later_rounds", len(edit.Later)))
+
+		urm.respondSeriesEdit(i, fmt.Sprintf("Round update request received. Applying it to %s in this series.", roundseries.LaterRounds(len(edit.Later))))
+		return UpdateRoundOperationResult{Success: "series edit published"}, nil
+	})
+}
+
+func (urm *updateRoundManager) respondSeriesEdit(i *discordgo.InteractionCreate, content string) {
+	_ = urm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+}
@@ -0,0 +1,120 @@
+package updateround
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+func TestUpdateRoundManager_SeriesEditAppliesToLaterRounds(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	editedRound := sharedtypes.RoundID(uuid.MustParse("550e8400-e29b-41d4-a716-446655440000"))
+	laterRounds := []sharedtypes.RoundID{sharedtypes.RoundID(uuid.New()), sharedtypes.RoundID(uuid.New())}
+
+	first := time.Date(2025, time.May, 1, 18, 0, 0, 0, loc)
+	starts := roundseries.Occurrences(first, roundseries.RecurrenceWeekly, first.AddDate(0, 0, 14))
+	rounds := append([]sharedtypes.RoundID{editedRound}, laterRounds...)
+	messages := []string{"message-123", "message-456", "message-789"}
+	series := roundseries.Series{ID: "series-1", GuildID: "test-guild", Title: "League Night"}
+	for idx, start := range starts {
+		series.Occurrences = append(series.Occurrences, roundseries.Occurrence{StartTime: start, RoundID: rounds[idx], ChannelID: "events", MessageID: messages[idx]})
+	}
+	store := &roundseries.FakeStore{Series: []roundseries.Series{series}}
+
+	fakeSession := discord.NewFakeSession()
+	var responses []*discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		responses = append(responses, r)
+		return nil
+	}
+
+	fakePublisher := &testutils.FakeEventBus{}
+	var published []discordroundevents.RoundUpdateModalSubmittedPayloadV1
+	fakePublisher.PublishFunc = func(topic string, msgs ...*message.Message) error {
+		var payload discordroundevents.RoundUpdateModalSubmittedPayloadV1
+		if err := json.Unmarshal(msgs[0].Payload, &payload); err != nil {
+			t.Fatalf("failed to unmarshal payload: %v", err)
+		}
+		published = append(published, payload)
+		return nil
+	}
+
+	urm := &updateRoundManager{
+		session:          fakeSession,
+		publisher:        fakePublisher,
+		logger:           loggerfrolfbot.NoOpLogger,
+		interactionStore: testutils.NewFakeStorage[any](),
+		operationWrapper: testOperationWrapper,
+		seriesStore:      store,
+	}
+
+	// Move the first round a day later and half an hour earlier.
+	submit := createTestUpdateInteraction("", "", "2025-05-02 17:30", "America/Chicago", "New Park")
+	if _, err := urm.HandleUpdateRoundModalSubmit(context.Background(), submit); err != nil {
+		t.Fatalf("HandleUpdateRoundModalSubmit() error = %v", err)
+	}
+	if len(published) != 1 {
+		t.Fatalf("expected only the edited round to be published, got %d", len(published))
+	}
+
+	ack := responses[0].Data
+	if len(ack.Components) != 1 {
+		t.Fatalf("expected a series edit button, got %+v", ack.Components)
+	}
+	button := ack.Components[0].(discordgo.ActionsRow).Components[0].(discordgo.Button)
+	if button.Label != "Apply to the 2 later rounds in this series" {
+		t.Fatalf("unexpected button label %q", button.Label)
+	}
+
+	click := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		GuildID: "test-guild",
+		Type:    discordgo.InteractionMessageComponent,
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "user-123"}},
+		Data:    discordgo.MessageComponentInteractionData{CustomID: button.CustomID},
+	}}
+	result, err := urm.HandleApplySeriesEditButton(context.Background(), click)
+	if err != nil || result.Success != "series edit published" {
+		t.Fatalf("HandleApplySeriesEditButton() = %+v, %v", result, err)
+	}
+
+	if len(published) != 3 {
+		t.Fatalf("expected 2 more update requests, got %d", len(published)-1)
+	}
+	wantStarts := []string{"2025-05-09 17:30", "2025-05-16 17:30"}
+	for idx, payload := range published[1:] {
+		if payload.RoundID != laterRounds[idx] {
+			t.Errorf("update %d targets %s, want %s", idx, payload.RoundID, laterRounds[idx])
+		}
+		if payload.StartTime == nil || *payload.StartTime != wantStarts[idx] {
+			t.Errorf("update %d start time = %v, want %s", idx, payload.StartTime, wantStarts[idx])
+		}
+		if payload.Location == nil || string(*payload.Location) != "New Park" {
+			t.Errorf("update %d location = %v, want New Park", idx, payload.Location)
+		}
+		if payload.Title != nil {
+			t.Errorf("update %d should leave the title alone, got %v", idx, *payload.Title)
+		}
+	}
+
+	if _, err := urm.HandleApplySeriesEditButton(context.Background(), click); err != nil {
+		t.Fatalf("second click error = %v", err)
+	}
+	if len(published) != 3 {
+		t.Fatal("expected the series edit to apply only once")
+	}
+}
@@ -0,0 +1,132 @@
+package updateround
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	roundtime "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_time"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+)
+
+const (
+	startTimeConfirmPrefix = "update_round_time_confirm|"
+	startTimeAdjustPrefix  = "update_round_time_adjust|"
+	startTimeKeyPrefix     = "update_round_time:"
+)
+
+// sendStartTimePreview holds an update whose start time was typed as a
+// phrase and asks the editor to confirm or adjust the time it resolved to.
+func (urm *updateRoundManager) sendStartTimePreview(ctx context.Context, i *discordgo.InteractionCreate, submission updateSubmission, start time.Time) (UpdateRoundOperationResult, error) {
+	token := uuid.NewString()
+	if err := urm.interactionStore.Set(ctx, startTimeKeyPrefix+token, submission); err != nil {
+		err = fmt.Errorf("failed to store start time preview: %w", err)
+		return UpdateRoundOperationResult{Error: err}, err
+	}
+
+	err := urm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content:    roundtime.PreviewContent(submission.RawStartTime, start),
+			Flags:      discordgo.MessageFlagsEphemeral,
+			Components: roundtime.PreviewComponents(startTimeConfirmPrefix+token, startTimeAdjustPrefix+token),
+		},
+	})
+	if err != nil {
+		return UpdateRoundOperationResult{Error: err}, err
+	}
+	return UpdateRoundOperationResult{Success: "start time preview sent"}, nil
+}
+
+// HandleStartTimeConfirmButton publishes the update held by a start time
+// preview.
+func (urm *updateRoundManager) HandleStartTimeConfirmButton(ctx context.Context, i *discordgo.InteractionCreate) (UpdateRoundOperationResult, error) {
+	if i == nil || i.Interaction == nil {
+		err := fmt.Errorf("interaction is nil or incomplete")
+		return UpdateRoundOperationResult{Error: err}, err
+	}
+
+	userID := getUserIDFromInteraction(i)
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.UserIDKey, userID)
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_update_round_time_confirm")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.GuildIDKey, i.GuildID)
+
+	return urm.operationWrapper(ctx, "handle_update_round_time_confirm", func(ctx context.Context) (UpdateRoundOperationResult, error) {
+		key := startTimeKeyPrefix + strings.TrimPrefix(i.MessageComponentData().CustomID, startTimeConfirmPrefix)
+		submission, ok := urm.heldSubmission(ctx, i, key)
+		if !ok {
+			return UpdateRoundOperationResult{Failure: "start time preview expired"}, nil
+		}
+		urm.interactionStore.Delete(ctx, key)
+
+		urm.logger.InfoContext(ctx, "Start time confirmed",
+			attr.RoundID("round_id", submission.RoundID),
+			attr.String("raw_start_time", submission.RawStartTime),
+			attr.String("start_time", submission.StartTime))
+		return urm.submitUpdate(ctx, i, userID, submission, discordgo.InteractionResponseUpdateMessage)
+	})
+}
+
+// HandleStartTimeAdjustButton reopens the update round modal with a start
+// time preview's values so the editor can correct them.
+func (urm *updateRoundManager) HandleStartTimeAdjustButton(ctx context.Context, i *discordgo.InteractionCreate) (UpdateRoundOperationResult, error) {
+	if i == nil || i.Interaction == nil {
+		err := fmt.Errorf("interaction is nil or incomplete")
+		return UpdateRoundOperationResult{Error: err}, err
+	}
+
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "handle_update_round_time_adjust")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "button")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.GuildIDKey, i.GuildID)
+
+	return urm.operationWrapper(ctx, "handle_update_round_time_adjust", func(ctx context.Context) (UpdateRoundOperationResult, error) {
+		key := startTimeKeyPrefix + strings.TrimPrefix(i.MessageComponentData().CustomID, startTimeAdjustPrefix)
+		submission, ok := urm.heldSubmission(ctx, i, key)
+		if !ok {
+			return UpdateRoundOperationResult{Failure: "start time preview expired"}, nil
+		}
+		urm.interactionStore.Delete(ctx, key)
+
+		customID := fmt.Sprintf("update_round_modal|%s|%s", submission.RoundID, submission.MessageID)
+		err := urm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseModal,
+			Data: updateRoundModal(customID, map[string]string{
+				"title":       submission.Title,
+				"description": submission.Description,
+				"start_time":  submission.StartTime,
+				"timezone":    submission.Timezone,
+				"location":    submission.Location,
+			}),
+		})
+		if err != nil {
+			return UpdateRoundOperationResult{Error: err}, err
+		}
+		return UpdateRoundOperationResult{Success: "modal sent"}, nil
+	})
+}
+
+// heldSubmission loads the update behind a start time preview, telling the
+// editor when it has expired.
+func (urm *updateRoundManager) heldSubmission(ctx context.Context, i *discordgo.InteractionCreate, key string) (updateSubmission, bool) {
+	stored, err := urm.interactionStore.Get(ctx, key)
+	if err == nil {
+		if submission, ok := stored.(updateSubmission); ok {
+			return submission, true
+		}
+		urm.logger.WarnContext(ctx, "Unexpected start time preview type", attr.String("type", fmt.Sprintf("%T", stored)))
+	}
+
+	_ = urm.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    "This start time preview has expired. Edit the round again.",
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+	return updateSubmission{}, false
+}
@@ -0,0 +1,146 @@
+package updateround
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	roundtime "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_time"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
+	loggerfrolfbot "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/logging"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/bwmarrin/discordgo"
+)
+
+func Test_updateRoundManager_StartTimePreview(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	tomorrow := time.Now().In(loc).AddDate(0, 0, 1)
+	wantStart := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 18, 0, 0, 0, loc).Format(roundtime.Layout)
+
+	fakeSession := discord.NewFakeSession()
+	var responses []*discordgo.InteractionResponse
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		responses = append(responses, r)
+		return nil
+	}
+	var published []*message.Message
+	fakePublisher := &testutils.FakeEventBus{}
+	fakePublisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		published = append(published, messages...)
+		return nil
+	}
+
+	urm := &updateRoundManager{
+		session:          fakeSession,
+		publisher:        fakePublisher,
+		logger:           loggerfrolfbot.NoOpLogger,
+		helper:           &testutils.FakeHelpers{},
+		config:           &config.Config{},
+		interactionStore: testutils.NewFakeStorage[any](),
+		operationWrapper: testOperationWrapper,
+	}
+
+	submit := func() []discordgo.MessageComponent {
+		t.Helper()
+		responses = nil
+		result, err := urm.HandleUpdateRoundModalSubmit(context.Background(), createTestUpdateInteraction("", "", "tomorrow 6pm", "", ""))
+		if err != nil || result.Success != "start time preview sent" {
+			t.Fatalf("HandleUpdateRoundModalSubmit() = %+v, %v", result, err)
+		}
+		if len(published) != 0 {
+			t.Fatal("expected nothing to be published before the start time is confirmed")
+		}
+		if !strings.Contains(responses[0].Data.Content, `Read from "tomorrow 6pm" in America/Chicago`) {
+			t.Fatalf("unexpected preview %q", responses[0].Data.Content)
+		}
+		return responses[0].Data.Components[0].(discordgo.ActionsRow).Components
+	}
+	click := func(button discordgo.MessageComponent) *discordgo.InteractionCreate {
+		return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			ID:        "button-interaction",
+			GuildID:   "test-guild",
+			ChannelID: "channel-1",
+			Type:      discordgo.InteractionMessageComponent,
+			Member:    &discordgo.Member{User: &discordgo.User{ID: "user-123"}},
+			Data:      discordgo.MessageComponentInteractionData{CustomID: button.(discordgo.Button).CustomID},
+		}}
+	}
+
+	t.Run("adjust reopens the modal", func(t *testing.T) {
+		buttons := submit()
+		responses = nil
+		if _, err := urm.HandleStartTimeAdjustButton(context.Background(), click(buttons[1])); err != nil {
+			t.Fatalf("HandleStartTimeAdjustButton() error = %v", err)
+		}
+		modal := responses[0]
+		if modal.Type != discordgo.InteractionResponseModal || modal.Data.CustomID != "update_round_modal|550e8400-e29b-41d4-a716-446655440000|message-123" {
+			t.Fatalf("expected the update round modal, got %+v", modal)
+		}
+		input := modal.Data.Components[2].(discordgo.ActionsRow).Components[0].(discordgo.TextInput)
+		if input.Value != wantStart {
+			t.Fatalf("start time input = %q, want %q", input.Value, wantStart)
+		}
+	})
+
+	t.Run("confirm publishes the resolved time", func(t *testing.T) {
+		buttons := submit()
+		responses = nil
+		if _, err := urm.HandleStartTimeConfirmButton(context.Background(), click(buttons[0])); err != nil {
+			t.Fatalf("HandleStartTimeConfirmButton() error = %v", err)
+		}
+		if responses[0].Type != discordgo.InteractionResponseUpdateMessage || responses[0].Data.Content != "Round update request received." {
+			t.Fatalf("expected the preview to become the ack, got %+v", responses[0])
+		}
+		if len(published) != 1 {
+			t.Fatalf("expected one update request, got %d", len(published))
+		}
+
+		var payload discordroundevents.RoundUpdateModalSubmittedPayloadV1
+		if err := json.Unmarshal(published[0].Payload, &payload); err != nil {
+			t.Fatalf("failed to unmarshal payload: %v", err)
+		}
+		if payload.StartTime == nil || *payload.StartTime != wantStart || payload.MessageID != "message-123" {
+			t.Fatalf("unexpected payload %+v", payload)
+		}
+		if got := published[0].Metadata.Get("raw_start_time"); got != wantStart {
+			t.Fatalf("raw_start_time = %q, want %q", got, wantStart)
+		}
+	})
+}
+
+func Test_updateRoundManager_HandleUpdateRoundModalSubmit_UnrecognisedStartTime(t *testing.T) {
+	fakeSession := discord.NewFakeSession()
+	var content string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		content = r.Data.Content
+		return nil
+	}
+	fakePublisher := &testutils.FakeEventBus{}
+	fakePublisher.PublishFunc = func(topic string, messages ...*message.Message) error {
+		t.Fatal("expected an unrecognised start time not to publish")
+		return nil
+	}
+
+	urm := &updateRoundManager{
+		session:          fakeSession,
+		publisher:        fakePublisher,
+		logger:           loggerfrolfbot.NoOpLogger,
+		interactionStore: testutils.NewFakeStorage[any](),
+		operationWrapper: testOperationWrapper,
+	}
+
+	if _, err := urm.HandleUpdateRoundModalSubmit(context.Background(), createTestUpdateInteraction("", "", "after work", "", "")); err != nil {
+		t.Fatalf("HandleUpdateRoundModalSubmit() error = %v", err)
+	}
+	if content != roundtime.ErrUnrecognised.Error() {
+		t.Fatalf("unexpected response %q", content)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
@@ -33,6 +34,9 @@ type UpdateRoundManager interface {
 	SendUpdateRoundModal(ctx context.Context, i *discordgo.InteractionCreate, roundID sharedtypes.RoundID) (UpdateRoundOperationResult, error)
 	HandleUpdateRoundModalSubmit(ctx context.Context, i *discordgo.InteractionCreate) (UpdateRoundOperationResult, error)
 	HandleUpdateRoundModalCancel(ctx context.Context, i *discordgo.InteractionCreate) (UpdateRoundOperationResult, error)
+	HandleApplySeriesEditButton(ctx context.Context, i *discordgo.InteractionCreate) (UpdateRoundOperationResult, error)
+	HandleStartTimeConfirmButton(ctx context.Context, i *discordgo.InteractionCreate) (UpdateRoundOperationResult, error)
+	HandleStartTimeAdjustButton(ctx context.Context, i *discordgo.InteractionCreate) (UpdateRoundOperationResult, error)
 }
 
 type updateRoundManager struct {
@@ -47,6 +51,7 @@ type updateRoundManager struct {
 	metrics             discordmetrics.DiscordMetrics
 	operationWrapper    func(ctx context.Context, opName string, fn func(ctx context.Context) (UpdateRoundOperationResult, error)) (UpdateRoundOperationResult, error)
 	guildConfigResolver guildconfig.GuildConfigResolver
+	seriesStore         roundseries.Store
 }
 
 // NewUpdateRoundManager creates a new UpdateRoundManager instance.
@@ -11,6 +11,7 @@ import (
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/guildconfig"
 	rounddiscord "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord"
+	scoreround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_round"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
@@ -29,6 +30,7 @@ type ScheduledEventRSVPListener struct {
 	nativeEventMap        rounddiscord.NativeEventMap
 	messageMap            rounddiscord.MessageMap
 	pendingNativeEventMap rounddiscord.PendingNativeEventMap
+	scoreRound            scoreround.ScoreRoundManager
 	session               discord.Session
 	config                *config.Config
 	guildConfig           guildconfig.GuildConfigResolver
@@ -55,6 +57,7 @@ func NewScheduledEventRSVPListener(
 	nativeEventMap rounddiscord.NativeEventMap,
 	messageMap rounddiscord.MessageMap,
 	pendingNativeEventMap rounddiscord.PendingNativeEventMap,
+	scoreRound scoreround.ScoreRoundManager,
 	session discord.Session,
 	cfg *config.Config,
 	guildConfig guildconfig.GuildConfigResolver,
@@ -66,6 +69,7 @@ func NewScheduledEventRSVPListener(
 		nativeEventMap:        nativeEventMap,
 		messageMap:            messageMap,
 		pendingNativeEventMap: pendingNativeEventMap,
+		scoreRound:            scoreRound,
 		session:               session,
 		config:                cfg,
 		guildConfig:           guildConfig,
@@ -414,6 +418,9 @@ func (l *ScheduledEventRSVPListener) handleEventCompleted(event *discordgo.Guild
 	if !ok {
 		return
 	}
+	if l.holdFinalization(guildID, roundID) {
+		return
+	}
 
 	payload := roundevents.RoundFinalizeRequestedPayloadV1{
 		GuildID: guildID,
@@ -580,6 +587,35 @@ func (l *ScheduledEventRSVPListener) handleEventFieldUpdate(event *discordgo.Gui
 		attr.String("round_id", roundID.String()))
 }
 
+// holdFinalization reports whether the round's finalization is held back
+// because scores on its scorecard are still waiting to be confirmed. The
+// scorecard requests the finalization once they're settled.
+func (l *ScheduledEventRSVPListener) holdFinalization(guildID sharedtypes.GuildID, roundID sharedtypes.RoundID) bool {
+	if l.scoreRound == nil {
+		return false
+	}
+	messageID, ok := l.messageMap.Load(roundID)
+	if !ok {
+		return false
+	}
+
+	ctx := context.Background()
+	channelID := l.resolveEventChannel(ctx, string(guildID))
+	held, err := l.scoreRound.HoldFinalization(ctx, guildID, roundID, channelID, messageID)
+	if err != nil {
+		l.logger.Warn("Failed to hold finalization for held scores",
+			attr.String("guild_id", string(guildID)),
+			attr.String("round_id", roundID.String()),
+			attr.Error(err))
+	}
+	if held {
+		l.logger.Info("Holding round finalization for held scores",
+			attr.String("guild_id", string(guildID)),
+			attr.String("round_id", roundID.String()))
+	}
+	return held
+}
+
 // resolveRoundID resolves a DiscordEventID to a RoundID.
 // First checks the in-memory NativeEventMap, then falls back to a NATS
 // request-reply via the event bus (for post-restart scenarios).
@@ -94,7 +94,10 @@ func (h *RoundHandlers) HandleRoundCreated(ctx context.Context, payload *roundev
 	discordEventID, nativeResults := h.resolveNativeEvent(ctx, payload)
 	results = append(results, nativeResults...)
 
-	// 2. Send the Embed (Wait for this to complete before sending URL)
+	// 2. Send the Embed (Wait for this to complete before sending URL). The
+	// created event carries the create request's correlation ID, which its
+	// embed options were kept under.
+	correlationID, _ := ctx.Value("correlation_id").(string)
 	sendResult, err := h.service.GetCreateRoundManager().SendRoundEventEmbed(
 		guildID,
 		channelID,
@@ -104,6 +107,7 @@ func (h *RoundHandlers) HandleRoundCreated(ctx context.Context, payload *roundev
 		roundtypes.Location(location),
 		sharedtypes.DiscordID(payload.UserID),
 		roundID,
+		h.service.GetCreateRoundManager().EmbedOptions(ctx, correlationID),
 	)
 	if err != nil {
 		if discordEventID != "" {
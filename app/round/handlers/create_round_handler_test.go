@@ -11,6 +11,7 @@ import (
 	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	rounddiscord "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord"
 	createround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/create_round"
+	roundcards "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_cards"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	discordroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
 	sharedroundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/discord/round"
@@ -144,7 +145,7 @@ func TestRoundHandlers_HandleRoundCreated(t *testing.T) {
 						},
 					}, nil
 				}
-				f.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+				f.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 					return createround.CreateRoundOperationResult{
 						Success: &discordgo.Message{
 							ID:        "discord-message-123",
@@ -183,7 +184,7 @@ func TestRoundHandlers_HandleRoundCreated(t *testing.T) {
 						},
 					}, nil
 				}
-				f.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+				f.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 					return createround.CreateRoundOperationResult{}, errors.New("failed to send round event embed")
 				}
 			},
@@ -211,7 +212,7 @@ func TestRoundHandlers_HandleRoundCreated(t *testing.T) {
 				f.CreateRoundManager.CreateNativeEventFunc = func(ctx context.Context, guildID string, roundID sharedtypes.RoundID, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, userID sharedtypes.DiscordID) (createround.CreateRoundOperationResult, error) {
 					return createround.CreateRoundOperationResult{Error: errors.New("discord api failed")}, nil
 				}
-				f.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+				f.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 					return createround.CreateRoundOperationResult{
 						Success: &discordgo.Message{
 							ID:        "discord-message-123",
@@ -247,7 +248,7 @@ func TestRoundHandlers_HandleRoundCreated(t *testing.T) {
 						},
 					}, nil
 				}
-				f.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+				f.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 					return createround.CreateRoundOperationResult{
 						Success: &discordgo.Message{
 							ID:        "discord-message-123",
@@ -339,7 +340,7 @@ func TestRoundHandlers_HandleRoundCreated_DoesNotPublishChallengeLinkRequestWith
 			Success: &discordgo.GuildScheduledEvent{ID: "native-event-123"},
 		}, nil
 	}
-	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 		return createround.CreateRoundOperationResult{
 			Success: &discordgo.Message{ID: "discord-message-123", ChannelID: channelID},
 		}, nil
@@ -392,7 +393,7 @@ func TestRoundHandlers_HandleRoundCreated_DoesNotRequireChallengeScheduleLookup(
 		createNativeEventCalled = true
 		return createround.CreateRoundOperationResult{}, nil
 	}
-	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 		sendRoundEventEmbedCalled = true
 		return createround.CreateRoundOperationResult{
 			Success: &discordgo.Message{ID: "discord-message-456", ChannelID: channelID},
@@ -446,7 +447,7 @@ func TestRoundHandlers_HandleRoundCreated_DoesNotPublishChallengeLinkRequestFrom
 			Success: &discordgo.GuildScheduledEvent{ID: "native-event-123"},
 		}, nil
 	}
-	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 		return createround.CreateRoundOperationResult{
 			Success: &discordgo.Message{ID: "discord-message-123", ChannelID: channelID},
 		}, nil
@@ -510,7 +511,7 @@ func TestRoundHandlers_HandleRoundCreated_RetriesEmbedAfterNativeEventSuccess(t
 			},
 		}, nil
 	}
-	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 		sendEmbedCalls++
 		if sendEmbedCalls == 1 {
 			return createround.CreateRoundOperationResult{}, errors.New("discord transient failure")
@@ -621,7 +622,7 @@ func TestRoundHandlers_HandleRoundCreated_ReusesPendingNativeEvent(t *testing.T)
 		createNativeCalled = true
 		return createround.CreateRoundOperationResult{}, nil
 	}
-	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 		return createround.CreateRoundOperationResult{
 			Success: &discordgo.Message{
 				ID:        "discord-message-123",
@@ -838,3 +839,53 @@ func TestRoundHandlers_HandleRoundValidationFailed(t *testing.T) {
 		})
 	}
 }
+
+func TestRoundHandlers_HandleRoundCreated_PassesCreateRequestOptions(t *testing.T) {
+	parsedTime, _ := time.Parse(time.RFC3339, "2024-01-01T12:00:00Z")
+	startTime := sharedtypes.StartTime(parsedTime)
+
+	fakeRoundDiscord := &FakeRoundDiscord{}
+	want := createround.RoundEmbedOptions{MaxPlayers: 20, CardMode: roundcards.ModeBalanced}
+	fakeRoundDiscord.CreateRoundManager.EmbedOptionsFunc = func(ctx context.Context, correlationID string) createround.RoundEmbedOptions {
+		if correlationID != "create-correlation-1" {
+			t.Errorf("embed options looked up under %q, want the create request's correlation ID", correlationID)
+			return createround.RoundEmbedOptions{}
+		}
+		return want
+	}
+	var got createround.RoundEmbedOptions
+	fakeRoundDiscord.CreateRoundManager.SendRoundEventEmbedFunc = func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
+		got = options
+		return createround.CreateRoundOperationResult{
+			Success: &discordgo.Message{ID: "discord-message-123", ChannelID: channelID},
+		}, nil
+	}
+
+	h := NewRoundHandlers(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		&config.Config{},
+		nil,
+		fakeRoundDiscord,
+		nil,
+	)
+
+	// The created event carries the create request's correlation ID, as the
+	// create failure events do.
+	ctx := context.WithValue(context.Background(), "correlation_id", "create-correlation-1")
+	if _, err := h.HandleRoundCreated(ctx, &roundevents.RoundCreatedPayloadV1{
+		GuildID: "guild-1",
+		BaseRoundPayload: roundtypes.BaseRoundPayload{
+			RoundID:   sharedtypes.RoundID(uuid.New()),
+			Title:     roundtypes.Title("League Night"),
+			StartTime: &startTime,
+			UserID:    sharedtypes.DiscordID("creator-1"),
+		},
+		ChannelID: "channel-1",
+	}); err != nil {
+		t.Fatalf("HandleRoundCreated() error = %v", err)
+	}
+
+	if got != want {
+		t.Fatalf("embed options = %+v, want %+v", got, want)
+	}
+}
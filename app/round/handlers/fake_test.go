@@ -11,6 +11,8 @@ import (
 	finalizeround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/finalize_round"
 	roundreminder "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_reminder"
 	roundrsvp "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_rsvp"
+	roundseries "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_series"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
 	scoreround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_round"
 	scorecardupload "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/scorecard_upload"
 	startround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/start_round"
@@ -39,6 +41,8 @@ type FakeRoundDiscord struct {
 	GetNativeEventMapFunc         func() rounddiscord.NativeEventMap
 	GetPendingNativeEventMapFunc  func() rounddiscord.PendingNativeEventMap
 	GetMessageMapFunc             func() rounddiscord.MessageMap
+	GetRoundSeriesStoreFunc       func() roundseries.Store
+	GetScoreAuditLogFunc          func() scoreaudit.Log
 
 	// Holds the sub-fakes
 	CreateRoundManager     FakeCreateRoundManager
@@ -55,6 +59,7 @@ type FakeRoundDiscord struct {
 	NativeEventMap         rounddiscord.NativeEventMap
 	PendingNativeEventMap  rounddiscord.PendingNativeEventMap
 	MessageMap             FakeMessageMap
+	RoundSeriesStore       roundseries.Store
 }
 
 func (f *FakeRoundDiscord) GetCreateRoundManager() createround.CreateRoundManager {
@@ -157,6 +162,23 @@ func (f *FakeRoundDiscord) GetPendingNativeEventMap() rounddiscord.PendingNative
 	return f.PendingNativeEventMap
 }
 
+func (f *FakeRoundDiscord) GetRoundSeriesStore() roundseries.Store {
+	if f.GetRoundSeriesStoreFunc != nil {
+		return f.GetRoundSeriesStoreFunc()
+	}
+	if f.RoundSeriesStore == nil {
+		f.RoundSeriesStore = &roundseries.FakeStore{}
+	}
+	return f.RoundSeriesStore
+}
+
+func (f *FakeRoundDiscord) GetScoreAuditLog() scoreaudit.Log {
+	if f.GetScoreAuditLogFunc != nil {
+		return f.GetScoreAuditLogFunc()
+	}
+	return nil
+}
+
 func (f *FakeRoundDiscord) GetMessageMap() rounddiscord.MessageMap {
 	if f.GetMessageMapFunc != nil {
 		return f.GetMessageMapFunc()
@@ -197,10 +219,14 @@ type FakeCreateRoundManager struct {
 	UpdateInteractionResponseFunc                func(ctx context.Context, correlationID, message string, edit ...*discordgo.WebhookEdit) (createround.CreateRoundOperationResult, error)
 	UpdateInteractionResponseWithRetryButtonFunc func(ctx context.Context, correlationID, message string) (createround.CreateRoundOperationResult, error)
 	HandleCreateRoundModalCancelFunc             func(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error)
-	SendRoundEventEmbedFunc                      func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error)
+	SendRoundEventEmbedFunc                      func(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error)
+	EmbedOptionsFunc                             func(ctx context.Context, correlationID string) createround.RoundEmbedOptions
 	SendRoundEventURLFunc                        func(guildID string, channelID string, eventID string) (createround.CreateRoundOperationResult, error)
 	SendCreateRoundModalFunc                     func(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error)
 	HandleRetryCreateRoundFunc                   func(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error)
+	HandleStartTimeConfirmButtonFunc             func(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error)
+	HandleStartTimeAdjustButtonFunc              func(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error)
+	HandleRunItBackButtonFunc                    func(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error)
 	CreateNativeEventFunc                        func(ctx context.Context, guildID string, roundID sharedtypes.RoundID, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, userID sharedtypes.DiscordID) (createround.CreateRoundOperationResult, error)
 }
 
@@ -239,13 +265,20 @@ func (f *FakeCreateRoundManager) HandleCreateRoundModalCancel(ctx context.Contex
 	return createround.CreateRoundOperationResult{}, nil
 }
 
-func (f *FakeCreateRoundManager) SendRoundEventEmbed(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID) (createround.CreateRoundOperationResult, error) {
+func (f *FakeCreateRoundManager) SendRoundEventEmbed(guildID string, channelID string, title roundtypes.Title, description roundtypes.Description, startTime sharedtypes.StartTime, location roundtypes.Location, creatorID sharedtypes.DiscordID, roundID sharedtypes.RoundID, options createround.RoundEmbedOptions) (createround.CreateRoundOperationResult, error) {
 	if f.SendRoundEventEmbedFunc != nil {
-		return f.SendRoundEventEmbedFunc(guildID, channelID, title, description, startTime, location, creatorID, roundID)
+		return f.SendRoundEventEmbedFunc(guildID, channelID, title, description, startTime, location, creatorID, roundID, options)
 	}
 	return createround.CreateRoundOperationResult{}, nil
 }
 
+func (f *FakeCreateRoundManager) EmbedOptions(ctx context.Context, correlationID string) createround.RoundEmbedOptions {
+	if f.EmbedOptionsFunc != nil {
+		return f.EmbedOptionsFunc(ctx, correlationID)
+	}
+	return createround.RoundEmbedOptions{}
+}
+
 func (f *FakeCreateRoundManager) SendCreateRoundModal(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error) {
 	if f.SendCreateRoundModalFunc != nil {
 		return f.SendCreateRoundModalFunc(ctx, i)
@@ -260,6 +293,27 @@ func (f *FakeCreateRoundManager) HandleRetryCreateRound(ctx context.Context, i *
 	return createround.CreateRoundOperationResult{}, nil
 }
 
+func (f *FakeCreateRoundManager) HandleStartTimeConfirmButton(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error) {
+	if f.HandleStartTimeConfirmButtonFunc != nil {
+		return f.HandleStartTimeConfirmButtonFunc(ctx, i)
+	}
+	return createround.CreateRoundOperationResult{}, nil
+}
+
+func (f *FakeCreateRoundManager) HandleStartTimeAdjustButton(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error) {
+	if f.HandleStartTimeAdjustButtonFunc != nil {
+		return f.HandleStartTimeAdjustButtonFunc(ctx, i)
+	}
+	return createround.CreateRoundOperationResult{}, nil
+}
+
+func (f *FakeCreateRoundManager) HandleRunItBackButton(ctx context.Context, i *discordgo.InteractionCreate) (createround.CreateRoundOperationResult, error) {
+	if f.HandleRunItBackButtonFunc != nil {
+		return f.HandleRunItBackButtonFunc(ctx, i)
+	}
+	return createround.CreateRoundOperationResult{}, nil
+}
+
 func (f *FakeCreateRoundManager) SendRoundEventURL(guildID string, channelID string, eventID string) (createround.CreateRoundOperationResult, error) {
 	if f.SendRoundEventURLFunc != nil {
 		return f.SendRoundEventURLFunc(guildID, channelID, eventID)
@@ -334,6 +388,7 @@ func (f *FakeRoundReminderManager) SendRoundReminder(ctx context.Context, payloa
 type FakeStartRoundManager struct {
 	TransformRoundToScorecardFunc func(ctx context.Context, payload *roundevents.DiscordRoundStartPayloadV1, existingEmbed *discordgo.MessageEmbed) (startround.StartRoundOperationResult, error)
 	UpdateRoundToScorecardFunc    func(ctx context.Context, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1) (startround.StartRoundOperationResult, error)
+	ReopenScorecardFunc           func(ctx context.Context, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1) (startround.StartRoundOperationResult, error)
 }
 
 func (f *FakeStartRoundManager) TransformRoundToScorecard(ctx context.Context, payload *roundevents.DiscordRoundStartPayloadV1, existingEmbed *discordgo.MessageEmbed) (startround.StartRoundOperationResult, error) {
@@ -350,6 +405,13 @@ func (f *FakeStartRoundManager) UpdateRoundToScorecard(ctx context.Context, chan
 	return startround.StartRoundOperationResult{}, nil
 }
 
+func (f *FakeStartRoundManager) ReopenScorecard(ctx context.Context, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1) (startround.StartRoundOperationResult, error) {
+	if f.ReopenScorecardFunc != nil {
+		return f.ReopenScorecardFunc(ctx, channelID, messageID, payload)
+	}
+	return startround.StartRoundOperationResult{}, nil
+}
+
 // FakeScoreRoundManager
 type FakeScoreRoundManager struct {
 	HandleScoreButtonFunc             func(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error)
@@ -359,6 +421,12 @@ type FakeScoreRoundManager struct {
 	UpdateScoreEmbedFunc              func(ctx context.Context, channelID, messageID string, userID sharedtypes.DiscordID, score *sharedtypes.Score) (scoreround.ScoreRoundOperationResult, error)
 	UpdateScoreEmbedBulkFunc          func(ctx context.Context, channelID, messageID string, participants []roundtypes.Participant) (scoreround.ScoreRoundOperationResult, error)
 	AddLateParticipantToScorecardFunc func(ctx context.Context, channelID, messageID string, participants []roundtypes.Participant) (scoreround.ScoreRoundOperationResult, error)
+	HandleKeepScoreButtonFunc         func(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error)
+	HandleLiveScoreInteractionFunc    func(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error)
+	HandleAttestationFunc             func(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error)
+	HoldFinalizationFunc              func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, channelID, messageID string) (bool, error)
+	ResumeFinalizationFunc            func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, channelID, messageID string) error
+	HandleFinalizeOverrideFunc        func(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error)
 }
 
 func (f *FakeScoreRoundManager) HandleScoreButton(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error) {
@@ -410,11 +478,56 @@ func (f *FakeScoreRoundManager) AddLateParticipantToScorecard(ctx context.Contex
 	return scoreround.ScoreRoundOperationResult{}, nil
 }
 
+func (f *FakeScoreRoundManager) HandleKeepScoreButton(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error) {
+	if f.HandleKeepScoreButtonFunc != nil {
+		return f.HandleKeepScoreButtonFunc(ctx, i)
+	}
+	return scoreround.ScoreRoundOperationResult{}, nil
+}
+
+func (f *FakeScoreRoundManager) HandleLiveScoreInteraction(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error) {
+	if f.HandleLiveScoreInteractionFunc != nil {
+		return f.HandleLiveScoreInteractionFunc(ctx, i)
+	}
+	return scoreround.ScoreRoundOperationResult{}, nil
+}
+
+func (f *FakeScoreRoundManager) HandleAttestation(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error) {
+	if f.HandleAttestationFunc != nil {
+		return f.HandleAttestationFunc(ctx, i)
+	}
+	return scoreround.ScoreRoundOperationResult{}, nil
+}
+
+func (f *FakeScoreRoundManager) HoldFinalization(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, channelID, messageID string) (bool, error) {
+	if f.HoldFinalizationFunc != nil {
+		return f.HoldFinalizationFunc(ctx, guildID, roundID, channelID, messageID)
+	}
+	return false, nil
+}
+
+func (f *FakeScoreRoundManager) ResumeFinalization(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, channelID, messageID string) error {
+	if f.ResumeFinalizationFunc != nil {
+		return f.ResumeFinalizationFunc(ctx, guildID, roundID, channelID, messageID)
+	}
+	return nil
+}
+
+func (f *FakeScoreRoundManager) HandleFinalizeOverride(ctx context.Context, i *discordgo.InteractionCreate) (scoreround.ScoreRoundOperationResult, error) {
+	if f.HandleFinalizeOverrideFunc != nil {
+		return f.HandleFinalizeOverrideFunc(ctx, i)
+	}
+	return scoreround.ScoreRoundOperationResult{}, nil
+}
+
 // FakeFinalizeRoundManager
 type FakeFinalizeRoundManager struct {
 	TransformRoundToFinalizedScorecardFunc func(payload roundevents.RoundFinalizedEmbedUpdatePayloadV1) (*discordgo.MessageEmbed, []discordgo.MessageComponent, error)
 	FinalizeScorecardEmbedFunc             func(ctx context.Context, eventMessageID string, channelID string, embedPayload roundevents.RoundFinalizedEmbedUpdatePayloadV1) (finalizeround.FinalizeRoundOperationResult, error)
 	PostFinalizedEmbedFunc                 func(ctx context.Context, channelID string, embedPayload roundevents.RoundFinalizedEmbedUpdatePayloadV1) (finalizeround.FinalizeRoundOperationResult, error)
+	HandleReopenButtonFunc                 func(ctx context.Context, i *discordgo.InteractionCreate) (finalizeround.FinalizeRoundOperationResult, error)
+	HandleReopenConfirmButtonFunc          func(ctx context.Context, i *discordgo.InteractionCreate) (finalizeround.FinalizeRoundOperationResult, error)
+	HandleReopenCancelButtonFunc           func(ctx context.Context, i *discordgo.InteractionCreate) (finalizeround.FinalizeRoundOperationResult, error)
 }
 
 func (f *FakeFinalizeRoundManager) TransformRoundToFinalizedScorecard(payload roundevents.RoundFinalizedEmbedUpdatePayloadV1) (*discordgo.MessageEmbed, []discordgo.MessageComponent, error) {
@@ -438,10 +551,32 @@ func (f *FakeFinalizeRoundManager) PostFinalizedEmbed(ctx context.Context, chann
 	return finalizeround.FinalizeRoundOperationResult{}, nil
 }
 
+func (f *FakeFinalizeRoundManager) HandleReopenButton(ctx context.Context, i *discordgo.InteractionCreate) (finalizeround.FinalizeRoundOperationResult, error) {
+	if f.HandleReopenButtonFunc != nil {
+		return f.HandleReopenButtonFunc(ctx, i)
+	}
+	return finalizeround.FinalizeRoundOperationResult{}, nil
+}
+
+func (f *FakeFinalizeRoundManager) HandleReopenConfirmButton(ctx context.Context, i *discordgo.InteractionCreate) (finalizeround.FinalizeRoundOperationResult, error) {
+	if f.HandleReopenConfirmButtonFunc != nil {
+		return f.HandleReopenConfirmButtonFunc(ctx, i)
+	}
+	return finalizeround.FinalizeRoundOperationResult{}, nil
+}
+
+func (f *FakeFinalizeRoundManager) HandleReopenCancelButton(ctx context.Context, i *discordgo.InteractionCreate) (finalizeround.FinalizeRoundOperationResult, error) {
+	if f.HandleReopenCancelButtonFunc != nil {
+		return f.HandleReopenCancelButtonFunc(ctx, i)
+	}
+	return finalizeround.FinalizeRoundOperationResult{}, nil
+}
+
 // FakeDeleteRoundManager
 type FakeDeleteRoundManager struct {
-	HandleDeleteRoundButtonFunc func(ctx context.Context, i *discordgo.InteractionCreate) (deleteround.DeleteRoundOperationResult, error)
-	DeleteRoundEventEmbedFunc   func(ctx context.Context, discordMessageID string, channelID string) (deleteround.DeleteRoundOperationResult, error)
+	HandleDeleteRoundButtonFunc  func(ctx context.Context, i *discordgo.InteractionCreate) (deleteround.DeleteRoundOperationResult, error)
+	DeleteRoundEventEmbedFunc    func(ctx context.Context, discordMessageID string, channelID string) (deleteround.DeleteRoundOperationResult, error)
+	HandleCancelSeriesButtonFunc func(ctx context.Context, i *discordgo.InteractionCreate) (deleteround.DeleteRoundOperationResult, error)
 }
 
 func (f *FakeDeleteRoundManager) HandleDeleteRoundButton(ctx context.Context, i *discordgo.InteractionCreate) (deleteround.DeleteRoundOperationResult, error) {
@@ -458,6 +593,13 @@ func (f *FakeDeleteRoundManager) DeleteRoundEventEmbed(ctx context.Context, disc
 	return deleteround.DeleteRoundOperationResult{}, nil
 }
 
+func (f *FakeDeleteRoundManager) HandleCancelSeriesButton(ctx context.Context, i *discordgo.InteractionCreate) (deleteround.DeleteRoundOperationResult, error) {
+	if f.HandleCancelSeriesButtonFunc != nil {
+		return f.HandleCancelSeriesButtonFunc(ctx, i)
+	}
+	return deleteround.DeleteRoundOperationResult{}, nil
+}
+
 // FakeUpdateRoundManager
 type FakeUpdateRoundManager struct {
 	UpdateRoundEventEmbedFunc        func(ctx context.Context, channelID string, messageID string, title *roundtypes.Title, description *roundtypes.Description, startTime *sharedtypes.StartTime, location *roundtypes.Location) (updateround.UpdateRoundOperationResult, error)
@@ -465,6 +607,9 @@ type FakeUpdateRoundManager struct {
 	SendUpdateRoundModalFunc         func(ctx context.Context, i *discordgo.InteractionCreate, roundID sharedtypes.RoundID) (updateround.UpdateRoundOperationResult, error)
 	HandleUpdateRoundModalSubmitFunc func(ctx context.Context, i *discordgo.InteractionCreate) (updateround.UpdateRoundOperationResult, error)
 	HandleUpdateRoundModalCancelFunc func(ctx context.Context, i *discordgo.InteractionCreate) (updateround.UpdateRoundOperationResult, error)
+	HandleApplySeriesEditButtonFunc  func(ctx context.Context, i *discordgo.InteractionCreate) (updateround.UpdateRoundOperationResult, error)
+	HandleStartTimeConfirmButtonFunc func(ctx context.Context, i *discordgo.InteractionCreate) (updateround.UpdateRoundOperationResult, error)
+	HandleStartTimeAdjustButtonFunc  func(ctx context.Context, i *discordgo.InteractionCreate) (updateround.UpdateRoundOperationResult, error)
 }
 
 func (f *FakeUpdateRoundManager) UpdateRoundEventEmbed(ctx context.Context, channelID string, messageID string, title *roundtypes.Title, description *roundtypes.Description, startTime *sharedtypes.StartTime, location *roundtypes.Location) (updateround.UpdateRoundOperationResult, error) {
@@ -502,6 +647,27 @@ func (f *FakeUpdateRoundManager) HandleUpdateRoundModalCancel(ctx context.Contex
 	return updateround.UpdateRoundOperationResult{}, nil
 }
 
+func (f *FakeUpdateRoundManager) HandleApplySeriesEditButton(ctx context.Context, i *discordgo.InteractionCreate) (updateround.UpdateRoundOperationResult, error) {
+	if f.HandleApplySeriesEditButtonFunc != nil {
+		return f.HandleApplySeriesEditButtonFunc(ctx, i)
+	}
+	return updateround.UpdateRoundOperationResult{}, nil
+}
+
+func (f *FakeUpdateRoundManager) HandleStartTimeConfirmButton(ctx context.Context, i *discordgo.InteractionCreate) (updateround.UpdateRoundOperationResult, error) {
+	if f.HandleStartTimeConfirmButtonFunc != nil {
+		return f.HandleStartTimeConfirmButtonFunc(ctx, i)
+	}
+	return updateround.UpdateRoundOperationResult{}, nil
+}
+
+func (f *FakeUpdateRoundManager) HandleStartTimeAdjustButton(ctx context.Context, i *discordgo.InteractionCreate) (updateround.UpdateRoundOperationResult, error) {
+	if f.HandleStartTimeAdjustButtonFunc != nil {
+		return f.HandleStartTimeAdjustButtonFunc(ctx, i)
+	}
+	return updateround.UpdateRoundOperationResult{}, nil
+}
+
 // FakeTagUpdateManager
 type FakeTagUpdateManager struct {
 	UpdateDiscordEmbedsWithTagChangesFunc func(ctx context.Context, payload roundevents.ScheduledRoundsSyncedPayloadV1, tagUpdates map[sharedtypes.DiscordID]*sharedtypes.TagNumber) (tagupdates.TagUpdateOperationResult, error)
@@ -524,11 +690,18 @@ func (f *FakeTagUpdateManager) UpdateTagsInEmbed(ctx context.Context, channelID,
 
 // FakeScorecardUploadManager
 type FakeScorecardUploadManager struct {
-	HandleScorecardUploadButtonFunc      func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
-	HandleScorecardUploadModalSubmitFunc func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
-	HandleFileUploadMessageFunc          func(s discord.Session, m *discordgo.MessageCreate)
-	EnsureRoundThreadInstructionsFunc    func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, parentChannelID, eventMessageID string) error
-	SendUploadErrorFunc                  func(ctx context.Context, channelID, userID, errorMsg string) error
+	HandleScorecardUploadButtonFunc         func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
+	HandleScorecardUploadModalSubmitFunc    func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
+	HandleFileUploadMessageFunc             func(s discord.Session, m *discordgo.MessageCreate)
+	EnsureRoundThreadInstructionsFunc       func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, parentChannelID, eventMessageID string) error
+	SendUploadErrorFunc                     func(ctx context.Context, channelID, userID, errorMsg string) error
+	HandleImportScorecardMessageCommandFunc func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
+	HandleImportScorecardRoundSelectFunc    func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
+	HandleScorecardPreviewConfirmFunc       func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
+	HandleScorecardPreviewCancelFunc        func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
+	PromptUnmatchedPlayersFunc              func(ctx context.Context, importID string) error
+	HandleUnmatchedPlayerSelectFunc         func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
+	HandleUnmatchedPlayersConfirmFunc       func(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error)
 }
 
 func (f *FakeScorecardUploadManager) HandleScorecardUploadButton(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error) {
@@ -565,6 +738,55 @@ func (f *FakeScorecardUploadManager) SendUploadError(ctx context.Context, channe
 	return nil
 }
 
+func (f *FakeScorecardUploadManager) HandleImportScorecardMessageCommand(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error) {
+	if f.HandleImportScorecardMessageCommandFunc != nil {
+		return f.HandleImportScorecardMessageCommandFunc(ctx, i)
+	}
+	return scorecardupload.ScorecardUploadOperationResult{}, nil
+}
+
+func (f *FakeScorecardUploadManager) HandleImportScorecardRoundSelect(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error) {
+	if f.HandleImportScorecardRoundSelectFunc != nil {
+		return f.HandleImportScorecardRoundSelectFunc(ctx, i)
+	}
+	return scorecardupload.ScorecardUploadOperationResult{}, nil
+}
+
+func (f *FakeScorecardUploadManager) HandleScorecardPreviewConfirm(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error) {
+	if f.HandleScorecardPreviewConfirmFunc != nil {
+		return f.HandleScorecardPreviewConfirmFunc(ctx, i)
+	}
+	return scorecardupload.ScorecardUploadOperationResult{}, nil
+}
+
+func (f *FakeScorecardUploadManager) HandleScorecardPreviewCancel(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error) {
+	if f.HandleScorecardPreviewCancelFunc != nil {
+		return f.HandleScorecardPreviewCancelFunc(ctx, i)
+	}
+	return scorecardupload.ScorecardUploadOperationResult{}, nil
+}
+
+func (f *FakeScorecardUploadManager) PromptUnmatchedPlayers(ctx context.Context, importID string) error {
+	if f.PromptUnmatchedPlayersFunc != nil {
+		return f.PromptUnmatchedPlayersFunc(ctx, importID)
+	}
+	return nil
+}
+
+func (f *FakeScorecardUploadManager) HandleUnmatchedPlayerSelect(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error) {
+	if f.HandleUnmatchedPlayerSelectFunc != nil {
+		return f.HandleUnmatchedPlayerSelectFunc(ctx, i)
+	}
+	return scorecardupload.ScorecardUploadOperationResult{}, nil
+}
+
+func (f *FakeScorecardUploadManager) HandleUnmatchedPlayersConfirm(ctx context.Context, i *discordgo.InteractionCreate) (scorecardupload.ScorecardUploadOperationResult, error) {
+	if f.HandleUnmatchedPlayersConfirmFunc != nil {
+		return f.HandleUnmatchedPlayersConfirmFunc(ctx, i)
+	}
+	return scorecardupload.ScorecardUploadOperationResult{}, nil
+}
+
 // FakeGuildConfigResolver is a programmable fake for guildconfig.GuildConfigResolver
 type FakeGuildConfigResolver struct {
 	GetGuildConfigWithContextFunc func(ctx context.Context, guildID string) (*storage.GuildConfig, error)
@@ -50,6 +50,7 @@ type Handlers interface {
 	HandleRoundFinalized(ctx context.Context, payload *roundevents.RoundFinalizedDiscordPayloadV1) ([]handlerwrapper.Result, error)
 	HandleRoundCompleted(ctx context.Context, payload *roundevents.RoundCompletedPayloadV1) ([]handlerwrapper.Result, error)
 	HandleRoundStarted(ctx context.Context, payload *roundevents.DiscordRoundStartPayloadV1) ([]handlerwrapper.Result, error)
+	HandleRoundReopened(ctx context.Context, payload *roundevents.DiscordRoundStartPayloadV1) ([]handlerwrapper.Result, error)
 
 	// Tag handling
 	HandleRoundParticipantJoined(ctx context.Context, payload *roundevents.ParticipantJoinedPayloadV1) ([]handlerwrapper.Result, error)
@@ -6,6 +6,7 @@ import (
 
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
 	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	sharedtypes "github.com/Black-And-White-Club/frolf-bot-shared/types/shared"
 	"github.com/Black-And-White-Club/frolf-bot-shared/utils/handlerwrapper"
 )
 
@@ -61,6 +62,7 @@ func (h *RoundHandlers) HandleParticipantScoreUpdated(ctx context.Context, paylo
 	if updateResult.Error != nil {
 		return nil, fmt.Errorf("scorecard update failed: %w", updateResult.Error)
 	}
+	h.resumeFinalization(ctx, payload.GuildID, payload.RoundID, channelID, messageID)
 
 	return nil, nil
 }
@@ -111,10 +113,24 @@ func (h *RoundHandlers) HandleScoresBulkUpdated(ctx context.Context, payload *ro
 	if updateResult.Error != nil {
 		return nil, fmt.Errorf("bulk scorecard update failed: %w", updateResult.Error)
 	}
+	h.resumeFinalization(ctx, payload.GuildID, payload.RoundID, channelID, messageID)
 
 	return nil, nil
 }
 
+// resumeFinalization requests a finalization held for attestation once
+// a score update has settled the last held score.
+func (h *RoundHandlers) resumeFinalization(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, channelID, messageID string) {
+	if messageID == "" {
+		return
+	}
+	if err := h.service.GetScoreRoundManager().ResumeFinalization(ctx, guildID, roundID, channelID, messageID); err != nil {
+		h.logger.WarnContext(ctx, "failed to resume held finalization",
+			attr.RoundID("round_id", roundID),
+			attr.Error(err))
+	}
+}
+
 // HandleScoreUpdateError processes a failed score update event.
 func (h *RoundHandlers) HandleScoreUpdateError(ctx context.Context, payload *roundevents.RoundScoreUpdateErrorPayloadV1) ([]handlerwrapper.Result, error) {
 	if payload.Error == "" {
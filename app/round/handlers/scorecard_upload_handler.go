@@ -108,6 +108,8 @@ func (rh *RoundHandlers) HandleScorecardParseFailed(ctx context.Context, payload
 		"user_id", payload.UserID,
 		"error", payload.Error)
 
+	rh.promptUnmatchedPlayers(ctx, payload.ImportID)
+
 	// Notify user in Discord about parsing failure
 	// If guild channel, sends DM to user instead of public message
 	if payload.ChannelID != "" {
@@ -127,6 +129,8 @@ func (rh *RoundHandlers) HandleImportFailed(ctx context.Context, payload *rounde
 		"user_id", payload.UserID,
 		"error", payload.Error)
 
+	rh.promptUnmatchedPlayers(ctx, payload.ImportID)
+
 	// Notify user in Discord about import failure
 	// If guild channel, sends DM to user instead of public message
 	if payload.ChannelID != "" {
@@ -139,6 +143,16 @@ func (rh *RoundHandlers) HandleImportFailed(ctx context.Context, payload *rounde
 	return nil, nil
 }
 
+// promptUnmatchedPlayers asks the uploader who the scorecard's unmatched names
+// are, so the import can be re-run with them.
+func (rh *RoundHandlers) promptUnmatchedPlayers(ctx context.Context, importID string) {
+	if err := rh.service.GetScorecardUploadManager().PromptUnmatchedPlayers(ctx, importID); err != nil {
+		rh.logger.WarnContext(ctx, "Failed to prompt for unmatched scorecard names",
+			"import_id", importID,
+			"error", err)
+	}
+}
+
 // HandleScorecardURLRequested handles scorecard URL requested events.
 func (rh *RoundHandlers) HandleScorecardURLRequested(ctx context.Context, payload *roundevents.ScorecardURLRequestedPayloadV1) ([]handlerwrapper.Result, error) {
 	// TODO: Respond with scorecard URL in Discord
@@ -199,6 +199,27 @@ func TestRoundHandlers_HandleImportFailed(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "unmatched_prompt_fails_still_notifies",
+			payload: &roundevents.ImportFailedPayloadV1{
+				ImportID:  "import-123",
+				RoundID:   sharedtypes.RoundID(uuid.New()),
+				UserID:    "user-123",
+				ChannelID: "channel-123",
+				Error:     "Import failed",
+			},
+			ctx:     context.Background(),
+			wantErr: false,
+			wantLen: 0,
+			setup: func(f *FakeRoundDiscord) {
+				f.ScorecardUploadManager.PromptUnmatchedPlayersFunc = func(ctx context.Context, importID string) error {
+					return errors.New("identities unavailable")
+				}
+				f.ScorecardUploadManager.SendUploadErrorFunc = func(ctx context.Context, channelID, userID, errorMsg string) error {
+					return nil
+				}
+			},
+		},
 		{
 			name: "send_error_fails",
 			payload: &roundevents.ImportFailedPayloadV1{
@@ -273,6 +294,27 @@ func TestRoundHandlers_HandleScorecardParseFailed(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "unmatched_prompt_fails_still_notifies",
+			payload: &roundevents.ScorecardParseFailedPayloadV1{
+				ImportID:  "import-123",
+				RoundID:   sharedtypes.RoundID(uuid.New()),
+				UserID:    "user-123",
+				ChannelID: "channel-123",
+				Error:     "Parse failed",
+			},
+			ctx:     context.Background(),
+			wantErr: false,
+			wantLen: 0,
+			setup: func(f *FakeRoundDiscord) {
+				f.ScorecardUploadManager.PromptUnmatchedPlayersFunc = func(ctx context.Context, importID string) error {
+					return errors.New("identities unavailable")
+				}
+				f.ScorecardUploadManager.SendUploadErrorFunc = func(ctx context.Context, channelID, userID, errorMsg string) error {
+					return nil
+				}
+			},
+		},
 		{
 			name: "send_error_fails",
 			payload: &roundevents.ScorecardParseFailedPayloadV1{
@@ -16,27 +16,7 @@ func (h *RoundHandlers) HandleRoundStarted(ctx context.Context, payload *roundev
 		return nil, fmt.Errorf("missing event message ID in round start payload")
 	}
 
-	// Use the channel ID from the payload instead of config
-	channelID := payload.DiscordChannelID
-	if channelID == "" && payload.Config != nil {
-		channelID = payload.Config.EventChannelID
-	}
-	if channelID == "" {
-		if h.guildConfigResolver != nil {
-			guildCfg, err := h.guildConfigResolver.GetGuildConfigWithContext(ctx, string(payload.GuildID))
-			if err != nil || guildCfg == nil {
-				h.logger.WarnContext(ctx, "failed to resolve guild config for round start, falling back to global config",
-					attr.String("guild_id", string(payload.GuildID)),
-					attr.Error(err))
-				channelID = h.config.GetEventChannelID()
-			} else {
-				channelID = guildCfg.EventChannelID
-			}
-		} else {
-			channelID = h.config.GetEventChannelID()
-		}
-	}
-
+	channelID := h.roundStartChannelID(ctx, payload)
 	eventMessageID := payload.EventMessageID
 
 	// Update round to scorecard
@@ -73,3 +53,40 @@ func (h *RoundHandlers) HandleRoundStarted(ctx context.Context, payload *roundev
 	// Acked after successful handling.
 	return []handlerwrapper.Result{}, nil
 }
+
+// HandleRoundReopened puts a reopened round's embed back to the in-progress
+// scorecard with the current scores. The round already started once, so its
+// cards, upload instructions and scheduled event are left as they are.
+func (h *RoundHandlers) HandleRoundReopened(ctx context.Context, payload *roundevents.DiscordRoundStartPayloadV1) ([]handlerwrapper.Result, error) {
+	if payload.EventMessageID == "" {
+		return nil, fmt.Errorf("missing event message ID in round reopen payload")
+	}
+
+	channelID := h.roundStartChannelID(ctx, payload)
+	if _, err := h.service.GetStartRoundManager().ReopenScorecard(ctx, channelID, payload.EventMessageID, payload); err != nil {
+		return nil, fmt.Errorf("failed to reopen round scorecard: %w", err)
+	}
+
+	return []handlerwrapper.Result{}, nil
+}
+
+// roundStartChannelID returns the channel of a started round's embed, from
+// the payload when it carries one and from the guild config otherwise.
+func (h *RoundHandlers) roundStartChannelID(ctx context.Context, payload *roundevents.DiscordRoundStartPayloadV1) string {
+	if payload.DiscordChannelID != "" {
+		return payload.DiscordChannelID
+	}
+	if payload.Config != nil && payload.Config.EventChannelID != "" {
+		return payload.Config.EventChannelID
+	}
+	if h.guildConfigResolver != nil {
+		guildCfg, err := h.guildConfigResolver.GetGuildConfigWithContext(ctx, string(payload.GuildID))
+		if err == nil && guildCfg != nil {
+			return guildCfg.EventChannelID
+		}
+		h.logger.WarnContext(ctx, "failed to resolve guild config for round start, falling back to global config",
+			attr.String("guild_id", string(payload.GuildID)),
+			attr.Error(err))
+	}
+	return h.config.GetEventChannelID()
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"testing"
 
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
 	startround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/start_round"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	roundevents "github.com/Black-And-White-Club/frolf-bot-shared/events/round"
@@ -206,3 +207,53 @@ func TestRoundHandlers_HandleRoundStarted_InstructionPostingErrorIsNonFatal(t *t
 		t.Fatalf("expected EnsureRoundThreadInstructions to be called")
 	}
 }
+
+func TestRoundHandlers_HandleRoundReopened_OnlyTransformsEmbed(t *testing.T) {
+	testRoundID := sharedtypes.RoundID(uuid.New())
+
+	fakeRoundDiscord := &FakeRoundDiscord{}
+	fakeRoundDiscord.StartRoundManager.UpdateRoundToScorecardFunc = func(ctx context.Context, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1) (startround.StartRoundOperationResult, error) {
+		t.Fatalf("a reopened round must not be started again")
+		return startround.StartRoundOperationResult{}, nil
+	}
+	reopened := false
+	fakeRoundDiscord.StartRoundManager.ReopenScorecardFunc = func(ctx context.Context, channelID, messageID string, payload *roundevents.DiscordRoundStartPayloadV1) (startround.StartRoundOperationResult, error) {
+		reopened = true
+		if channelID != "channel-123" || messageID != "event-message-123" {
+			t.Fatalf("reopened %s/%s, want channel-123/event-message-123", channelID, messageID)
+		}
+		return startround.StartRoundOperationResult{Success: true}, nil
+	}
+	fakeRoundDiscord.ScorecardUploadManager.EnsureRoundThreadInstructionsFunc = func(ctx context.Context, guildID sharedtypes.GuildID, roundID sharedtypes.RoundID, parentChannelID, eventMessageID string) error {
+		t.Fatalf("upload instructions were posted again")
+		return nil
+	}
+	fakeSession := discord.NewFakeSession()
+	fakeSession.GuildScheduledEventEditFunc = func(guildID, eventID string, params *discordgo.GuildScheduledEventParams, options ...discordgo.RequestOption) (*discordgo.GuildScheduledEvent, error) {
+		t.Fatalf("the completed scheduled event was edited")
+		return nil, nil
+	}
+	fakeRoundDiscord.GetSessionFunc = func() discord.Session { return fakeSession }
+
+	h := NewRoundHandlers(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		&config.Config{},
+		nil,
+		fakeRoundDiscord,
+		nil,
+	)
+
+	results, err := h.HandleRoundReopened(context.Background(), &roundevents.DiscordRoundStartPayloadV1{
+		GuildID:          "guild-1",
+		RoundID:          testRoundID,
+		EventMessageID:   "event-message-123",
+		DiscordChannelID: "channel-123",
+		DiscordEventID:   "scheduled-event-1",
+	})
+	if err != nil || len(results) != 0 {
+		t.Fatalf("HandleRoundReopened() = %v, %v", results, err)
+	}
+	if !reopened {
+		t.Fatalf("expected the scorecard to be reopened")
+	}
+}
@@ -12,7 +12,10 @@ import (
 	createround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/create_round"
 	deleteround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/delete_round"
 	embedpagination "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/embed_pagination"
+	finalizeround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/finalize_round"
 	roundrsvp "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_rsvp"
+	roundtemplate "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/round_template"
+	scoreaudit "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_audit"
 	scoreround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/score_round"
 	scorecardupload "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/scorecard_upload"
 	updateround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/update_round"
@@ -78,9 +81,15 @@ func InitializeRoundModule(
 	createround.RegisterHandlers(interactionRegistry, roundDiscord.GetCreateRoundManager())
 	roundrsvp.RegisterHandlers(interactionRegistry, roundDiscord.GetRoundRsvpManager())
 	deleteround.RegisterHandlers(interactionRegistry, roundDiscord.GetDeleteRoundManager())
+	if cfg.BackendFeatureEnabled(config.BackendFeatureRoundReopen) {
+		finalizeround.RegisterHandlers(interactionRegistry, roundDiscord.GetFinalizeRoundManager())
+	}
 	scoreround.RegisterHandlers(interactionRegistry, roundDiscord.GetScoreRoundManager())
 	updateround.RegisterHandlers(interactionRegistry, roundDiscord.GetUpdateRoundManager())
 	scorecardupload.RegisterHandlers(interactionRegistry, messageRegistry, roundDiscord.GetScorecardUploadManager())
+	roundtemplate.RegisterHandlers(interactionRegistry, roundtemplate.NewRoundTemplateManager(session, eventBus, logger))
+	scoreaudit.RegisterHandlers(interactionRegistry, scoreaudit.NewAuditManager(session, eventBus, logger, roundDiscord.GetScoreAuditLog(), guildConfig))
+	interactionRegistry.RegisterAutocompleteHandler(createround.CommandSpec().Name(), "template", roundtemplate.NewTemplateNameHandler(cfg, eventBus))
 	embedpagination.ConfigurePersistence(embedpagination.PersistenceConfig{
 		EventBus: eventBus,
 		Helper:   helper,
@@ -93,6 +102,7 @@ func InitializeRoundModule(
 		roundDiscord.GetNativeEventMap(),
 		roundDiscord.GetMessageMap(),
 		roundDiscord.GetPendingNativeEventMap(),
+		roundDiscord.GetScoreRoundManager(),
 		session,
 		cfg,
 		guildConfig,
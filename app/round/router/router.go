@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	finalizeround "github.com/Black-And-White-Club/discord-frolf-bot/app/round/discord/finalize_round"
 	handlers "github.com/Black-And-White-Club/discord-frolf-bot/app/round/handlers"
 	"github.com/Black-And-White-Club/discord-frolf-bot/config"
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
@@ -179,6 +180,11 @@ func (r *RoundRouter) RegisterHandlers(ctx context.Context, handlers handlers.Ha
 	registerHandler(deps, roundevents.RoundFinalizedDiscordV1, handlers.HandleRoundFinalized)
 	registerHandler(deps, roundevents.RoundCompletedV1, handlers.HandleRoundCompleted)
 	registerHandler(deps, roundevents.RoundStartedDiscordV2, handlers.HandleRoundStarted)
+	// A reopened round goes back to the in-progress scorecard and is
+	// finalized again through RoundFinalizedDiscordV1.
+	if r.config.BackendFeatureEnabled(config.BackendFeatureRoundReopen) {
+		registerHandler(deps, finalizeround.RoundReopenedDiscordV1, handlers.HandleRoundReopened)
+	}
 	registerHandler(deps, sharedevents.PointsAwardedV1, handlers.HandlePointsAwarded)
 
 	// Tag handling
@@ -85,6 +85,9 @@ type GuildConfig struct {
 	EditorRoleID         string                              `json:"editor_role_id"`
 	AdminRoleID          string                              `json:"admin_role_id"`
 	RoleMappings         map[string]string                   `json:"role_mappings"`
+	Timezone             string                              `json:"timezone,omitempty"`
+	Reminders            []ReminderSetting                   `json:"reminders,omitempty"`
+	AttestedScoring      *bool                               `json:"attested_scoring,omitempty"`
 	Entitlements         guildtypes.ResolvedClubEntitlements `json:"entitlements,omitempty"`
 	CachedAt             time.Time                           `json:"cached_at"`
 	RefreshedAt          time.Time                           `json:"refreshed_at"`
@@ -92,6 +95,13 @@ type GuildConfig struct {
 	IsRequestPending     bool                                `json:"is_request_pending"`
 }
 
+// ReminderSetting is one of a guild's round reminders: how long before the
+// round starts it is sent, e.g. "2h", and an optional custom message.
+type ReminderSetting struct {
+	Offset   string `json:"offset"`
+	Template string `json:"template,omitempty"`
+}
+
 type GuildConfigCacheInterface interface {
 	Get(guildID string) (*GuildConfig, bool)
 	Set(guildID string, config *GuildConfig) error
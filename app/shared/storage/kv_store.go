@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// kvBucket is the part of a JetStream key-value bucket a KVStore uses.
+type kvBucket interface {
+	Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error)
+	Put(ctx context.Context, key string, value []byte) (uint64, error)
+	Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error
+	Keys(ctx context.Context, opts ...jetstream.WatchOpt) ([]string, error)
+}
+
+// KVStore is an ISInterface kept in a JetStream key-value bucket, so entries
+// survive restarts and every worker sees them. Values are stored as JSON and
+// keys must be valid NATS subject tokens, e.g. "prompt.123".
+type KVStore[T any] struct {
+	kv kvBucket
+}
+
+// NewKVStore opens the bucket, creating it if needed. Entries expire ttl after
+// they were last set.
+func NewKVStore[T any](ctx context.Context, js jetstream.JetStream, bucket string, ttl time.Duration) (*KVStore[T], error) {
+	if js == nil {
+		return nil, fmt.Errorf("JetStream is required for the %s bucket", bucket)
+	}
+	kv, err := js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket:  bucket,
+		TTL:     ttl,
+		History: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s bucket: %w", bucket, err)
+	}
+	return &KVStore[T]{kv: kv}, nil
+}
+
+// Set stores value under key, replacing any earlier value.
+func (s *KVStore[T]) Set(ctx context.Context, key string, value T) error {
+	if key == "" {
+		return errors.New("key is empty")
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	if _, err := s.kv.Put(ctx, key, data); err != nil {
+		return fmt.Errorf("failed to store %s: %w", key, err)
+	}
+	return nil
+}
+
+// Get returns the value stored under key, or ErrNotFound once it was deleted
+// or expired.
+func (s *KVStore[T]) Get(ctx context.Context, key string) (T, error) {
+	var value T
+	entry, err := s.kv.Get(ctx, key)
+	if errors.Is(err, jetstream.ErrKeyNotFound) {
+		return value, ErrNotFound
+	}
+	if err != nil {
+		return value, fmt.Errorf("failed to load %s: %w", key, err)
+	}
+	if err := json.Unmarshal(entry.Value(), &value); err != nil {
+		return value, fmt.Errorf("failed to decode %s: %w", key, err)
+	}
+	return value, nil
+}
+
+// Delete removes key. Failures are logged; the entry still expires with the
+// bucket's TTL.
+func (s *KVStore[T]) Delete(ctx context.Context, key string) {
+	if err := s.kv.Delete(ctx, key); err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+		slog.WarnContext(ctx, "KVStore: Failed to delete item",
+			attr.String("key", key),
+			attr.Error(err))
+	}
+}
+
+// Keys returns every stored key.
+func (s *KVStore[T]) Keys(ctx context.Context) ([]string, error) {
+	keys, err := s.kv.Keys(ctx)
+	if errors.Is(err, jetstream.ErrNoKeysFound) {
+		return nil, nil
+	}
+	return keys, err
+}
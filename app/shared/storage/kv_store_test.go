@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+type fakeKVEntry struct {
+	key   string
+	value []byte
+}
+
+func (e fakeKVEntry) Bucket() string                  { return "test" }
+func (e fakeKVEntry) Key() string                     { return e.key }
+func (e fakeKVEntry) Value() []byte                   { return e.value }
+func (e fakeKVEntry) Revision() uint64                { return 1 }
+func (e fakeKVEntry) Created() time.Time              { return time.Time{} }
+func (e fakeKVEntry) Delta() uint64                   { return 0 }
+func (e fakeKVEntry) Operation() jetstream.KeyValueOp { return jetstream.KeyValuePut }
+
+type fakeKVBucket struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func (f *fakeKVBucket) Get(ctx context.Context, key string) (jetstream.KeyValueEntry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	value, ok := f.data[key]
+	if !ok {
+		return nil, jetstream.ErrKeyNotFound
+	}
+	return fakeKVEntry{key: key, value: value}, nil
+}
+
+func (f *fakeKVBucket) Put(ctx context.Context, key string, value []byte) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.data[key] = value
+	return uint64(len(f.data)), nil
+}
+
+func (f *fakeKVBucket) Delete(ctx context.Context, key string, opts ...jetstream.KVDeleteOpt) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.data, key)
+	return nil
+}
+
+func (f *fakeKVBucket) Keys(ctx context.Context, opts ...jetstream.WatchOpt) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.data) == 0 {
+		return nil, jetstream.ErrNoKeysFound
+	}
+	keys := make([]string, 0, len(f.data))
+	for key := range f.data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+type kvTestValue struct {
+	ChannelID string    `json:"channel_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func TestKVStore_RoundTripsJSON(t *testing.T) {
+	store := &KVStore[kvTestValue]{kv: &fakeKVBucket{data: map[string][]byte{}}}
+	ctx := context.Background()
+
+	if keys, err := store.Keys(ctx); err != nil || len(keys) != 0 {
+		t.Fatalf("expected no keys in an empty bucket, got %v, %v", keys, err)
+	}
+
+	want := kvTestValue{ChannelID: "channel-1", ExpiresAt: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)}
+	if err := store.Set(ctx, "prompt.1", want); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	got, err := store.Get(ctx, "prompt.1")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.ChannelID != want.ChannelID || !got.ExpiresAt.Equal(want.ExpiresAt) {
+		t.Fatalf("Get() = %+v, want %+v", got, want)
+	}
+	if keys, err := store.Keys(ctx); err != nil || len(keys) != 1 || keys[0] != "prompt.1" {
+		t.Fatalf("Keys() = %v, %v", keys, err)
+	}
+
+	store.Delete(ctx, "prompt.1")
+	if _, err := store.Get(ctx, "prompt.1"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after Delete, got %v", err)
+	}
+}
+
+func TestKVStore_SetRejectsEmptyKey(t *testing.T) {
+	store := &KVStore[kvTestValue]{kv: &fakeKVBucket{data: map[string][]byte{}}}
+	if err := store.Set(context.Background(), "", kvTestValue{}); err == nil {
+		t.Fatal("expected an empty key to be rejected")
+	}
+}
@@ -7,10 +7,17 @@ import (
 	"time"
 
 	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/nats-io/nats.go"
 )
 
 // NATSRequest sends a JSON-encoded request to a NATS subject and waits for a JSON-encoded response.
+// The reply inbox is sent both as the NATS reply subject and in the reply_to header, which is
+// where the backend's request handlers look for it. The wait is bounded by timeout or the
+// context deadline, whichever comes first.
 func NATSRequest[Req any, Resp any](ctx context.Context, eb eventbus.EventBus, subject string, req Req, timeout time.Duration) (*Resp, error) {
+	if eb == nil {
+		return nil, fmt.Errorf("NATS connection not available")
+	}
 	conn := eb.GetNATSConnection()
 	if conn == nil {
 		return nil, fmt.Errorf("NATS connection not available")
@@ -21,7 +28,31 @@ func NATSRequest[Req any, Resp any](ctx context.Context, eb eventbus.EventBus, s
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	msg, err := conn.RequestWithContext(ctx, subject, reqBytes)
+	inbox := nats.NewInbox()
+	sub, err := conn.SubscribeSync(inbox)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe reply inbox: %w", err)
+	}
+	defer func() {
+		_ = sub.Unsubscribe()
+	}()
+
+	reqMsg := nats.NewMsg(subject)
+	reqMsg.Reply = inbox
+	reqMsg.Data = reqBytes
+	reqMsg.Header.Set("reply_to", inbox)
+
+	if err := conn.PublishMsg(reqMsg); err != nil {
+		return nil, fmt.Errorf("NATS request failed: %w", err)
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	msg, err := sub.NextMsgWithContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("NATS request failed: %w", err)
 	}
@@ -0,0 +1,17 @@
+package user
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/user/discord/notifications"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/user/discord/role"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/user/discord/udisc"
+)
+
+// Commands returns the application commands contributed by the user module.
+func Commands() []interactions.CommandSpec {
+	return []interactions.CommandSpec{
+		role.CommandSpec(),
+		udisc.CommandSpec(),
+		notifications.CommandSpec(),
+	}
+}
@@ -0,0 +1,35 @@
+package notifications
+
+import (
+	"strings"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	"github.com/Black-And-White-Club/discord-frolf-bot/config"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /notifications command.
+func CommandSpec() interactions.CommandSpec {
+	options := make([]*discordgo.ApplicationCommandOption, 0, len(notify.Categories))
+	for _, category := range notify.Categories {
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Type:        discordgo.ApplicationCommandOptionBoolean,
+			Name:        string(category),
+			Description: "DM me about " + strings.ToLower(category.Label()),
+			Required:    false,
+		})
+	}
+
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "notifications",
+			Description: "Show or choose which alerts the bot DMs you (Available to all players)",
+			Options:     options,
+		},
+		RequiredPermission: interactions.PlayerRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+		BackendFeature:     config.BackendFeatureNotifications,
+	}
+}
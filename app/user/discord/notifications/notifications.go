@@ -0,0 +1,118 @@
+// Package notifications implements /notifications, which shows or changes
+// which DM alerts a player gets.
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	"github.com/Black-And-White-Club/frolf-bot-shared/eventbus"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	discordmetrics "github.com/Black-And-White-Club/frolf-bot-shared/observability/otel/metrics/discord"
+	"github.com/bwmarrin/discordgo"
+)
+
+// NotificationsManager handles /notifications.
+type NotificationsManager interface {
+	HandleNotificationsCommand(ctx context.Context, i *discordgo.InteractionCreate)
+}
+
+type notificationsManager struct {
+	session            discord.Session
+	logger             *slog.Logger
+	currentPreferences func(ctx context.Context, guildID, userID string) (*notify.PreferencesResponsePayloadV1, error)
+	savePreferences    func(ctx context.Context, request notify.PreferencesSetRequestPayloadV1) (*notify.PreferencesResponsePayloadV1, error)
+}
+
+// NewNotificationsManager creates a NotificationsManager backed by the
+// backend's notification preference requests.
+func NewNotificationsManager(session discord.Session, eventBus eventbus.EventBus, logger *slog.Logger) NotificationsManager {
+	return &notificationsManager{
+		session: session,
+		logger:  logger,
+		currentPreferences: func(ctx context.Context, guildID, userID string) (*notify.PreferencesResponsePayloadV1, error) {
+			return notify.RequestPreferences(ctx, eventBus, guildID, userID)
+		},
+		savePreferences: func(ctx context.Context, request notify.PreferencesSetRequestPayloadV1) (*notify.PreferencesResponsePayloadV1, error) {
+			return notify.SavePreferences(ctx, eventBus, request)
+		},
+	}
+}
+
+// HandleNotificationsCommand shows the player's alerts, or turns the
+// categories given on or off.
+func (m *notificationsManager) HandleNotificationsCommand(ctx context.Context, i *discordgo.InteractionCreate) {
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.CommandNameKey, "notifications")
+	ctx = discordmetrics.WithValue(ctx, discordmetrics.InteractionType, "application_command")
+
+	// Requests go to the backend, so defer before making them.
+	if err := m.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral},
+	}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to defer notifications interaction", attr.Error(err))
+		return
+	}
+
+	changes := notify.Preferences{}
+	for _, opt := range i.ApplicationCommandData().Options {
+		for _, category := range notify.Categories {
+			if opt.Name == string(category) {
+				changes[category] = opt.BoolValue()
+			}
+		}
+	}
+
+	userID := userID(i)
+	var response *notify.PreferencesResponsePayloadV1
+	var err error
+	content := "Your DM alerts:\n"
+	if len(changes) == 0 {
+		response, err = m.currentPreferences(ctx, i.GuildID, userID)
+	} else {
+		response, err = m.savePreferences(ctx, notify.PreferencesSetRequestPayloadV1{GuildID: i.GuildID, UserID: userID, Preferences: changes})
+		content = "✅ Updated your DM alerts:\n"
+	}
+
+	switch {
+	case err != nil:
+		m.logger.ErrorContext(ctx, "Failed to load or save notification preferences", attr.Error(err), attr.String("guild_id", i.GuildID), attr.String("user_id", userID))
+		content = "❌ Couldn't reach your notification settings right now. Please try again."
+	case response.Error != "":
+		content = "❌ " + response.Error
+	default:
+		content += describe(response.Preferences) +
+			"\nTurn one on or off with e.g. `/notifications new_rounds:True`. Make sure DMs from server members are allowed."
+	}
+
+	if _, err := m.session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: &content}); err != nil {
+		m.logger.ErrorContext(ctx, "Failed to edit notifications response", attr.Error(err))
+	}
+}
+
+// describe lists every category and whether it is on.
+func describe(preferences notify.Preferences) string {
+	var sb strings.Builder
+	for _, category := range notify.Categories {
+		state := "🔕 off"
+		if preferences[category] {
+			state = "🔔 on"
+		}
+		sb.WriteString(fmt.Sprintf("%s **%s** (`%s`)\n", state, category.Label(), category))
+	}
+	return sb.String()
+}
+
+func userID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
@@ -0,0 +1,120 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	discord "github.com/Black-And-White-Club/discord-frolf-bot/app/discordgo"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/notify"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/testutils"
+	"github.com/bwmarrin/discordgo"
+)
+
+func newNotificationsCommand(options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:      "interaction-1",
+		GuildID: "guild-1",
+		Type:    discordgo.InteractionApplicationCommand,
+		Member:  &discordgo.Member{User: &discordgo.User{ID: "player-1"}},
+		Data: discordgo.ApplicationCommandInteractionData{
+			Name:    "notifications",
+			Options: options,
+		},
+	}}
+}
+
+func boolOption(category notify.Category, value bool) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{Name: string(category), Type: discordgo.ApplicationCommandOptionBoolean, Value: value}
+}
+
+// newTestManager returns a manager for a player who only gets challenge
+// alerts, whose saves fail unless a test overrides them, and a pointer to the
+// content of its last response edit.
+func newTestManager(t *testing.T) (*notificationsManager, *string) {
+	t.Helper()
+
+	fakeSession := discord.NewFakeSession()
+	var content string
+	fakeSession.InteractionRespondFunc = func(i *discordgo.Interaction, r *discordgo.InteractionResponse, opts ...discordgo.RequestOption) error {
+		if r.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource || r.Data.Flags != discordgo.MessageFlagsEphemeral {
+			t.Errorf("expected an ephemeral deferred response, got %+v", r)
+		}
+		return nil
+	}
+	fakeSession.InteractionResponseEditFunc = func(i *discordgo.Interaction, edit *discordgo.WebhookEdit, opts ...discordgo.RequestOption) (*discordgo.Message, error) {
+		content = *edit.Content
+		return &discordgo.Message{}, nil
+	}
+
+	return &notificationsManager{
+		session: fakeSession,
+		logger:  testutils.NoOpLogger(),
+		currentPreferences: func(ctx context.Context, guildID, userID string) (*notify.PreferencesResponsePayloadV1, error) {
+			return &notify.PreferencesResponsePayloadV1{Preferences: notify.Preferences{notify.Challenges: true, notify.NewRounds: false}}, nil
+		},
+		savePreferences: func(ctx context.Context, request notify.PreferencesSetRequestPayloadV1) (*notify.PreferencesResponsePayloadV1, error) {
+			return nil, errors.New("backend unavailable")
+		},
+	}, &content
+}
+
+func TestNotificationsManager_ShowsPreferences(t *testing.T) {
+	m, content := newTestManager(t)
+	m.HandleNotificationsCommand(context.Background(), newNotificationsCommand())
+
+	want := "Your DM alerts:\n" +
+		"🔕 off **Round reminders** (`round_reminders`)\n" +
+		"🔔 on **Challenges received or accepted** (`challenges`)\n" +
+		"🔕 off **Gaining or losing a tag** (`tag_changes`)\n" +
+		"🔕 off **New rounds posted** (`new_rounds`)\n" +
+		"\nTurn one on or off with e.g. `/notifications new_rounds:True`. Make sure DMs from server members are allowed."
+	if *content != want {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
+
+func TestNotificationsManager_Update(t *testing.T) {
+	m, content := newTestManager(t)
+	var saved notify.PreferencesSetRequestPayloadV1
+	m.savePreferences = func(ctx context.Context, request notify.PreferencesSetRequestPayloadV1) (*notify.PreferencesResponsePayloadV1, error) {
+		saved = request
+		return &notify.PreferencesResponsePayloadV1{Preferences: notify.Preferences{notify.Challenges: false, notify.TagChanges: true}}, nil
+	}
+
+	m.HandleNotificationsCommand(context.Background(), newNotificationsCommand(
+		boolOption(notify.TagChanges, true),
+		boolOption(notify.Challenges, false),
+	))
+
+	want := notify.PreferencesSetRequestPayloadV1{
+		GuildID:     "guild-1",
+		UserID:      "player-1",
+		Preferences: notify.Preferences{notify.TagChanges: true, notify.Challenges: false},
+	}
+	if !reflect.DeepEqual(saved, want) {
+		t.Fatalf("save request = %+v, want %+v", saved, want)
+	}
+	if !strings.HasPrefix(*content, "✅ Updated your DM alerts:\n") || !strings.Contains(*content, "🔔 on **Gaining or losing a tag**") ||
+		!strings.Contains(*content, "🔕 off **Challenges received or accepted**") {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
+
+func TestNotificationsManager_Failures(t *testing.T) {
+	m, content := newTestManager(t)
+	m.HandleNotificationsCommand(context.Background(), newNotificationsCommand(boolOption(notify.NewRounds, true)))
+	if *content != "❌ Couldn't reach your notification settings right now. Please try again." {
+		t.Fatalf("unexpected response %q", *content)
+	}
+
+	m.currentPreferences = func(ctx context.Context, guildID, userID string) (*notify.PreferencesResponsePayloadV1, error) {
+		return &notify.PreferencesResponsePayloadV1{Error: "You aren't signed up in this server."}, nil
+	}
+	m.HandleNotificationsCommand(context.Background(), newNotificationsCommand())
+	if *content != "❌ You aren't signed up in this server." {
+		t.Fatalf("unexpected response %q", *content)
+	}
+}
@@ -0,0 +1,18 @@
+package notifications
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/Black-And-White-Club/frolf-bot-shared/observability/attr"
+	"github.com/bwmarrin/discordgo"
+)
+
+// RegisterHandlers registers the notifications command.
+func RegisterHandlers(registry *interactions.Registry, manager NotificationsManager) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
+		slog.Info("Handling notifications command", attr.String("interaction_id", i.ID))
+		manager.HandleNotificationsCommand(ctx, i)
+	})
+}
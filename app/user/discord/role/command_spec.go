@@ -0,0 +1,27 @@
+package role
+
+import (
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
+	"github.com/bwmarrin/discordgo"
+)
+
+// CommandSpec returns the /updaterole command (Editor role or higher).
+func CommandSpec() interactions.CommandSpec {
+	return interactions.CommandSpec{
+		Command: &discordgo.ApplicationCommand{
+			Name:        "updaterole",
+			Description: "Request a role for a user (Requires Editor role or higher)",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionUser,
+					Name:        "user",
+					Description: "The user to request a role for",
+					Required:    true,
+				},
+			},
+		},
+		RequiredPermission: interactions.EditorRequired,
+		RequiresSetup:      true,
+		IsMutating:         true,
+	}
+}
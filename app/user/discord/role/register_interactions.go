@@ -12,10 +12,10 @@ import (
 
 func RegisterHandlers(registry *interactions.Registry, manager RoleManager) {
 	// updaterole command requires Editor role or higher and guild setup
-	registry.RegisterMutatingHandler("updaterole", func(ctx context.Context, i *discordgo.InteractionCreate) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
 		slog.Info("Handling /updaterole command", attr.String("command_name", i.ApplicationCommandData().Name))
 		manager.HandleRoleRequestCommand(ctx, i)
-	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.EditorRequired, RequiresSetup: true})
+	})
 
 	// Role button interactions require Editor role or higher
 	registry.RegisterMutatingHandler("role_button_", func(ctx context.Context, i *discordgo.InteractionCreate) {
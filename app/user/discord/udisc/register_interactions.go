@@ -13,7 +13,7 @@ func RegisterUDiscInteractions(
 	manager UDiscManager,
 ) {
 	// Register slash command handler
-	registry.RegisterMutatingHandler("set-udisc-name", func(ctx context.Context, i *discordgo.InteractionCreate) {
+	registry.RegisterCommandHandler(CommandSpec(), func(ctx context.Context, i *discordgo.InteractionCreate) {
 		_, _ = manager.HandleSetUDiscNameCommand(ctx, i)
-	}, interactions.MutatingHandlerPolicy{RequiredPermission: interactions.PlayerRequired, RequiresSetup: true})
+	})
 }
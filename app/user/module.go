@@ -11,6 +11,7 @@ import (
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/interactions"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/shared/storage"
 	userdiscord "github.com/Black-And-White-Club/discord-frolf-bot/app/user/discord"
+	"github.com/Black-And-White-Club/discord-frolf-bot/app/user/discord/notifications"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/user/discord/role"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/user/discord/signup"
 	"github.com/Black-And-White-Club/discord-frolf-bot/app/user/discord/udisc"
@@ -55,6 +56,7 @@ func InitializeUserModule(
 	role.RegisterHandlers(interactionRegistry, userDiscord.GetRoleManager())
 	signup.RegisterHandlers(interactionRegistry, userDiscord.GetSignupManager())
 	udisc.RegisterUDiscInteractions(interactionRegistry, userDiscord.GetUDiscManager())
+	notifications.RegisterHandlers(interactionRegistry, notifications.NewNotificationsManager(session, eventBus, logger))
 
 	// Build Watermill Handlers
 	userHandlers := userhandlers.NewUserHandlers(
@@ -4,11 +4,19 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
+// defaultTagSwapTimeoutMinutes bounds how long a /tagswap prompt stays open.
+const defaultTagSwapTimeoutMinutes = 15
+
+// defaultLiveScoreHoles is how many holes the live scorekeeper offers.
+const defaultLiveScoreHoles = 18
+
 // Config represents the application configuration
 type Config struct {
 	NATS          NATSConfig          `yaml:"nats"`
@@ -18,6 +26,10 @@ type Config struct {
 	PWA           PWAConfig           `yaml:"pwa"`
 	DatabaseURL   string              `yaml:"database_url"` // PostgreSQL connection string
 
+	// BackendFeatures lists the backend features released to this bot; see
+	// BackendFeature.
+	BackendFeatures []BackendFeature `yaml:"backend_features"`
+
 	// Internal state management
 	mu       sync.RWMutex // For thread-safe access
 	isFromDB bool         // Track if config came from database
@@ -32,18 +44,22 @@ type NATSConfig struct {
 // Fields other than Token and AppID are treated as global defaults
 // and should be resolved per-guild via GuildConfigResolver whenever possible.
 type DiscordConfig struct {
-	Token                string            `yaml:"token"`
-	SignupChannelID      string            `yaml:"signup_channel_id"`      // Default only
-	SignupMessageID      string            `yaml:"signup_message_id"`      // Default only
-	SignupEmoji          string            `yaml:"signup_emoji"`           // Default only
-	RegisteredRoleID     string            `yaml:"registered_role_id"`     // Default only
-	EventChannelID       string            `yaml:"event_channel_id"`       // Default only
-	LeaderboardChannelID string            `yaml:"leaderboard_channel_id"` // Default only
-	GuildID              string            `yaml:"guild_id"`               // Default/Main guild only; deprecated for multi-tenant scoping
-	AppID                string            `yaml:"app_id"`
-	URL                  string            `yaml:"url"`
-	RoleMappings         map[string]string `yaml:"role_mappings"`
-	AdminRoleID          string            `yaml:"admin_role_id"` // Default only
+	Token                 string            `yaml:"token"`
+	SignupChannelID       string            `yaml:"signup_channel_id"`      // Default only
+	SignupMessageID       string            `yaml:"signup_message_id"`      // Default only
+	SignupEmoji           string            `yaml:"signup_emoji"`           // Default only
+	RegisteredRoleID      string            `yaml:"registered_role_id"`     // Default only
+	EventChannelID        string            `yaml:"event_channel_id"`       // Default only
+	LeaderboardChannelID  string            `yaml:"leaderboard_channel_id"` // Default only
+	GuildID               string            `yaml:"guild_id"`               // Default/Main guild only; deprecated for multi-tenant scoping
+	AppID                 string            `yaml:"app_id"`
+	URL                   string            `yaml:"url"`
+	RoleMappings          map[string]string `yaml:"role_mappings"`
+	AdminRoleID           string            `yaml:"admin_role_id"` // Default only
+	ShardID               int               `yaml:"shard_id"`
+	ShardCount            int               `yaml:"shard_count"`
+	TagSwapTimeoutMinutes int               `yaml:"tag_swap_timeout_minutes"` // How long a /tagswap prompt stays open
+	LiveScoreHoles        int               `yaml:"live_score_holes"`         // Holes the live scorekeeper offers
 }
 
 // ServiceConfig holds service metadata
@@ -71,6 +87,53 @@ type PWAConfig struct {
 	RequestTimeout int    `yaml:"request_timeout"` // Timeout in seconds for magic link requests
 }
 
+// BackendFeature names a feature whose backend requests are not part of the
+// shared contract yet. Its requests go to the feature's subject + "." +
+// guildID. Until the backend serves them and the feature is listed in
+// BACKEND_FEATURES, its commands are not registered and its code paths keep
+// their behaviour from before the feature.
+type BackendFeature string
+
+const (
+	BackendFeatureRoundLists       BackendFeature = "round_lists"
+	BackendFeatureSeasonLists      BackendFeature = "season_lists"
+	BackendFeatureRoundSeries      BackendFeature = "round_series"
+	BackendFeatureRoundTemplates   BackendFeature = "round_templates"
+	BackendFeatureGuildTimezone    BackendFeature = "guild_timezone"
+	BackendFeatureGuildReminders   BackendFeature = "guild_reminders"
+	BackendFeatureNotifications    BackendFeature = "notifications"
+	BackendFeatureScorecardImports BackendFeature = "scorecard_imports"
+	BackendFeatureUDiscIdentities  BackendFeature = "udisc_identities"
+	BackendFeatureAttestedScoring  BackendFeature = "attested_scoring"
+	BackendFeatureScoreAudit       BackendFeature = "score_audit"
+	BackendFeatureRoundReopen      BackendFeature = "round_reopen"
+)
+
+// BackendFeatureEnabled reports whether feature is released to this bot. A
+// nil config releases nothing.
+func (c *Config) BackendFeatureEnabled(feature BackendFeature) bool {
+	if c == nil {
+		return false
+	}
+	for _, released := range c.BackendFeatures {
+		if released == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// parseBackendFeatures reads a comma-separated BACKEND_FEATURES value.
+func parseBackendFeatures(value string) []BackendFeature {
+	var features []BackendFeature
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			features = append(features, BackendFeature(name))
+		}
+	}
+	return features
+}
+
 // LoadConfigFromEnvironment loads configuration from environment variables only
 func LoadConfigFromEnvironment() (*Config, error) {
 	cfg := &Config{}
@@ -94,6 +157,7 @@ func LoadConfigFromEnvironment() (*Config, error) {
 	// Set optional fields with defaults
 	cfg.NATS.URL = getEnvOrDefault("NATS_URL", "nats://localhost:4222")
 	cfg.DatabaseURL = os.Getenv("DATABASE_URL") // Can be empty
+	cfg.BackendFeatures = parseBackendFeatures(os.Getenv("BACKEND_FEATURES"))
 
 	// Discord optional fields
 	cfg.Discord.SignupChannelID = os.Getenv("DISCORD_SIGNUP_CHANNEL_ID")
@@ -105,6 +169,13 @@ func LoadConfigFromEnvironment() (*Config, error) {
 	cfg.Discord.AppID = os.Getenv("DISCORD_APP_ID")
 	cfg.Discord.URL = os.Getenv("DISCORD_URL")
 	cfg.Discord.AdminRoleID = os.Getenv("DISCORD_ADMIN_ROLE_ID")
+	cfg.Discord.ShardID = getIntEnvOrDefault("SHARD_ID", 0)
+	cfg.Discord.ShardCount = getIntEnvOrDefault("SHARD_COUNT", 1)
+	cfg.Discord.TagSwapTimeoutMinutes = getIntEnvOrDefault("TAG_SWAP_TIMEOUT_MINUTES", defaultTagSwapTimeoutMinutes)
+	cfg.Discord.LiveScoreHoles = getIntEnvOrDefault("LIVE_SCORE_HOLES", defaultLiveScoreHoles)
+	if err := cfg.Discord.validateSharding(); err != nil {
+		return nil, err
+	}
 
 	// Service config
 	cfg.Service.Name = getEnvOrDefault("SERVICE_NAME", "discord-frolf-bot")
@@ -147,9 +218,13 @@ func LoadBaseConfig() (*Config, error) {
 	// Initialize base config with defaults
 	config := &Config{
 		Discord: DiscordConfig{
-			Token:       getEnvOrError("DISCORD_TOKEN"),
-			AppID:       getEnvOrError("DISCORD_APP_ID"),
-			SignupEmoji: "🐍", // Default emoji
+			Token:                 getEnvOrError("DISCORD_TOKEN"),
+			AppID:                 getEnvOrError("DISCORD_APP_ID"),
+			SignupEmoji:           "🐍", // Default emoji
+			ShardID:               getIntEnvOrDefault("SHARD_ID", 0),
+			ShardCount:            getIntEnvOrDefault("SHARD_COUNT", 1),
+			TagSwapTimeoutMinutes: getIntEnvOrDefault("TAG_SWAP_TIMEOUT_MINUTES", defaultTagSwapTimeoutMinutes),
+			LiveScoreHoles:        getIntEnvOrDefault("LIVE_SCORE_HOLES", defaultLiveScoreHoles),
 			// Guild-specific fields will be populated from backend
 		},
 		Service: ServiceConfig{
@@ -174,6 +249,7 @@ func LoadBaseConfig() (*Config, error) {
 			BaseURL:        getEnvOrDefault("PWA_BASE_URL", "https://frolf-bot.duckdns.org"),
 			RequestTimeout: getIntEnvOrDefault("PWA_REQUEST_TIMEOUT", 5),
 		},
+		BackendFeatures: parseBackendFeatures(os.Getenv("BACKEND_FEATURES")),
 	}
 
 	// Parse float for sample rate
@@ -183,9 +259,42 @@ func LoadBaseConfig() (*Config, error) {
 		}
 	}
 
+	if err := config.Discord.validateSharding(); err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
 
+// TagSwapTimeout returns how long a /tagswap prompt may be answered, falling
+// back to the default when unset or non-positive.
+func (d *DiscordConfig) TagSwapTimeout() time.Duration {
+	if d.TagSwapTimeoutMinutes <= 0 {
+		return defaultTagSwapTimeoutMinutes * time.Minute
+	}
+	return time.Duration(d.TagSwapTimeoutMinutes) * time.Minute
+}
+
+// LiveScoreHoleCount returns how many holes the live scorekeeper offers,
+// falling back to the default when unset or non-positive.
+func (c *Config) LiveScoreHoleCount() int {
+	if c == nil || c.Discord.LiveScoreHoles <= 0 {
+		return defaultLiveScoreHoles
+	}
+	return c.Discord.LiveScoreHoles
+}
+
+// validateSharding checks that SHARD_ID falls within SHARD_COUNT.
+func (d *DiscordConfig) validateSharding() error {
+	if d.ShardCount < 1 {
+		return fmt.Errorf("SHARD_COUNT must be at least 1, got %d", d.ShardCount)
+	}
+	if d.ShardID < 0 || d.ShardID >= d.ShardCount {
+		return fmt.Errorf("SHARD_ID must be in [0, %d), got %d", d.ShardCount, d.ShardID)
+	}
+	return nil
+}
+
 // getEnvOrError returns environment variable value or returns an error if missing
 func getEnvOrError(key string) string {
 	if value := os.Getenv(key); value != "" {
@@ -256,6 +365,9 @@ func applyEnvironmentOverrides(cfg *Config) {
 	if dbURL := os.Getenv("DATABASE_URL"); dbURL != "" {
 		cfg.DatabaseURL = dbURL
 	}
+	if backendFeatures := os.Getenv("BACKEND_FEATURES"); backendFeatures != "" {
+		cfg.BackendFeatures = parseBackendFeatures(backendFeatures)
+	}
 
 	// Discord overrides
 	if signupChannelID := os.Getenv("DISCORD_SIGNUP_CHANNEL_ID"); signupChannelID != "" {
@@ -285,6 +397,26 @@ func applyEnvironmentOverrides(cfg *Config) {
 	if adminRoleID := os.Getenv("DISCORD_ADMIN_ROLE_ID"); adminRoleID != "" {
 		cfg.Discord.AdminRoleID = adminRoleID
 	}
+	if shardID := os.Getenv("SHARD_ID"); shardID != "" {
+		if id, err := strconv.Atoi(shardID); err == nil {
+			cfg.Discord.ShardID = id
+		}
+	}
+	if shardCount := os.Getenv("SHARD_COUNT"); shardCount != "" {
+		if count, err := strconv.Atoi(shardCount); err == nil {
+			cfg.Discord.ShardCount = count
+		}
+	}
+	if tagSwapTimeout := os.Getenv("TAG_SWAP_TIMEOUT_MINUTES"); tagSwapTimeout != "" {
+		if minutes, err := strconv.Atoi(tagSwapTimeout); err == nil {
+			cfg.Discord.TagSwapTimeoutMinutes = minutes
+		}
+	}
+	if liveScoreHoles := os.Getenv("LIVE_SCORE_HOLES"); liveScoreHoles != "" {
+		if holes, err := strconv.Atoi(liveScoreHoles); err == nil {
+			cfg.Discord.LiveScoreHoles = holes
+		}
+	}
 
 	// Service overrides
 	if serviceName := os.Getenv("SERVICE_NAME"); serviceName != "" {
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -27,6 +28,23 @@ import (
 // Version is set at build time via ldflags in CI.
 var Version = "dev"
 
+// healthResponse is the body of /health and /ready. Shards lists the gateway
+// state of every shard this process knows about.
+type healthResponse struct {
+	Status  string           `json:"status"`
+	Reason  string           `json:"reason,omitempty"`
+	Service string           `json:"service"`
+	Version string           `json:"version,omitempty"`
+	Mode    string           `json:"mode"`
+	Shards  []bot.ShardState `json:"shards"`
+}
+
+func writeHealthResponse(w http.ResponseWriter, status int, resp healthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 func runtimeServiceVersion(configured string) string {
 	// `configured` comes from config loading, but we still prefer a late env override
 	// so runtime and CI can stamp the deployed version without rewriting config files.
@@ -83,6 +101,20 @@ func main() {
 
 // runStandaloneMode runs the bot in single-pod mode (current behavior)
 func runStandaloneMode(ctx context.Context) error {
+	return runBot(ctx, bot.RuntimeModeStandalone)
+}
+
+// runGatewayMode owns the Discord websocket and forwards events to workers.
+func runGatewayMode(ctx context.Context) error {
+	return runBot(ctx, bot.RuntimeModeGateway)
+}
+
+// runWorkerMode consumes forwarded gateway events and runs the business logic.
+func runWorkerMode(ctx context.Context) error {
+	return runBot(ctx, bot.RuntimeModeWorker)
+}
+
+func runBot(ctx context.Context, mode bot.RuntimeMode) error {
 	var cfg *config.Config
 	var err error
 
@@ -113,7 +145,7 @@ func runStandaloneMode(ctx context.Context) error {
 	}
 
 	logger := obs.Provider.Logger
-	logger.Info("Observability initialized successfully")
+	logger.Info("Observability initialized successfully", attr.String("bot_mode", string(mode)))
 
 	// --- Central Storage Hub Initialization ---
 	// Initializing the shared storage container (Interaction Store + Guild Cache)
@@ -125,6 +157,13 @@ func runStandaloneMode(ctx context.Context) error {
 		return fmt.Errorf("failed to create Discord session: %w", err)
 	}
 
+	// Each gateway process owns one shard; Discord routes a guild's events to
+	// shard (guild_id >> 22) % SHARD_COUNT.
+	if mode != bot.RuntimeModeWorker {
+		discordSession.ShardID = cfg.Discord.ShardID
+		discordSession.ShardCount = cfg.Discord.ShardCount
+	}
+
 	discordSession.Identify.Intents = discordgo.IntentsGuilds |
 		discordgo.IntentsGuildMessages |
 		discordgo.IntentsGuildMessageReactions |
@@ -133,10 +172,27 @@ func runStandaloneMode(ctx context.Context) error {
 		discordgo.IntentGuildMembers |
 		discordgo.IntentDirectMessages
 
+	// Workers never open the websocket, so State.User is never populated by
+	// Ready. Fetch it over REST so bot-authored events can still be ignored.
+	if mode == bot.RuntimeModeWorker {
+		self, err := discordSession.User("@me")
+		if err != nil {
+			return fmt.Errorf("failed to fetch bot user: %w", err)
+		}
+		discordSession.State.User = self
+	}
+
 	discordSessionWrapper := discord.NewDiscordSession(discordSession, logger)
 
 	// --- Bot Initialization ---
-	discordBot, err := bot.NewDiscordBot(
+	newBot := bot.NewDiscordBot
+	switch mode {
+	case bot.RuntimeModeGateway:
+		newBot = bot.NewGatewayBot
+	case bot.RuntimeModeWorker:
+		newBot = bot.NewWorkerBot
+	}
+	discordBot, err := newBot(
 		discordSessionWrapper,
 		cfg,
 		logger,
@@ -171,32 +227,42 @@ func runStandaloneMode(ctx context.Context) error {
 	// --- Health Check Server ---
 	healthMux := http.NewServeMux()
 	healthMux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		resp := healthResponse{
+			Status:  "healthy",
+			Service: "discord-frolf-bot",
+			Version: cfg.Service.Version,
+			Mode:    string(mode),
+			Shards:  discordBot.ShardStates(),
+		}
 		if err := getRuntimeErr(); err != nil {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusServiceUnavailable)
-			fmt.Fprintf(w, `{"status":"unhealthy","reason":"runtime_failed","service":"discord-frolf-bot","version":"%s"}`, cfg.Service.Version)
+			resp.Status = "unhealthy"
+			resp.Reason = "runtime_failed"
+			writeHealthResponse(w, http.StatusServiceUnavailable, resp)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintf(w, `{"status":"healthy","service":"discord-frolf-bot","version":"%s"}`, cfg.Service.Version)
+		writeHealthResponse(w, http.StatusOK, resp)
 	})
 
 	healthMux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+		resp := healthResponse{
+			Status:  "ready",
+			Service: "discord-frolf-bot",
+			Mode:    string(mode),
+			Shards:  discordBot.ShardStates(),
+		}
 		if err := getRuntimeErr(); err != nil {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusServiceUnavailable)
-			fmt.Fprintf(w, `{"status":"not_ready","reason":"runtime_failed"}`)
+			resp.Status = "not_ready"
+			resp.Reason = "runtime_failed"
+			writeHealthResponse(w, http.StatusServiceUnavailable, resp)
 			return
 		}
 		if discordSession == nil || discordSession.State == nil || discordSession.State.User == nil {
-			w.WriteHeader(http.StatusServiceUnavailable)
-			fmt.Fprintf(w, `{"status":"not_ready","reason":"discord_session_not_ready"}`)
+			resp.Status = "not_ready"
+			resp.Reason = "discord_session_not_ready"
+			writeHealthResponse(w, http.StatusServiceUnavailable, resp)
 			return
 		}
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintf(w, `{"status":"ready","service":"discord-frolf-bot"}`)
+		writeHealthResponse(w, http.StatusOK, resp)
 	})
 
 	if os.Getenv("PPROF_ENABLED") == "true" {
@@ -317,12 +383,3 @@ func runSetup(_ context.Context, guildID string) error {
 
 	return fmt.Errorf("setup CLI mode is not supported in this build; run '/frolf-setup' in guild %s instead", guildID)
 }
-
-// runGatewayMode and runWorkerMode would follow a similar pattern once implemented...
-func runGatewayMode(_ context.Context) error {
-	return fmt.Errorf("BOT_MODE=gateway is not implemented; use standalone mode until gateway runtime is shipped")
-}
-
-func runWorkerMode(_ context.Context) error {
-	return fmt.Errorf("BOT_MODE=worker is not implemented; use standalone mode until worker runtime is shipped")
-}